@@ -13,9 +13,9 @@ import (
 	"postmatic-api/config"
 	"postmatic-api/internal"
 	"postmatic-api/internal/internal_middleware"
-	"postmatic-api/internal/module/headless/mailer"
 	"postmatic-api/internal/module/headless/queue"
 	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
 
 	"github.com/go-chi/chi/v5"
 	chiMw "github.com/go-chi/chi/v5/middleware"
@@ -25,6 +25,11 @@ import (
 func main() {
 	cfg := config.Load()
 
+	// client IP dari header forwarded hanya jika request datang dari reverse proxy yang dipercaya
+	if err := utils.SetTrustedProxies(cfg.TRUSTED_PROXIES); err != nil {
+		log.Fatal("Invalid TRUSTED_PROXIES: " + err.Error())
+	}
+
 	db, err := config.ConnectDB(cfg.DATABASE_URL)
 	if err != nil {
 		log.Fatal(err)
@@ -41,22 +46,44 @@ func main() {
 	asynqClient := config.NewAsynqClient(cfg)
 	defer asynqClient.Close()
 
+	// satu graph service untuk HTTP router & worker
+	svc := internal.NewServices(db, cfg, asynqClient, rdb)
+
 	// worker (dequeue)
-	mailerSvc := mailer.NewService(cfg)
 	asynqServer := config.NewAsynqServer(cfg, asynq.Config{
 		Concurrency: 10,
+		// exponential backoff untuk task deliver scheduled post
+		RetryDelayFunc: queue.RetryDelay,
 		Queues: map[string]int{
 			"default": 1,
 		},
 	})
 	go func() {
 		w := queue.NewWorker(asynqServer)
-		w.RegisterMailer(mailerSvc) // ✅ tanpa *
+		svc.RegisterWorker(w)
 		if err := w.Run(); err != nil {
 			log.Fatal(err)
 		}
 	}()
 
+	// scheduler (task periodik)
+	asynqScheduler := queue.NewScheduler(config.NewAsynqScheduler(cfg, nil))
+	if err := asynqScheduler.RegisterPaymentReconcile(cfg.PAYMENT_RECONCILE_INTERVAL, queue.ReconcilePendingPaymentsPayload{
+		PendingAfter: cfg.PAYMENT_RECONCILE_PENDING_AFTER,
+		Limit:        cfg.PAYMENT_RECONCILE_BATCH_SIZE,
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := asynqScheduler.RegisterScheduledPostSweep(cfg.SCHEDULED_POST_SWEEP_INTERVAL, queue.SweepStuckScheduledPostsPayload{
+		StuckAfter: cfg.SCHEDULED_POST_STUCK_AFTER,
+		Limit:      cfg.SCHEDULED_POST_SWEEP_BATCH_SIZE,
+	}); err != nil {
+		log.Fatal(err)
+	}
+	if err := asynqScheduler.Start(); err != nil {
+		log.Fatal(err)
+	}
+
 	// HTTP router root
 	r := chi.NewRouter()
 	r.Use(chiMw.RequestID)
@@ -64,8 +91,7 @@ func main() {
 	r.Use(chiMw.StripSlashes)
 	r.Use(chiMw.Recoverer)
 
-	// inject cfg + asynqClient + rdb
-	r.Mount("/api", internal.NewRouter(db, cfg, asynqClient, rdb))
+	r.Mount("/api", internal.NewRouter(svc, cfg))
 
 	srv := &http.Server{
 		Addr:    ":" + cfg.PORT,
@@ -94,5 +120,6 @@ func main() {
 	defer cancel()
 
 	_ = srv.Shutdown(ctx)
+	asynqScheduler.Shutdown()
 	asynqServer.Shutdown()
 }
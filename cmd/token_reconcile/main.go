@@ -0,0 +1,95 @@
+// cmd/token_reconcile/main.go
+// Admin command: hitung ulang saldo token per business & token type dari transaksi & reservation aktif,
+// laporkan drift terhadap snapshot, dan (opsional) perbaiki snapshot.
+//
+//	go run ./cmd/token_reconcile                 # report only
+//	go run ./cmd/token_reconcile -fix            # report + overwrite snapshot yang drift
+//	go run ./cmd/token_reconcile -release-expired=false
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"postmatic-api/config"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
+	"postmatic-api/internal/repository/entity"
+
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	_ = godotenv.Load()
+
+	var (
+		dsn            = flag.String("dsn", os.Getenv("DATABASE_URL"), "Postgres DSN (default: env DATABASE_URL)")
+		fix            = flag.Bool("fix", false, "Overwrite drifted balance snapshots with recomputed values")
+		releaseExpired = flag.Bool("release-expired", true, "Release reservations past expires_at before recomputing")
+		asJSON         = flag.Bool("json", false, "Print result as JSON")
+	)
+	flag.Parse()
+
+	if strings.TrimSpace(*dsn) == "" {
+		log.Fatal("DATABASE_URL empty. Set env DATABASE_URL or pass -dsn.")
+	}
+
+	db, err := config.ConnectDB(*dsn)
+	if err != nil {
+		log.Fatalf("connect db: %v", err)
+	}
+	defer func() { _ = db.Close() }()
+
+	svc := token_ledger_service.NewService(entity.NewStore(db))
+	drifts, err := svc.ReconcileBalances(context.Background(), token_ledger_service.ReconcileBalancesInput{
+		Fix:            *fix,
+		ReleaseExpired: *releaseExpired,
+	})
+	if err != nil {
+		log.Fatalf("reconcile: %v", err)
+	}
+
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(drifts); err != nil {
+			log.Fatalf("encode: %v", err)
+		}
+	} else {
+		printTable(drifts)
+	}
+
+	// exit code != 0 jika masih ada drift yang belum diperbaiki (untuk alert cron)
+	for _, d := range drifts {
+		if !d.Fixed {
+			os.Exit(2)
+		}
+	}
+}
+
+func printTable(drifts []token_ledger_service.BalanceDriftResponse) {
+	if len(drifts) == 0 {
+		fmt.Println("no drift: all token balance snapshots match transactions")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "BUSINESS\tTOKEN TYPE\tIN (exp/act)\tOUT (exp/act)\tRESERVED (exp/act)\tFIXED")
+	for _, d := range drifts {
+		fmt.Fprintf(w, "%d\t%s\t%d/%d\t%d/%d\t%d/%d\t%t\n",
+			d.BusinessRootID,
+			d.TokenType,
+			d.Expected.TotalIn, d.Actual.TotalIn,
+			d.Expected.TotalOut, d.Actual.TotalOut,
+			d.Expected.Reserved, d.Actual.Reserved,
+			d.Fixed,
+		)
+	}
+	_ = w.Flush()
+	fmt.Printf("%d balance(s) drifted\n", len(drifts))
+}
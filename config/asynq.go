@@ -22,3 +22,7 @@ func NewAsynqClient(cfg *Config) *asynq.Client {
 func NewAsynqServer(cfg *Config, asynqCfg asynq.Config) *asynq.Server {
 	return asynq.NewServer(AsynqRedisOpt(cfg), asynqCfg)
 }
+
+func NewAsynqScheduler(cfg *Config, opts *asynq.SchedulerOpts) *asynq.Scheduler {
+	return asynq.NewScheduler(AsynqRedisOpt(cfg), opts)
+}
@@ -4,6 +4,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -27,8 +28,12 @@ type Config struct {
 	AUTH_URL      string
 
 	// ROUTE
-	VERIFY_EMAIL_ROUTE  string
-	INVITE_MEMBER_ROUTE string
+	VERIFY_EMAIL_ROUTE       string
+	INVITE_MEMBER_ROUTE      string
+	RESET_PASSWORD_ROUTE     string
+	OWNERSHIP_TRANSFER_ROUTE string
+	TWO_FACTOR_ROUTE         string
+	EMAIL_CHANGE_ROUTE       string
 
 	// DATABASE
 	DATABASE_URL string
@@ -40,18 +45,30 @@ type Config struct {
 	REDIS_DB   int
 
 	// JWT
-	JWT_ACCESS_TOKEN_SECRET         string
-	JWT_REFRESH_TOKEN_SECRET        string
-	JWT_CREATE_ACCOUNT_TOKEN_SECRET string
-	JWT_INVITATION_TOKEN_SECRET     string
+	JWT_ACCESS_TOKEN_SECRET             string
+	JWT_REFRESH_TOKEN_SECRET            string
+	JWT_CREATE_ACCOUNT_TOKEN_SECRET     string
+	JWT_INVITATION_TOKEN_SECRET         string
+	JWT_RESET_PASSWORD_TOKEN_SECRET     string
+	JWT_OWNERSHIP_TRANSFER_TOKEN_SECRET string
+	JWT_TWO_FACTOR_CHALLENGE_SECRET     string
+	JWT_REAUTH_TOKEN_SECRET             string
+	JWT_EMAIL_CHANGE_TOKEN_SECRET       string
 
 	// TIME
-	JWT_ACCESS_TOKEN_EXPIRED         time.Duration // minutes
-	JWT_REFRESH_TOKEN_EXPIRED        time.Duration // days
-	JWT_REFRESH_TOKEN_RENEWAL        time.Duration // days
-	JWT_CREATE_ACCOUNT_TOKEN_EXPIRED time.Duration // minutes
-	JWT_INVITATION_TOKEN_EXPIRED     time.Duration // days
-	CAN_RESEND_EMAIL_AFTER           int64         // minutes
+	JWT_ACCESS_TOKEN_EXPIRED             time.Duration // minutes
+	JWT_REFRESH_TOKEN_EXPIRED            time.Duration // days
+	JWT_REFRESH_TOKEN_RENEWAL            time.Duration // days
+	JWT_REFRESH_TOKEN_REUSE_GRACE        time.Duration // seconds, token lama masih diterima setelah dirotasi (refresh bersamaan)
+	JWT_CREATE_ACCOUNT_TOKEN_EXPIRED     time.Duration // minutes
+	JWT_INVITATION_TOKEN_EXPIRED         time.Duration // days
+	JWT_RESET_PASSWORD_TOKEN_EXPIRED     time.Duration // minutes
+	JWT_OWNERSHIP_TRANSFER_TOKEN_EXPIRED time.Duration // days
+	JWT_TWO_FACTOR_CHALLENGE_EXPIRED     time.Duration // minutes
+	JWT_REAUTH_TOKEN_EXPIRED             time.Duration // minutes
+	JWT_EMAIL_CHANGE_TOKEN_EXPIRED       time.Duration // minutes, link konfirmasi ke email baru
+	JWT_EMAIL_CHANGE_REVERT_EXPIRED      time.Duration // days, link revert ke email lama
+	CAN_RESEND_EMAIL_AFTER               int64         // minutes
 
 	// SMTP
 	SMTP_HOST        string
@@ -88,10 +105,44 @@ type Config struct {
 	MIDTRANS_CLIENT_KEY    string
 	MIDTRANS_MERCHANT_ID   string
 	MIDTRANS_IS_PRODUCTION bool
+	// FAKE PAYMENT GATEWAY (kosong = nonaktif, jangan diisi di production)
+	PAYMENT_FAKE_GATEWAY_SECRET string
 
 	// AI PROVIDERS
 	GOOGLE_GENAI_API_KEY string
 	OPENAI_API_KEY       string
+	// GENERATIVE
+	GENERATIVE_IMAGE_TOKEN_COST int64 // token per generated image
+	// SOCIAL ACCOUNT
+	SOCIAL_ACCOUNT_SECRET       string // enkripsi credential OAuth + sign state
+	SOCIAL_ACCOUNT_REDIRECT_URL string
+	SOCIAL_OAUTH_PROVIDER       string   // provider OAuth akun sosial: "" (nonaktif) | fake
+	SOCIAL_OAUTH_FAKE_PLATFORMS []string // platform yang memakai fake provider, wajib jika SOCIAL_OAUTH_PROVIDER=fake
+	SOCIAL_PUBLISHER            string   // publisher scheduled post: memory | http_stub
+	SOCIAL_PUBLISHER_STUB_URL   string   // wajib jika SOCIAL_PUBLISHER=http_stub
+	// TWO FACTOR
+	TWO_FACTOR_SECRET       string // enkripsi TOTP secret
+	TWO_FACTOR_MAX_ATTEMPTS int64  // salah kode maksimal per window challenge
+	// LOGIN LIMITER (credential login)
+	LOGIN_LIMITER_WINDOW              time.Duration // minutes, sliding window percobaan gagal
+	LOGIN_LIMITER_FREE_ATTEMPTS       int64         // gagal per email sebelum progressive delay
+	LOGIN_LIMITER_MAX_DELAY           time.Duration // seconds, batas atas progressive delay
+	LOGIN_LIMITER_LOCKOUT_ATTEMPTS    int64         // gagal per email sampai lockout
+	LOGIN_LIMITER_LOCKOUT_DURATION    time.Duration // minutes
+	LOGIN_LIMITER_IP_LOCKOUT_ATTEMPTS int64         // gagal per client IP (semua email) sampai lockout
+
+	// CLIENT IP
+	TRUSTED_PROXIES []string // IP / CIDR reverse proxy yang header forwarded-nya dipercaya (kosong = RemoteAddr)
+	// PAYMENT RECONCILE
+	PAYMENT_RECONCILE_INTERVAL      time.Duration // minutes
+	PAYMENT_RECONCILE_PENDING_AFTER time.Duration // minutes
+	PAYMENT_RECONCILE_BATCH_SIZE    int32
+	// SCHEDULED POST SWEEP (post tertahan di status publishing)
+	SCHEDULED_POST_SWEEP_INTERVAL   time.Duration // minutes
+	SCHEDULED_POST_STUCK_AFTER      time.Duration // minutes, harus lebih lama dari seluruh retry task deliver
+	SCHEDULED_POST_SWEEP_BATCH_SIZE int32
+	// CREATOR MARKETPLACE
+	CREATOR_PLATFORM_COMMISSION_PERCENTAGE int64 // potongan platform dari setiap penjualan template (0-100)
 }
 
 func Load() *Config {
@@ -109,9 +160,12 @@ func Load() *Config {
 	jwtAccessTokenExpired, _ := strconv.Atoi(getEnv("JWT_ACCESS_TOKEN_EXPIRED"))
 	jwtRefreshTokenExpired, _ := strconv.Atoi(getEnv("JWT_REFRESH_TOKEN_EXPIRED"))
 	jwtRefreshTokenRenewal, _ := strconv.Atoi(getEnv("JWT_REFRESH_TOKEN_RENEWAL"))
+	jwtRefreshTokenReuseGrace := getEnvPositiveInt("JWT_REFRESH_TOKEN_REUSE_GRACE", 10)
 	jwtCreateAccountTokenExpired, _ := strconv.Atoi(getEnv("JWT_CREATE_ACCOUNT_TOKEN_EXPIRED"))
 	canResendEmailAfter, _ := strconv.Atoi(getEnv("CAN_RESEND_EMAIL_AFTER"))
 	jwtInvitationTokenExpired, _ := strconv.Atoi(getEnv("JWT_INVITATION_TOKEN_EXPIRED"))
+	jwtResetPasswordTokenExpired, _ := strconv.Atoi(getEnv("JWT_RESET_PASSWORD_TOKEN_EXPIRED"))
+	jwtOwnershipTransferTokenExpired, _ := strconv.Atoi(getEnv("JWT_OWNERSHIP_TRANSFER_TOKEN_EXPIRED"))
 
 	jwtAccessTokenExpiredDuration := time.Duration(jwtAccessTokenExpired) * time.Minute
 	jwtRefreshTokenExpiredDuration := time.Duration(jwtRefreshTokenExpired) * time.Hour * 24
@@ -119,6 +173,8 @@ func Load() *Config {
 	jwtCreateAccountTokenExpiredDuration := time.Duration(jwtCreateAccountTokenExpired) * time.Minute
 	canResendEmailAfterDuration := int64(canResendEmailAfter * 60)
 	jwtInvitationTokenExpiredDuration := time.Duration(jwtInvitationTokenExpired) * time.Hour * 24
+	jwtResetPasswordTokenExpiredDuration := time.Duration(jwtResetPasswordTokenExpired) * time.Minute
+	jwtOwnershipTransferTokenExpiredDuration := time.Duration(jwtOwnershipTransferTokenExpired) * time.Hour * 24
 
 	s3PresignExpiresInt, err := strconv.Atoi(getEnv("S3_PRESIGN_EXPIRES_SECONDS"))
 	if err != nil {
@@ -126,6 +182,76 @@ func Load() *Config {
 	}
 	s3PresignExpiresDuration := time.Duration(s3PresignExpiresInt) * time.Second
 
+	generativeImageTokenCostStr := getEnvOptional("GENERATIVE_IMAGE_TOKEN_COST", "")
+	if generativeImageTokenCostStr == "" {
+		generativeImageTokenCostStr = "1"
+	}
+	generativeImageTokenCost, err := strconv.ParseInt(generativeImageTokenCostStr, 10, 64)
+	if err != nil || generativeImageTokenCost <= 0 {
+		panic("ENV GENERATIVE_IMAGE_TOKEN_COST must be positive number")
+	}
+
+	jwtTwoFactorChallengeExpired := getEnvPositiveInt("JWT_TWO_FACTOR_CHALLENGE_EXPIRED", 5)
+	jwtReauthTokenExpired := getEnvPositiveInt("JWT_REAUTH_TOKEN_EXPIRED", 5)
+	jwtEmailChangeTokenExpired := getEnvPositiveInt("JWT_EMAIL_CHANGE_TOKEN_EXPIRED", 60)
+	jwtEmailChangeRevertExpired := getEnvPositiveInt("JWT_EMAIL_CHANGE_REVERT_EXPIRED", 7)
+	twoFactorMaxAttempts := getEnvPositiveInt("TWO_FACTOR_MAX_ATTEMPTS", 5)
+
+	loginLimiterWindow := getEnvPositiveInt("LOGIN_LIMITER_WINDOW", 15)
+	loginLimiterFreeAttempts := getEnvPositiveInt("LOGIN_LIMITER_FREE_ATTEMPTS", 3)
+	loginLimiterMaxDelay := getEnvPositiveInt("LOGIN_LIMITER_MAX_DELAY", 60)
+	loginLimiterLockoutAttempts := getEnvPositiveInt("LOGIN_LIMITER_LOCKOUT_ATTEMPTS", 10)
+	loginLimiterLockoutDuration := getEnvPositiveInt("LOGIN_LIMITER_LOCKOUT_DURATION", 30)
+	loginLimiterIPLockoutAttempts := getEnvPositiveInt("LOGIN_LIMITER_IP_LOCKOUT_ATTEMPTS", 50)
+	trustedProxies := getEnvList("TRUSTED_PROXIES")
+	if loginLimiterFreeAttempts >= loginLimiterLockoutAttempts || loginLimiterLockoutAttempts >= loginLimiterIPLockoutAttempts {
+		panic("ENV LOGIN_LIMITER_FREE_ATTEMPTS < LOGIN_LIMITER_LOCKOUT_ATTEMPTS < LOGIN_LIMITER_IP_LOCKOUT_ATTEMPTS is required")
+	}
+
+	paymentReconcileInterval := getEnvPositiveInt("PAYMENT_RECONCILE_INTERVAL", 5)
+	paymentReconcilePendingAfter := getEnvPositiveInt("PAYMENT_RECONCILE_PENDING_AFTER", 15)
+	paymentReconcileBatchSize := getEnvPositiveInt("PAYMENT_RECONCILE_BATCH_SIZE", 100)
+	// kosong = belum ada provider, connect akun sosial ditolak (SOCIAL_PLATFORM_OAUTH_NOT_SUPPORTED).
+	// fake hanya untuk development / testing, platform-nya wajib disebut eksplisit
+	socialOAuthProvider := getEnvOptional("SOCIAL_OAUTH_PROVIDER", "")
+	socialOAuthFakePlatforms := getEnvList("SOCIAL_OAUTH_FAKE_PLATFORMS")
+	switch socialOAuthProvider {
+	case "":
+	case "fake":
+		if getEnv("MODE") == "production" {
+			panic("ENV SOCIAL_OAUTH_PROVIDER=fake is not allowed when MODE=production")
+		}
+		if len(socialOAuthFakePlatforms) == 0 {
+			panic("ENV SOCIAL_OAUTH_FAKE_PLATFORMS is required when SOCIAL_OAUTH_PROVIDER=fake")
+		}
+	default:
+		panic("ENV SOCIAL_OAUTH_PROVIDER must be empty or one of: fake")
+	}
+	// wajib dipilih eksplisit, tanpa publisher scheduled post tidak boleh ditandai published
+	socialPublisher := getEnv("SOCIAL_PUBLISHER")
+	socialPublisherStubURL := getEnvOptional("SOCIAL_PUBLISHER_STUB_URL", "")
+	switch socialPublisher {
+	case "memory":
+	case "http_stub":
+		if socialPublisherStubURL == "" {
+			panic("ENV SOCIAL_PUBLISHER_STUB_URL is required when SOCIAL_PUBLISHER=http_stub")
+		}
+	default:
+		panic("ENV SOCIAL_PUBLISHER must be one of: memory, http_stub")
+	}
+	scheduledPostSweepInterval := getEnvPositiveInt("SCHEDULED_POST_SWEEP_INTERVAL", 10)
+	scheduledPostStuckAfter := getEnvPositiveInt("SCHEDULED_POST_STUCK_AFTER", 60)
+	scheduledPostSweepBatchSize := getEnvPositiveInt("SCHEDULED_POST_SWEEP_BATCH_SIZE", 100)
+
+	creatorCommissionStr := getEnvOptional("CREATOR_PLATFORM_COMMISSION_PERCENTAGE", "")
+	if creatorCommissionStr == "" {
+		creatorCommissionStr = "20"
+	}
+	creatorCommission, err := strconv.ParseInt(creatorCommissionStr, 10, 64)
+	if err != nil || creatorCommission < 0 || creatorCommission > 100 {
+		panic("ENV CREATOR_PLATFORM_COMMISSION_PERCENTAGE must be number between 0 and 100")
+	}
+
 	return &Config{
 		// COMMON
 		MODE:              getEnv("MODE"),
@@ -142,8 +268,12 @@ func Load() *Config {
 		AUTH_URL:      getEnv("AUTH_URL"),
 
 		// ROUTE
-		VERIFY_EMAIL_ROUTE:  getEnv("VERIFY_EMAIL_ROUTE"),
-		INVITE_MEMBER_ROUTE: getEnv("INVITE_MEMBER_ROUTE"),
+		VERIFY_EMAIL_ROUTE:       getEnv("VERIFY_EMAIL_ROUTE"),
+		INVITE_MEMBER_ROUTE:      getEnv("INVITE_MEMBER_ROUTE"),
+		RESET_PASSWORD_ROUTE:     getEnv("RESET_PASSWORD_ROUTE"),
+		OWNERSHIP_TRANSFER_ROUTE: getEnv("OWNERSHIP_TRANSFER_ROUTE"),
+		TWO_FACTOR_ROUTE:         getEnvOptional("TWO_FACTOR_ROUTE", "/two-factor"),
+		EMAIL_CHANGE_ROUTE:       getEnvOptional("EMAIL_CHANGE_ROUTE", "/email-change"),
 
 		// DATABASE
 		DATABASE_URL: getEnv("DATABASE_URL"),
@@ -155,18 +285,30 @@ func Load() *Config {
 		REDIS_DB:   redisDB,
 
 		// JWT
-		JWT_ACCESS_TOKEN_SECRET:         getEnv("JWT_ACCESS_TOKEN_SECRET"),
-		JWT_REFRESH_TOKEN_SECRET:        getEnv("JWT_REFRESH_TOKEN_SECRET"),
-		JWT_CREATE_ACCOUNT_TOKEN_SECRET: getEnv("JWT_CREATE_ACCOUNT_TOKEN_SECRET"),
-		JWT_INVITATION_TOKEN_SECRET:     getEnv("JWT_INVITATION_TOKEN_SECRET"),
+		JWT_ACCESS_TOKEN_SECRET:             getEnv("JWT_ACCESS_TOKEN_SECRET"),
+		JWT_REFRESH_TOKEN_SECRET:            getEnv("JWT_REFRESH_TOKEN_SECRET"),
+		JWT_CREATE_ACCOUNT_TOKEN_SECRET:     getEnv("JWT_CREATE_ACCOUNT_TOKEN_SECRET"),
+		JWT_INVITATION_TOKEN_SECRET:         getEnv("JWT_INVITATION_TOKEN_SECRET"),
+		JWT_RESET_PASSWORD_TOKEN_SECRET:     getEnv("JWT_RESET_PASSWORD_TOKEN_SECRET"),
+		JWT_OWNERSHIP_TRANSFER_TOKEN_SECRET: getEnv("JWT_OWNERSHIP_TRANSFER_TOKEN_SECRET"),
+		JWT_TWO_FACTOR_CHALLENGE_SECRET:     getEnv("JWT_TWO_FACTOR_CHALLENGE_SECRET"),
+		JWT_REAUTH_TOKEN_SECRET:             getEnv("JWT_REAUTH_TOKEN_SECRET"),
+		JWT_EMAIL_CHANGE_TOKEN_SECRET:       getEnv("JWT_EMAIL_CHANGE_TOKEN_SECRET"),
 
 		// TIME
-		JWT_ACCESS_TOKEN_EXPIRED:         jwtAccessTokenExpiredDuration,
-		JWT_REFRESH_TOKEN_EXPIRED:        jwtRefreshTokenExpiredDuration,
-		JWT_REFRESH_TOKEN_RENEWAL:        jwtRefreshTokenRenewalDuration,
-		JWT_CREATE_ACCOUNT_TOKEN_EXPIRED: jwtCreateAccountTokenExpiredDuration,
-		CAN_RESEND_EMAIL_AFTER:           canResendEmailAfterDuration,
-		JWT_INVITATION_TOKEN_EXPIRED:     jwtInvitationTokenExpiredDuration,
+		JWT_ACCESS_TOKEN_EXPIRED:             jwtAccessTokenExpiredDuration,
+		JWT_REFRESH_TOKEN_EXPIRED:            jwtRefreshTokenExpiredDuration,
+		JWT_REFRESH_TOKEN_RENEWAL:            jwtRefreshTokenRenewalDuration,
+		JWT_REFRESH_TOKEN_REUSE_GRACE:        time.Duration(jwtRefreshTokenReuseGrace) * time.Second,
+		JWT_CREATE_ACCOUNT_TOKEN_EXPIRED:     jwtCreateAccountTokenExpiredDuration,
+		CAN_RESEND_EMAIL_AFTER:               canResendEmailAfterDuration,
+		JWT_INVITATION_TOKEN_EXPIRED:         jwtInvitationTokenExpiredDuration,
+		JWT_RESET_PASSWORD_TOKEN_EXPIRED:     jwtResetPasswordTokenExpiredDuration,
+		JWT_OWNERSHIP_TRANSFER_TOKEN_EXPIRED: jwtOwnershipTransferTokenExpiredDuration,
+		JWT_TWO_FACTOR_CHALLENGE_EXPIRED:     time.Duration(jwtTwoFactorChallengeExpired) * time.Minute,
+		JWT_REAUTH_TOKEN_EXPIRED:             time.Duration(jwtReauthTokenExpired) * time.Minute,
+		JWT_EMAIL_CHANGE_TOKEN_EXPIRED:       time.Duration(jwtEmailChangeTokenExpired) * time.Minute,
+		JWT_EMAIL_CHANGE_REVERT_EXPIRED:      time.Duration(jwtEmailChangeRevertExpired) * 24 * time.Hour,
 
 		// SMTP
 		SMTP_HOST:        getEnv("SMTP_HOST"),
@@ -203,10 +345,44 @@ func Load() *Config {
 		MIDTRANS_CLIENT_KEY:    getEnv("MIDTRANS_CLIENT_KEY"),
 		MIDTRANS_MERCHANT_ID:   getEnv("MIDTRANS_MERCHANT_ID"),
 		MIDTRANS_IS_PRODUCTION: getEnvOptional("MIDTRANS_IS_PRODUCTION", "false") == "true",
+		// FAKE PAYMENT GATEWAY
+		PAYMENT_FAKE_GATEWAY_SECRET: getEnvOptional("PAYMENT_FAKE_GATEWAY_SECRET", ""),
 
 		// AI PROVIDERS
 		GOOGLE_GENAI_API_KEY: getEnv("GOOGLE_GENAI_API_KEY"),
 		OPENAI_API_KEY:       getEnv("OPENAI_API_KEY"),
+		// GENERATIVE
+		GENERATIVE_IMAGE_TOKEN_COST: generativeImageTokenCost,
+		// SOCIAL ACCOUNT
+		SOCIAL_ACCOUNT_SECRET:       getEnv("SOCIAL_ACCOUNT_SECRET"),
+		SOCIAL_ACCOUNT_REDIRECT_URL: getEnv("SOCIAL_ACCOUNT_REDIRECT_URL"),
+		SOCIAL_OAUTH_PROVIDER:       socialOAuthProvider,
+		SOCIAL_OAUTH_FAKE_PLATFORMS: socialOAuthFakePlatforms,
+		SOCIAL_PUBLISHER:            socialPublisher,
+		SOCIAL_PUBLISHER_STUB_URL:   socialPublisherStubURL,
+		// TWO FACTOR
+		TWO_FACTOR_SECRET:       getEnv("TWO_FACTOR_SECRET"),
+		TWO_FACTOR_MAX_ATTEMPTS: int64(twoFactorMaxAttempts),
+		// LOGIN LIMITER
+		LOGIN_LIMITER_WINDOW:              time.Duration(loginLimiterWindow) * time.Minute,
+		LOGIN_LIMITER_FREE_ATTEMPTS:       int64(loginLimiterFreeAttempts),
+		LOGIN_LIMITER_MAX_DELAY:           time.Duration(loginLimiterMaxDelay) * time.Second,
+		LOGIN_LIMITER_LOCKOUT_ATTEMPTS:    int64(loginLimiterLockoutAttempts),
+		LOGIN_LIMITER_LOCKOUT_DURATION:    time.Duration(loginLimiterLockoutDuration) * time.Minute,
+		LOGIN_LIMITER_IP_LOCKOUT_ATTEMPTS: int64(loginLimiterIPLockoutAttempts),
+
+		// CLIENT IP
+		TRUSTED_PROXIES: trustedProxies,
+		// PAYMENT RECONCILE
+		PAYMENT_RECONCILE_INTERVAL:      time.Duration(paymentReconcileInterval) * time.Minute,
+		PAYMENT_RECONCILE_PENDING_AFTER: time.Duration(paymentReconcilePendingAfter) * time.Minute,
+		PAYMENT_RECONCILE_BATCH_SIZE:    int32(paymentReconcileBatchSize),
+		// SCHEDULED POST SWEEP
+		SCHEDULED_POST_SWEEP_INTERVAL:   time.Duration(scheduledPostSweepInterval) * time.Minute,
+		SCHEDULED_POST_STUCK_AFTER:      time.Duration(scheduledPostStuckAfter) * time.Minute,
+		SCHEDULED_POST_SWEEP_BATCH_SIZE: int32(scheduledPostSweepBatchSize),
+		// CREATOR MARKETPLACE
+		CREATOR_PLATFORM_COMMISSION_PERCENTAGE: creatorCommission,
 	}
 }
 
@@ -223,3 +399,27 @@ func getEnvOptional(key string, def string) string {
 	}
 	return def
 }
+
+// getEnvPositiveInt: env opsional berupa angka positif, kosong = def
+func getEnvPositiveInt(key string, def int) int {
+	value := getEnvOptional(key, "")
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		panic("ENV " + key + " must be positive number")
+	}
+	return n
+}
+
+// getEnvList: env opsional berupa daftar dipisah koma, kosong = nil
+func getEnvList(key string) []string {
+	var res []string
+	for _, item := range strings.Split(getEnvOptional(key, ""), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			res = append(res, item)
+		}
+	}
+	return res
+}
@@ -15,6 +15,7 @@ import (
 	"postmatic-api/pkg/response"
 
 	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
 )
 
 type contextOwnedBusinessKey string
@@ -54,67 +55,67 @@ func (o *OwnedBusiness) OwnedBusinessMiddleware(next http.Handler) http.Handler
 			return
 		}
 
-		// 1) cek redis dulu
-		list, err := o.repo.GetOwnedBusinessByProfileID(r.Context(), prof.ID)
+		business, err := o.resolve(r.Context(), prof.ID, intBusinessId)
 		if err != nil {
-			// redis error => boleh fallback DB (recommended) atau return 500
-			// aku fallback DB biar lebih resilient:
-			list = nil
-		} else {
-			for _, v := range list {
-				if v.BusinessRootID == intBusinessId {
-					ctx := context.WithValue(r.Context(), OwnedBusinessContextKey, &OwnedBusinessContext{
-						MemberID:       v.MemberID,
-						BusinessRootID: v.BusinessRootID,
-						Role:           v.Role,
-					})
-					next.ServeHTTP(w, r.WithContext(ctx))
-					return
-				}
-			}
-		}
-
-		// 2) kalau redis kosong / tidak ada businessId tsb => cek DB
-		dbMember, err := o.store.GetMemberByProfileIdAndBusinessRootId(r.Context(),
-			entity.GetMemberByProfileIdAndBusinessRootIdParams{
-				ProfileID:      prof.ID,
-				BusinessRootID: intBusinessId,
-			},
-		)
-
-		if err == sql.ErrNoRows {
-			fmt.Println("err == sql.ErrNoRows")
-			// kamu bilang: kalau tidak ditemukan => forbidden karena bukan member
-			response.Error(w, r, errs.NewForbidden("FORBIDDEN"), nil)
+			response.Error(w, r, err, nil)
 			return
 		}
 
-		if dbMember.Status != entity.BusinessMemberStatusAccepted {
-			fmt.Println("dbMember.Status != entity.BusinessMemberStatusAccepted")
-			response.Error(w, r, errs.NewForbidden("FORBIDDEN"), nil)
-			return
-		}
+		ctx := context.WithValue(r.Context(), OwnedBusinessContextKey, business)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
 
-		if err != nil {
-			response.Error(w, r, errs.NewInternalServerError(err), nil)
-			return
+// resolve mencari membership (accepted) profile pada business: redis dulu, fallback DB
+func (o *OwnedBusiness) resolve(ctx context.Context, profileID uuid.UUID, businessRootID int64) (*OwnedBusinessContext, error) {
+	// 1) cek redis dulu
+	list, err := o.repo.GetOwnedBusinessByProfileID(ctx, profileID)
+	if err == nil {
+		for _, v := range list {
+			if v.BusinessRootID == businessRootID {
+				return &OwnedBusinessContext{
+					MemberID:       v.MemberID,
+					BusinessRootID: v.BusinessRootID,
+					Role:           v.Role,
+				}, nil
+			}
 		}
+	}
+	// redis error => fallback DB biar lebih resilient
+
+	// 2) kalau redis kosong / tidak ada businessId tsb => cek DB
+	dbMember, err := o.store.GetMemberByProfileIdAndBusinessRootId(ctx,
+		entity.GetMemberByProfileIdAndBusinessRootIdParams{
+			ProfileID:      profileID,
+			BusinessRootID: businessRootID,
+		},
+	)
+
+	if err == sql.ErrNoRows {
+		// kalau tidak ditemukan => forbidden karena bukan member
+		return nil, errs.NewForbidden("FORBIDDEN")
+	}
 
-		// 3) upsert ke redis (best-effort; kalau gagal jangan block request)
-		_ = o.repo.UpsertOneBusiness(r.Context(), prof.ID, ownedBusinessRepo.RedisBusinessSub{
-			MemberID:       dbMember.ID,
-			BusinessRootID: dbMember.BusinessRootID,
-			Role:           dbMember.Role,
-		}, o.ttl)
-
-		// 4) set context dan lanjut
-		ctx := context.WithValue(r.Context(), OwnedBusinessContextKey, &OwnedBusinessContext{
-			MemberID:       dbMember.ID,
-			BusinessRootID: dbMember.BusinessRootID,
-			Role:           dbMember.Role,
-		})
-		next.ServeHTTP(w, r.WithContext(ctx))
-	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	if dbMember.Status != entity.BusinessMemberStatusAccepted {
+		return nil, errs.NewForbidden("FORBIDDEN")
+	}
+
+	// 3) upsert ke redis (best-effort; kalau gagal jangan block request)
+	_ = o.repo.UpsertOneBusiness(ctx, profileID, ownedBusinessRepo.RedisBusinessSub{
+		MemberID:       dbMember.ID,
+		BusinessRootID: dbMember.BusinessRootID,
+		Role:           dbMember.Role,
+	}, o.ttl)
+
+	return &OwnedBusinessContext{
+		MemberID:       dbMember.ID,
+		BusinessRootID: dbMember.BusinessRootID,
+		Role:           dbMember.Role,
+	}, nil
 }
 
 type OwnedBusinessContext struct {
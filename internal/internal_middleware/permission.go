@@ -0,0 +1,121 @@
+// internal/internal_middleware/permission.go
+package internal_middleware
+
+import (
+	"context"
+	"net/http"
+	"sort"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/response"
+
+	"github.com/google/uuid"
+)
+
+// Permission adalah aksi pada resource business (format "resource:action")
+type Permission string
+
+const (
+	// BUSINESS
+	PermBusinessRead     Permission = "business:read"
+	PermBusinessDelete   Permission = "business:delete"
+	PermBusinessTransfer Permission = "business:transfer_ownership"
+	// knowledge, role, timezone pref, rss subscription
+	PermSettingsWrite Permission = "settings:write"
+	PermProductWrite  Permission = "product:write"
+	// CONTENT
+	PermContentWrite    Permission = "content:write"
+	PermContentGenerate Permission = "content:generate"
+	PermContentPublish  Permission = "content:publish"
+	PermSocialAccount   Permission = "social_account:manage"
+	// MEMBERS
+	PermMembersRead   Permission = "members:read"
+	PermMembersInvite Permission = "members:invite"
+	PermMembersManage Permission = "members:manage"
+	// BILLING
+	PermBillingRead     Permission = "billing:read"
+	PermBillingPurchase Permission = "billing:purchase"
+	// AUDIT
+	PermAuditRead Permission = "audit:read"
+)
+
+var (
+	allRoles        = []entity.BusinessMemberRole{entity.BusinessMemberRoleOwner, entity.BusinessMemberRoleAdmin, entity.BusinessMemberRoleMember}
+	ownerAndAdmin   = []entity.BusinessMemberRole{entity.BusinessMemberRoleOwner, entity.BusinessMemberRoleAdmin}
+	ownerOnly       = []entity.BusinessMemberRole{entity.BusinessMemberRoleOwner}
+	permissionRoles = map[Permission][]entity.BusinessMemberRole{
+		PermBusinessRead:     allRoles,
+		PermBusinessDelete:   ownerOnly,
+		PermBusinessTransfer: ownerOnly,
+		PermSettingsWrite:    ownerAndAdmin,
+		PermProductWrite:     ownerAndAdmin,
+		PermContentWrite:     allRoles,
+		PermContentGenerate:  allRoles,
+		PermContentPublish:   ownerAndAdmin,
+		PermSocialAccount:    ownerAndAdmin,
+		PermMembersRead:      allRoles,
+		PermMembersInvite:    ownerAndAdmin,
+		PermMembersManage:    ownerOnly,
+		PermBillingRead:      ownerAndAdmin,
+		PermBillingPurchase:  ownerAndAdmin,
+		PermAuditRead:        ownerAndAdmin,
+	}
+)
+
+type RolePermissionsResponse struct {
+	Role        entity.BusinessMemberRole `json:"role"`
+	Permissions []Permission              `json:"permissions"`
+}
+
+// RoleHasPermission cek apakah role member punya permission (permission tidak terdaftar = ditolak)
+func RoleHasPermission(role entity.BusinessMemberRole, perm Permission) bool {
+	for _, r := range permissionRoles[perm] {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// PermissionsOfRole mengembalikan semua permission yang dimiliki role
+func PermissionsOfRole(role entity.BusinessMemberRole) []Permission {
+	perms := make([]Permission, 0, len(permissionRoles))
+	for perm := range permissionRoles {
+		if RoleHasPermission(role, perm) {
+			perms = append(perms, perm)
+		}
+	}
+	sort.Slice(perms, func(i, j int) bool { return perms[i] < perms[j] })
+	return perms
+}
+
+// RequirePermission dipasang SETELAH OwnedBusinessMiddleware (butuh OwnedBusinessContext)
+func (o *OwnedBusiness) RequirePermission(perm Permission) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			business, err := OwnedBusinessFromContext(r.Context())
+			if err != nil {
+				response.Error(w, r, err, nil)
+				return
+			}
+			if !RoleHasPermission(business.Role, perm) {
+				response.Error(w, r, errs.NewForbidden("INSUFFICIENT_PERMISSION"), nil)
+				return
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// AuthorizeBusiness dipakai route yang businessId-nya tidak ada di URL (mis. body / query)
+func (o *OwnedBusiness) AuthorizeBusiness(ctx context.Context, profileID uuid.UUID, businessRootID int64, perm Permission) (*OwnedBusinessContext, error) {
+	business, err := o.resolve(ctx, profileID, businessRootID)
+	if err != nil {
+		return nil, err
+	}
+	if !RoleHasPermission(business.Role, perm) {
+		return nil, errs.NewForbidden("INSUFFICIENT_PERMISSION")
+	}
+	return business, nil
+}
@@ -24,11 +24,15 @@ func (h *Handler) Routes() chi.Router {
 	r := chi.NewRouter()
 
 	r.Post("/login", h.LoginCredential)
+	r.Post("/two-factor", h.VerifyTwoFactor)
 	r.Post("/register", h.Register)
 	r.Post("/refresh-token", h.RefreshToken)
 	r.Get("/verify/{createAccountToken}", h.CheckVerifyToken)
 	r.Post("/verify/{createAccountToken}", h.SubmitVerifyToken)
 	r.Post("/resend-email-verification", h.ResendEmailVerification)
+	r.Post("/forgot-password", h.RequestResetPassword)
+	r.Get("/reset-password/{resetPasswordToken}", h.CheckResetPasswordToken)
+	r.Post("/reset-password/{resetPasswordToken}", h.ResetPassword)
 
 	return r
 }
@@ -54,6 +58,35 @@ func (h *Handler) LoginCredential(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// 2FA aktif: belum ada session, FE lanjut ke POST /two-factor
+	if res.TwoFactorRequired {
+		response.OK(w, r, "TWO_FACTOR_REQUIRED", res)
+		return
+	}
+
+	SetAuthCookies(w, r, h.cfg, res.AccessToken, res.RefreshToken)
+
+	response.OK(w, r, "LOGIN_SUCCESS", res)
+}
+
+func (h *Handler) VerifyTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req auth_service.VerifyTwoFactorInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	sessionInput := auth_service.SessionInput{
+		DeviceInfo: utils.ExtractClientInfo(r),
+	}
+	res, err := h.authSvc.VerifyTwoFactor(r.Context(), req, sessionInput)
+
+	if err != nil {
+		response.Error(w, r, err, res)
+		return
+	}
+
 	SetAuthCookies(w, r, h.cfg, res.AccessToken, res.RefreshToken)
 
 	response.OK(w, r, "LOGIN_SUCCESS", res)
@@ -87,13 +120,19 @@ func (h *Handler) RefreshToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := h.authSvc.RefreshToken(r.Context(), req)
+	sessionInput := auth_service.SessionInput{
+		DeviceInfo: utils.ExtractClientInfo(r),
+	}
+	res, err := h.authSvc.RefreshToken(r.Context(), req, sessionInput)
 
 	if err != nil {
 		response.Error(w, r, err, nil)
 		return
 	}
 
+	// refresh token selalu dirotasi, cookie ikut diperbarui
+	SetAuthCookies(w, r, h.cfg, res.AccessToken, res.RefreshToken)
+
 	response.OK(w, r, "REFRESH_TOKEN_SUCCESS", res)
 }
 
@@ -133,6 +172,11 @@ func (h *Handler) SubmitVerifyToken(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if res.TwoFactorRequired {
+		response.OK(w, r, "TWO_FACTOR_REQUIRED", res)
+		return
+	}
+
 	SetAuthCookies(w, r, h.cfg, res.AccessToken, res.RefreshToken)
 
 	response.OK(w, r, "SUBMIT_VERIFY_TOKEN_SUCCESS", res)
@@ -156,3 +200,53 @@ func (h *Handler) ResendEmailVerification(w http.ResponseWriter, r *http.Request
 
 	response.OK(w, r, "RESEND_EMAIL_VERIFICATION_SUCCESS", res)
 }
+
+func (h *Handler) RequestResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req auth_service.RequestResetPasswordInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	res, err := h.authSvc.RequestResetPassword(r.Context(), req)
+
+	if err != nil {
+		response.Error(w, r, err, res)
+		return
+	}
+
+	response.OK(w, r, "REQUEST_RESET_PASSWORD_SUCCESS", res)
+}
+
+func (h *Handler) CheckResetPasswordToken(w http.ResponseWriter, r *http.Request) {
+	resetPasswordToken := chi.URLParam(r, "resetPasswordToken")
+
+	res, err := h.authSvc.CheckResetPasswordToken(r.Context(), resetPasswordToken)
+
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "CHECK_RESET_PASSWORD_TOKEN_SUCCESS", res)
+}
+
+func (h *Handler) ResetPassword(w http.ResponseWriter, r *http.Request) {
+	var req auth_service.ResetPasswordInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.Token = chi.URLParam(r, "resetPasswordToken")
+
+	res, err := h.authSvc.ResetPassword(r.Context(), req)
+
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "RESET_PASSWORD_SUCCESS", res)
+}
@@ -0,0 +1,61 @@
+// internal/module/account/auth/handler/login_limiter.go
+package auth_handler
+
+import (
+	"net/http"
+
+	"postmatic-api/internal/internal_middleware"
+	auth_service "postmatic-api/internal/module/account/auth/service"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+// LoginLimiterRoutes: endpoint admin (router memasang middleware adminOnly)
+func (h *Handler) LoginLimiterRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", h.GetLoginLimiterStatus)
+	r.Post("/unlock", h.UnlockLogin)
+
+	return r
+}
+
+func (h *Handler) GetLoginLimiterStatus(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	req := auth_service.LoginLimiterInput{
+		Email:    q.Get("email"),
+		ClientIP: q.Get("clientIp"),
+	}
+
+	res, err := h.authSvc.GetLoginLimiterStatus(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GET_LOGIN_LIMITER_SUCCESS", res)
+}
+
+func (h *Handler) UnlockLogin(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req auth_service.LoginLimiterInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	res, err := h.authSvc.UnlockLogin(r.Context(), req, prof.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "UNLOCK_LOGIN_SUCCESS", res)
+}
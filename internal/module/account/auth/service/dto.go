@@ -22,6 +22,12 @@ type RefreshTokenInput struct {
 	RefreshToken string `json:"refreshToken" validate:"required"`
 }
 
+type VerifyTwoFactorInput struct {
+	ChallengeToken string `json:"challengeToken" validate:"required"`
+	// kode TOTP 6 digit atau recovery code
+	Code string `json:"code" validate:"required,max=20"`
+}
+
 type SessionInput struct {
 	DeviceInfo utils.ClientInfo
 }
@@ -35,3 +41,26 @@ type SubmitVerifyTokenInput struct {
 	Token string `json:"token" validate:"required"`
 	From  string `json:"from" validate:"required"`
 }
+
+type RequestResetPasswordInput struct {
+	From  string `json:"from" validate:"required"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+type ResetPasswordInput struct {
+	Token    string
+	Password string `json:"password" validate:"required,min=6"`
+}
+
+// LoginLimiterInput: admin cek / unlock login limiter, minimal salah satu diisi
+type LoginLimiterInput struct {
+	Email    string `json:"email" validate:"omitempty,email"`
+	ClientIP string `json:"clientIp" validate:"omitempty,ip"`
+}
+
+type ReauthenticateInput struct {
+	// wajib jika profile punya password, profile tanpa password cukup kode 2FA (atau re-auth google)
+	Password string `json:"password" validate:"omitempty"`
+	// wajib jika 2FA aktif: kode TOTP atau recovery code
+	Code string `json:"code" validate:"omitempty,max=20"`
+}
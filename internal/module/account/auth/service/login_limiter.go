@@ -0,0 +1,224 @@
+// internal/module/account/auth/service/login_limiter.go
+package auth_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/repository/entity"
+	loginLimiterRepo "postmatic-api/internal/repository/redis/login_limiter_repository"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// loginLimiterInputs: percobaan login dihitung per email & per client IP
+func loginLimiterInputs(email, clientIP string) []loginLimiterRepo.LimiterLoginInput {
+	inputs := []loginLimiterRepo.LimiterLoginInput{{
+		Scope:      loginLimiterRepo.LimiterLoginScopeEmail,
+		Identifier: normalizeLoginEmail(email),
+	}}
+	if clientIP != "" {
+		inputs = append(inputs, loginLimiterRepo.LimiterLoginInput{
+			Scope:      loginLimiterRepo.LimiterLoginScopeIP,
+			Identifier: clientIP,
+		})
+	}
+	return inputs
+}
+
+func normalizeLoginEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// checkLoginLimiter: ACCOUNT_LOCKED jika email / IP terkunci, PLEASE_WAIT jika progressive delay masih berjalan.
+// retryAfter dalam detik. Redis error tidak memblokir login (fail open).
+func (s *AuthService) checkLoginLimiter(ctx context.Context, email, clientIP string) (int64, error) {
+	var lockedSeconds, delaySeconds int64
+
+	for _, in := range loginLimiterInputs(email, clientIP) {
+		state, err := s.loginLimiterRepo.GetLimiterLogin(ctx, in, s.cfg.LOGIN_LIMITER_WINDOW)
+		if err != nil {
+			logger.From(ctx).Warn("failed to get login limiter", "scope", in.Scope, "err", err)
+			continue
+		}
+		lockedSeconds = max(lockedSeconds, state.LockedSeconds)
+		delaySeconds = max(delaySeconds, state.DelaySeconds)
+	}
+
+	if lockedSeconds > 0 {
+		return lockedSeconds, errs.NewBadRequest("ACCOUNT_LOCKED")
+	}
+	if delaySeconds > 0 {
+		return delaySeconds, errs.NewBadRequest("PLEASE_WAIT")
+	}
+	return 0, nil
+}
+
+// recordLoginFailure mencatat login gagal untuk email & IP, lalu memasang delay / lockout.
+// Mengembalikan retryAfter (detik) untuk percobaan berikutnya & apakah percobaan ini memicu lockout.
+// profile nil jika email tidak terdaftar: tetap dihitung sehingga delay / lockout sama dengan email terdaftar.
+func (s *AuthService) recordLoginFailure(ctx context.Context, email string, session SessionInput, profile *entity.Profile) (int64, bool) {
+	var retryAfter int64
+	locked := false
+
+	for _, in := range loginLimiterInputs(email, session.DeviceInfo.ClientIP) {
+		failures, err := s.loginLimiterRepo.RecordFailure(ctx, in, s.cfg.LOGIN_LIMITER_WINDOW)
+		if err != nil {
+			logger.From(ctx).Warn("failed to record login failure", "scope", in.Scope, "err", err)
+			continue
+		}
+
+		// threshold per scope: IP menampung banyak email, jadi batasnya lebih longgar
+		freeAttempts, lockoutAttempts := s.cfg.LOGIN_LIMITER_FREE_ATTEMPTS, s.cfg.LOGIN_LIMITER_LOCKOUT_ATTEMPTS
+		if in.Scope == loginLimiterRepo.LimiterLoginScopeIP {
+			freeAttempts, lockoutAttempts = s.cfg.LOGIN_LIMITER_LOCKOUT_ATTEMPTS, s.cfg.LOGIN_LIMITER_IP_LOCKOUT_ATTEMPTS
+		}
+
+		if failures >= lockoutAttempts {
+			lockDuration := s.cfg.LOGIN_LIMITER_LOCKOUT_DURATION
+			newlyLocked, err := s.loginLimiterRepo.SaveLock(ctx, in, lockDuration)
+			if err != nil {
+				logger.From(ctx).Warn("failed to save login lock", "scope", in.Scope, "err", err)
+				continue
+			}
+			locked = true
+			retryAfter = max(retryAfter, int64(lockDuration.Seconds()))
+
+			if newlyLocked {
+				logger.From(ctx).Warn("login locked", "scope", in.Scope, "identifier", in.Identifier, "failures", failures)
+				if in.Scope == loginLimiterRepo.LimiterLoginScopeEmail && profile != nil {
+					s.enqueueAccountLocked(ctx, *profile, session, lockDuration)
+				}
+			}
+			continue
+		}
+
+		if failures > freeAttempts {
+			delay := loginDelay(failures-freeAttempts, s.cfg.LOGIN_LIMITER_MAX_DELAY)
+			if err := s.loginLimiterRepo.SaveDelay(ctx, in, delay); err != nil {
+				logger.From(ctx).Warn("failed to save login delay", "scope", in.Scope, "err", err)
+				continue
+			}
+			retryAfter = max(retryAfter, int64(delay.Seconds()))
+		}
+	}
+
+	return retryAfter, locked
+}
+
+// resetLoginLimiter dipanggil setelah password benar. Hanya scope email, counter IP tetap berjalan.
+func (s *AuthService) resetLoginLimiter(ctx context.Context, email string) {
+	err := s.loginLimiterRepo.DeleteLimiterLogin(ctx, loginLimiterRepo.LimiterLoginInput{
+		Scope:      loginLimiterRepo.LimiterLoginScopeEmail,
+		Identifier: normalizeLoginEmail(email),
+	})
+	if err != nil {
+		logger.From(ctx).Warn("failed to reset login limiter", "err", err)
+	}
+}
+
+func (s *AuthService) enqueueAccountLocked(ctx context.Context, profile entity.Profile, session SessionInput, lockDuration time.Duration) {
+	ctxQ, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+
+	err := s.queue.EnqueueAccountLocked(ctxQ, mailer.AccountLockedInputDTO{
+		Name:          profile.Name,
+		To:            profile.Email,
+		ClientIP:      session.DeviceInfo.ClientIP,
+		Device:        session.DeviceInfo.Browser + " - " + session.DeviceInfo.Platform,
+		LockedMinutes: int64(lockDuration.Minutes()),
+		LockedUntil:   time.Now().Add(lockDuration),
+	})
+	if err != nil {
+		logger.From(ctx).Warn("enqueue account locked failed", "err", err)
+	}
+}
+
+// loginDelay: progressive delay 1s, 2s, 4s, ... dibatasi maxDelay
+func loginDelay(excess int64, maxDelay time.Duration) time.Duration {
+	if excess > 30 {
+		return maxDelay
+	}
+	return min(time.Duration(1<<(excess-1))*time.Second, maxDelay)
+}
+
+// loginFailed mencatat percobaan gagal lalu mengembalikan error login beserta retryAfter.
+// Jika percobaan ini memicu lockout, error menjadi ACCOUNT_LOCKED.
+func (s *AuthService) loginFailed(ctx context.Context, email string, session SessionInput, profile *entity.Profile, code string) (LoginResponse, error) {
+	retryAfter, locked := s.recordLoginFailure(ctx, email, session, profile)
+	res := LoginResponse{
+		Email:      email,
+		RetryAfter: retryAfter,
+	}
+	if locked {
+		return res, errs.NewBadRequest("ACCOUNT_LOCKED")
+	}
+	return res, errs.NewUnauthorized(code)
+}
+
+// GetLoginLimiterStatus (admin) melihat counter, delay & lockout untuk email dan / atau client IP
+func (s *AuthService) GetLoginLimiterStatus(ctx context.Context, input LoginLimiterInput) (LoginLimiterStatusResponse, error) {
+	if input.Email == "" && input.ClientIP == "" {
+		return LoginLimiterStatusResponse{}, errs.NewBadRequest("EMAIL_OR_CLIENT_IP_REQUIRED")
+	}
+
+	var res LoginLimiterStatusResponse
+	for _, in := range adminLoginLimiterInputs(input) {
+		state, err := s.loginLimiterRepo.GetLimiterLogin(ctx, in, s.cfg.LOGIN_LIMITER_WINDOW)
+		if err != nil {
+			return LoginLimiterStatusResponse{}, errs.NewInternalServerError(err)
+		}
+
+		scope := &LoginLimiterScopeResponse{
+			Identifier:    state.Identifier,
+			Failures:      state.Failures,
+			RetryAfter:    state.DelaySeconds,
+			Locked:        state.LockedSeconds > 0,
+			LockedSeconds: state.LockedSeconds,
+		}
+		if in.Scope == loginLimiterRepo.LimiterLoginScopeEmail {
+			res.Email = scope
+		} else {
+			res.ClientIP = scope
+		}
+	}
+
+	return res, nil
+}
+
+// UnlockLogin (admin) menghapus counter, delay & lockout untuk email dan / atau client IP
+func (s *AuthService) UnlockLogin(ctx context.Context, input LoginLimiterInput, adminProfileID uuid.UUID) (LoginLimiterStatusResponse, error) {
+	if input.Email == "" && input.ClientIP == "" {
+		return LoginLimiterStatusResponse{}, errs.NewBadRequest("EMAIL_OR_CLIENT_IP_REQUIRED")
+	}
+
+	for _, in := range adminLoginLimiterInputs(input) {
+		if err := s.loginLimiterRepo.DeleteLimiterLogin(ctx, in); err != nil {
+			return LoginLimiterStatusResponse{}, errs.NewInternalServerError(err)
+		}
+		logger.From(ctx).Info("login limiter unlocked", "scope", in.Scope, "identifier", in.Identifier, "adminProfileId", adminProfileID)
+	}
+
+	return s.GetLoginLimiterStatus(ctx, input)
+}
+
+func adminLoginLimiterInputs(input LoginLimiterInput) []loginLimiterRepo.LimiterLoginInput {
+	var inputs []loginLimiterRepo.LimiterLoginInput
+	if input.Email != "" {
+		inputs = append(inputs, loginLimiterRepo.LimiterLoginInput{
+			Scope:      loginLimiterRepo.LimiterLoginScopeEmail,
+			Identifier: normalizeLoginEmail(input.Email),
+		})
+	}
+	if input.ClientIP != "" {
+		inputs = append(inputs, loginLimiterRepo.LimiterLoginInput{
+			Scope:      loginLimiterRepo.LimiterLoginScopeIP,
+			Identifier: input.ClientIP,
+		})
+	}
+	return inputs
+}
@@ -0,0 +1,109 @@
+// internal/module/account/auth/service/reauth.go
+package auth_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"postmatic-api/internal/module/headless/token"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// Reauthenticate: user yang sudah login memasukkan ulang password (+ kode 2FA jika aktif)
+// untuk mendapatkan reauth token singkat. Percobaan salah ikut login limiter (per email & IP).
+// Profile tanpa password (ex: hanya google) re-auth dengan kode 2FA, atau lewat google jika 2FA tidak aktif.
+func (s *AuthService) Reauthenticate(ctx context.Context, profileID uuid.UUID, input ReauthenticateInput, session SessionInput) (ReauthenticateResponse, error) {
+	profile, err := s.store.GetProfileById(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return ReauthenticateResponse{}, errs.NewUnauthorized("PROFILE_NOT_FOUND")
+	}
+	if err != nil {
+		return ReauthenticateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	users, err := s.store.ListUsersByProfileId(ctx, profile.ID)
+	if err != nil {
+		return ReauthenticateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	var credUser *entity.User
+	for i := range users {
+		if users[i].Provider == entity.AuthProviderCredential && users[i].Password.Valid {
+			credUser = &users[i]
+			break
+		}
+	}
+
+	enabled, err := s.twoFactorSvc.IsEnabled(ctx, profile.ID)
+	if err != nil {
+		return ReauthenticateResponse{}, err
+	}
+
+	if credUser == nil {
+		// tanpa password & tanpa 2FA: re-auth lewat sign-in google (POST /account/profile/providers/reauth/google)
+		if !enabled {
+			return ReauthenticateResponse{}, errs.NewBadRequest("REAUTH_GOOGLE_REQUIRED")
+		}
+		if res, err := s.verifyReauthTwoFactor(ctx, profile.ID, input.Code); err != nil {
+			return res, err
+		}
+		return s.reauthTokenResponse(profile.ID)
+	}
+
+	if input.Password == "" {
+		return ReauthenticateResponse{}, errs.NewBadRequest("PASSWORD_REQUIRED")
+	}
+
+	// 1. Limiter login yang sama dengan LoginCredential
+	if retryAfter, err := s.checkLoginLimiter(ctx, profile.Email, session.DeviceInfo.ClientIP); err != nil {
+		return ReauthenticateResponse{RetryAfter: retryAfter}, err
+	}
+
+	// 2. Password
+	if !utils.ComparePassword(credUser.Password.String, input.Password) {
+		res, err := s.loginFailed(ctx, profile.Email, session, &profile, "PASSWORD_WRONG")
+		return ReauthenticateResponse{RetryAfter: res.RetryAfter}, err
+	}
+	s.resetLoginLimiter(ctx, profile.Email)
+
+	// 3. 2FA
+	if enabled {
+		if res, err := s.verifyReauthTwoFactor(ctx, profile.ID, input.Code); err != nil {
+			return res, err
+		}
+	}
+
+	return s.reauthTokenResponse(profile.ID)
+}
+
+// verifyReauthTwoFactor: kode TOTP / recovery code, percobaan salah dibatasi limiter 2FA
+func (s *AuthService) verifyReauthTwoFactor(ctx context.Context, profileID uuid.UUID, code string) (ReauthenticateResponse, error) {
+	if code == "" {
+		return ReauthenticateResponse{}, errs.NewBadRequest("TWO_FACTOR_CODE_REQUIRED")
+	}
+	if err := s.twoFactorSvc.VerifyCode(ctx, profileID, code); err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) && appErr.Message == "TWO_FACTOR_TOO_MANY_ATTEMPTS" {
+			return ReauthenticateResponse{RetryAfter: s.twoFactorSvc.RetryAfter(ctx, profileID)}, err
+		}
+		return ReauthenticateResponse{}, err
+	}
+	return ReauthenticateResponse{}, nil
+}
+
+func (s *AuthService) reauthTokenResponse(profileID uuid.UUID) (ReauthenticateResponse, error) {
+	reauthToken, err := s.tm.GenerateReauthToken(token.GenerateReauthTokenInput{ProfileID: profileID})
+	if err != nil {
+		return ReauthenticateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return ReauthenticateResponse{
+		ReauthToken: reauthToken,
+		ExpiresIn:   int64(s.tm.ReauthTTL().Seconds()),
+	}, nil
+}
@@ -8,11 +8,13 @@ import (
 	"time"
 
 	"postmatic-api/config"
+	two_factor_service "postmatic-api/internal/module/account/two_factor/service"
 	"postmatic-api/internal/module/headless/mailer"
 	"postmatic-api/internal/module/headless/queue"
 	"postmatic-api/internal/module/headless/token"
 	"postmatic-api/internal/repository/entity"
 	emailLimiterRepo "postmatic-api/internal/repository/redis/email_limiter_repository"
+	loginLimiterRepo "postmatic-api/internal/repository/redis/login_limiter_repository"
 	sessRepo "postmatic-api/internal/repository/redis/session_repository"
 	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/logger"
@@ -27,18 +29,22 @@ type AuthService struct {
 	cfg              config.Config
 	sessionRepo      *sessRepo.SessionRepository
 	emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo
+	loginLimiterRepo *loginLimiterRepo.LimiterLoginRepo
 	tm               token.TokenMaker
+	twoFactorSvc     *two_factor_service.TwoFactorService
 }
 
 // Update Constructor: Minta Token Maker dari main.go
-func NewService(store entity.Store, queue queue.MailerProducer, cfg config.Config, sessionRepo *sessRepo.SessionRepository, emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo, tm token.TokenMaker) *AuthService {
+func NewService(store entity.Store, queue queue.MailerProducer, cfg config.Config, sessionRepo *sessRepo.SessionRepository, emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo, loginLimiterRepo *loginLimiterRepo.LimiterLoginRepo, tm token.TokenMaker, twoFactorSvc *two_factor_service.TwoFactorService) *AuthService {
 	return &AuthService{
 		store:            store,
 		queue:            queue,
 		cfg:              cfg,
 		sessionRepo:      sessionRepo,
 		emailLimiterRepo: emailLimiterRepo,
+		loginLimiterRepo: loginLimiterRepo,
 		tm:               tm,
+		twoFactorSvc:     twoFactorSvc,
 	}
 }
 
@@ -154,10 +160,20 @@ func (s *AuthService) Register(ctx context.Context, input RegisterInput) (Regist
 
 func (s *AuthService) LoginCredential(ctx context.Context, input LoginCredentialInput, session SessionInput) (LoginResponse, error) {
 
+	// 0. Brute-force limiter (per email & client IP)
+	if retryAfter, err := s.checkLoginLimiter(ctx, input.Email, session.DeviceInfo.ClientIP); err != nil {
+		return LoginResponse{
+			Email:      input.Email,
+			RetryAfter: retryAfter,
+		}, err
+	}
+
 	// 1. Ambil Profile (Read Only - Tidak perlu Tx)
+	// email tidak terdaftar, credential belum di-link & password salah mengembalikan error yang sama
+	// agar response login tidak bisa dipakai untuk enumerasi email
 	profile, err := s.store.GetProfileByEmail(ctx, input.Email)
 	if err == sql.ErrNoRows {
-		return LoginResponse{}, errs.NewUnauthorized("EMAIL_NOT_FOUND")
+		return s.loginFailed(ctx, input.Email, session, nil, "INVALID_CREDENTIALS")
 	}
 	if err != nil {
 		return LoginResponse{}, errs.NewInternalServerError(err)
@@ -181,30 +197,20 @@ func (s *AuthService) LoginCredential(ctx context.Context, input LoginCredential
 		}
 	}
 
-	// LOGIC: Jika user credential belum ada, tapi input password -> Create User (Link Account)
-	if !userCredFound {
-		hashedPassword, err := utils.HashPassword(input.Password)
-		if err != nil {
-			return LoginResponse{}, errs.NewInternalServerError(err)
-		}
+	// User credential belum di-link (mis. akun google) -> tidak dibuat otomatis,
+	// password di-setup dari profile (POST /account/profile/password)
+	if !userCredFound || !targetUser.Password.Valid {
+		return s.loginFailed(ctx, input.Email, session, &profile, "INVALID_CREDENTIALS")
+	}
 
-		// Single Write - Cukup panggil store langsung (Auto Commit)
-		createdUser, err := s.store.CreateUser(ctx, entity.CreateUserParams{
-			ProfileID: profile.ID,
-			Password:  sql.NullString{String: hashedPassword, Valid: true},
-			Provider:  entity.AuthProviderCredential,
-		})
-		if err != nil {
-			return LoginResponse{}, errs.NewInternalServerError(err)
-		}
-		targetUser = createdUser
-	} else {
-		// Jika user ada, COMPARE PASSWORD
-		if !targetUser.Password.Valid || !utils.ComparePassword(targetUser.Password.String, input.Password) {
-			return LoginResponse{}, errs.NewUnauthorized("INVALID_CREDENTIALS")
-		}
+	// COMPARE PASSWORD
+	if !utils.ComparePassword(targetUser.Password.String, input.Password) {
+		return s.loginFailed(ctx, input.Email, session, &profile, "INVALID_CREDENTIALS")
 	}
 
+	// Password benar, counter percobaan gagal email ini di-reset
+	s.resetLoginLimiter(ctx, input.Email)
+
 	if !targetUser.VerifiedAt.Valid {
 		// A. CEK LIMITER DULU
 		checkLimiter, _ := s.emailLimiterRepo.GetLimiterEmail(ctx, profile.Email)
@@ -258,7 +264,67 @@ func (s *AuthService) LoginCredential(ctx context.Context, input LoginCredential
 		}, errs.NewUnauthorized("EMAIL_NOT_VERIFIED")
 	}
 
-	// 3. Generate Tokens
+	// 3. 2FA: session baru dibuat setelah kode diverifikasi (VerifyTwoFactor)
+	challenge, err := s.twoFactorSvc.CreateChallenge(ctx, two_factor_service.CreateChallengeInput{
+		Profile:  profile,
+		Provider: entity.AuthProviderCredential,
+		From:     input.From,
+	})
+	if err != nil {
+		return LoginResponse{}, err
+	}
+	if challenge.Required {
+		return LoginResponse{
+			ID:                 profile.ID,
+			Name:               profile.Name,
+			Email:              profile.Email,
+			TwoFactorRequired:  true,
+			ChallengeToken:     challenge.ChallengeToken,
+			ChallengeExpiresIn: challenge.ExpiresIn,
+		}, nil
+	}
+
+	// 4. Generate Tokens & Session
+	return s.createSession(ctx, profile, session)
+}
+
+// VerifyTwoFactor: langkah kedua login (credential & google), membuat session setelah kode valid
+func (s *AuthService) VerifyTwoFactor(ctx context.Context, input VerifyTwoFactorInput, session SessionInput) (VerifyTwoFactorResponse, error) {
+	claims, err := s.twoFactorSvc.VerifyChallenge(ctx, input.ChallengeToken, input.Code)
+	if err != nil {
+		// sertakan retryAfter seperti PLEASE_WAIT
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) && appErr.Message == "TWO_FACTOR_TOO_MANY_ATTEMPTS" {
+			if decoded, e := s.tm.ValidateTwoFactorChallengeToken(input.ChallengeToken); e == nil {
+				return VerifyTwoFactorResponse{
+					LoginResponse: LoginResponse{RetryAfter: s.twoFactorSvc.RetryAfter(ctx, decoded.ProfileID)},
+				}, err
+			}
+		}
+		return VerifyTwoFactorResponse{}, err
+	}
+
+	profile, err := s.store.GetProfileById(ctx, claims.ProfileID)
+	if err == sql.ErrNoRows {
+		return VerifyTwoFactorResponse{}, errs.NewUnauthorized("PROFILE_NOT_FOUND")
+	}
+	if err != nil {
+		return VerifyTwoFactorResponse{}, errs.NewInternalServerError(err)
+	}
+
+	res, err := s.createSession(ctx, profile, session)
+	if err != nil {
+		return VerifyTwoFactorResponse{}, err
+	}
+
+	return VerifyTwoFactorResponse{
+		LoginResponse: res,
+		From:          claims.From,
+	}, nil
+}
+
+// createSession generate access & refresh token lalu simpan session ke redis
+func (s *AuthService) createSession(ctx context.Context, profile entity.Profile, session SessionInput) (LoginResponse, error) {
 	pID := profile.ID
 
 	var imageUrl *string
@@ -309,7 +375,6 @@ func (s *AuthService) LoginCredential(ctx context.Context, input LoginCredential
 		return LoginResponse{}, errs.NewInternalServerError(err)
 	}
 
-	// 4. Return Response Lengkap
 	return LoginResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
@@ -320,27 +385,88 @@ func (s *AuthService) LoginCredential(ctx context.Context, input LoginCredential
 		RetryAfter:   0,
 	}, nil
 }
-func (s *AuthService) RefreshToken(ctx context.Context, input RefreshTokenInput) (LoginResponse, error) {
+
+func (s *AuthService) RefreshToken(ctx context.Context, input RefreshTokenInput, session SessionInput) (LoginResponse, error) {
 	// 1. Validasi Signature JWT
 	valid, err := s.tm.ValidateRefreshToken(input.RefreshToken)
 	if err != nil {
 		return LoginResponse{}, errs.NewUnauthorized("INVALID_REFRESH_TOKEN")
 	}
 
-	// 2. Cek Keberadaan Session di Redis (Whitelist Check)
-	// Ingat: GetSessionByRefreshToken Anda sebelumnya me-return POINTER (*RedisSession)
+	// 2. Cek Keberadaan Session di Redis (Whitelist Check via index hash token)
 	sess, err := s.sessionRepo.GetSessionByRefreshToken(ctx, valid.ID, input.RefreshToken)
 	if err != nil {
 		return LoginResponse{}, errs.NewInternalServerError(err)
 	}
 	if sess == nil {
+		// Token sudah pernah dirotasi -> kemungkinan dicuri, revoke seluruh session family
+		rotated, err := s.sessionRepo.GetRotatedRefreshToken(ctx, input.RefreshToken)
+		if err != nil {
+			return LoginResponse{}, errs.NewInternalServerError(err)
+		}
+		if rotated != nil {
+			// refresh bersamaan (ex: dua tab) dalam grace window mendapat token hasil rotasi yang sama
+			graceToken, err := s.sessionRepo.GetGraceRefreshToken(ctx, input.RefreshToken)
+			if err != nil {
+				return LoginResponse{}, errs.NewInternalServerError(err)
+			}
+			if graceToken == "" {
+				return LoginResponse{}, s.revokeSessionFamily(ctx, *rotated, session)
+			}
+			sess, err = s.sessionRepo.GetSessionByRefreshToken(ctx, valid.ID, graceToken)
+			if err != nil {
+				return LoginResponse{}, errs.NewInternalServerError(err)
+			}
+			if sess == nil {
+				// token hasil rotasi juga sudah dirotasi / session dicabut
+				return LoginResponse{}, s.revokeSessionFamily(ctx, *rotated, session)
+			}
+			return s.refreshTokenResponse(ctx, valid.ID, valid.Email, graceToken)
+		}
+
 		// Token valid secara signature, tapi tidak ada di Redis (sudah logout/revoked)
 		return LoginResponse{}, errs.NewUnauthorized("SESSION_EXPIRED_OR_REVOKED")
 	}
 
-	profUUID := valid.ID
+	// 3. Rotasi Refresh Token (selalu), token lama ditandai used
+	newRefreshToken, err := s.tm.GenerateRefreshToken(
+		token.GenerateRefreshTokenInput{
+			ID:    valid.ID,
+			Email: valid.Email,
+		},
+	)
+	if err != nil {
+		return LoginResponse{}, errs.NewInternalServerError(err)
+	}
+
+	family := sessRepo.SessionRef{ProfileID: sess.ProfileID, SessionID: sess.ID}
+	sess.RefreshToken = newRefreshToken
+
+	// Jika sisa umur session kurang dari batas renewal, perpanjang session menjadi full lagi
+	if time.Until(sess.ExpiredAt) < s.cfg.JWT_REFRESH_TOKEN_RENEWAL {
+		sess.ExpiredAt = time.Now().Add(s.cfg.JWT_REFRESH_TOKEN_EXPIRED)
+	}
+
+	rotatedTo, rotated, err := s.sessionRepo.RotateRefreshToken(ctx, *sess, input.RefreshToken,
+		time.Until(sess.ExpiredAt), s.cfg.JWT_REFRESH_TOKEN_EXPIRED, s.cfg.JWT_REFRESH_TOKEN_REUSE_GRACE)
+	if err != nil {
+		return LoginResponse{}, errs.NewInternalServerError(err)
+	}
+	if !rotated {
+		// Token yang sama dipakai bersamaan: request lain sudah merotasi, pakai token hasil rotasi tersebut
+		if rotatedTo == "" {
+			return LoginResponse{}, s.revokeSessionFamily(ctx, family, session)
+		}
+		newRefreshToken = rotatedTo
+	}
+
+	// 4. Generate Access Token Baru (Selalu dilakukan)
+	return s.refreshTokenResponse(ctx, valid.ID, valid.Email, newRefreshToken)
+}
 
-	profile, err := s.store.GetProfileById(ctx, profUUID)
+// refreshTokenResponse membuat access token baru untuk refresh token hasil rotasi
+func (s *AuthService) refreshTokenResponse(ctx context.Context, profileID uuid.UUID, email string, refreshToken string) (LoginResponse, error) {
+	profile, err := s.store.GetProfileById(ctx, profileID)
 	if err != nil {
 		return LoginResponse{}, errs.NewInternalServerError(err)
 	}
@@ -350,11 +476,10 @@ func (s *AuthService) RefreshToken(ctx context.Context, input RefreshTokenInput)
 		imageUrl = &profile.ImageUrl.String
 	}
 
-	// 3. Generate Access Token Baru (Selalu dilakukan)
 	accessToken, err := s.tm.GenerateAccessToken(
 		token.GenerateAccessTokenInput{
-			ID:       valid.ID,
-			Email:    valid.Email,
+			ID:       profileID,
+			Email:    email,
 			Name:     profile.Name,
 			ImageUrl: imageUrl,
 			Role:     profile.Role,
@@ -364,53 +489,32 @@ func (s *AuthService) RefreshToken(ctx context.Context, input RefreshTokenInput)
 		return LoginResponse{}, errs.NewInternalServerError(err)
 	}
 
-	// 4. Logic Rotasi Refresh Token (Jika perlu)
-	currentRefreshToken := input.RefreshToken
-
-	// Hitung sisa waktu hidup token
-	timeLeft := time.Until(sess.ExpiredAt)
-
-	// Jika sisa waktu kurang dari batas renewal (misal kurang dari 1 hari), ROTASI TOKEN
-	if timeLeft < s.cfg.JWT_REFRESH_TOKEN_RENEWAL {
-		// A. Generate Token Baru
-		newRefreshToken, err := s.tm.GenerateRefreshToken(
-			token.GenerateRefreshTokenInput{
-				ID:    valid.ID,
-				Email: valid.Email,
-			},
-		)
-		if err != nil {
-			return LoginResponse{}, errs.NewInternalServerError(err)
-		}
-
-		// B. Update Object Session (Memory)
-		sess.RefreshToken = newRefreshToken
-
-		// C. Perpanjang ExpiredAt (Memory)
-		// Reset umur session menjadi full lagi (misal 7 hari lagi dari sekarang)
-		sess.ExpiredAt = time.Now().Add(s.cfg.JWT_REFRESH_TOKEN_EXPIRED)
-
-		// D. SIMPAN KE REDIS (PENTING!!!)
-		// Kita overwrite session ID yang sama dengan data baru & TTL baru
-		err = s.sessionRepo.SaveSession(ctx, *sess, s.cfg.JWT_REFRESH_TOKEN_EXPIRED)
-		if err != nil {
-			return LoginResponse{}, errs.NewInternalServerError(err)
-		}
-
-		// E. Update variable return
-		currentRefreshToken = newRefreshToken
-	}
-
 	return LoginResponse{
 		AccessToken:  accessToken,
-		RefreshToken: currentRefreshToken, // Bisa token lama, bisa token baru
-		ID:           valid.ID,
-		Name:         valid.Name,
-		Email:        valid.Email,
-		ImageUrl:     valid.ImageUrl,
+		RefreshToken: refreshToken,
+		ID:           profileID,
+		Name:         profile.Name,
+		Email:        email,
+		ImageUrl:     imageUrl,
 	}, nil
 }
 
+// revokeSessionFamily: refresh token yang sudah dirotasi dipakai lagi, session (beserta token aktifnya) dicabut
+func (s *AuthService) revokeSessionFamily(ctx context.Context, family sessRepo.SessionRef, session SessionInput) error {
+	logger.From(ctx).Warn("security event: refresh token reuse detected, session family revoked",
+		"event", "REFRESH_TOKEN_REUSE",
+		"profileId", family.ProfileID,
+		"sessionId", family.SessionID,
+		"clientIp", session.DeviceInfo.ClientIP,
+		"userAgent", session.DeviceInfo.UserAgent,
+	)
+
+	if err := s.sessionRepo.DeleteSessionByID(ctx, family.ProfileID, family.SessionID); err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return errs.NewUnauthorized("REFRESH_TOKEN_REUSED")
+}
+
 // FOR GET THERE'S NO STORE IN DB (ONLY CHECK FOR UI)
 func (s *AuthService) CheckVerifyToken(ctx context.Context, input string) (VerifyCreateAccountTokenResponse, error) {
 	valid, err := s.tm.ValidateCreateAccountToken(input)
@@ -539,6 +643,28 @@ func (s *AuthService) SubmitVerifyToken(ctx context.Context, input SubmitVerifyT
 		return VerifyCreateAccountResponse{}, errs.NewInternalServerError(err)
 	}
 
+	// profile yang sudah punya 2FA (ex: dari google login) tetap wajib kode sebelum session dibuat
+	challenge, err := s.twoFactorSvc.CreateChallenge(ctx, two_factor_service.CreateChallengeInput{
+		Profile:  entity.Profile{ID: profileId, Email: *valid.Email},
+		Provider: entity.AuthProviderCredential,
+		From:     input.From,
+	})
+	if err != nil {
+		return VerifyCreateAccountResponse{}, err
+	}
+	if challenge.Required {
+		return VerifyCreateAccountResponse{
+			ID:                 valid.ID,
+			Name:               valid.Name,
+			Email:              valid.Email,
+			ImageUrl:           valid.ImageUrl,
+			Valid:              true,
+			TwoFactorRequired:  true,
+			ChallengeToken:     challenge.ChallengeToken,
+			ChallengeExpiresIn: challenge.ExpiresIn,
+		}, nil
+	}
+
 	var imageUrl *string
 	if valid.ImageUrl != nil && *valid.ImageUrl != "" {
 		imageUrl = valid.ImageUrl
@@ -716,3 +842,169 @@ func (s *AuthService) ResendEmailVerification(ctx context.Context, input ResendE
 	}, nil
 
 }
+
+func (s *AuthService) RequestResetPassword(ctx context.Context, input RequestResetPasswordInput) (RequestResetPasswordResponse, error) {
+	// 1. CEK RATE LIMITER (dipakai bersama dengan email verifikasi)
+	limiterEmail := normalizeLoginEmail(input.Email)
+	checkLimiter, err := s.emailLimiterRepo.GetLimiterEmail(ctx, limiterEmail)
+	if err != nil {
+		return RequestResetPasswordResponse{}, errs.NewInternalServerError(err)
+	}
+	if checkLimiter != nil {
+		return RequestResetPasswordResponse{
+			Email:      input.Email,
+			RetryAfter: checkLimiter.RetryAfterSeconds,
+		}, errs.NewBadRequest("PLEASE_WAIT")
+	}
+
+	retryAfter := s.cfg.CAN_RESEND_EMAIL_AFTER
+	res := RequestResetPasswordResponse{
+		Email:      input.Email,
+		RetryAfter: retryAfter,
+	}
+
+	// 2. SET RATE LIMITER untuk semua email (terdaftar atau tidak),
+	// agar PLEASE_WAIT tidak membedakan email yang terdaftar
+	err = s.emailLimiterRepo.SaveLimiterEmail(ctx, limiterEmail, time.Duration(retryAfter)*time.Second)
+	if err != nil {
+		logger.From(ctx).Warn("failed to save email limiter", "err", err)
+	}
+
+	users, err := s.store.GetUserByEmailProfile(ctx, input.Email)
+	if err != nil {
+		return RequestResetPasswordResponse{}, errs.NewInternalServerError(err)
+	}
+
+	var user *entity.GetUserByEmailProfileRow
+	for i := range users {
+		if users[i].Provider == entity.AuthProviderCredential {
+			user = &users[i]
+			break
+		}
+	}
+
+	// 3. Email tidak terdaftar sebagai credential -> tetap response sukses
+	// agar endpoint tidak bisa dipakai untuk enumerasi email
+	if user == nil || !user.Password.Valid {
+		logger.From(ctx).Info("reset password requested for unknown credential email")
+		return res, nil
+	}
+
+	resetToken, err := s.tm.GenerateResetPasswordToken(token.GenerateResetPasswordTokenInput{
+		ID:             user.ProfileID,
+		UserID:         user.ID,
+		Email:          user.Email,
+		HashedPassword: user.Password.String,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to generate reset password token", "profileId", user.ProfileID, "error", err)
+		return res, nil
+	}
+
+	// 4. Enqueue Reset Password Email
+	ctxQ, cancel := context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	err = s.queue.EnqueueResetPassword(ctxQ, mailer.ResetPasswordInputDTO{
+		Name:  user.Name,
+		To:    user.Email,
+		Token: resetToken,
+		From:  input.From,
+	})
+	if err != nil {
+		// response sama dengan email tidak terdaftar, gagal enqueue hanya dicatat di log
+		logger.From(ctx).Error("Failed to enqueue reset password email", "profileId", user.ProfileID, "error", err)
+	}
+
+	return res, nil
+}
+
+// FOR GET THERE'S NO STORE IN DB (ONLY CHECK FOR UI)
+func (s *AuthService) CheckResetPasswordToken(ctx context.Context, input string) (CheckResetPasswordTokenResponse, error) {
+	claims, _, err := s.validateResetPasswordToken(ctx, input)
+	if err != nil {
+		return CheckResetPasswordTokenResponse{Valid: false}, err
+	}
+
+	return CheckResetPasswordTokenResponse{
+		ID:    &claims.ID,
+		Email: &claims.Email,
+		Valid: true,
+	}, nil
+}
+
+func (s *AuthService) ResetPassword(ctx context.Context, input ResetPasswordInput) (ResetPasswordResponse, error) {
+	claims, user, err := s.validateResetPasswordToken(ctx, input.Token)
+	if err != nil {
+		return ResetPasswordResponse{}, err
+	}
+
+	hashedPassword, err := utils.HashPassword(input.Password)
+	if err != nil {
+		return ResetPasswordResponse{}, errs.NewInternalServerError(err)
+	}
+
+	var profile entity.Profile
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		if _, err := q.UpdateUserPassword(ctx, entity.UpdateUserPasswordParams{
+			ID:       user.ID,
+			Password: sql.NullString{String: hashedPassword, Valid: true},
+		}); err != nil {
+			return err
+		}
+
+		// reset via email sekaligus membuktikan kepemilikan email
+		if !user.VerifiedAt.Valid {
+			if _, err := q.VerifyUser(ctx, user.ID); err != nil {
+				return err
+			}
+		}
+
+		p, err := q.GetProfileById(ctx, claims.ID)
+		if err != nil {
+			return err
+		}
+		profile = p
+		return nil
+	})
+	if err != nil {
+		return ResetPasswordResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// REVOKE SEMUA SESSION (logout dari semua device)
+	if err := s.sessionRepo.DeleteAllSessions(ctx, claims.ID); err != nil {
+		logger.From(ctx).Error("failed to revoke sessions after reset password", "profileId", claims.ID, "err", err)
+	}
+
+	return ResetPasswordResponse{
+		ID:    profile.ID,
+		Name:  profile.Name,
+		Email: profile.Email,
+	}, nil
+}
+
+// validateResetPasswordToken cek signature + user credential + password belum berubah sejak token dibuat
+func (s *AuthService) validateResetPasswordToken(ctx context.Context, input string) (*token.ResetPasswordTokenClaims, entity.User, error) {
+	claims, err := s.tm.ValidateResetPasswordToken(input)
+	if err != nil {
+		return nil, entity.User{}, errs.NewBadRequest("INVALID_RESET_PASSWORD_TOKEN")
+	}
+
+	user, err := s.store.GetUserById(ctx, claims.UserID)
+	if err == sql.ErrNoRows {
+		return nil, entity.User{}, errs.NewBadRequest("INVALID_RESET_PASSWORD_TOKEN")
+	}
+	if err != nil {
+		return nil, entity.User{}, errs.NewInternalServerError(err)
+	}
+
+	if user.ProfileID != claims.ID || user.Provider != entity.AuthProviderCredential || !user.Password.Valid {
+		return nil, entity.User{}, errs.NewBadRequest("INVALID_RESET_PASSWORD_TOKEN")
+	}
+
+	// token sudah dipakai (password sudah diganti)
+	if token.PasswordFingerprint(user.Password.String) != claims.PasswordFingerprint {
+		return nil, entity.User{}, errs.NewBadRequest("RESET_PASSWORD_TOKEN_ALREADY_USED")
+	}
+
+	return claims, user, nil
+}
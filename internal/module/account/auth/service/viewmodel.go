@@ -12,6 +12,16 @@ type LoginResponse struct {
 	AccessToken  string    `json:"accessToken"`
 	RefreshToken string    `json:"refreshToken"`
 	RetryAfter   int64     `json:"retryAfter"`
+	// 2FA aktif: token kosong, lanjut POST /account/auth/two-factor dengan challengeToken
+	TwoFactorRequired  bool   `json:"twoFactorRequired"`
+	ChallengeToken     string `json:"challengeToken,omitempty"`
+	ChallengeExpiresIn int64  `json:"challengeExpiresIn,omitempty"`
+}
+
+type VerifyTwoFactorResponse struct {
+	LoginResponse
+	// redirect setelah login (challenge dari google login)
+	From string `json:"from,omitempty"`
 }
 
 type RegisterResponse struct {
@@ -41,6 +51,10 @@ type VerifyCreateAccountResponse struct {
 	Valid        bool       `json:"valid"`
 	AccessToken  string     `json:"accessToken"`
 	RefreshToken string     `json:"refreshToken"`
+	// 2FA aktif: token kosong, lanjut POST /account/auth/two-factor dengan challengeToken
+	TwoFactorRequired  bool   `json:"twoFactorRequired"`
+	ChallengeToken     string `json:"challengeToken,omitempty"`
+	ChallengeExpiresIn int64  `json:"challengeExpiresIn,omitempty"`
 }
 
 type SessionResponse struct {
@@ -61,3 +75,45 @@ type ResendEmailVerificationResponse struct {
 	ImageUrl   *string   `json:"imageUrl"`
 	RetryAfter int64     `json:"retryAfter"`
 }
+
+type RequestResetPasswordResponse struct {
+	Email      string `json:"email"`
+	RetryAfter int64  `json:"retryAfter"`
+}
+
+type CheckResetPasswordTokenResponse struct {
+	// Profile ID
+	ID    *uuid.UUID `json:"id"`
+	Email *string    `json:"email"`
+	Valid bool       `json:"valid"`
+}
+
+type ResetPasswordResponse struct {
+	// Profile ID
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+}
+
+type LoginLimiterScopeResponse struct {
+	Identifier string `json:"identifier"`
+	// login gagal dalam sliding window
+	Failures int64 `json:"failures"`
+	// sisa progressive delay (detik)
+	RetryAfter int64 `json:"retryAfter"`
+	Locked     bool  `json:"locked"`
+	// sisa lockout (detik)
+	LockedSeconds int64 `json:"lockedSeconds"`
+}
+
+type LoginLimiterStatusResponse struct {
+	Email    *LoginLimiterScopeResponse `json:"email"`
+	ClientIP *LoginLimiterScopeResponse `json:"clientIp"`
+}
+
+type ReauthenticateResponse struct {
+	ReauthToken string `json:"reauthToken"`
+	// detik
+	ExpiresIn  int64 `json:"expiresIn"`
+	RetryAfter int64 `json:"retryAfter"`
+}
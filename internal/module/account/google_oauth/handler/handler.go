@@ -3,10 +3,12 @@ package google_oauth_handler
 
 import (
 	"net/http"
+	"net/url"
 
 	"postmatic-api/config"
 	auth_handler "postmatic-api/internal/module/account/auth/handler"
 	google_oauth_service "postmatic-api/internal/module/account/google_oauth/service"
+	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/response"
 	"postmatic-api/pkg/utils"
 
@@ -72,6 +74,30 @@ func (h *Handler) LoginGoogleCallback(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// mode link dari profile: tidak ada session baru, kembali ke halaman asal
+	if res.LinkedProvider != "" {
+		http.Redirect(w, r, res.From, http.StatusFound)
+		return
+	}
+
+	// mode reauth: reauth token di fragment (tidak terkirim ke server / referer), kembali ke halaman asal
+	if res.ReauthToken != "" {
+		redirectURL, err := url.Parse(res.From)
+		if err != nil {
+			response.Error(w, r, errs.NewInternalServerError(err), nil)
+			return
+		}
+		redirectURL.Fragment = url.Values{"reauthToken": {res.ReauthToken}}.Encode()
+		http.Redirect(w, r, redirectURL.String(), http.StatusFound)
+		return
+	}
+
+	// 2FA aktif: belum ada session, arahkan ke halaman input kode
+	if res.TwoFactorRequired {
+		http.Redirect(w, r, res.TwoFactorURL, http.StatusFound)
+		return
+	}
+
 	// ✅ mode redirect (callback dipanggil browser)
 	auth_handler.SetAuthCookies(w, r, h.cfg, res.AccessToken, res.RefreshToken)
 	http.Redirect(w, r, res.From, http.StatusFound)
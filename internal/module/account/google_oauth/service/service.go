@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"postmatic-api/config"
+	two_factor_service "postmatic-api/internal/module/account/two_factor/service"
 	"postmatic-api/internal/module/headless/mailer"
 	"postmatic-api/internal/module/headless/queue"
 	"postmatic-api/internal/module/headless/token"
@@ -21,6 +22,7 @@ import (
 	emailLimiterRepo "postmatic-api/internal/repository/redis/email_limiter_repository"
 	sessRepo "postmatic-api/internal/repository/redis/session_repository"
 	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
 
 	"github.com/google/uuid"
 	"golang.org/x/oauth2"
@@ -54,6 +56,7 @@ type GoogleOAuthService struct {
 	emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo
 	conf             *oauth2.Config
 	tm               token.TokenMaker
+	twoFactorSvc     *two_factor_service.TwoFactorService
 }
 
 func NewService(
@@ -63,6 +66,7 @@ func NewService(
 	sessionRepo *sessRepo.SessionRepository,
 	emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo,
 	tm token.TokenMaker,
+	twoFactorSvc *two_factor_service.TwoFactorService,
 ) *GoogleOAuthService {
 	oauthConf := cfg.GoogleOAuthConfig()
 	return &GoogleOAuthService{
@@ -73,6 +77,7 @@ func NewService(
 		emailLimiterRepo: emailLimiterRepo,
 		conf:             oauthConf,
 		tm:               tm,
+		twoFactorSvc:     twoFactorSvc,
 	}
 }
 
@@ -109,6 +114,57 @@ func (s *GoogleOAuthService) GetGoogleAuthURL(ctx context.Context, from string)
 	return GoogleOAuthAuthURLResponse{AuthURL: authURL}, nil
 }
 
+// GetGoogleLinkURL auth url untuk link google ke profile yang sedang login (reauth dicek caller)
+func (s *GoogleOAuthService) GetGoogleLinkURL(ctx context.Context, profileID uuid.UUID, from string) (GoogleOAuthAuthURLResponse, error) {
+	normFrom, err := s.normalizeFrom(from)
+	if err != nil {
+		return GoogleOAuthAuthURLResponse{}, errs.NewBadRequest("GOOGLE_OAUTH_INVALID_FROM")
+	}
+
+	state, err := s.signState(oauthStatePayload{
+		From:      normFrom,
+		Exp:       time.Now().Add(10 * time.Minute).Unix(),
+		N:         uuid.NewString(),
+		Mode:      oauthModeLink,
+		ProfileID: profileID.String(),
+	})
+	if err != nil {
+		return GoogleOAuthAuthURLResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// select_account: user bisa memilih akun google selain yang sedang aktif di browser
+	authURL := s.conf.AuthCodeURL(state, oauth2.SetAuthURLParam("prompt", "select_account"))
+
+	return GoogleOAuthAuthURLResponse{AuthURL: authURL}, nil
+}
+
+// GetGoogleReauthURL auth url untuk re-auth profile tanpa password lewat sign-in google ulang
+func (s *GoogleOAuthService) GetGoogleReauthURL(ctx context.Context, profileID uuid.UUID, from string) (GoogleOAuthAuthURLResponse, error) {
+	normFrom, err := s.normalizeFrom(from)
+	if err != nil {
+		return GoogleOAuthAuthURLResponse{}, errs.NewBadRequest("GOOGLE_OAUTH_INVALID_FROM")
+	}
+
+	state, err := s.signState(oauthStatePayload{
+		From:      normFrom,
+		Exp:       time.Now().Add(10 * time.Minute).Unix(),
+		N:         uuid.NewString(),
+		Mode:      oauthModeReauth,
+		ProfileID: profileID.String(),
+	})
+	if err != nil {
+		return GoogleOAuthAuthURLResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// max_age=0: google meminta user sign-in ulang (session google browser tidak cukup)
+	authURL := s.conf.AuthCodeURL(state,
+		oauth2.SetAuthURLParam("prompt", "select_account"),
+		oauth2.SetAuthURLParam("max_age", "0"),
+	)
+
+	return GoogleOAuthAuthURLResponse{AuthURL: authURL}, nil
+}
+
 /* -----------------------------
    CALLBACK: code -> token -> id_token -> profile/user -> app tokens
 ------------------------------ */
@@ -157,6 +213,17 @@ func (s *GoogleOAuthService) LoginGoogleCallback(
 		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_TOKEN_MISSING_REQUIRED_CLAIMS")
 	}
 
+	// mode link: tidak membuat session, hanya menambah user provider google
+	if st.Mode == oauthModeLink {
+		emailVerified, _ := payload.Claims["email_verified"].(bool)
+		return s.linkGoogle(ctx, st, email, emailVerified)
+	}
+
+	// mode reauth: tidak membuat session, hanya reauth token untuk profile dari state
+	if st.Mode == oauthModeReauth {
+		return s.reauthGoogle(ctx, st, email, payload.Claims)
+	}
+
 	// 4) cari/buat profile berdasar email (karena email hanya di profile)
 	profile, err := s.store.GetProfileByEmail(ctx, email)
 	if err != nil && err != sql.ErrNoRows {
@@ -166,6 +233,7 @@ func (s *GoogleOAuthService) LoginGoogleCallback(
 	// 5) jika profile ada, cari user provider google
 	var targetUser entity.User
 	userGoogleFound := false
+	hasVerifiedUser := false
 
 	if profile.ID != uuid.Nil {
 		users, err := s.store.ListUsersByProfileId(ctx, profile.ID)
@@ -173,10 +241,12 @@ func (s *GoogleOAuthService) LoginGoogleCallback(
 			return LoginGoogleResponse{}, errs.NewInternalServerError(err)
 		}
 		for _, u := range users {
-			if u.Provider == entity.AuthProviderGoogle {
+			if u.VerifiedAt.Valid {
+				hasVerifiedUser = true
+			}
+			if u.Provider == entity.AuthProviderGoogle && !userGoogleFound {
 				targetUser = u
 				userGoogleFound = true
-				break
 			}
 		}
 	}
@@ -235,7 +305,12 @@ func (s *GoogleOAuthService) LoginGoogleCallback(
 		})
 	}
 
-	// 7) profile ada tapi belum ada user google -> buat user google
+	// 7) profile ada tapi belum ada user google:
+	// - sudah punya provider terverifikasi -> wajib link dari profile (POST /account/profile/providers/google)
+	// - belum ada yang terverifikasi -> google membuktikan kepemilikan email, buat user google
+	if profile.ID != uuid.Nil && !userGoogleFound && hasVerifiedUser {
+		return LoginGoogleResponse{}, errs.NewUnauthorized("GOOGLE_NOT_LINKED")
+	}
 	if profile.ID != uuid.Nil && !userGoogleFound {
 		e := s.store.ExecTx(ctx, func(q *entity.Queries) error {
 			user, err := q.CreateUser(ctx, entity.CreateUserParams{
@@ -274,7 +349,32 @@ func (s *GoogleOAuthService) LoginGoogleCallback(
 		imageUrl = &profile.ImageUrl.String
 	}
 
-	// 9) issue token app kamu
+	// 9) 2FA aktif -> redirect ke halaman kode, session dibuat di POST /account/auth/two-factor
+	challenge, err := s.twoFactorSvc.CreateChallenge(ctx, two_factor_service.CreateChallengeInput{
+		Profile:  profile,
+		Provider: entity.AuthProviderGoogle,
+		From:     input.From,
+	})
+	if err != nil {
+		return LoginGoogleResponse{}, err
+	}
+	if challenge.Required {
+		q := url.Values{}
+		q.Set("challengeToken", challenge.ChallengeToken)
+		q.Set("from", input.From)
+
+		return LoginGoogleResponse{
+			ID:                profile.ID.String(),
+			Name:              profile.Name,
+			Email:             profile.Email,
+			ImageUrl:          imageUrl,
+			From:              input.From,
+			TwoFactorRequired: true,
+			TwoFactorURL:      s.cfg.AUTH_URL + s.cfg.TWO_FACTOR_ROUTE + "?" + q.Encode(),
+		}, nil
+	}
+
+	// 10) issue token app kamu
 	accessToken, err := s.tm.GenerateAccessToken(
 		token.GenerateAccessTokenInput{
 			ID:       targetUser.ID,
@@ -297,7 +397,7 @@ func (s *GoogleOAuthService) LoginGoogleCallback(
 		return LoginGoogleResponse{}, errs.NewInternalServerError(err)
 	}
 
-	// 10) save session
+	// 11) save session
 	sessionID := uuid.New()
 	newSession := sessRepo.RedisSession{
 		ID:           sessionID,
@@ -436,10 +536,23 @@ func (s *GoogleOAuthService) isAllowedHost(host string) bool {
    STATE SIGN/VERIFY (HMAC)
 ------------------------------ */
 
+// mode callback: kosong = login, "link" = hubungkan google ke profile yang sedang login,
+// "reauth" = re-auth profile yang sedang login (tanpa password)
+const (
+	oauthModeLink   = "link"
+	oauthModeReauth = "reauth"
+)
+
+// batas umur sign-in google (claim auth_time) untuk mode reauth
+const googleReauthMaxAge = 5 * time.Minute
+
 type oauthStatePayload struct {
 	From string `json:"from"`
 	Exp  int64  `json:"exp"`
 	N    string `json:"n"` // nonce
+	Mode string `json:"mode,omitempty"`
+	// Profile ID (mode link / reauth)
+	ProfileID string `json:"profileId,omitempty"`
 }
 
 func (s *GoogleOAuthService) oauthStateSecret() []byte {
@@ -500,3 +613,136 @@ func getStringClaim(claims map[string]any, key string) string {
 	s, _ := v.(string)
 	return s
 }
+
+// linkGoogle menambah user provider google ke profile dari state (mode link).
+// Email google wajib sama & terverifikasi karena login google mencari profile berdasarkan email.
+func (s *GoogleOAuthService) linkGoogle(ctx context.Context, st oauthStatePayload, email string, emailVerified bool) (LoginGoogleResponse, error) {
+	profileID, err := uuid.Parse(st.ProfileID)
+	if err != nil {
+		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_TOKEN_VERIFY_STATE_FAILED")
+	}
+	if !emailVerified {
+		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_EMAIL_NOT_VERIFIED")
+	}
+
+	var profile entity.Profile
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		p, err := q.GetProfileByIdForUpdate(ctx, profileID)
+		if err == sql.ErrNoRows {
+			return errs.NewUnauthorized("PROFILE_NOT_FOUND")
+		}
+		if err != nil {
+			return err
+		}
+		profile = p
+
+		if !strings.EqualFold(profile.Email, email) {
+			return errs.NewBadRequest("GOOGLE_EMAIL_MISMATCH")
+		}
+
+		users, err := q.ListUsersByProfileId(ctx, profile.ID)
+		if err != nil {
+			return err
+		}
+		for _, u := range users {
+			if u.Provider == entity.AuthProviderGoogle {
+				return errs.NewBadRequest("PROVIDER_ALREADY_LINKED")
+			}
+		}
+
+		user, err := q.CreateUser(ctx, entity.CreateUserParams{
+			ProfileID: profile.ID,
+			Provider:  entity.AuthProviderGoogle,
+			Password:  sql.NullString{},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = q.VerifyUser(ctx, user.ID)
+		return err
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return LoginGoogleResponse{}, appErr
+		}
+		return LoginGoogleResponse{}, errs.NewInternalServerError(err)
+	}
+
+	var imageUrl *string
+	if profile.ImageUrl.Valid {
+		imageUrl = &profile.ImageUrl.String
+	}
+
+	return LoginGoogleResponse{
+		ID:             profile.ID.String(),
+		Name:           profile.Name,
+		Email:          profile.Email,
+		ImageUrl:       imageUrl,
+		From:           st.From,
+		LinkedProvider: entity.AuthProviderGoogle,
+	}, nil
+}
+
+// reauthGoogle menerbitkan reauth token jika akun google yang baru sign-in adalah google yang ter-link ke profile.
+// Profile dengan 2FA aktif tetap re-auth dengan kode 2FA (POST /account/profile/providers/reauth).
+func (s *GoogleOAuthService) reauthGoogle(ctx context.Context, st oauthStatePayload, email string, claims map[string]any) (LoginGoogleResponse, error) {
+	profileID, err := uuid.Parse(st.ProfileID)
+	if err != nil {
+		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_TOKEN_VERIFY_STATE_FAILED")
+	}
+
+	// auth_time dikirim google karena max_age, sign-in lama (session browser) ditolak
+	if authTime, ok := claims["auth_time"].(float64); ok && time.Since(time.Unix(int64(authTime), 0)) > googleReauthMaxAge {
+		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_REAUTH_EXPIRED")
+	}
+
+	profile, err := s.store.GetProfileById(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return LoginGoogleResponse{}, errs.NewUnauthorized("PROFILE_NOT_FOUND")
+	}
+	if err != nil {
+		return LoginGoogleResponse{}, errs.NewInternalServerError(err)
+	}
+	if !strings.EqualFold(profile.Email, email) {
+		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_EMAIL_MISMATCH")
+	}
+
+	users, err := s.store.ListUsersByProfileId(ctx, profile.ID)
+	if err != nil {
+		return LoginGoogleResponse{}, errs.NewInternalServerError(err)
+	}
+	linked := false
+	for _, u := range users {
+		if u.Provider == entity.AuthProviderGoogle && u.VerifiedAt.Valid {
+			linked = true
+			break
+		}
+	}
+	if !linked {
+		return LoginGoogleResponse{}, errs.NewBadRequest("GOOGLE_NOT_LINKED")
+	}
+
+	enabled, err := s.twoFactorSvc.IsEnabled(ctx, profile.ID)
+	if err != nil {
+		return LoginGoogleResponse{}, err
+	}
+	if enabled {
+		return LoginGoogleResponse{}, errs.NewBadRequest("TWO_FACTOR_CODE_REQUIRED")
+	}
+
+	reauthToken, err := s.tm.GenerateReauthToken(token.GenerateReauthTokenInput{ProfileID: profile.ID})
+	if err != nil {
+		return LoginGoogleResponse{}, errs.NewInternalServerError(err)
+	}
+
+	logger.From(ctx).Info("profile reauthenticated with google", "profileId", profile.ID)
+
+	return LoginGoogleResponse{
+		ID:          profile.ID.String(),
+		Name:        profile.Name,
+		Email:       profile.Email,
+		From:        st.From,
+		ReauthToken: reauthToken,
+	}, nil
+}
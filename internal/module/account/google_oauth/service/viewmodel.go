@@ -1,6 +1,8 @@
 // internal/module/account/google_oauth/viewmodel.go
 package google_oauth_service
 
+import "postmatic-api/internal/repository/entity"
+
 type LoginGoogleResponse struct {
 	// Profile ID
 	ID           string  `json:"id"`
@@ -10,6 +12,13 @@ type LoginGoogleResponse struct {
 	AccessToken  string  `json:"accessToken"`
 	RefreshToken string  `json:"refreshToken"`
 	From         string  `json:"from"`
+	// 2FA aktif: token kosong, browser diarahkan ke halaman input kode (AUTH_URL + TWO_FACTOR_ROUTE)
+	TwoFactorRequired bool   `json:"twoFactorRequired"`
+	TwoFactorURL      string `json:"twoFactorUrl,omitempty"`
+	// mode link (dari profile): tanpa token & session, browser kembali ke from
+	LinkedProvider entity.AuthProvider `json:"linkedProvider,omitempty"`
+	// mode reauth: browser kembali ke from dengan reauth token di fragment
+	ReauthToken string `json:"reauthToken,omitempty"`
 }
 
 // Response untuk endpoint "ambil auth url" (dipakai tombol FE)
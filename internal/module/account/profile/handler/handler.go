@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"postmatic-api/internal/internal_middleware"
 	profile_service "postmatic-api/internal/module/account/profile/service"
+	two_factor_service "postmatic-api/internal/module/account/two_factor/service"
 
 	"postmatic-api/pkg/response"
 	"postmatic-api/pkg/utils"
@@ -15,11 +16,12 @@ import (
 )
 
 type Handler struct {
-	profSvc *profile_service.ProfileService
+	profSvc      *profile_service.ProfileService
+	twoFactorSvc *two_factor_service.TwoFactorService
 }
 
-func NewHandler(profSvc *profile_service.ProfileService) *Handler {
-	return &Handler{profSvc: profSvc}
+func NewHandler(profSvc *profile_service.ProfileService, twoFactorSvc *two_factor_service.TwoFactorService) *Handler {
+	return &Handler{profSvc: profSvc, twoFactorSvc: twoFactorSvc}
 }
 
 func (h *Handler) Routes() chi.Router {
@@ -29,6 +31,24 @@ func (h *Handler) Routes() chi.Router {
 	r.Put("/", h.UpdateProfile)
 	r.Put("/password", h.UpdatePassword)
 	r.Post("/password", h.SetupPassword)
+	r.Post("/email", h.RequestEmailChange)
+
+	// TWO FACTOR (TOTP)
+	r.Get("/two-factor", h.GetTwoFactor)
+	r.Post("/two-factor/enroll", h.EnrollTwoFactor)
+	r.Post("/two-factor/confirm", h.ConfirmTwoFactor)
+	r.Post("/two-factor/recovery-codes", h.RegenerateRecoveryCodes)
+	r.Post("/two-factor/disable", h.DisableTwoFactor)
+
+	return r
+}
+
+// EmailChangeRoutes tanpa login, dibuka dari link email (token sebagai bukti)
+func (h *Handler) EmailChangeRoutes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Post("/confirm/{token}", h.ConfirmEmailChange)
+	r.Post("/revert/{token}", h.RevertEmailChange)
 
 	return r
 }
@@ -148,3 +168,168 @@ func (h *Handler) SetupPassword(w http.ResponseWriter, r *http.Request) {
 	// 3. Response setup password biasanya return sukses info
 	response.OK(w, r, "SETUP_PASSWORD_SUCCESS_CHECK_EMAIL", res)
 }
+
+func (h *Handler) RequestEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req profile_service.RequestEmailChangeInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	user, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	if user == nil {
+		response.Error(w, r, errors.New("USER_NOT_FOUND"), nil)
+		return
+	}
+
+	res, err := h.profSvc.RequestEmailChange(r.Context(), user.ID, req)
+	if err != nil {
+		// PLEASE_WAIT membawa retryAfter
+		response.Error(w, r, err, res)
+		return
+	}
+
+	response.OK(w, r, "EMAIL_CHANGE_REQUESTED_CHECK_EMAIL", res)
+}
+
+func (h *Handler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	res, err := h.profSvc.ConfirmEmailChange(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "EMAIL_CHANGE_CONFIRMED", res)
+}
+
+func (h *Handler) RevertEmailChange(w http.ResponseWriter, r *http.Request) {
+	res, err := h.profSvc.RevertEmailChange(r.Context(), chi.URLParam(r, "token"))
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "EMAIL_CHANGE_REVERTED", res)
+}
+
+func (h *Handler) GetTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	if user == nil {
+		response.Error(w, r, errors.New("USER_NOT_FOUND"), nil)
+		return
+	}
+
+	res, err := h.twoFactorSvc.GetStatus(r.Context(), user.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GET_TWO_FACTOR_SUCCESS", res)
+}
+
+func (h *Handler) EnrollTwoFactor(w http.ResponseWriter, r *http.Request) {
+	user, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	if user == nil {
+		response.Error(w, r, errors.New("USER_NOT_FOUND"), nil)
+		return
+	}
+
+	res, err := h.twoFactorSvc.Enroll(r.Context(), user.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "ENROLL_TWO_FACTOR_SUCCESS", res)
+}
+
+func (h *Handler) ConfirmTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req two_factor_service.ConfirmTwoFactorInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	user, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	if user == nil {
+		response.Error(w, r, errors.New("USER_NOT_FOUND"), nil)
+		return
+	}
+
+	res, err := h.twoFactorSvc.Confirm(r.Context(), user.ID, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "CONFIRM_TWO_FACTOR_SUCCESS", res)
+}
+
+func (h *Handler) RegenerateRecoveryCodes(w http.ResponseWriter, r *http.Request) {
+	var req two_factor_service.TwoFactorCodeInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	user, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	if user == nil {
+		response.Error(w, r, errors.New("USER_NOT_FOUND"), nil)
+		return
+	}
+
+	res, err := h.twoFactorSvc.RegenerateRecoveryCodes(r.Context(), user.ID, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "REGENERATE_RECOVERY_CODES_SUCCESS", res)
+}
+
+func (h *Handler) DisableTwoFactor(w http.ResponseWriter, r *http.Request) {
+	var req two_factor_service.TwoFactorCodeInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	user, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	if user == nil {
+		response.Error(w, r, errors.New("USER_NOT_FOUND"), nil)
+		return
+	}
+
+	res, err := h.twoFactorSvc.Disable(r.Context(), user.ID, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "DISABLE_TWO_FACTOR_SUCCESS", res)
+}
@@ -18,6 +18,13 @@ type UpdatePasswordInput struct {
 }
 
 type SetupPasswordInput struct {
-	Password string `validate:"required,min=8,max=20"`
-	From     string `validate:"required,url"`
+	Password    string `validate:"required,min=8,max=20"`
+	ReauthToken string `json:"reauthToken" validate:"required"`
+	From        string `validate:"required,url"`
+}
+
+type RequestEmailChangeInput struct {
+	NewEmail    string `json:"newEmail" validate:"required,email"`
+	ReauthToken string `json:"reauthToken" validate:"required"`
+	From        string `validate:"required,url"`
 }
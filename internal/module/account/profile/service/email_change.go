@@ -0,0 +1,324 @@
+// internal/module/account/profile/service/email_change.go
+package profile_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/module/headless/token"
+	"postmatic-api/internal/repository/entity"
+	emailChangeRepo "postmatic-api/internal/repository/redis/email_change_repository"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+// RequestEmailChange mengirim link konfirmasi ke email baru & notifikasi (link revert) ke email lama.
+// Email profile baru berubah setelah link konfirmasi dibuka.
+func (s *ProfileService) RequestEmailChange(ctx context.Context, profileID uuid.UUID, input RequestEmailChangeInput) (RequestEmailChangeResponse, error) {
+	claims, err := s.tm.ValidateReauthToken(input.ReauthToken)
+	if err != nil || claims.ProfileID != profileID {
+		return RequestEmailChangeResponse{}, errs.NewUnauthorized("INVALID_REAUTH_TOKEN")
+	}
+
+	profile, err := s.store.GetProfileById(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return RequestEmailChangeResponse{}, errs.NewUnauthorized("PROFILE_NOT_FOUND")
+	}
+	if err != nil {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+
+	newEmail := strings.ToLower(strings.TrimSpace(input.NewEmail))
+	if strings.EqualFold(newEmail, profile.Email) {
+		return RequestEmailChangeResponse{}, errs.NewBadRequest("EMAIL_SAME")
+	}
+
+	// 1. CEK REVERT WINDOW (perubahan sebelumnya masih bisa di-revert dari email lama)
+	revertWindow, err := s.emailChangeRepo.GetRevertWindow(ctx, profile.ID)
+	if err != nil {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+	if revertWindow != nil {
+		return RequestEmailChangeResponse{
+			RetryAfter: revertWindow.RetryAfterSeconds,
+		}, errs.NewBadRequest("EMAIL_CHANGE_REVERT_WINDOW_OPEN")
+	}
+
+	// 2. CEK LIMITER (email lama, konsisten dengan setup password)
+	checkLimiter, _ := s.emailLimiterRepo.GetLimiterEmail(ctx, profile.Email)
+	if checkLimiter != nil {
+		return RequestEmailChangeResponse{
+			RetryAfter: checkLimiter.RetryAfterSeconds,
+		}, errs.NewBadRequest("PLEASE_WAIT")
+	}
+
+	// 3. CEK UNIQUE (dicek ulang saat konfirmasi)
+	if _, err := s.store.GetProfileByEmail(ctx, newEmail); err == nil {
+		return RequestEmailChangeResponse{}, errs.NewBadRequest("EMAIL_ALREADY_EXISTS")
+	} else if err != sql.ErrNoRows {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// 4. GENERATE TOKEN (confirm & revert berbagi request id)
+	requestID := uuid.NewString()
+	tokenInput := token.GenerateEmailChangeTokenInput{
+		ProfileID: profile.ID,
+		OldEmail:  profile.Email,
+		NewEmail:  newEmail,
+		RequestID: requestID,
+		Purpose:   token.EmailChangeTokenPurposeConfirm,
+	}
+	confirmToken, err := s.tm.GenerateEmailChangeToken(tokenInput)
+	if err != nil {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+	tokenInput.Purpose = token.EmailChangeTokenPurposeRevert
+	revertToken, err := s.tm.GenerateEmailChangeToken(tokenInput)
+	if err != nil {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// 5. SIMPAN PENDING (menimpa request sebelumnya)
+	confirmTTL := s.tm.EmailChangeTTL(token.EmailChangeTokenPurposeConfirm)
+	err = s.emailChangeRepo.SavePendingEmailChange(ctx, emailChangeRepo.PendingEmailChange{
+		ProfileID: profile.ID,
+		RequestID: requestID,
+	}, confirmTTL)
+	if err != nil {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// 6. KIRIM EMAIL
+	ctxQ, cancelQ := context.WithTimeout(ctx, 5*time.Second)
+	defer cancelQ()
+	err = s.queue.EnqueueEmailChangeConfirm(ctxQ, mailer.EmailChangeConfirmInputDTO{
+		Name:     profile.Name,
+		To:       newEmail,
+		NewEmail: newEmail,
+		Token:    confirmToken,
+		From:     input.From,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to enqueue email change confirm", "error", err)
+	}
+	err = s.queue.EnqueueEmailChangeNotice(ctxQ, mailer.EmailChangeNoticeInputDTO{
+		Name:     profile.Name,
+		To:       profile.Email,
+		NewEmail: newEmail,
+		Token:    revertToken,
+		From:     input.From,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to enqueue email change notice", "error", err)
+	}
+
+	// 7. SIMPAN LIMITER
+	retryAfter := s.cfg.CAN_RESEND_EMAIL_AFTER
+	err = s.emailLimiterRepo.SaveLimiterEmail(ctx, profile.Email, time.Duration(retryAfter)*time.Second)
+	if err != nil {
+		return RequestEmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return RequestEmailChangeResponse{
+		NewEmail:   newEmail,
+		ExpiresIn:  int64(confirmTTL.Seconds()),
+		RetryAfter: retryAfter,
+	}, nil
+}
+
+// ConfirmEmailChange menerapkan email baru lalu logout semua session
+func (s *ProfileService) ConfirmEmailChange(ctx context.Context, tokenString string) (EmailChangeResponse, error) {
+	claims, err := s.tm.ValidateEmailChangeToken(tokenString, token.EmailChangeTokenPurposeConfirm)
+	if err != nil {
+		return EmailChangeResponse{}, errs.NewBadRequest("INVALID_EMAIL_CHANGE_TOKEN")
+	}
+
+	// hanya request terakhir yang masih pending yang bisa dikonfirmasi
+	pendingID, err := s.emailChangeRepo.GetPendingEmailChange(ctx, claims.ProfileID)
+	if err != nil {
+		return EmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+	if pendingID == "" || pendingID != claims.ID {
+		return EmailChangeResponse{}, errs.NewBadRequest("EMAIL_CHANGE_TOKEN_ALREADY_USED")
+	}
+
+	var updated entity.Profile
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		profile, err := q.GetProfileByIdForUpdate(ctx, claims.ProfileID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errs.NewBadRequest("PROFILE_NOT_FOUND")
+			}
+			return err
+		}
+		if !strings.EqualFold(profile.Email, claims.OldEmail) {
+			return errs.NewBadRequest("EMAIL_CHANGE_TOKEN_ALREADY_USED")
+		}
+
+		updated, err = s.applyEmailChange(ctx, q, profile.ID, claims.NewEmail)
+		return err
+	})
+	if err != nil {
+		return EmailChangeResponse{}, mapEmailChangeError(err)
+	}
+
+	// buka revert window selama token revert request ini masih berlaku
+	revertTTL := s.tm.EmailChangeTTL(token.EmailChangeTokenPurposeRevert) - s.tm.EmailChangeTTL(token.EmailChangeTokenPurposeConfirm)
+	revertTTL += time.Until(claims.ExpiresAt.Time)
+	if revertTTL > 0 {
+		err = s.emailChangeRepo.SaveRevertWindow(ctx, emailChangeRepo.PendingEmailChange{
+			ProfileID: updated.ID,
+			RequestID: claims.ID,
+		}, revertTTL)
+		if err != nil {
+			logger.From(ctx).Error("failed to save email change revert window", "profileId", updated.ID, "error", err)
+		}
+	}
+
+	s.finishEmailChange(ctx, updated.ID)
+	logger.From(ctx).Info("profile email changed", "profileId", updated.ID, "requestId", claims.ID)
+
+	return EmailChangeResponse{ID: updated.ID, Name: updated.Name, Email: updated.Email}, nil
+}
+
+// RevertEmailChange dari email lama: membatalkan request pending atau mengembalikan email yang sudah diganti
+func (s *ProfileService) RevertEmailChange(ctx context.Context, tokenString string) (EmailChangeResponse, error) {
+	claims, err := s.tm.ValidateEmailChangeToken(tokenString, token.EmailChangeTokenPurposeRevert)
+	if err != nil {
+		return EmailChangeResponse{}, errs.NewBadRequest("INVALID_EMAIL_CHANGE_TOKEN")
+	}
+
+	pendingID, err := s.emailChangeRepo.GetPendingEmailChange(ctx, claims.ProfileID)
+	if err != nil {
+		return EmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+	revertWindow, err := s.emailChangeRepo.GetRevertWindow(ctx, claims.ProfileID)
+	if err != nil {
+		return EmailChangeResponse{}, errs.NewInternalServerError(err)
+	}
+	confirmed := revertWindow != nil && revertWindow.RequestID == claims.ID
+
+	var result entity.Profile
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		profile, err := q.GetProfileByIdForUpdate(ctx, claims.ProfileID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return errs.NewBadRequest("PROFILE_NOT_FOUND")
+			}
+			return err
+		}
+
+		switch {
+		// sudah dikonfirmasi & revert window milik request ini: kembalikan ke email lama,
+		// apapun email profile saat ini
+		case confirmed:
+			if strings.EqualFold(profile.Email, claims.OldEmail) {
+				result = profile
+				return nil
+			}
+			result, err = s.applyEmailChange(ctx, q, profile.ID, claims.OldEmail)
+			return err
+		// belum dikonfirmasi: cukup batalkan request yang sama
+		case strings.EqualFold(profile.Email, claims.OldEmail):
+			if pendingID != claims.ID {
+				return errs.NewBadRequest("EMAIL_CHANGE_NOT_FOUND")
+			}
+			result = profile
+			return nil
+		default:
+			return errs.NewBadRequest("EMAIL_CHANGE_TOKEN_ALREADY_USED")
+		}
+	})
+	if err != nil {
+		return EmailChangeResponse{}, mapEmailChangeError(err)
+	}
+
+	if confirmed {
+		if err := s.emailChangeRepo.DeleteRevertWindow(ctx, result.ID); err != nil {
+			logger.From(ctx).Warn("failed to delete email change revert window", "profileId", result.ID, "error", err)
+		}
+	}
+	s.finishEmailChange(ctx, result.ID)
+	logger.From(ctx).Warn("profile email change reverted", "profileId", result.ID, "requestId", claims.ID)
+
+	return EmailChangeResponse{ID: result.ID, Name: result.Name, Email: result.Email}, nil
+}
+
+/* -----------------------------
+   HELPERS
+------------------------------ */
+
+// applyEmailChange dipanggil di dalam transaksi dengan row profile sudah di-lock
+func (s *ProfileService) applyEmailChange(ctx context.Context, q *entity.Queries, profileID uuid.UUID, email string) (entity.Profile, error) {
+	// cek ulang unique, email bisa saja sudah dipakai sejak request dibuat
+	existing, err := q.GetProfileByEmail(ctx, email)
+	if err == nil && existing.ID != profileID {
+		return entity.Profile{}, errs.NewBadRequest("EMAIL_ALREADY_EXISTS")
+	}
+	if err != nil && err != sql.ErrNoRows {
+		return entity.Profile{}, err
+	}
+
+	updated, err := q.UpdateProfileEmail(ctx, entity.UpdateProfileEmailParams{
+		ID:    profileID,
+		Email: email,
+	})
+	if err != nil {
+		return entity.Profile{}, err
+	}
+
+	users, err := q.ListUsersByProfileId(ctx, profileID)
+	if err != nil {
+		return entity.Profile{}, err
+	}
+	for _, u := range users {
+		switch u.Provider {
+		// akun google terikat ke email lama, link ulang dari halaman provider
+		case entity.AuthProviderGoogle:
+			if _, err := q.DeleteUserByProfileIdAndProvider(ctx, entity.DeleteUserByProfileIdAndProviderParams{
+				ProfileID: profileID,
+				Provider:  entity.AuthProviderGoogle,
+			}); err != nil {
+				return entity.Profile{}, err
+			}
+		// link dari email tujuan membuktikan kepemilikan email
+		case entity.AuthProviderCredential:
+			if !u.VerifiedAt.Valid {
+				if _, err := q.VerifyUser(ctx, u.ID); err != nil {
+					return entity.Profile{}, err
+				}
+			}
+		}
+	}
+
+	return updated, nil
+}
+
+// finishEmailChange menghapus request pending & semua session (token lama membawa email lama)
+func (s *ProfileService) finishEmailChange(ctx context.Context, profileID uuid.UUID) {
+	if err := s.emailChangeRepo.DeletePendingEmailChange(ctx, profileID); err != nil {
+		logger.From(ctx).Warn("failed to delete pending email change", "profileId", profileID, "error", err)
+	}
+	if err := s.sessionRepo.DeleteAllSessions(ctx, profileID); err != nil {
+		logger.From(ctx).Error("failed to revoke sessions after email change", "profileId", profileID, "error", err)
+	}
+}
+
+func mapEmailChangeError(err error) error {
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	// race dengan register / email change lain di luar lock profile
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == "23505" {
+		return errs.NewBadRequest("EMAIL_ALREADY_EXISTS")
+	}
+	return errs.NewInternalServerError(err)
+}
@@ -16,7 +16,9 @@ import (
 
 	"postmatic-api/pkg/utils"
 
+	emailChangeRepo "postmatic-api/internal/repository/redis/email_change_repository"
 	emailLimiterRepo "postmatic-api/internal/repository/redis/email_limiter_repository"
+	sessRepo "postmatic-api/internal/repository/redis/session_repository"
 
 	"github.com/google/uuid"
 )
@@ -26,16 +28,20 @@ type ProfileService struct {
 	queue            queue.MailerProducer
 	cfg              config.Config
 	emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo
+	emailChangeRepo  *emailChangeRepo.EmailChangeRepo
+	sessionRepo      *sessRepo.SessionRepository
 	tm               token.TokenMaker
 }
 
 // Update Constructor: Minta Token Maker dari main.go
-func NewService(store entity.Store, queue queue.MailerProducer, cfg config.Config, emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo, tm token.TokenMaker) *ProfileService {
+func NewService(store entity.Store, queue queue.MailerProducer, cfg config.Config, emailLimiterRepo *emailLimiterRepo.LimiterEmailRepo, emailChangeRepo *emailChangeRepo.EmailChangeRepo, sessionRepo *sessRepo.SessionRepository, tm token.TokenMaker) *ProfileService {
 	return &ProfileService{
 		store:            store,
 		queue:            queue,
 		cfg:              cfg,
 		emailLimiterRepo: emailLimiterRepo,
+		emailChangeRepo:  emailChangeRepo,
+		sessionRepo:      sessionRepo,
 		tm:               tm,
 	}
 }
@@ -226,6 +232,11 @@ func (s *ProfileService) UpdatePassword(ctx context.Context, profileId uuid.UUID
 // untuk pengguna yang login oauth, lalu ingin setup password credential account
 // PERBAIKAN LOGIC SETUP PASSWORD
 func (s *ProfileService) SetupPassword(ctx context.Context, profileId uuid.UUID, input SetupPasswordInput) (SetupPasswordResponse, error) {
+	// menambah cara login baru, wajib re-auth (access token saja tidak cukup)
+	claims, err := s.tm.ValidateReauthToken(input.ReauthToken)
+	if err != nil || claims.ProfileID != profileId {
+		return SetupPasswordResponse{}, errs.NewUnauthorized("INVALID_REAUTH_TOKEN")
+	}
 
 	profile, err := s.GetProfile(ctx, profileId)
 	if err != nil {
@@ -261,7 +272,7 @@ func (s *ProfileService) SetupPassword(ctx context.Context, profileId uuid.UUID,
 	}
 
 	e := s.store.ExecTx(ctx, func(q *entity.Queries) error {
-		_, err := s.store.CreateUser(ctx, inputUser)
+		_, err := q.CreateUser(ctx, inputUser)
 		if err != nil {
 			return err
 		}
@@ -40,3 +40,17 @@ type UpdateProfileResponse struct {
 type SetupPasswordResponse struct {
 	RetryAfter int64 `json:"retryAfter"`
 }
+
+type RequestEmailChangeResponse struct {
+	NewEmail string `json:"newEmail"`
+	// detik sebelum link konfirmasi expired
+	ExpiresIn  int64 `json:"expiresIn"`
+	RetryAfter int64 `json:"retryAfter"`
+}
+
+type EmailChangeResponse struct {
+	// Profile ID
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+}
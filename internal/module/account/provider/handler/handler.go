@@ -0,0 +1,145 @@
+// internal/module/account/provider/handler/handler.go
+package provider_handler
+
+import (
+	"net/http"
+
+	"postmatic-api/internal/internal_middleware"
+	auth_service "postmatic-api/internal/module/account/auth/service"
+	provider_service "postmatic-api/internal/module/account/provider/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	providerSvc *provider_service.ProviderService
+	authSvc     *auth_service.AuthService
+}
+
+func NewHandler(providerSvc *provider_service.ProviderService, authSvc *auth_service.AuthService) *Handler {
+	return &Handler{providerSvc: providerSvc, authSvc: authSvc}
+}
+
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", h.ListProviders)
+	r.Post("/reauth", h.Reauthenticate)
+	r.Post("/reauth/google", h.ReauthGoogle)
+	r.Post("/google", h.LinkGoogle)
+	r.Post("/{provider}/unlink", h.UnlinkProvider)
+
+	return r
+}
+
+func (h *Handler) ListProviders(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.providerSvc.ListProviders(r.Context(), prof.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GET_PROVIDERS_SUCCESS", res)
+}
+
+func (h *Handler) Reauthenticate(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req auth_service.ReauthenticateInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	sessionInput := auth_service.SessionInput{
+		DeviceInfo: utils.ExtractClientInfo(r),
+	}
+	res, err := h.authSvc.Reauthenticate(r.Context(), prof.ID, req, sessionInput)
+	if err != nil {
+		response.Error(w, r, err, res)
+		return
+	}
+
+	response.OK(w, r, "REAUTH_SUCCESS", res)
+}
+
+func (h *Handler) ReauthGoogle(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req provider_service.ReauthGoogleInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	res, err := h.providerSvc.ReauthGoogle(r.Context(), prof.ID, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GOOGLE_REAUTH_URL_SUCCESS", res)
+}
+
+func (h *Handler) LinkGoogle(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req provider_service.LinkGoogleInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	res, err := h.providerSvc.LinkGoogle(r.Context(), prof.ID, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GOOGLE_LINK_URL_SUCCESS", res)
+}
+
+func (h *Handler) UnlinkProvider(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	provider := entity.AuthProvider(chi.URLParam(r, "provider"))
+
+	var req provider_service.UnlinkProviderInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	res, err := h.providerSvc.UnlinkProvider(r.Context(), prof.ID, provider, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "UNLINK_PROVIDER_SUCCESS", res)
+}
@@ -0,0 +1,18 @@
+// internal/module/account/provider/service/dto.go
+package provider_service
+
+type LinkGoogleInput struct {
+	// dari POST /account/profile/providers/reauth
+	ReauthToken string `json:"reauthToken" validate:"required"`
+	// halaman tujuan setelah callback google (allowlist sama dengan google login)
+	From string `json:"from" validate:"required"`
+}
+
+type UnlinkProviderInput struct {
+	ReauthToken string `json:"reauthToken" validate:"required"`
+}
+
+type ReauthGoogleInput struct {
+	// halaman tujuan setelah callback google, reauth token dikirim di fragment (#reauthToken=...)
+	From string `json:"from" validate:"required"`
+}
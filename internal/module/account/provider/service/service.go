@@ -0,0 +1,209 @@
+// internal/module/account/provider/service/service.go
+package provider_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	google_oauth_service "postmatic-api/internal/module/account/google_oauth/service"
+	"postmatic-api/internal/module/headless/token"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// urutan tampil provider di profile
+var supportedProviders = []entity.AuthProvider{
+	entity.AuthProviderCredential,
+	entity.AuthProviderGoogle,
+}
+
+type ProviderService struct {
+	store     entity.Store
+	tm        token.TokenMaker
+	googleSvc *google_oauth_service.GoogleOAuthService
+}
+
+func NewService(store entity.Store, tm token.TokenMaker, googleSvc *google_oauth_service.GoogleOAuthService) *ProviderService {
+	return &ProviderService{
+		store:     store,
+		tm:        tm,
+		googleSvc: googleSvc,
+	}
+}
+
+func (s *ProviderService) ListProviders(ctx context.Context, profileID uuid.UUID) (ListProvidersResponse, error) {
+	users, err := s.store.ListUsersByProfileId(ctx, profileID)
+	if err != nil {
+		return ListProvidersResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return buildProvidersResponse(users), nil
+}
+
+// LinkGoogle mengembalikan auth url google mode link, user dibuat saat callback
+func (s *ProviderService) LinkGoogle(ctx context.Context, profileID uuid.UUID, input LinkGoogleInput) (LinkGoogleResponse, error) {
+	if err := s.validateReauth(profileID, input.ReauthToken); err != nil {
+		return LinkGoogleResponse{}, err
+	}
+
+	users, err := s.store.ListUsersByProfileId(ctx, profileID)
+	if err != nil {
+		return LinkGoogleResponse{}, errs.NewInternalServerError(err)
+	}
+	if findUser(users, entity.AuthProviderGoogle) != nil {
+		return LinkGoogleResponse{}, errs.NewBadRequest("PROVIDER_ALREADY_LINKED")
+	}
+
+	res, err := s.googleSvc.GetGoogleLinkURL(ctx, profileID, input.From)
+	if err != nil {
+		return LinkGoogleResponse{}, err
+	}
+
+	return LinkGoogleResponse{AuthURL: res.AuthURL}, nil
+}
+
+// ReauthGoogle mengembalikan auth url google mode reauth untuk profile yang ter-link google,
+// reauth token diterbitkan saat callback (profile tanpa password)
+func (s *ProviderService) ReauthGoogle(ctx context.Context, profileID uuid.UUID, input ReauthGoogleInput) (ReauthGoogleResponse, error) {
+	users, err := s.store.ListUsersByProfileId(ctx, profileID)
+	if err != nil {
+		return ReauthGoogleResponse{}, errs.NewInternalServerError(err)
+	}
+	if u := findUser(users, entity.AuthProviderGoogle); u == nil || !u.VerifiedAt.Valid {
+		return ReauthGoogleResponse{}, errs.NewBadRequest("GOOGLE_NOT_LINKED")
+	}
+
+	res, err := s.googleSvc.GetGoogleReauthURL(ctx, profileID, input.From)
+	if err != nil {
+		return ReauthGoogleResponse{}, err
+	}
+
+	return ReauthGoogleResponse{AuthURL: res.AuthURL}, nil
+}
+
+// UnlinkProvider hanya jika masih ada provider lain yang terverifikasi
+func (s *ProviderService) UnlinkProvider(ctx context.Context, profileID uuid.UUID, provider entity.AuthProvider, input UnlinkProviderInput) (ListProvidersResponse, error) {
+	if !isSupportedProvider(provider) {
+		return ListProvidersResponse{}, errs.NewBadRequest("INVALID_PROVIDER")
+	}
+	if err := s.validateReauth(profileID, input.ReauthToken); err != nil {
+		return ListProvidersResponse{}, err
+	}
+
+	var remaining []entity.User
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		// lock profile: dua unlink bersamaan tidak boleh menghapus semua provider
+		if _, err := q.GetProfileByIdForUpdate(ctx, profileID); err != nil {
+			if err == sql.ErrNoRows {
+				return errs.NewUnauthorized("PROFILE_NOT_FOUND")
+			}
+			return err
+		}
+
+		users, err := q.ListUsersByProfileId(ctx, profileID)
+		if err != nil {
+			return err
+		}
+
+		if findUser(users, provider) == nil {
+			return errs.NewBadRequest("PROVIDER_NOT_LINKED")
+		}
+
+		remaining = remaining[:0]
+		hasOtherVerified := false
+		for _, u := range users {
+			if u.Provider == provider {
+				continue
+			}
+			remaining = append(remaining, u)
+			if u.VerifiedAt.Valid {
+				hasOtherVerified = true
+			}
+		}
+		if !hasOtherVerified {
+			return errs.NewBadRequest("LAST_VERIFIED_PROVIDER")
+		}
+
+		_, err = q.DeleteUserByProfileIdAndProvider(ctx, entity.DeleteUserByProfileIdAndProviderParams{
+			ProfileID: profileID,
+			Provider:  provider,
+		})
+		return err
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return ListProvidersResponse{}, appErr
+		}
+		return ListProvidersResponse{}, errs.NewInternalServerError(err)
+	}
+
+	logger.From(ctx).Info("login provider unlinked", "profileId", profileID, "provider", provider)
+
+	return buildProvidersResponse(remaining), nil
+}
+
+/* -----------------------------
+   HELPERS
+------------------------------ */
+
+func (s *ProviderService) validateReauth(profileID uuid.UUID, reauthToken string) error {
+	claims, err := s.tm.ValidateReauthToken(reauthToken)
+	if err != nil || claims.ProfileID != profileID {
+		return errs.NewUnauthorized("INVALID_REAUTH_TOKEN")
+	}
+	return nil
+}
+
+func buildProvidersResponse(users []entity.User) ListProvidersResponse {
+	verifiedCount := 0
+	for _, u := range users {
+		if u.VerifiedAt.Valid {
+			verifiedCount++
+		}
+	}
+
+	providers := make([]ProviderResponse, 0, len(supportedProviders))
+	for _, p := range supportedProviders {
+		item := ProviderResponse{Provider: p}
+
+		if u := findUser(users, p); u != nil {
+			item.Linked = true
+			item.Verified = u.VerifiedAt.Valid
+			item.IsPasswordSet = u.Password.Valid
+			item.CanUnlink = verifiedCount > 1 || (verifiedCount == 1 && !u.VerifiedAt.Valid)
+			if u.VerifiedAt.Valid {
+				item.VerifiedAt = &u.VerifiedAt.Time
+			}
+			if u.CreatedAt.Valid {
+				item.LinkedAt = &u.CreatedAt.Time
+			}
+		}
+
+		providers = append(providers, item)
+	}
+
+	return ListProvidersResponse{Providers: providers}
+}
+
+func findUser(users []entity.User, provider entity.AuthProvider) *entity.User {
+	for i := range users {
+		if users[i].Provider == provider {
+			return &users[i]
+		}
+	}
+	return nil
+}
+
+func isSupportedProvider(provider entity.AuthProvider) bool {
+	for _, p := range supportedProviders {
+		if p == provider {
+			return true
+		}
+	}
+	return false
+}
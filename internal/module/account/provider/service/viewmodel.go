@@ -0,0 +1,32 @@
+// internal/module/account/provider/service/viewmodel.go
+package provider_service
+
+import (
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+)
+
+type ProviderResponse struct {
+	Provider   entity.AuthProvider `json:"provider"`
+	Linked     bool                `json:"linked"`
+	Verified   bool                `json:"verified"`
+	VerifiedAt *time.Time          `json:"verifiedAt"`
+	LinkedAt   *time.Time          `json:"linkedAt"`
+	// credential: password sudah di-set
+	IsPasswordSet bool `json:"isPasswordSet"`
+	// false jika ini satu-satunya provider terverifikasi
+	CanUnlink bool `json:"canUnlink"`
+}
+
+type ListProvidersResponse struct {
+	Providers []ProviderResponse `json:"providers"`
+}
+
+type LinkGoogleResponse struct {
+	AuthURL string `json:"authUrl"`
+}
+
+type ReauthGoogleResponse struct {
+	AuthURL string `json:"authUrl"`
+}
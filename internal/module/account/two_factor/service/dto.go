@@ -0,0 +1,20 @@
+// internal/module/account/two_factor/dto.go
+package two_factor_service
+
+import "postmatic-api/internal/repository/entity"
+
+// TwoFactorCodeInput: kode TOTP 6 digit atau recovery code (XXXXX-XXXXX)
+type TwoFactorCodeInput struct {
+	Code string `json:"code" validate:"required,max=20"`
+}
+
+// ConfirmTwoFactorInput: konfirmasi enroll, wajib kode TOTP (bukan recovery code)
+type ConfirmTwoFactorInput struct {
+	Code string `json:"code" validate:"required,len=6,numeric"`
+}
+
+type CreateChallengeInput struct {
+	Profile  entity.Profile
+	Provider entity.AuthProvider
+	From     string
+}
@@ -0,0 +1,399 @@
+// internal/module/account/two_factor/service.go
+package two_factor_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"postmatic-api/config"
+	"postmatic-api/internal/module/headless/token"
+	"postmatic-api/internal/repository/entity"
+	twoFactorLimiterRepo "postmatic-api/internal/repository/redis/two_factor_limiter_repository"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// TwoFactorService: TOTP 2FA per profile (enroll, recovery code, challenge login)
+type TwoFactorService struct {
+	store       entity.Store
+	tm          token.TokenMaker
+	limiterRepo *twoFactorLimiterRepo.LimiterTwoFactorRepo
+	encryptKey  []byte
+	issuer      string
+	maxAttempts int64
+}
+
+func NewService(store entity.Store, cfg config.Config, tm token.TokenMaker, limiterRepo *twoFactorLimiterRepo.LimiterTwoFactorRepo) *TwoFactorService {
+	return &TwoFactorService{
+		store:       store,
+		tm:          tm,
+		limiterRepo: limiterRepo,
+		encryptKey:  utils.DeriveKey(cfg.TWO_FACTOR_SECRET, "two_factor:totp_secret"),
+		issuer:      cfg.APP_NAME,
+		maxAttempts: cfg.TWO_FACTOR_MAX_ATTEMPTS,
+	}
+}
+
+/* -----------------------------
+   PROFILE (enroll / confirm / disable)
+------------------------------ */
+
+func (s *TwoFactorService) GetStatus(ctx context.Context, profileID uuid.UUID) (TwoFactorStatusResponse, error) {
+	tf, err := s.store.GetProfileTwoFactor(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return TwoFactorStatusResponse{}, nil
+	}
+	if err != nil {
+		return TwoFactorStatusResponse{}, errs.NewInternalServerError(err)
+	}
+
+	res := TwoFactorStatusResponse{
+		Enabled: tf.EnabledAt.Valid,
+		Pending: !tf.EnabledAt.Valid,
+	}
+	if tf.EnabledAt.Valid {
+		res.EnabledAt = &tf.EnabledAt.Time
+
+		remaining, err := s.store.CountUnusedProfileTwoFactorRecoveryCodes(ctx, profileID)
+		if err != nil {
+			return TwoFactorStatusResponse{}, errs.NewInternalServerError(err)
+		}
+		res.RemainingRecoveryCodes = remaining
+	}
+	return res, nil
+}
+
+// Enroll membuat secret baru (pending), belum aktif sampai dikonfirmasi dengan kode pertama
+func (s *TwoFactorService) Enroll(ctx context.Context, profileID uuid.UUID) (EnrollTwoFactorResponse, error) {
+	profile, err := s.store.GetProfileById(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return EnrollTwoFactorResponse{}, errs.NewUnauthorized("PROFILE_NOT_FOUND")
+	}
+	if err != nil {
+		return EnrollTwoFactorResponse{}, errs.NewInternalServerError(err)
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return EnrollTwoFactorResponse{}, errs.NewInternalServerError(err)
+	}
+	encrypted, err := utils.EncryptString(s.encryptKey, secret)
+	if err != nil {
+		return EnrollTwoFactorResponse{}, errs.NewInternalServerError(err)
+	}
+
+	_, err = s.store.UpsertPendingProfileTwoFactor(ctx, entity.UpsertPendingProfileTwoFactorParams{
+		ProfileID:       profileID,
+		SecretEncrypted: encrypted,
+	})
+	if err == sql.ErrNoRows {
+		// conflict dengan 2FA yang sudah aktif
+		return EnrollTwoFactorResponse{}, errs.NewBadRequest("TWO_FACTOR_ALREADY_ENABLED")
+	}
+	if err != nil {
+		return EnrollTwoFactorResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return EnrollTwoFactorResponse{
+		OTPAuthURI: buildOTPAuthURI(s.issuer, profile.Email, secret),
+		Secret:     secret,
+	}, nil
+}
+
+// Confirm mengaktifkan 2FA & mengembalikan recovery codes (plaintext hanya sekali)
+func (s *TwoFactorService) Confirm(ctx context.Context, profileID uuid.UUID, input ConfirmTwoFactorInput) (RecoveryCodesResponse, error) {
+	tf, err := s.store.GetProfileTwoFactor(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return RecoveryCodesResponse{}, errs.NewBadRequest("TWO_FACTOR_NOT_ENROLLED")
+	}
+	if err != nil {
+		return RecoveryCodesResponse{}, errs.NewInternalServerError(err)
+	}
+	if tf.EnabledAt.Valid {
+		return RecoveryCodesResponse{}, errs.NewBadRequest("TWO_FACTOR_ALREADY_ENABLED")
+	}
+
+	secret, err := utils.DecryptString(s.encryptKey, tf.SecretEncrypted)
+	if err != nil {
+		return RecoveryCodesResponse{}, errs.NewInternalServerError(err)
+	}
+	step, ok := validateTOTP(secret, input.Code, time.Now())
+	if !ok {
+		return RecoveryCodesResponse{}, errs.NewBadRequest("INVALID_TWO_FACTOR_CODE")
+	}
+
+	var codes []string
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		if _, err := q.UseProfileTwoFactorStep(ctx, entity.UseProfileTwoFactorStepParams{
+			Step:      step,
+			ProfileID: profileID,
+		}); err != nil {
+			if err == sql.ErrNoRows {
+				return errs.NewBadRequest("INVALID_TWO_FACTOR_CODE")
+			}
+			return err
+		}
+
+		if _, err := q.EnableProfileTwoFactor(ctx, profileID); err != nil {
+			if err == sql.ErrNoRows {
+				return errs.NewBadRequest("TWO_FACTOR_ALREADY_ENABLED")
+			}
+			return err
+		}
+
+		codes, err = replaceRecoveryCodes(ctx, q, profileID)
+		return err
+	})
+	if err != nil {
+		return RecoveryCodesResponse{}, wrapTxError(err)
+	}
+
+	return RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// RegenerateRecoveryCodes: recovery code lama tidak berlaku lagi
+func (s *TwoFactorService) RegenerateRecoveryCodes(ctx context.Context, profileID uuid.UUID, input TwoFactorCodeInput) (RecoveryCodesResponse, error) {
+	if err := s.verifyCodeLimited(ctx, profileID, input.Code); err != nil {
+		return RecoveryCodesResponse{}, err
+	}
+
+	var codes []string
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		var err error
+		codes, err = replaceRecoveryCodes(ctx, q, profileID)
+		return err
+	})
+	if err != nil {
+		return RecoveryCodesResponse{}, wrapTxError(err)
+	}
+
+	return RecoveryCodesResponse{RecoveryCodes: codes}, nil
+}
+
+// Disable: enroll pending bisa dibatalkan langsung, 2FA aktif wajib kode valid
+func (s *TwoFactorService) Disable(ctx context.Context, profileID uuid.UUID, input TwoFactorCodeInput) (TwoFactorStatusResponse, error) {
+	tf, err := s.store.GetProfileTwoFactor(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return TwoFactorStatusResponse{}, errs.NewBadRequest("TWO_FACTOR_NOT_ENABLED")
+	}
+	if err != nil {
+		return TwoFactorStatusResponse{}, errs.NewInternalServerError(err)
+	}
+
+	if tf.EnabledAt.Valid {
+		if err := s.verifyCodeLimited(ctx, profileID, input.Code); err != nil {
+			return TwoFactorStatusResponse{}, err
+		}
+	}
+
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		if err := q.DeleteProfileTwoFactorRecoveryCodes(ctx, profileID); err != nil {
+			return err
+		}
+		return q.DeleteProfileTwoFactor(ctx, profileID)
+	})
+	if err != nil {
+		return TwoFactorStatusResponse{}, wrapTxError(err)
+	}
+
+	return TwoFactorStatusResponse{}, nil
+}
+
+/* -----------------------------
+   LOGIN CHALLENGE
+------------------------------ */
+
+func (s *TwoFactorService) IsEnabled(ctx context.Context, profileID uuid.UUID) (bool, error) {
+	tf, err := s.store.GetProfileTwoFactor(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, errs.NewInternalServerError(err)
+	}
+	return tf.EnabledAt.Valid, nil
+}
+
+// CreateChallenge dipanggil setelah password / google login valid.
+// Required=false berarti 2FA tidak aktif, caller langsung membuat session.
+func (s *TwoFactorService) CreateChallenge(ctx context.Context, input CreateChallengeInput) (ChallengeResponse, error) {
+	enabled, err := s.IsEnabled(ctx, input.Profile.ID)
+	if err != nil {
+		return ChallengeResponse{}, err
+	}
+	if !enabled {
+		return ChallengeResponse{Required: false}, nil
+	}
+
+	challengeToken, err := s.tm.GenerateTwoFactorChallengeToken(token.GenerateTwoFactorChallengeTokenInput{
+		ProfileID: input.Profile.ID,
+		Email:     input.Profile.Email,
+		Provider:  input.Provider,
+		From:      input.From,
+	})
+	if err != nil {
+		return ChallengeResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return ChallengeResponse{
+		Required:       true,
+		ChallengeToken: challengeToken,
+		ExpiresIn:      int64(s.tm.TwoFactorChallengeTTL().Seconds()),
+	}, nil
+}
+
+// VerifyChallenge memvalidasi challenge token + kode, challenge hanya bisa dipakai sekali
+func (s *TwoFactorService) VerifyChallenge(ctx context.Context, challengeToken string, code string) (*token.TwoFactorChallengeClaims, error) {
+	claims, err := s.tm.ValidateTwoFactorChallengeToken(challengeToken)
+	if err != nil {
+		return nil, errs.NewUnauthorized("INVALID_TWO_FACTOR_CHALLENGE_TOKEN")
+	}
+
+	if err := s.verifyCodeLimited(ctx, claims.ProfileID, code); err != nil {
+		return nil, err
+	}
+
+	ok, err := s.limiterRepo.ConsumeChallenge(ctx, claims.ID, s.tm.TwoFactorChallengeTTL())
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+	if !ok {
+		return nil, errs.NewUnauthorized("INVALID_TWO_FACTOR_CHALLENGE_TOKEN")
+	}
+
+	return claims, nil
+}
+
+// VerifyCode kode TOTP / recovery code untuk profile yang sudah login (re-authentication)
+func (s *TwoFactorService) VerifyCode(ctx context.Context, profileID uuid.UUID, code string) error {
+	return s.verifyCodeLimited(ctx, profileID, code)
+}
+
+// RetryAfter sisa waktu limiter percobaan kode (detik), 0 = tidak terkena limit
+func (s *TwoFactorService) RetryAfter(ctx context.Context, profileID uuid.UUID) int64 {
+	limiter, _ := s.limiterRepo.GetLimiterTwoFactor(ctx, profileID)
+	if limiter == nil || limiter.Attempts < s.maxAttempts {
+		return 0
+	}
+	return limiter.RetryAfterSeconds
+}
+
+/* -----------------------------
+   HELPERS
+------------------------------ */
+
+// verifyCodeLimited: verifyCode + limiter percobaan salah per profile
+func (s *TwoFactorService) verifyCodeLimited(ctx context.Context, profileID uuid.UUID, code string) error {
+	if s.RetryAfter(ctx, profileID) > 0 {
+		return errs.NewBadRequest("TWO_FACTOR_TOO_MANY_ATTEMPTS")
+	}
+
+	err := s.verifyCode(ctx, profileID, code)
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) && appErr.Message == "INVALID_TWO_FACTOR_CODE" {
+		if _, e := s.limiterRepo.IncrementLimiterTwoFactor(ctx, profileID, s.tm.TwoFactorChallengeTTL()); e != nil {
+			logger.From(ctx).Warn("failed to increment two factor limiter", "err", e)
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	if e := s.limiterRepo.DeleteLimiterTwoFactor(ctx, profileID); e != nil {
+		logger.From(ctx).Warn("failed to reset two factor limiter", "err", e)
+	}
+	return nil
+}
+
+// verifyCode menerima kode TOTP (6 digit) atau recovery code (sekali pakai)
+func (s *TwoFactorService) verifyCode(ctx context.Context, profileID uuid.UUID, code string) error {
+	tf, err := s.store.GetProfileTwoFactor(ctx, profileID)
+	if err == sql.ErrNoRows || (err == nil && !tf.EnabledAt.Valid) {
+		return errs.NewBadRequest("TWO_FACTOR_NOT_ENABLED")
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	normalized := normalizeCode(code)
+
+	// A. TOTP
+	if len(normalized) == totpDigits && isNumeric(normalized) {
+		secret, err := utils.DecryptString(s.encryptKey, tf.SecretEncrypted)
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		step, ok := validateTOTP(secret, normalized, time.Now())
+		if !ok {
+			return errs.NewBadRequest("INVALID_TWO_FACTOR_CODE")
+		}
+
+		// kode yang sama (atau step lebih lama) tidak bisa dipakai ulang
+		_, err = s.store.UseProfileTwoFactorStep(ctx, entity.UseProfileTwoFactorStepParams{
+			Step:      step,
+			ProfileID: profileID,
+		})
+		if err == sql.ErrNoRows {
+			return errs.NewBadRequest("INVALID_TWO_FACTOR_CODE")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		return nil
+	}
+
+	// B. Recovery code
+	if len(normalized) != recoveryCodeSize {
+		return errs.NewBadRequest("INVALID_TWO_FACTOR_CODE")
+	}
+	_, err = s.store.UseProfileTwoFactorRecoveryCode(ctx, entity.UseProfileTwoFactorRecoveryCodeParams{
+		ProfileID: profileID,
+		CodeHash:  hashRecoveryCode(normalized),
+	})
+	if err == sql.ErrNoRows {
+		return errs.NewBadRequest("INVALID_TWO_FACTOR_CODE")
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	logger.From(ctx).Info("two factor recovery code used", "profileID", profileID)
+	return nil
+}
+
+// replaceRecoveryCodes menghapus recovery code lama & membuat yang baru (dalam tx)
+func replaceRecoveryCodes(ctx context.Context, q *entity.Queries, profileID uuid.UUID) ([]string, error) {
+	if err := q.DeleteProfileTwoFactorRecoveryCodes(ctx, profileID); err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, recoveryCodeCount)
+	for len(codes) < recoveryCodeCount {
+		code, err := generateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		if err := q.CreateProfileTwoFactorRecoveryCode(ctx, entity.CreateProfileTwoFactorRecoveryCodeParams{
+			ProfileID: profileID,
+			CodeHash:  hashRecoveryCode(normalizeCode(code)),
+		}); err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+func wrapTxError(err error) error {
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+	return errs.NewInternalServerError(err)
+}
@@ -0,0 +1,28 @@
+// internal/module/account/two_factor/viewmodel.go
+package two_factor_service
+
+import "time"
+
+type TwoFactorStatusResponse struct {
+	Enabled                bool       `json:"enabled"`
+	EnabledAt              *time.Time `json:"enabledAt"`
+	Pending                bool       `json:"pending"`
+	RemainingRecoveryCodes int64      `json:"remainingRecoveryCodes"`
+}
+
+type EnrollTwoFactorResponse struct {
+	// tampilkan sebagai QR code, secret untuk input manual
+	OTPAuthURI string `json:"otpauthUri"`
+	Secret     string `json:"secret"`
+}
+
+// RecoveryCodesResponse: plaintext hanya dikembalikan sekali, yang disimpan hanya hash
+type RecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type ChallengeResponse struct {
+	Required       bool   `json:"required"`
+	ChallengeToken string `json:"challengeToken"`
+	ExpiresIn      int64  `json:"expiresIn"`
+}
@@ -0,0 +1,289 @@
+// internal/module/affiliator/affiliator_wallet/handler/handler.go
+package affiliator_wallet_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"postmatic-api/internal/internal_middleware"
+	affiliator_wallet_service "postmatic-api/internal/module/affiliator/affiliator_wallet/service"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+type Handler struct {
+	svc *affiliator_wallet_service.AffiliatorWalletService
+}
+
+func NewHandler(svc *affiliator_wallet_service.AffiliatorWalletService) *Handler {
+	return &Handler{svc: svc}
+}
+
+func (h *Handler) Routes(adminOnly func(http.Handler) http.Handler) chi.Router {
+	r := chi.NewRouter()
+
+	r.Use(func(next http.Handler) http.Handler {
+		return internal_middleware.ReqFilterMiddleware(next, affiliator_wallet_service.SORT_BY)
+	})
+
+	r.Get("/", h.GetWallet)
+	r.Get("/transaction", h.GetWalletTransactions)
+	r.Route("/payout-request", func(r chi.Router) {
+		r.Get("/", h.GetPayoutRequests)
+		r.Post("/", h.CreatePayoutRequest)
+		r.Get("/{payoutRequestId}", h.GetPayoutRequestDetail)
+		r.Post("/{payoutRequestId}/cancel", h.CancelPayoutRequest)
+	})
+
+	// Admin only routes (payout queue)
+	r.Route("/admin/payout-request", func(r chi.Router) {
+		r.Use(adminOnly)
+		r.Get("/", h.GetPayoutRequestQueue)
+		r.Get("/{payoutRequestId}", h.GetPayoutRequestDetailAdmin)
+		r.Post("/{payoutRequestId}/approve", h.ApprovePayoutRequest)
+		r.Post("/{payoutRequestId}/reject", h.RejectPayoutRequest)
+	})
+
+	return r
+}
+
+func (h *Handler) GetWallet(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.GetWalletByProfileId(r.Context(), prof.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_AFFILIATOR_WALLET", res)
+}
+
+// GetWalletTransactions: category = type (reward, clawback, payout)
+func (h *Handler) GetWalletTransactions(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+	if filter.Category != "" && !utils.StringInSlice(filter.Category, affiliator_wallet_service.TransactionTypeValues) {
+		response.ValidationFailed(w, r, map[string]string{"category": "INVALID_TRANSACTION_TYPE"})
+		return
+	}
+
+	res, pag, err := h.svc.GetWalletTransactions(r.Context(), affiliator_wallet_service.GetWalletTransactionsFilter{
+		ProfileID:  prof.ID,
+		Type:       filter.Category,
+		DateStart:  filter.DateStart,
+		DateEnd:    filter.DateEnd,
+		SortBy:     filter.SortByDB(),
+		SortDir:    filter.Sort,
+		Page:       filter.Page,
+		PageOffset: filter.Offset(),
+		PageLimit:  filter.Limit,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "SUCCESS_GET_AFFILIATOR_WALLET_TRANSACTIONS", res, &filter, &pag)
+}
+
+// GetPayoutRequests: category = status (pending, approved, rejected, canceled)
+func (h *Handler) GetPayoutRequests(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	h.getPayoutRequests(w, r, &prof.ID)
+}
+
+func (h *Handler) GetPayoutRequestQueue(w http.ResponseWriter, r *http.Request) {
+	h.getPayoutRequests(w, r, nil)
+}
+
+func (h *Handler) getPayoutRequests(w http.ResponseWriter, r *http.Request, profileID *uuid.UUID) {
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+	if filter.Category != "" && !utils.StringInSlice(filter.Category, affiliator_wallet_service.PayoutStatusValues) {
+		response.ValidationFailed(w, r, map[string]string{"category": "INVALID_PAYOUT_STATUS"})
+		return
+	}
+
+	res, pag, err := h.svc.GetPayoutRequests(r.Context(), affiliator_wallet_service.GetPayoutRequestsFilter{
+		ProfileID:  profileID,
+		Status:     filter.Category,
+		Search:     filter.Search,
+		DateStart:  filter.DateStart,
+		DateEnd:    filter.DateEnd,
+		SortBy:     filter.SortByDB(),
+		SortDir:    filter.Sort,
+		Page:       filter.Page,
+		PageOffset: filter.Offset(),
+		PageLimit:  filter.Limit,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "SUCCESS_GET_AFFILIATOR_PAYOUT_REQUESTS", res, &filter, &pag)
+}
+
+func (h *Handler) CreatePayoutRequest(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req affiliator_wallet_service.CreatePayoutRequestInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.ProfileID = prof.ID
+
+	res, err := h.svc.CreatePayoutRequest(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_CREATE_AFFILIATOR_PAYOUT_REQUEST", res)
+}
+
+func (h *Handler) GetPayoutRequestDetail(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := parsePayoutRequestID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.GetPayoutRequestDetail(r.Context(), id, &prof.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_AFFILIATOR_PAYOUT_REQUEST", res)
+}
+
+func (h *Handler) CancelPayoutRequest(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := parsePayoutRequestID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.CancelPayoutRequest(r.Context(), affiliator_wallet_service.CancelPayoutRequestInput{
+		ID:        id,
+		ProfileID: prof.ID,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_CANCEL_AFFILIATOR_PAYOUT_REQUEST", res)
+}
+
+func (h *Handler) GetPayoutRequestDetailAdmin(w http.ResponseWriter, r *http.Request) {
+	id, err := parsePayoutRequestID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.GetPayoutRequestDetail(r.Context(), id, nil)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_AFFILIATOR_PAYOUT_REQUEST", res)
+}
+
+func (h *Handler) ApprovePayoutRequest(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.parseReviewPayoutRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.ApprovePayoutRequest(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_APPROVE_AFFILIATOR_PAYOUT_REQUEST", res)
+}
+
+func (h *Handler) RejectPayoutRequest(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.parseReviewPayoutRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.RejectPayoutRequest(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_REJECT_AFFILIATOR_PAYOUT_REQUEST", res)
+}
+
+func (h *Handler) parseReviewPayoutRequest(w http.ResponseWriter, r *http.Request) (affiliator_wallet_service.ReviewPayoutRequestInput, bool) {
+	var req affiliator_wallet_service.ReviewPayoutRequestInput
+
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return req, false
+	}
+	id, err := parsePayoutRequestID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return req, false
+	}
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return req, false
+	}
+	req.ID = id
+	req.AdminProfileID = prof.ID
+	return req, true
+}
+
+func parsePayoutRequestID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "payoutRequestId"), 10, 64)
+	if err != nil {
+		return 0, errs.NewValidationFailed(map[string]string{
+			"payoutRequestId": "payoutRequestId must be an integer64",
+		})
+	}
+	return id, nil
+}
@@ -0,0 +1,24 @@
+// internal/module/affiliator/affiliator_wallet/dto.go
+package affiliator_wallet_service
+
+import "github.com/google/uuid"
+
+type CreatePayoutRequestInput struct {
+	Amount            int64  `json:"amount" validate:"required,min=1"`
+	BankName          string `json:"bankName" validate:"required,max=100"`
+	BankAccountNumber string `json:"bankAccountNumber" validate:"required,numeric,max=50"`
+	BankAccountName   string `json:"bankAccountName" validate:"required,max=255"`
+	ProfileID         uuid.UUID
+}
+
+type CancelPayoutRequestInput struct {
+	ID        int64
+	ProfileID uuid.UUID
+}
+
+// ReviewPayoutRequestInput dipakai admin untuk approve / reject
+type ReviewPayoutRequestInput struct {
+	Note           *string `json:"note" validate:"omitempty,max=1000"`
+	ID             int64
+	AdminProfileID uuid.UUID
+}
@@ -0,0 +1,36 @@
+// internal/module/affiliator/affiliator_wallet/filter.go
+package affiliator_wallet_service
+
+import "github.com/google/uuid"
+
+var SORT_BY = []string{"id", "amount"}
+
+var TransactionTypeValues = []string{"reward", "clawback", "payout"}
+
+var PayoutStatusValues = []string{"pending", "approved", "rejected", "canceled"}
+
+type GetWalletTransactionsFilter struct {
+	ProfileID  uuid.UUID `json:"profileId"`
+	Type       string    `json:"type"`
+	DateStart  *string   `json:"dateStart"`
+	DateEnd    *string   `json:"dateEnd"`
+	SortBy     string    `json:"sortBy"`
+	SortDir    string    `json:"sortDir"`
+	Page       int       `json:"page"`
+	PageOffset int       `json:"pageOffset"`
+	PageLimit  int       `json:"pageLimit"`
+}
+
+// GetPayoutRequestsFilter: ProfileID nil = semua affiliator (admin queue)
+type GetPayoutRequestsFilter struct {
+	ProfileID  *uuid.UUID `json:"profileId"`
+	Status     string     `json:"status"`
+	Search     string     `json:"search"`
+	DateStart  *string    `json:"dateStart"`
+	DateEnd    *string    `json:"dateEnd"`
+	SortBy     string     `json:"sortBy"`
+	SortDir    string     `json:"sortDir"`
+	Page       int        `json:"page"`
+	PageOffset int        `json:"pageOffset"`
+	PageLimit  int        `json:"pageLimit"`
+}
@@ -0,0 +1,103 @@
+// internal/module/affiliator/affiliator_wallet/reward.go
+package affiliator_wallet_service
+
+import (
+	"context"
+	"database/sql"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+)
+
+// AccrueReward menambahkan reward referral record ke wallet owner referral code.
+// Dipanggil di dalam transaksi saat payment berubah menjadi success, idempotent per referral record.
+func (s *AffiliatorWalletService) AccrueReward(ctx context.Context, q *entity.Queries, referralRecordID int64) error {
+	log := logger.From(ctx)
+
+	record, err := q.GetReferralRecordWithOwnerById(ctx, referralRecordID)
+	if err == sql.ErrNoRows {
+		return errs.NewNotFound("REFERRAL_RECORD_NOT_FOUND")
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	if record.RewardAmountGranted <= 0 {
+		return nil
+	}
+
+	wallet, err := q.UpsertAffiliatorWalletByProfileId(ctx, entity.UpsertAffiliatorWalletByProfileIdParams{
+		ProfileID: record.OwnerProfileID,
+		Currency:  record.RewardCurrency,
+	})
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	_, err = q.CreateAffiliatorWalletReferralTransaction(ctx, entity.CreateAffiliatorWalletReferralTransactionParams{
+		AffiliatorWalletID: wallet.ID,
+		Type:               entity.AffiliatorWalletTransactionTypeReward,
+		Amount:             record.RewardAmountGranted,
+		Currency:           record.RewardCurrency,
+		ReferralRecordID:   sql.NullInt64{Int64: record.ID, Valid: true},
+	})
+	if err == sql.ErrNoRows {
+		log.Info("Affiliator reward already accrued", "referralRecordId", record.ID)
+		return nil
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	if _, err := q.CreditAffiliatorWalletEarned(ctx, entity.CreditAffiliatorWalletEarnedParams{
+		Amount: record.RewardAmountGranted,
+		ID:     wallet.ID,
+	}); err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	log.Info("Affiliator reward accrued", "referralRecordId", record.ID, "profileId", record.OwnerProfileID, "amount", record.RewardAmountGranted)
+	return nil
+}
+
+// ClawbackReward menarik kembali reward referral record yang payment-nya di-refund.
+// Tidak melakukan apa-apa jika reward belum pernah masuk atau sudah pernah di-clawback.
+func (s *AffiliatorWalletService) ClawbackReward(ctx context.Context, q *entity.Queries, referralRecordID int64) error {
+	log := logger.From(ctx)
+
+	reward, err := q.GetAffiliatorWalletTransactionByReferralRecordIdAndType(ctx, entity.GetAffiliatorWalletTransactionByReferralRecordIdAndTypeParams{
+		ReferralRecordID: sql.NullInt64{Int64: referralRecordID, Valid: true},
+		Type:             entity.AffiliatorWalletTransactionTypeReward,
+	})
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	_, err = q.CreateAffiliatorWalletReferralTransaction(ctx, entity.CreateAffiliatorWalletReferralTransactionParams{
+		AffiliatorWalletID: reward.AffiliatorWalletID,
+		Type:               entity.AffiliatorWalletTransactionTypeClawback,
+		Amount:             reward.Amount,
+		Currency:           reward.Currency,
+		ReferralRecordID:   reward.ReferralRecordID,
+	})
+	if err == sql.ErrNoRows {
+		log.Info("Affiliator reward already clawed back", "referralRecordId", referralRecordID)
+		return nil
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	if _, err := q.ClawbackAffiliatorWallet(ctx, entity.ClawbackAffiliatorWalletParams{
+		Amount: reward.Amount,
+		ID:     reward.AffiliatorWalletID,
+	}); err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	log.Info("Affiliator reward clawed back", "referralRecordId", referralRecordID, "amount", reward.Amount)
+	return nil
+}
@@ -0,0 +1,448 @@
+// internal/module/affiliator/affiliator_wallet/service.go
+package affiliator_wallet_service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/pagination"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// defaultWalletCurrency: saat ini reward referral hanya IDR
+const defaultWalletCurrency = "IDR"
+
+type AffiliatorWalletService struct {
+	store entity.Store
+}
+
+func NewService(store entity.Store) *AffiliatorWalletService {
+	return &AffiliatorWalletService{
+		store: store,
+	}
+}
+
+// GetWalletByProfileId: wallet yang belum pernah menerima reward dikembalikan dengan saldo 0
+func (s *AffiliatorWalletService) GetWalletByProfileId(ctx context.Context, profileID uuid.UUID) (WalletResponse, error) {
+	wallet, err := s.store.GetAffiliatorWalletByProfileId(ctx, profileID)
+	if err == sql.ErrNoRows {
+		return WalletResponse{Currency: defaultWalletCurrency}, nil
+	}
+	if err != nil {
+		return WalletResponse{}, errs.NewInternalServerError(err)
+	}
+	return mapWalletToResponse(wallet), nil
+}
+
+func (s *AffiliatorWalletService) GetWalletTransactions(ctx context.Context, filter GetWalletTransactionsFilter) ([]WalletTransactionResponse, pagination.Pagination, error) {
+	wallet, err := s.store.GetAffiliatorWalletByProfileId(ctx, filter.ProfileID)
+	if err == sql.ErrNoRows {
+		return []WalletTransactionResponse{}, pagination.NewPagination(&pagination.PaginationParams{
+			Total: 0,
+			Page:  filter.Page,
+			Limit: filter.PageLimit,
+		}), nil
+	}
+	if err != nil {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	var typeFilter entity.NullAffiliatorWalletTransactionType
+	if filter.Type != "" {
+		typeFilter = entity.NullAffiliatorWalletTransactionType{
+			AffiliatorWalletTransactionType: entity.AffiliatorWalletTransactionType(filter.Type),
+			Valid:                           true,
+		}
+	}
+
+	count, err := s.store.CountAllAffiliatorWalletTransactionsByWalletId(ctx, entity.CountAllAffiliatorWalletTransactionsByWalletIdParams{
+		AffiliatorWalletID: wallet.ID,
+		Type:               typeFilter,
+		DateStart:          utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:            utils.NullStringToNullTime(filter.DateEnd),
+	})
+	if err != nil {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	trxs, err := s.store.GetAllAffiliatorWalletTransactionsByWalletId(ctx, entity.GetAllAffiliatorWalletTransactionsByWalletIdParams{
+		AffiliatorWalletID: wallet.ID,
+		Type:               typeFilter,
+		DateStart:          utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:            utils.NullStringToNullTime(filter.DateEnd),
+		SortBy:             filter.SortBy,
+		SortDir:            filter.SortDir,
+		PageOffset:         int32(filter.PageOffset),
+		PageLimit:          int32(filter.PageLimit),
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	result := make([]WalletTransactionResponse, 0, len(trxs))
+	for _, t := range trxs {
+		result = append(result, mapWalletTransactionToResponse(t))
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	return result, pag, nil
+}
+
+// CreatePayoutRequest menahan (on_hold) saldo available sampai admin approve / reject
+func (s *AffiliatorWalletService) CreatePayoutRequest(ctx context.Context, input CreatePayoutRequestInput) (PayoutRequestDetailResponse, error) {
+	var payout entity.AffiliatorPayoutRequest
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		wallet, err := q.GetAffiliatorWalletByProfileId(ctx, input.ProfileID)
+		if err == sql.ErrNoRows {
+			return errs.NewBadRequest("INSUFFICIENT_WALLET_BALANCE")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		_, err = q.HoldAffiliatorWalletBalance(ctx, entity.HoldAffiliatorWalletBalanceParams{
+			Amount: input.Amount,
+			ID:     wallet.ID,
+		})
+		if err == sql.ErrNoRows {
+			return errs.NewBadRequest("INSUFFICIENT_WALLET_BALANCE")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		payout, err = q.CreateAffiliatorPayoutRequest(ctx, entity.CreateAffiliatorPayoutRequestParams{
+			ProfileID:         input.ProfileID,
+			Amount:            input.Amount,
+			Currency:          wallet.Currency,
+			BankName:          strings.TrimSpace(input.BankName),
+			BankAccountNumber: strings.TrimSpace(input.BankAccountNumber),
+			BankAccountName:   strings.TrimSpace(input.BankAccountName),
+		})
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		if _, err := q.CreateAffiliatorPayoutRequestHistory(ctx, entity.CreateAffiliatorPayoutRequestHistoryParams{
+			AffiliatorPayoutRequestID: payout.ID,
+			Status:                    entity.AffiliatorPayoutStatusPending,
+			ActorProfileID:            input.ProfileID,
+		}); err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return PayoutRequestDetailResponse{}, err
+	}
+
+	logger.From(ctx).Info("Affiliator payout requested", "payoutRequestId", payout.ID, "profileId", input.ProfileID, "amount", input.Amount)
+	return s.GetPayoutRequestDetail(ctx, payout.ID, &input.ProfileID)
+}
+
+// GetPayoutRequests: filter.ProfileID nil = admin queue (semua affiliator)
+func (s *AffiliatorWalletService) GetPayoutRequests(ctx context.Context, filter GetPayoutRequestsFilter) ([]PayoutRequestResponse, pagination.Pagination, error) {
+	var profileID uuid.NullUUID
+	if filter.ProfileID != nil {
+		profileID = uuid.NullUUID{UUID: *filter.ProfileID, Valid: true}
+	}
+
+	var status entity.NullAffiliatorPayoutStatus
+	if filter.Status != "" {
+		status = entity.NullAffiliatorPayoutStatus{
+			AffiliatorPayoutStatus: entity.AffiliatorPayoutStatus(filter.Status),
+			Valid:                  true,
+		}
+	}
+
+	payouts, err := s.store.GetAllAffiliatorPayoutRequests(ctx, entity.GetAllAffiliatorPayoutRequestsParams{
+		ProfileID:  profileID,
+		Status:     status,
+		Search:     filter.Search,
+		DateStart:  utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:    utils.NullStringToNullTime(filter.DateEnd),
+		PageOffset: int32(filter.PageOffset),
+		PageLimit:  int32(filter.PageLimit),
+		SortBy:     filter.SortBy,
+		SortDir:    filter.SortDir,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	result := make([]PayoutRequestResponse, 0, len(payouts))
+	for _, p := range payouts {
+		result = append(result, mapPayoutRequestToResponse(entity.GetAffiliatorPayoutRequestByIdRow(p)))
+	}
+
+	count, err := s.store.CountAllAffiliatorPayoutRequests(ctx, entity.CountAllAffiliatorPayoutRequestsParams{
+		ProfileID: profileID,
+		Status:    status,
+		Search:    filter.Search,
+		DateStart: utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:   utils.NullStringToNullTime(filter.DateEnd),
+	})
+	if err != nil {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	return result, pag, nil
+}
+
+// GetPayoutRequestDetail: profileID nil = admin (tanpa cek kepemilikan)
+func (s *AffiliatorWalletService) GetPayoutRequestDetail(ctx context.Context, id int64, profileID *uuid.UUID) (PayoutRequestDetailResponse, error) {
+	payout, err := s.store.GetAffiliatorPayoutRequestById(ctx, id)
+	if err != nil && err != sql.ErrNoRows {
+		return PayoutRequestDetailResponse{}, errs.NewInternalServerError(err)
+	}
+	if payout.ID == 0 || (profileID != nil && payout.ProfileID != *profileID) {
+		return PayoutRequestDetailResponse{}, errs.NewNotFound("PAYOUT_REQUEST_NOT_FOUND")
+	}
+
+	histories, err := s.store.GetAffiliatorPayoutRequestHistoriesByPayoutRequestId(ctx, payout.ID)
+	if err != nil && err != sql.ErrNoRows {
+		return PayoutRequestDetailResponse{}, errs.NewInternalServerError(err)
+	}
+
+	res := PayoutRequestDetailResponse{
+		PayoutRequestResponse: mapPayoutRequestToResponse(payout),
+		Histories:             make([]PayoutRequestHistoryResponse, 0, len(histories)),
+	}
+	for _, h := range histories {
+		var note *string
+		if h.Note.Valid {
+			note = &h.Note.String
+		}
+		res.Histories = append(res.Histories, PayoutRequestHistoryResponse{
+			ID:     h.ID,
+			Status: string(h.Status),
+			Note:   note,
+			Actor: PayoutHistoryActorSub{
+				ID:    h.ActorProfileID,
+				Name:  h.ActorName,
+				Email: h.ActorEmail,
+			},
+			CreatedAt: h.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// CancelPayoutRequest: affiliator membatalkan request miliknya yang masih pending
+func (s *AffiliatorWalletService) CancelPayoutRequest(ctx context.Context, input CancelPayoutRequestInput) (PayoutRequestDetailResponse, error) {
+	if err := s.settlePayoutRequest(ctx, settlePayoutRequestInput{
+		ID:             input.ID,
+		Status:         entity.AffiliatorPayoutStatusCanceled,
+		ActorProfileID: input.ProfileID,
+		OwnerProfileID: &input.ProfileID,
+	}); err != nil {
+		return PayoutRequestDetailResponse{}, err
+	}
+	return s.GetPayoutRequestDetail(ctx, input.ID, &input.ProfileID)
+}
+
+// ApprovePayoutRequest: admin sudah mentransfer dana, saldo on_hold menjadi withdrawn
+func (s *AffiliatorWalletService) ApprovePayoutRequest(ctx context.Context, input ReviewPayoutRequestInput) (PayoutRequestDetailResponse, error) {
+	if err := s.settlePayoutRequest(ctx, settlePayoutRequestInput{
+		ID:             input.ID,
+		Status:         entity.AffiliatorPayoutStatusApproved,
+		Note:           input.Note,
+		ActorProfileID: input.AdminProfileID,
+		Reviewed:       true,
+	}); err != nil {
+		return PayoutRequestDetailResponse{}, err
+	}
+	return s.GetPayoutRequestDetail(ctx, input.ID, nil)
+}
+
+// RejectPayoutRequest: saldo on_hold dikembalikan ke available, alasan wajib diisi
+func (s *AffiliatorWalletService) RejectPayoutRequest(ctx context.Context, input ReviewPayoutRequestInput) (PayoutRequestDetailResponse, error) {
+	if input.Note == nil || strings.TrimSpace(*input.Note) == "" {
+		return PayoutRequestDetailResponse{}, errs.NewValidationFailed(map[string]string{
+			"note": "is required",
+		})
+	}
+	if err := s.settlePayoutRequest(ctx, settlePayoutRequestInput{
+		ID:             input.ID,
+		Status:         entity.AffiliatorPayoutStatusRejected,
+		Note:           input.Note,
+		ActorProfileID: input.AdminProfileID,
+		Reviewed:       true,
+	}); err != nil {
+		return PayoutRequestDetailResponse{}, err
+	}
+	return s.GetPayoutRequestDetail(ctx, input.ID, nil)
+}
+
+type settlePayoutRequestInput struct {
+	ID     int64
+	Status entity.AffiliatorPayoutStatus
+	Note   *string
+	// ActorProfileID dicatat di history
+	ActorProfileID uuid.UUID
+	// OwnerProfileID terisi jika actor harus pemilik request (cancel)
+	OwnerProfileID *uuid.UUID
+	// Reviewed: perubahan oleh admin (reviewed_by & reviewed_at diisi)
+	Reviewed bool
+}
+
+// settlePayoutRequest memindahkan request pending ke status akhir beserta saldo wallet & history-nya.
+// Lock order: payout request -> wallet.
+func (s *AffiliatorWalletService) settlePayoutRequest(ctx context.Context, input settlePayoutRequestInput) error {
+	note := utils.StringToNullString(input.Note)
+
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		payout, err := q.GetAffiliatorPayoutRequestByIdForUpdate(ctx, input.ID)
+		if err != nil && err != sql.ErrNoRows {
+			return errs.NewInternalServerError(err)
+		}
+		if payout.ID == 0 || (input.OwnerProfileID != nil && payout.ProfileID != *input.OwnerProfileID) {
+			return errs.NewNotFound("PAYOUT_REQUEST_NOT_FOUND")
+		}
+		if payout.Status != entity.AffiliatorPayoutStatusPending {
+			return errs.NewBadRequest("PAYOUT_REQUEST_ALREADY_" + strings.ToUpper(string(payout.Status)))
+		}
+
+		wallet, err := q.GetAffiliatorWalletByProfileId(ctx, payout.ProfileID)
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		if input.Status == entity.AffiliatorPayoutStatusApproved {
+			if _, err := q.WithdrawAffiliatorWalletHold(ctx, entity.WithdrawAffiliatorWalletHoldParams{
+				Amount: payout.Amount,
+				ID:     wallet.ID,
+			}); err != nil {
+				return errs.NewInternalServerError(err)
+			}
+			if _, err := q.CreateAffiliatorWalletTransaction(ctx, entity.CreateAffiliatorWalletTransactionParams{
+				AffiliatorWalletID:        wallet.ID,
+				Type:                      entity.AffiliatorWalletTransactionTypePayout,
+				Amount:                    payout.Amount,
+				Currency:                  payout.Currency,
+				AffiliatorPayoutRequestID: sql.NullInt64{Int64: payout.ID, Valid: true},
+			}); err != nil {
+				return errs.NewInternalServerError(err)
+			}
+		} else {
+			if _, err := q.ReleaseAffiliatorWalletHold(ctx, entity.ReleaseAffiliatorWalletHoldParams{
+				Amount: payout.Amount,
+				ID:     wallet.ID,
+			}); err != nil {
+				return errs.NewInternalServerError(err)
+			}
+		}
+
+		var reviewedBy uuid.NullUUID
+		if input.Reviewed {
+			reviewedBy = uuid.NullUUID{UUID: input.ActorProfileID, Valid: true}
+		}
+		if _, err := q.UpdateAffiliatorPayoutRequestStatus(ctx, entity.UpdateAffiliatorPayoutRequestStatusParams{
+			Status:              input.Status,
+			AdminNote:           note,
+			ReviewedByProfileID: reviewedBy,
+			ID:                  payout.ID,
+		}); err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		if _, err := q.CreateAffiliatorPayoutRequestHistory(ctx, entity.CreateAffiliatorPayoutRequestHistoryParams{
+			AffiliatorPayoutRequestID: payout.ID,
+			Status:                    input.Status,
+			Note:                      note,
+			ActorProfileID:            input.ActorProfileID,
+		}); err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.From(ctx).Info("Affiliator payout request settled", "payoutRequestId", input.ID, "status", input.Status, "actorProfileId", input.ActorProfileID)
+	return nil
+}
+
+func mapWalletToResponse(w entity.AffiliatorWallet) WalletResponse {
+	return WalletResponse{
+		Currency:        w.Currency,
+		Available:       w.TotalEarned - w.TotalClawedBack - w.TotalWithdrawn - w.OnHold,
+		OnHold:          w.OnHold,
+		TotalEarned:     w.TotalEarned,
+		TotalClawedBack: w.TotalClawedBack,
+		TotalWithdrawn:  w.TotalWithdrawn,
+	}
+}
+
+func mapWalletTransactionToResponse(t entity.AffiliatorWalletTransaction) WalletTransactionResponse {
+	var referralRecordID *int64
+	if t.ReferralRecordID.Valid {
+		referralRecordID = &t.ReferralRecordID.Int64
+	}
+	var payoutRequestID *int64
+	if t.AffiliatorPayoutRequestID.Valid {
+		payoutRequestID = &t.AffiliatorPayoutRequestID.Int64
+	}
+	return WalletTransactionResponse{
+		ID:               t.ID,
+		Type:             string(t.Type),
+		Amount:           t.Amount,
+		Currency:         t.Currency,
+		ReferralRecordID: referralRecordID,
+		PayoutRequestID:  payoutRequestID,
+		CreatedAt:        t.CreatedAt,
+	}
+}
+
+func mapPayoutRequestToResponse(p entity.GetAffiliatorPayoutRequestByIdRow) PayoutRequestResponse {
+	var adminNote *string
+	if p.AdminNote.Valid {
+		adminNote = &p.AdminNote.String
+	}
+	var reviewedAt *time.Time
+	if p.ReviewedAt.Valid {
+		reviewedAt = &p.ReviewedAt.Time
+	}
+	var image *string
+	if p.ProfileImageUrl.Valid {
+		image = &p.ProfileImageUrl.String
+	}
+	return PayoutRequestResponse{
+		ID:                p.ID,
+		Amount:            p.Amount,
+		Currency:          p.Currency,
+		BankName:          p.BankName,
+		BankAccountNumber: p.BankAccountNumber,
+		BankAccountName:   p.BankAccountName,
+		Status:            string(p.Status),
+		AdminNote:         adminNote,
+		ReviewedAt:        reviewedAt,
+		Profile: PayoutProfileSub{
+			ID:    p.ProfileID,
+			Name:  p.ProfileName,
+			Email: p.ProfileEmail,
+			Image: image,
+		},
+		CreatedAt: p.CreatedAt,
+		UpdatedAt: p.UpdatedAt,
+	}
+}
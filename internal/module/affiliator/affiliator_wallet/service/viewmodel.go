@@ -0,0 +1,69 @@
+// internal/module/affiliator/affiliator_wallet/viewmodel.go
+package affiliator_wallet_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type WalletResponse struct {
+	Currency string `json:"currency"`
+	// saldo yang bisa diajukan payout (boleh negatif jika clawback setelah payout)
+	Available       int64 `json:"available"`
+	OnHold          int64 `json:"onHold"`
+	TotalEarned     int64 `json:"totalEarned"`
+	TotalClawedBack int64 `json:"totalClawedBack"`
+	TotalWithdrawn  int64 `json:"totalWithdrawn"`
+}
+
+type WalletTransactionResponse struct {
+	ID               int64     `json:"id"`
+	Type             string    `json:"type"`
+	Amount           int64     `json:"amount"`
+	Currency         string    `json:"currency"`
+	ReferralRecordID *int64    `json:"referralRecordId"`
+	PayoutRequestID  *int64    `json:"payoutRequestId"`
+	CreatedAt        time.Time `json:"createdAt"`
+}
+
+type PayoutRequestResponse struct {
+	ID                int64            `json:"id"`
+	Amount            int64            `json:"amount"`
+	Currency          string           `json:"currency"`
+	BankName          string           `json:"bankName"`
+	BankAccountNumber string           `json:"bankAccountNumber"`
+	BankAccountName   string           `json:"bankAccountName"`
+	Status            string           `json:"status"`
+	AdminNote         *string          `json:"adminNote"`
+	ReviewedAt        *time.Time       `json:"reviewedAt"`
+	Profile           PayoutProfileSub `json:"profile"`
+	CreatedAt         time.Time        `json:"createdAt"`
+	UpdatedAt         time.Time        `json:"updatedAt"`
+}
+
+type PayoutProfileSub struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Image *string   `json:"image"`
+}
+
+type PayoutRequestDetailResponse struct {
+	PayoutRequestResponse
+	Histories []PayoutRequestHistoryResponse `json:"histories"`
+}
+
+type PayoutRequestHistoryResponse struct {
+	ID        int64                 `json:"id"`
+	Status    string                `json:"status"`
+	Note      *string               `json:"note"`
+	Actor     PayoutHistoryActorSub `json:"actor"`
+	CreatedAt time.Time             `json:"createdAt"`
+}
+
+type PayoutHistoryActorSub struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+}
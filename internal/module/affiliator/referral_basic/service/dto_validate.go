@@ -11,6 +11,8 @@ type ValidateReferralInput struct {
 	Code           string
 	ProfileID      uuid.UUID
 	BusinessRootID int64
+	// PaymentProductType yang dibeli, dicek terhadap allowed_product_types (special code)
+	ProductType string
 }
 
 // ReferralValidationResponse is the result of referral validation
@@ -18,9 +20,11 @@ type ReferralValidationResponse struct {
 	Valid             bool      `json:"valid"`
 	Message           string    `json:"message"`
 	ReferralCodeID    int64     `json:"referralCodeId,omitempty"`
+	Type              string    `json:"type,omitempty"`              // "basic" atau "special"
 	DiscountType      string    `json:"discountType,omitempty"`      // "fixed" atau "percentage"
 	TotalDiscount     int64     `json:"totalDiscount,omitempty"`     // nilai diskon (nominal atau %)
 	MaxDiscount       int64     `json:"maxDiscount,omitempty"`       // max cap untuk percentage
+	MaxUsage          *int32    `json:"maxUsage,omitempty"`          // global cap, dicek ulang saat create payment
 	OwnerProfileID    uuid.UUID `json:"ownerProfileId,omitempty"`    // untuk reward calculation
 	RewardPerReferral int64     `json:"rewardPerReferral,omitempty"` // reward to owner
 }
@@ -38,6 +42,10 @@ type ReferralCodeDetailResponse struct {
 	MaxUsage          *int32    `json:"maxUsage"`
 	RewardPerReferral int64     `json:"rewardPerReferral"`
 	OwnerProfileID    uuid.UUID `json:"ownerProfileId"`
-	CreatedAt         time.Time `json:"createdAt"`
-	UpdatedAt         time.Time `json:"updatedAt"`
+	// CAMPAIGN (special code)
+	StartsAt            *time.Time `json:"startsAt"`
+	EndsAt              *time.Time `json:"endsAt"`
+	AllowedProductTypes []string   `json:"allowedProductTypes"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
 }
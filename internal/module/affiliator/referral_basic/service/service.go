@@ -168,8 +168,24 @@ func (s *ReferralBasicService) ValidateReferralForPayment(ctx context.Context, i
 		}
 	}
 
-	// 5. Check max usage limit
+	// 4b. Check campaign window (special code)
+	now := time.Now()
+	if reff.StartsAt.Valid && now.Before(reff.StartsAt.Time) {
+		return &ReferralValidationResponse{Valid: false, Message: "REFERRAL_CODE_NOT_STARTED"}, nil
+	}
+	if reff.EndsAt.Valid && !now.Before(reff.EndsAt.Time) {
+		return &ReferralValidationResponse{Valid: false, Message: "REFERRAL_CODE_EXPIRED"}, nil
+	}
+
+	// 4c. Check allowed product (kosong = semua product)
+	if len(reff.AllowedProductTypes) > 0 && !utils.StringInSlice(input.ProductType, reff.AllowedProductTypes) {
+		return &ReferralValidationResponse{Valid: false, Message: "REFERRAL_CODE_NOT_APPLICABLE_TO_PRODUCT"}, nil
+	}
+
+	// 5. Check max usage limit (global untuk semua consumer)
+	var maxUsage *int32
 	if reff.MaxUsage.Valid {
+		maxUsage = &reff.MaxUsage.Int32
 		usageCount, err := s.store.CountReferralCodeUsage(ctx, reff.ID)
 		if err != nil {
 			return nil, errs.NewInternalServerError(err)
@@ -208,9 +224,11 @@ func (s *ReferralBasicService) ValidateReferralForPayment(ctx context.Context, i
 		Valid:             true,
 		Message:           "REFERRAL_CODE_VALID",
 		ReferralCodeID:    reff.ID,
+		Type:              string(reff.Type),
 		DiscountType:      string(reff.DiscountType),
 		TotalDiscount:     reff.TotalDiscount,
 		MaxDiscount:       reff.MaxDiscount,
+		MaxUsage:          maxUsage,
 		OwnerProfileID:    reff.ProfileID,
 		RewardPerReferral: reff.RewardPerReferral,
 	}, nil
@@ -225,19 +243,34 @@ func mapReferralCodeToDetail(r entity.ProfileReferralCode) ReferralCodeDetailRes
 	if r.MaxUsage.Valid {
 		maxUsage = &r.MaxUsage.Int32
 	}
+	var startsAt *time.Time
+	if r.StartsAt.Valid {
+		startsAt = &r.StartsAt.Time
+	}
+	var endsAt *time.Time
+	if r.EndsAt.Valid {
+		endsAt = &r.EndsAt.Time
+	}
+	allowed := r.AllowedProductTypes
+	if allowed == nil {
+		allowed = []string{}
+	}
 	return ReferralCodeDetailResponse{
-		ID:                r.ID,
-		Code:              r.Code,
-		Type:              string(r.Type),
-		IsActive:          r.IsActive,
-		TotalDiscount:     r.TotalDiscount,
-		DiscountType:      string(r.DiscountType),
-		ExpiredDays:       expDays,
-		MaxDiscount:       r.MaxDiscount,
-		MaxUsage:          maxUsage,
-		RewardPerReferral: r.RewardPerReferral,
-		OwnerProfileID:    r.ProfileID,
-		CreatedAt:         r.CreatedAt,
-		UpdatedAt:         r.UpdatedAt,
+		ID:                  r.ID,
+		Code:                r.Code,
+		Type:                string(r.Type),
+		IsActive:            r.IsActive,
+		TotalDiscount:       r.TotalDiscount,
+		DiscountType:        string(r.DiscountType),
+		ExpiredDays:         expDays,
+		MaxDiscount:         r.MaxDiscount,
+		MaxUsage:            maxUsage,
+		RewardPerReferral:   r.RewardPerReferral,
+		OwnerProfileID:      r.ProfileID,
+		StartsAt:            startsAt,
+		EndsAt:              endsAt,
+		AllowedProductTypes: allowed,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
 	}
 }
@@ -0,0 +1,179 @@
+// internal/module/affiliator/referral_special/handler/handler.go
+package referral_special_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"postmatic-api/internal/internal_middleware"
+	referral_special_service "postmatic-api/internal/module/affiliator/referral_special/service"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/filter"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc *referral_special_service.ReferralSpecialService
+}
+
+func NewHandler(svc *referral_special_service.ReferralSpecialService) *Handler {
+	return &Handler{svc: svc}
+}
+
+// Routes: seluruh endpoint referral special hanya untuk admin
+func (h *Handler) Routes(adminOnly func(http.Handler) http.Handler) chi.Router {
+	r := chi.NewRouter()
+
+	r.Use(adminOnly)
+	r.Use(func(next http.Handler) http.Handler {
+		return internal_middleware.ReqFilterMiddleware(next, referral_special_service.SORT_BY)
+	})
+
+	r.Get("/", h.GetAllReferralSpecials)
+	r.Post("/", h.CreateReferralSpecial)
+	r.Get("/{referralSpecialId}", h.GetReferralSpecialById)
+	r.Put("/{referralSpecialId}", h.UpdateReferralSpecial)
+	r.Delete("/{referralSpecialId}", h.DeleteReferralSpecial)
+
+	return r
+}
+
+// GetAllReferralSpecials: category = active / inactive
+func (h *Handler) GetAllReferralSpecials(w http.ResponseWriter, r *http.Request) {
+	reqFilter := internal_middleware.GetFilterFromContext(r.Context())
+
+	var isActive *bool
+	switch reqFilter.Category {
+	case "":
+	case "active", "inactive":
+		v := reqFilter.Category == "active"
+		isActive = &v
+	default:
+		response.ValidationFailed(w, r, map[string]string{"category": "category must be one of active, inactive"})
+		return
+	}
+
+	res, pag, err := h.svc.GetAllReferralSpecials(r.Context(), referral_special_service.GetReferralSpecialsFilter{
+		Search:     reqFilter.Search,
+		IsActive:   isActive,
+		SortBy:     sortByDB(reqFilter),
+		SortDir:    reqFilter.Sort,
+		Page:       reqFilter.Page,
+		PageOffset: reqFilter.Offset(),
+		PageLimit:  reqFilter.Limit,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "SUCCESS_GET_REFERRAL_SPECIALS", res, &reqFilter, &pag)
+}
+
+func (h *Handler) GetReferralSpecialById(w http.ResponseWriter, r *http.Request) {
+	id, err := parseReferralSpecialID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.GetReferralSpecialById(r.Context(), id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_REFERRAL_SPECIAL", res)
+}
+
+func (h *Handler) CreateReferralSpecial(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req referral_special_service.UpsertReferralSpecialInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.ProfileID = prof.ID
+
+	res, err := h.svc.CreateReferralSpecial(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_CREATE_REFERRAL_SPECIAL", res)
+}
+
+func (h *Handler) UpdateReferralSpecial(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := parseReferralSpecialID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req referral_special_service.UpsertReferralSpecialInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.ID = id
+	req.ProfileID = prof.ID
+
+	res, err := h.svc.UpdateReferralSpecial(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_UPDATE_REFERRAL_SPECIAL", res)
+}
+
+func (h *Handler) DeleteReferralSpecial(w http.ResponseWriter, r *http.Request) {
+	id, err := parseReferralSpecialID(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.DeleteReferralSpecial(r.Context(), id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_DELETE_REFERRAL_SPECIAL", res)
+}
+
+// sortByDB: kolom tambahan referral special yang tidak ada di whitelist ReqFilter.SortByDB
+func sortByDB(f filter.ReqFilter) string {
+	switch f.SortBy {
+	case "code":
+		return "code"
+	case "endsAt":
+		return "ends_at"
+	}
+	return f.SortByDB()
+}
+
+func parseReferralSpecialID(r *http.Request) (int64, error) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "referralSpecialId"), 10, 64)
+	if err != nil {
+		return 0, errs.NewValidationFailed(map[string]string{
+			"referralSpecialId": "referralSpecialId must be an integer64",
+		})
+	}
+	return id, nil
+}
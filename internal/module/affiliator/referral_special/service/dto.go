@@ -0,0 +1,32 @@
+// internal/module/affiliator/referral_special/dto.go
+package referral_special_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UpsertReferralSpecialInput struct {
+	// custom code, dinormalisasi uppercase
+	Code string `json:"code" validate:"required,min=3,max=50"`
+	// penerima reward, null = admin pembuat
+	OwnerProfileID *uuid.UUID `json:"ownerProfileId"`
+	IsActive       *bool      `json:"isActive" validate:"required"`
+	// CONSUMER
+	TotalDiscount int64  `json:"totalDiscount" validate:"gte=0"`
+	DiscountType  string `json:"discountType" validate:"required,oneof=fixed percentage"`
+	MaxDiscount   int64  `json:"maxDiscount" validate:"gte=0"`
+	// global cap untuk semua consumer, null = tanpa batas
+	MaxUsage *int32 `json:"maxUsage" validate:"omitempty,gte=1"`
+	// PRODUCER
+	RewardPerReferral int64 `json:"rewardPerReferral" validate:"gte=0"`
+	// CAMPAIGN
+	StartsAt *time.Time `json:"startsAt"`
+	EndsAt   *time.Time `json:"endsAt"`
+	// subset dari payment product type, kosong = semua product
+	AllowedProductTypes []string `json:"allowedProductTypes" validate:"omitempty,dive,oneof=image_token video_token livestream_token"`
+
+	ID        int64
+	ProfileID uuid.UUID
+}
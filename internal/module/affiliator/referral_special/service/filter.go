@@ -0,0 +1,14 @@
+// internal/module/affiliator/referral_special/filter.go
+package referral_special_service
+
+var SORT_BY = []string{"id", "code", "endsAt"}
+
+type GetReferralSpecialsFilter struct {
+	Search     string `json:"search"`
+	IsActive   *bool  `json:"isActive"`
+	SortBy     string `json:"sortBy"`
+	SortDir    string `json:"sortDir"`
+	Page       int    `json:"page"`
+	PageOffset int    `json:"pageOffset"`
+	PageLimit  int    `json:"pageLimit"`
+}
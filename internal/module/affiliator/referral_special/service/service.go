@@ -0,0 +1,242 @@
+// internal/module/affiliator/referral_special/service.go
+package referral_special_service
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/pagination"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// special code: huruf, angka, dash & underscore
+var specialCodeRE = regexp.MustCompile(`^[A-Z0-9_-]+$`)
+
+type ReferralSpecialService struct {
+	store entity.Store
+}
+
+func NewService(store entity.Store) *ReferralSpecialService {
+	return &ReferralSpecialService{store: store}
+}
+
+func (s *ReferralSpecialService) GetAllReferralSpecials(ctx context.Context, filter GetReferralSpecialsFilter) ([]ReferralSpecialResponse, pagination.Pagination, error) {
+	var isActive sql.NullBool
+	if filter.IsActive != nil {
+		isActive = sql.NullBool{Bool: *filter.IsActive, Valid: true}
+	}
+
+	codes, err := s.store.GetAllProfileReferralCodesSpecial(ctx, entity.GetAllProfileReferralCodesSpecialParams{
+		Search:     filter.Search,
+		IsActive:   isActive,
+		PageOffset: int32(filter.PageOffset),
+		PageLimit:  int32(filter.PageLimit),
+		SortBy:     filter.SortBy,
+		SortDir:    filter.SortDir,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	result := make([]ReferralSpecialResponse, 0, len(codes))
+	for _, c := range codes {
+		result = append(result, mapReferralSpecialToResponse(entity.GetProfileReferralCodeSpecialByIdRow(c)))
+	}
+
+	count, err := s.store.CountAllProfileReferralCodesSpecial(ctx, entity.CountAllProfileReferralCodesSpecialParams{
+		Search:   filter.Search,
+		IsActive: isActive,
+	})
+	if err != nil {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	return result, pag, nil
+}
+
+func (s *ReferralSpecialService) GetReferralSpecialById(ctx context.Context, id int64) (ReferralSpecialResponse, error) {
+	code, err := s.store.GetProfileReferralCodeSpecialById(ctx, id)
+	if err == sql.ErrNoRows {
+		return ReferralSpecialResponse{}, errs.NewNotFound("REFERRAL_CODE_NOT_FOUND")
+	}
+	if err != nil {
+		return ReferralSpecialResponse{}, errs.NewInternalServerError(err)
+	}
+	return mapReferralSpecialToResponse(code), nil
+}
+
+func (s *ReferralSpecialService) CreateReferralSpecial(ctx context.Context, input UpsertReferralSpecialInput) (ReferralSpecialResponse, error) {
+	if err := s.normalizeInput(ctx, &input); err != nil {
+		return ReferralSpecialResponse{}, err
+	}
+
+	created, err := s.store.CreateProfileReferralCodeSpecial(ctx, entity.CreateProfileReferralCodeSpecialParams{
+		ProfileID:           *input.OwnerProfileID,
+		Code:                input.Code,
+		IsActive:            *input.IsActive,
+		TotalDiscount:       input.TotalDiscount,
+		DiscountType:        entity.DiscountType(input.DiscountType),
+		MaxDiscount:         input.MaxDiscount,
+		MaxUsage:            utils.NullInt32ToNullInt32(input.MaxUsage),
+		RewardPerReferral:   input.RewardPerReferral,
+		StartsAt:            timePtrToNullTime(input.StartsAt),
+		EndsAt:              timePtrToNullTime(input.EndsAt),
+		AllowedProductTypes: input.AllowedProductTypes,
+		CreatedByProfileID:  uuid.NullUUID{UUID: input.ProfileID, Valid: true},
+	})
+	if utils.IsUniqueViolation(err) {
+		return ReferralSpecialResponse{}, errs.NewBadRequest("REFERRAL_CODE_ALREADY_EXISTS")
+	}
+	if err != nil {
+		return ReferralSpecialResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return s.GetReferralSpecialById(ctx, created.ID)
+}
+
+func (s *ReferralSpecialService) UpdateReferralSpecial(ctx context.Context, input UpsertReferralSpecialInput) (ReferralSpecialResponse, error) {
+	if err := s.normalizeInput(ctx, &input); err != nil {
+		return ReferralSpecialResponse{}, err
+	}
+
+	updated, err := s.store.UpdateProfileReferralCodeSpecial(ctx, entity.UpdateProfileReferralCodeSpecialParams{
+		ProfileID:           *input.OwnerProfileID,
+		Code:                input.Code,
+		IsActive:            *input.IsActive,
+		TotalDiscount:       input.TotalDiscount,
+		DiscountType:        entity.DiscountType(input.DiscountType),
+		MaxDiscount:         input.MaxDiscount,
+		MaxUsage:            utils.NullInt32ToNullInt32(input.MaxUsage),
+		RewardPerReferral:   input.RewardPerReferral,
+		StartsAt:            timePtrToNullTime(input.StartsAt),
+		EndsAt:              timePtrToNullTime(input.EndsAt),
+		AllowedProductTypes: input.AllowedProductTypes,
+		ID:                  input.ID,
+	})
+	if err == sql.ErrNoRows {
+		return ReferralSpecialResponse{}, errs.NewNotFound("REFERRAL_CODE_NOT_FOUND")
+	}
+	if utils.IsUniqueViolation(err) {
+		return ReferralSpecialResponse{}, errs.NewBadRequest("REFERRAL_CODE_ALREADY_EXISTS")
+	}
+	if err != nil {
+		return ReferralSpecialResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return s.GetReferralSpecialById(ctx, updated.ID)
+}
+
+// DeleteReferralSpecial: soft delete, referral record yang sudah ada tetap mengacu ke code ini
+func (s *ReferralSpecialService) DeleteReferralSpecial(ctx context.Context, id int64) (CreateUpdateDeleteResponse, error) {
+	deletedID, err := s.store.SoftDeleteProfileReferralCodeSpecial(ctx, id)
+	if err == sql.ErrNoRows {
+		return CreateUpdateDeleteResponse{}, errs.NewNotFound("REFERRAL_CODE_NOT_FOUND")
+	}
+	if err != nil {
+		return CreateUpdateDeleteResponse{}, errs.NewInternalServerError(err)
+	}
+	return CreateUpdateDeleteResponse{ID: deletedID}, nil
+}
+
+// normalizeInput: validasi + normalisasi yang sama dengan App.ReferralRule
+func (s *ReferralSpecialService) normalizeInput(ctx context.Context, input *UpsertReferralSpecialInput) error {
+	input.Code = strings.ToUpper(strings.TrimSpace(input.Code))
+	if !specialCodeRE.MatchString(input.Code) {
+		return errs.NewValidationFailed(map[string]string{
+			"code": "must contain only letters, numbers, dash or underscore",
+		})
+	}
+
+	// Normalisasi fixed: max_discount selalu = total_discount
+	if input.DiscountType == string(entity.DiscountTypeFixed) {
+		input.MaxDiscount = input.TotalDiscount
+	}
+	if input.DiscountType == string(entity.DiscountTypePercentage) && input.TotalDiscount > 100 {
+		return errs.NewBadRequest("TOTAL_DISCOUNT_MUST_BE_LESS_OR_EQUAL_100_PERCENT")
+	}
+
+	if input.StartsAt != nil && input.EndsAt != nil && !input.EndsAt.After(*input.StartsAt) {
+		return errs.NewBadRequest("ENDS_AT_MUST_BE_AFTER_STARTS_AT")
+	}
+
+	// dedupe allowed product types, tidak boleh nil (kolom NOT NULL)
+	allowed := make([]string, 0, len(input.AllowedProductTypes))
+	for _, t := range input.AllowedProductTypes {
+		if !utils.StringInSlice(t, allowed) {
+			allowed = append(allowed, t)
+		}
+	}
+	input.AllowedProductTypes = allowed
+
+	// owner default = admin pembuat
+	if input.OwnerProfileID == nil {
+		input.OwnerProfileID = &input.ProfileID
+		return nil
+	}
+	if _, err := s.store.GetProfileById(ctx, *input.OwnerProfileID); err == sql.ErrNoRows {
+		return errs.NewNotFound("OWNER_PROFILE_NOT_FOUND")
+	} else if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func mapReferralSpecialToResponse(r entity.GetProfileReferralCodeSpecialByIdRow) ReferralSpecialResponse {
+	var maxUsage *int32
+	if r.MaxUsage.Valid {
+		maxUsage = &r.MaxUsage.Int32
+	}
+	var startsAt *time.Time
+	if r.StartsAt.Valid {
+		startsAt = &r.StartsAt.Time
+	}
+	var endsAt *time.Time
+	if r.EndsAt.Valid {
+		endsAt = &r.EndsAt.Time
+	}
+	allowed := r.AllowedProductTypes
+	if allowed == nil {
+		allowed = []string{}
+	}
+	return ReferralSpecialResponse{
+		ID:                r.ID,
+		Code:              r.Code,
+		IsActive:          r.IsActive,
+		TotalDiscount:     r.TotalDiscount,
+		DiscountType:      string(r.DiscountType),
+		MaxDiscount:       r.MaxDiscount,
+		MaxUsage:          maxUsage,
+		UsageCount:        r.UsageCount,
+		RewardPerReferral: r.RewardPerReferral,
+		Owner: ReferralOwnerSub{
+			ID:    r.ProfileID,
+			Name:  r.OwnerName,
+			Email: r.OwnerEmail,
+		},
+		StartsAt:            startsAt,
+		EndsAt:              endsAt,
+		AllowedProductTypes: allowed,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+	}
+}
+
+func timePtrToNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
@@ -0,0 +1,39 @@
+// internal/module/affiliator/referral_special/viewmodel.go
+package referral_special_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ReferralSpecialResponse struct {
+	ID       int64  `json:"id"`
+	Code     string `json:"code"`
+	IsActive bool   `json:"isActive"`
+	// CONSUMER
+	TotalDiscount int64  `json:"totalDiscount"`
+	DiscountType  string `json:"discountType"`
+	MaxDiscount   int64  `json:"maxDiscount"`
+	MaxUsage      *int32 `json:"maxUsage"`
+	UsageCount    int32  `json:"usageCount"`
+	// PRODUCER
+	RewardPerReferral int64            `json:"rewardPerReferral"`
+	Owner             ReferralOwnerSub `json:"owner"`
+	// CAMPAIGN
+	StartsAt            *time.Time `json:"startsAt"`
+	EndsAt              *time.Time `json:"endsAt"`
+	AllowedProductTypes []string   `json:"allowedProductTypes"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+type ReferralOwnerSub struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+}
+
+type CreateUpdateDeleteResponse struct {
+	ID int64 `json:"id"`
+}
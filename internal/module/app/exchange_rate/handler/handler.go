@@ -0,0 +1,149 @@
+// internal/module/app/exchange_rate/handler/handler.go
+package exchange_rate_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"postmatic-api/internal/internal_middleware"
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc *exchange_rate_service.ExchangeRateService
+}
+
+func NewHandler(svc *exchange_rate_service.ExchangeRateService) *Handler {
+	return &Handler{svc: svc}
+}
+
+func (h *Handler) Routes(allAllowed, adminOnly func(http.Handler) http.Handler) chi.Router {
+	r := chi.NewRouter()
+
+	// All allowed routes with filter
+	r.Group(func(r chi.Router) {
+		r.Use(allAllowed)
+		r.Use(func(next http.Handler) http.Handler {
+			return internal_middleware.ReqFilterMiddleware(next, exchange_rate_service.SORT_BY)
+		})
+		r.Get("/", h.GetAllExchangeRates)
+		r.Get("/{id}", h.GetExchangeRateById)
+	})
+
+	// Admin only routes
+	r.Group(func(r chi.Router) {
+		r.Use(adminOnly)
+		r.Post("/", h.CreateExchangeRate)
+		r.Put("/{id}", h.UpdateExchangeRate)
+		r.Delete("/{id}", h.DeleteExchangeRate)
+	})
+
+	return r
+}
+
+func (h *Handler) GetAllExchangeRates(w http.ResponseWriter, r *http.Request) {
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+
+	filterData := exchange_rate_service.GetExchangeRatesFilter{
+		Search:     filter.Search,
+		SortBy:     filter.SortByDB(),
+		SortDir:    filter.Sort,
+		PageOffset: filter.Offset(),
+		PageLimit:  filter.Limit,
+		Page:       filter.Page,
+	}
+
+	res, pag, err := h.svc.GetAllExchangeRates(r.Context(), filterData)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "GET_EXCHANGE_RATES_SUCCESS", res, &filter, pag)
+}
+
+func (h *Handler) GetExchangeRateById(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.ValidationFailed(w, r, map[string]string{"id": "ID_MUST_BE_INTEGER"})
+		return
+	}
+
+	res, err := h.svc.GetExchangeRateById(r.Context(), id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GET_EXCHANGE_RATE_SUCCESS", res)
+}
+
+func (h *Handler) CreateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	var req exchange_rate_service.CreateExchangeRateInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ProfileID = prof.ID.String()
+
+	res, err := h.svc.CreateExchangeRate(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "CREATE_EXCHANGE_RATE_SUCCESS", res)
+}
+
+func (h *Handler) UpdateExchangeRate(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.ValidationFailed(w, r, map[string]string{"id": "ID_MUST_BE_INTEGER"})
+		return
+	}
+
+	var req exchange_rate_service.UpdateExchangeRateInput
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ID = id
+	req.ProfileID = prof.ID.String()
+
+	res, err := h.svc.UpdateExchangeRate(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "UPDATE_EXCHANGE_RATE_SUCCESS", res)
+}
+
+func (h *Handler) DeleteExchangeRate(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		response.ValidationFailed(w, r, map[string]string{"id": "ID_MUST_BE_INTEGER"})
+		return
+	}
+
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+
+	res, err := h.svc.DeleteExchangeRate(r.Context(), id, prof.ID.String())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "DELETE_EXCHANGE_RATE_SUCCESS", res)
+}
@@ -0,0 +1,99 @@
+// internal/module/app/exchange_rate/service/convert.go
+package exchange_rate_service
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+)
+
+// BaseCurrency currency harga app_token_products & fee payment method (sumber konversi)
+const BaseCurrency = "IDR"
+
+// rate disimpan sebagai fixed point (rate_micros = rate x 1.000.000)
+const rateScale = 1_000_000
+
+// Rate is an effective exchange rate: 1 QuoteCurrency = RateMicros / 1.000.000 BaseCurrency (dalam satuan utuh).
+// Semua nominal dalam minor unit currency masing-masing (sama seperti nominal payment, lihat CurrencyExponent).
+type Rate struct {
+	ID                int64
+	BaseCurrency      string
+	QuoteCurrency     string
+	BaseExponent      int
+	QuoteExponent     int
+	RateMicros        int64
+	RoundingMode      entity.ExchangeRateRoundingMode
+	RoundingIncrement int64
+	EffectiveAt       time.Time
+}
+
+// FromBase converts base amount to quote amount, dibulatkan sesuai rounding rules quote currency (increment dalam minor unit quote)
+func (r Rate) FromBase(amount int64) (int64, error) {
+	if amount == 0 {
+		return 0, nil
+	}
+	increment := max(r.RoundingIncrement, 1)
+
+	// quote = amount * rateScale * 10^quoteExp / (rateMicros * 10^baseExp), dibulatkan ke kelipatan increment
+	num := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rateScale))
+	num.Mul(num, pow10(r.QuoteExponent))
+	den := new(big.Int).Mul(big.NewInt(r.RateMicros), big.NewInt(increment))
+	den.Mul(den, pow10(r.BaseExponent))
+
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() != 0 {
+		switch r.RoundingMode {
+		case entity.ExchangeRateRoundingModeDown:
+		case entity.ExchangeRateRoundingModeNearest:
+			// half up
+			if new(big.Int).Mul(rem, big.NewInt(2)).Cmp(den) >= 0 {
+				quo.Add(quo, big.NewInt(1))
+			}
+		default:
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	res := new(big.Int).Mul(quo, big.NewInt(increment))
+	if !res.IsInt64() {
+		return 0, errs.NewInternalServerError(errors.New("OVERFLOW_CONVERT_EXCHANGE_RATE"))
+	}
+	return res.Int64(), nil
+}
+
+// ToBase converts quote amount to base amount (dibulatkan kebawah)
+func (r Rate) ToBase(amount int64) (int64, error) {
+	// base = amount * rateMicros * 10^baseExp / (rateScale * 10^quoteExp)
+	num := new(big.Int).Mul(big.NewInt(amount), big.NewInt(r.RateMicros))
+	num.Mul(num, pow10(r.BaseExponent))
+	den := new(big.Int).Mul(big.NewInt(rateScale), pow10(r.QuoteExponent))
+	res := num.Quo(num, den)
+	if !res.IsInt64() {
+		return 0, errs.NewInternalServerError(errors.New("OVERFLOW_CONVERT_EXCHANGE_RATE"))
+	}
+	return res.Int64(), nil
+}
+
+// Info returns the rate snapshot for price quote response
+func (r Rate) Info() ExchangeRateInfo {
+	return ExchangeRateInfo{
+		ID:            r.ID,
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		QuoteExponent: r.QuoteExponent,
+		Rate:          rateMicrosToFloat(r.RateMicros),
+		EffectiveAt:   r.EffectiveAt,
+	}
+}
+
+func rateFloatToMicros(rate float64) int64 {
+	return int64(math.Round(rate * rateScale))
+}
+
+func rateMicrosToFloat(micros int64) float64 {
+	return float64(micros) / rateScale
+}
@@ -0,0 +1,65 @@
+// internal/module/app/exchange_rate/service/currency.go
+package exchange_rate_service
+
+import (
+	"math/big"
+	"strings"
+)
+
+// currencyExponents jumlah digit minor unit per currency (ISO 4217).
+// Nominal payment disimpan dalam minor unit: USD 10,50 -> 1050.
+// IDR 0 digit: nominal IDR tetap rupiah utuh (midtrans tidak menerima desimal).
+var currencyExponents = map[string]int{
+	"IDR": 0,
+	"USD": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"AUD": 2,
+	"SGD": 2,
+	"MYR": 2,
+	"THB": 2,
+	"PHP": 2,
+	"JPY": 0,
+	"KRW": 0,
+	"VND": 0,
+}
+
+// CurrencyExponent returns jumlah digit minor unit currency, false jika currency belum didukung
+func CurrencyExponent(currency string) (int, bool) {
+	exp, ok := currencyExponents[strings.ToUpper(currency)]
+	return exp, ok
+}
+
+// FormatMinorAmount formats nominal minor unit dengan separator ribuan titik & desimal koma
+// ex: IDR 10000 -> "10.000", USD 1050 -> "10,50"
+func FormatMinorAmount(currency string, amount int64) string {
+	negative := amount < 0
+	abs := new(big.Int).Abs(big.NewInt(amount))
+
+	exp, _ := CurrencyExponent(currency)
+	digits := abs.String()
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+	whole, fraction := digits[:len(digits)-exp], digits[len(digits)-exp:]
+
+	var b strings.Builder
+	if negative {
+		b.WriteByte('-')
+	}
+	for i, c := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(c)
+	}
+	if fraction != "" {
+		b.WriteByte(',')
+		b.WriteString(fraction)
+	}
+	return b.String()
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
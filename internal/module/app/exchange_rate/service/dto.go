@@ -0,0 +1,35 @@
+// internal/module/app/exchange_rate/service/dto.go
+package exchange_rate_service
+
+import "time"
+
+type CreateExchangeRateInput struct {
+	ProfileID     string `json:"-"`
+	BaseCurrency  string `json:"baseCurrency" validate:"omitempty,len=3"`
+	QuoteCurrency string `json:"quoteCurrency" validate:"required,len=3"`
+	// 1 quote currency = rate base currency (ex: 1 USD = 16250.5 IDR)
+	Rate              float64                  `json:"rate" validate:"required,gt=0"`
+	RoundingMode      ExchangeRateRoundingMode `json:"roundingMode" validate:"required,oneof=up down nearest"`
+	RoundingIncrement int64                    `json:"roundingIncrement" validate:"required,min=1"`
+	// kosong = berlaku sekarang, tidak boleh di masa lalu
+	EffectiveAt *time.Time `json:"effectiveAt"`
+}
+
+type UpdateExchangeRateInput struct {
+	ID                int64                    `json:"-"`
+	ProfileID         string                   `json:"-"`
+	BaseCurrency      string                   `json:"baseCurrency" validate:"omitempty,len=3"`
+	QuoteCurrency     string                   `json:"quoteCurrency" validate:"required,len=3"`
+	Rate              float64                  `json:"rate" validate:"required,gt=0"`
+	RoundingMode      ExchangeRateRoundingMode `json:"roundingMode" validate:"required,oneof=up down nearest"`
+	RoundingIncrement int64                    `json:"roundingIncrement" validate:"required,min=1"`
+	EffectiveAt       *time.Time               `json:"effectiveAt"`
+}
+
+type ExchangeRateRoundingMode string
+
+const (
+	ExchangeRateRoundingModeUp      ExchangeRateRoundingMode = "up"
+	ExchangeRateRoundingModeDown    ExchangeRateRoundingMode = "down"
+	ExchangeRateRoundingModeNearest ExchangeRateRoundingMode = "nearest"
+)
@@ -0,0 +1,13 @@
+// internal/module/app/exchange_rate/service/filter.go
+package exchange_rate_service
+
+type GetExchangeRatesFilter struct {
+	Search     string `json:"search"`
+	SortBy     string `json:"sortBy"`
+	SortDir    string `json:"sortDir"`
+	PageOffset int    `json:"pageOffset"`
+	PageLimit  int    `json:"pageLimit"`
+	Page       int    `json:"page"`
+}
+
+var SORT_BY = []string{"effective_at", "quote_currency", "created_at", "id"}
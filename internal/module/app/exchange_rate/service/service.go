@@ -0,0 +1,267 @@
+// internal/module/app/exchange_rate/service/service.go
+package exchange_rate_service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/pagination"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type ExchangeRateService struct {
+	store entity.Store
+}
+
+func NewService(store entity.Store) *ExchangeRateService {
+	return &ExchangeRateService{store: store}
+}
+
+func (s *ExchangeRateService) GetAllExchangeRates(ctx context.Context, filter GetExchangeRatesFilter) ([]ExchangeRateResponse, *pagination.Pagination, error) {
+	search := sql.NullString{String: filter.Search, Valid: filter.Search != ""}
+
+	rates, err := s.store.GetAllExchangeRates(ctx, entity.GetAllExchangeRatesParams{
+		Search:     search,
+		SortBy:     filter.SortBy,
+		SortDir:    filter.SortDir,
+		PageOffset: int32(filter.PageOffset),
+		PageLimit:  int32(filter.PageLimit),
+	})
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	count, err := s.store.CountAllExchangeRates(ctx, search)
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	responses := make([]ExchangeRateResponse, 0, len(rates))
+	for _, r := range rates {
+		responses = append(responses, mapToResponse(r))
+	}
+
+	return responses, &pag, nil
+}
+
+func (s *ExchangeRateService) GetExchangeRateById(ctx context.Context, id int64) (ExchangeRateResponse, error) {
+	rate, err := s.store.GetExchangeRateById(ctx, id)
+	if err == sql.ErrNoRows {
+		return ExchangeRateResponse{}, errs.NewNotFound("EXCHANGE_RATE_NOT_FOUND")
+	}
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapToResponse(rate), nil
+}
+
+// GetEffectiveRate returns rate base -> quote yang berlaku sekarang
+func (s *ExchangeRateService) GetEffectiveRate(ctx context.Context, baseCurrency, quoteCurrency string) (Rate, error) {
+	rate, err := s.store.GetEffectiveExchangeRate(ctx, entity.GetEffectiveExchangeRateParams{
+		BaseCurrency:  strings.ToUpper(baseCurrency),
+		QuoteCurrency: strings.ToUpper(quoteCurrency),
+		At:            time.Now(),
+	})
+	if err == sql.ErrNoRows {
+		return Rate{}, errs.NewNotFound("EXCHANGE_RATE_NOT_FOUND")
+	}
+	if err != nil {
+		return Rate{}, errs.NewInternalServerError(err)
+	}
+
+	baseExp, baseOK := CurrencyExponent(rate.BaseCurrency)
+	quoteExp, quoteOK := CurrencyExponent(rate.QuoteCurrency)
+	if !baseOK || !quoteOK {
+		return Rate{}, errs.NewBadRequest("CURRENCY_NOT_SUPPORTED")
+	}
+
+	return Rate{
+		ID:                rate.ID,
+		BaseCurrency:      rate.BaseCurrency,
+		QuoteCurrency:     rate.QuoteCurrency,
+		BaseExponent:      baseExp,
+		QuoteExponent:     quoteExp,
+		RateMicros:        rate.RateMicros,
+		RoundingMode:      rate.RoundingMode,
+		RoundingIncrement: rate.RoundingIncrement,
+		EffectiveAt:       rate.EffectiveAt,
+	}, nil
+}
+
+func (s *ExchangeRateService) CreateExchangeRate(ctx context.Context, input CreateExchangeRateInput) (ExchangeRateResponse, error) {
+	profileID, err := uuid.Parse(input.ProfileID)
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewBadRequest("INVALID_PROFILE_ID")
+	}
+
+	base, quote, rateMicros, effectiveAt, err := normalizeRateInput(input.BaseCurrency, input.QuoteCurrency, input.Rate, input.EffectiveAt)
+	if err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	rate, err := s.store.CreateExchangeRate(ctx, entity.CreateExchangeRateParams{
+		BaseCurrency:       base,
+		QuoteCurrency:      quote,
+		RateMicros:         rateMicros,
+		RoundingMode:       entity.ExchangeRateRoundingMode(input.RoundingMode),
+		RoundingIncrement:  input.RoundingIncrement,
+		EffectiveAt:        effectiveAt,
+		CreatedByProfileID: profileID,
+	})
+	if isUniqueViolation(err) {
+		return ExchangeRateResponse{}, errs.NewBadRequest("EXCHANGE_RATE_ALREADY_EXISTS")
+	}
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapToResponse(rate), nil
+}
+
+// UpdateExchangeRate: hanya rate yang belum berlaku, rate yang sudah berlaku dikoreksi dengan membuat rate baru
+func (s *ExchangeRateService) UpdateExchangeRate(ctx context.Context, input UpdateExchangeRateInput) (ExchangeRateResponse, error) {
+	profileID, err := uuid.Parse(input.ProfileID)
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewBadRequest("INVALID_PROFILE_ID")
+	}
+
+	if err := s.ensureNotEffective(ctx, input.ID); err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	base, quote, rateMicros, effectiveAt, err := normalizeRateInput(input.BaseCurrency, input.QuoteCurrency, input.Rate, input.EffectiveAt)
+	if err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	rate, err := s.store.UpdateExchangeRate(ctx, entity.UpdateExchangeRateParams{
+		ID:                 input.ID,
+		BaseCurrency:       base,
+		QuoteCurrency:      quote,
+		RateMicros:         rateMicros,
+		RoundingMode:       entity.ExchangeRateRoundingMode(input.RoundingMode),
+		RoundingIncrement:  input.RoundingIncrement,
+		EffectiveAt:        effectiveAt,
+		UpdatedByProfileID: profileID,
+	})
+	if err == sql.ErrNoRows {
+		// sudah berlaku di antara pengecekan & update
+		return ExchangeRateResponse{}, errs.NewBadRequest("EXCHANGE_RATE_ALREADY_EFFECTIVE")
+	}
+	if isUniqueViolation(err) {
+		return ExchangeRateResponse{}, errs.NewBadRequest("EXCHANGE_RATE_ALREADY_EXISTS")
+	}
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapToResponse(rate), nil
+}
+
+// DeleteExchangeRate: hanya rate yang belum berlaku
+func (s *ExchangeRateService) DeleteExchangeRate(ctx context.Context, id int64, profileID string) (ExchangeRateResponse, error) {
+	pid, err := uuid.Parse(profileID)
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewBadRequest("INVALID_PROFILE_ID")
+	}
+
+	if err := s.ensureNotEffective(ctx, id); err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	rate, err := s.store.SoftDeleteExchangeRate(ctx, entity.SoftDeleteExchangeRateParams{
+		ID:                 id,
+		UpdatedByProfileID: pid,
+	})
+	if err == sql.ErrNoRows {
+		return ExchangeRateResponse{}, errs.NewBadRequest("EXCHANGE_RATE_ALREADY_EFFECTIVE")
+	}
+	if err != nil {
+		return ExchangeRateResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapToResponse(rate), nil
+}
+
+func (s *ExchangeRateService) ensureNotEffective(ctx context.Context, id int64) error {
+	existing, err := s.store.GetExchangeRateById(ctx, id)
+	if err == sql.ErrNoRows {
+		return errs.NewNotFound("EXCHANGE_RATE_NOT_FOUND")
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	if !existing.EffectiveAt.After(time.Now()) {
+		return errs.NewBadRequest("EXCHANGE_RATE_ALREADY_EFFECTIVE")
+	}
+	return nil
+}
+
+// normalizeRateInput: uppercase currency, base default IDR, rate ke micros, effective_at default sekarang
+func normalizeRateInput(baseCurrency, quoteCurrency string, rate float64, effectiveAt *time.Time) (string, string, int64, time.Time, error) {
+	base := strings.ToUpper(baseCurrency)
+	if base == "" {
+		base = BaseCurrency
+	}
+	quote := strings.ToUpper(quoteCurrency)
+	// currency tanpa exponent minor unit tidak bisa dikonversi
+	if _, ok := CurrencyExponent(base); !ok {
+		return "", "", 0, time.Time{}, errs.NewValidationFailed(map[string]string{"baseCurrency": "CURRENCY_NOT_SUPPORTED"})
+	}
+	if _, ok := CurrencyExponent(quote); !ok {
+		return "", "", 0, time.Time{}, errs.NewValidationFailed(map[string]string{"quoteCurrency": "CURRENCY_NOT_SUPPORTED"})
+	}
+	if base == quote {
+		return "", "", 0, time.Time{}, errs.NewValidationFailed(map[string]string{"quoteCurrency": "MUST_DIFFER_FROM_BASE_CURRENCY"})
+	}
+
+	rateMicros := rateFloatToMicros(rate)
+	if rateMicros <= 0 {
+		return "", "", 0, time.Time{}, errs.NewValidationFailed(map[string]string{"rate": "RATE_TOO_SMALL"})
+	}
+
+	now := time.Now()
+	at := now
+	if effectiveAt != nil {
+		if effectiveAt.Before(now) {
+			return "", "", 0, time.Time{}, errs.NewValidationFailed(map[string]string{"effectiveAt": "MUST_NOT_BE_IN_THE_PAST"})
+		}
+		at = *effectiveAt
+	}
+
+	return base, quote, rateMicros, at, nil
+}
+
+func isUniqueViolation(err error) bool {
+	pqErr, ok := err.(*pq.Error)
+	return ok && pqErr.Code == "23505"
+}
+
+func mapToResponse(r entity.AppExchangeRate) ExchangeRateResponse {
+	return ExchangeRateResponse{
+		ID:                r.ID,
+		BaseCurrency:      r.BaseCurrency,
+		QuoteCurrency:     r.QuoteCurrency,
+		Rate:              rateMicrosToFloat(r.RateMicros),
+		RateMicros:        r.RateMicros,
+		RoundingMode:      ExchangeRateRoundingMode(r.RoundingMode),
+		RoundingIncrement: r.RoundingIncrement,
+		EffectiveAt:       r.EffectiveAt,
+		IsEffective:       !r.EffectiveAt.After(time.Now()),
+		CreatedAt:         r.CreatedAt,
+		UpdatedAt:         r.UpdatedAt,
+	}
+}
@@ -0,0 +1,29 @@
+// internal/module/app/exchange_rate/service/viewmodel.go
+package exchange_rate_service
+
+import "time"
+
+type ExchangeRateResponse struct {
+	ID                int64                    `json:"id"`
+	BaseCurrency      string                   `json:"baseCurrency"`
+	QuoteCurrency     string                   `json:"quoteCurrency"`
+	Rate              float64                  `json:"rate"`
+	RateMicros        int64                    `json:"rateMicros"`
+	RoundingMode      ExchangeRateRoundingMode `json:"roundingMode"`
+	RoundingIncrement int64                    `json:"roundingIncrement"`
+	EffectiveAt       time.Time                `json:"effectiveAt"`
+	IsEffective       bool                     `json:"isEffective"`
+	CreatedAt         time.Time                `json:"createdAt"`
+	UpdatedAt         time.Time                `json:"updatedAt"`
+}
+
+// ExchangeRateInfo is the rate used for a price quote (dipakai token product & payment)
+type ExchangeRateInfo struct {
+	ID            int64  `json:"id"`
+	BaseCurrency  string `json:"baseCurrency"`
+	QuoteCurrency string `json:"quoteCurrency"`
+	// jumlah digit minor unit nominal quote currency (ex: USD 2 -> 1050 = 10,50)
+	QuoteExponent int       `json:"quoteExponent"`
+	Rate          float64   `json:"rate"`
+	EffectiveAt   time.Time `json:"effectiveAt"`
+}
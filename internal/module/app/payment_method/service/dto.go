@@ -10,6 +10,7 @@ type CreatePaymentMethodInput struct {
 	AdminType PaymentMethodAdminType `json:"adminType" validate:"required,oneof=fixed percentage"`
 	AdminFee  int64                  `json:"adminFee" validate:"min=0"`
 	IsActive  bool                   `json:"isActive"`
+	Gateway   PaymentMethodGateway   `json:"gateway" validate:"omitempty,oneof=midtrans fake"`
 }
 
 type UpdatePaymentMethodInput struct {
@@ -23,6 +24,7 @@ type UpdatePaymentMethodInput struct {
 	AdminType PaymentMethodAdminType `json:"adminType" validate:"required,oneof=fixed percentage"`
 	AdminFee  int64                  `json:"adminFee" validate:"min=0"`
 	IsActive  bool                   `json:"isActive"`
+	Gateway   PaymentMethodGateway   `json:"gateway" validate:"omitempty,oneof=midtrans fake"`
 }
 
 type PaymentMethodType string
@@ -38,3 +40,19 @@ const (
 	PaymentMethodAdminTypeFixed      PaymentMethodAdminType = "fixed"
 	PaymentMethodAdminTypePercentage PaymentMethodAdminType = "percentage"
 )
+
+// PaymentMethodGateway payment gateway yang memproses payment method
+type PaymentMethodGateway string
+
+const (
+	PaymentMethodGatewayMidtrans PaymentMethodGateway = "midtrans"
+	PaymentMethodGatewayFake     PaymentMethodGateway = "fake"
+)
+
+// OrDefault: gateway kosong = midtrans
+func (g PaymentMethodGateway) OrDefault() PaymentMethodGateway {
+	if g == "" {
+		return PaymentMethodGatewayMidtrans
+	}
+	return g
+}
@@ -132,6 +132,7 @@ func (s *PaymentMethodService) CreatePaymentMethod(ctx context.Context, input Cr
 			AdminType: entity.AppPaymentAdminType(input.AdminType),
 			AdminFee:  input.AdminFee,
 			IsActive:  input.IsActive,
+			Gateway:   entity.PaymentGatewayType(input.Gateway.OrDefault()),
 		})
 		if createErr != nil {
 			return createErr
@@ -152,6 +153,7 @@ func (s *PaymentMethodService) CreatePaymentMethod(ctx context.Context, input Cr
 			BeforeAdminFee:  method.AdminFee,
 			BeforeTaxFee:    method.TaxFee,
 			BeforeIsActive:  method.IsActive,
+			BeforeGateway:   method.Gateway,
 			AfterCode:       strings.ToUpper(input.Code),
 			AfterName:       method.Name,
 			AfterType:       method.Type,
@@ -160,6 +162,7 @@ func (s *PaymentMethodService) CreatePaymentMethod(ctx context.Context, input Cr
 			AfterAdminFee:   method.AdminFee,
 			AfterTaxFee:     method.TaxFee,
 			AfterIsActive:   method.IsActive,
+			AfterGateway:    method.Gateway,
 		})
 		return logErr
 	})
@@ -216,6 +219,7 @@ func (s *PaymentMethodService) UpdatePaymentMethod(ctx context.Context, input Up
 			AdminType: entity.AppPaymentAdminType(input.AdminType),
 			AdminFee:  input.AdminFee,
 			IsActive:  input.IsActive,
+			Gateway:   entity.PaymentGatewayType(input.Gateway.OrDefault()),
 		})
 		if updateErr != nil {
 			return updateErr
@@ -236,6 +240,7 @@ func (s *PaymentMethodService) UpdatePaymentMethod(ctx context.Context, input Up
 			BeforeAdminFee:  existing.AdminFee,
 			BeforeTaxFee:    existing.TaxFee,
 			BeforeIsActive:  existing.IsActive,
+			BeforeGateway:   existing.Gateway,
 			AfterCode:       strings.ToUpper(input.Code),
 			AfterName:       method.Name,
 			AfterType:       method.Type,
@@ -244,6 +249,7 @@ func (s *PaymentMethodService) UpdatePaymentMethod(ctx context.Context, input Up
 			AfterAdminFee:   method.AdminFee,
 			AfterTaxFee:     method.TaxFee,
 			AfterIsActive:   method.IsActive,
+			AfterGateway:    method.Gateway,
 		})
 		return logErr
 	})
@@ -293,6 +299,7 @@ func (s *PaymentMethodService) DeletePaymentMethod(ctx context.Context, id int64
 			BeforeAdminFee:  existing.AdminFee,
 			BeforeTaxFee:    existing.TaxFee,
 			BeforeIsActive:  existing.IsActive,
+			BeforeGateway:   existing.Gateway,
 			AfterCode:       strings.ToUpper(method.Code),
 			AfterName:       method.Name,
 			AfterType:       method.Type,
@@ -301,6 +308,7 @@ func (s *PaymentMethodService) DeletePaymentMethod(ctx context.Context, id int64
 			AfterAdminFee:   method.AdminFee,
 			AfterTaxFee:     method.TaxFee,
 			AfterIsActive:   method.IsActive,
+			AfterGateway:    method.Gateway,
 		})
 		return logErr
 	})
@@ -328,6 +336,7 @@ func mapToResponse(m entity.AppPaymentMethod) PaymentMethodResponse {
 		AdminType: PaymentMethodAdminType(m.AdminType),
 		AdminFee:  m.AdminFee,
 		IsActive:  m.IsActive,
+		Gateway:   PaymentMethodGateway(m.Gateway),
 		CreatedAt: m.CreatedAt,
 		UpdatedAt: m.UpdatedAt,
 	}
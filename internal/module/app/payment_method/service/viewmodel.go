@@ -12,6 +12,7 @@ type PaymentMethodResponse struct {
 	AdminType PaymentMethodAdminType `json:"adminType"`
 	AdminFee  int64                  `json:"adminFee"`
 	IsActive  bool                   `json:"isActive"`
+	Gateway   PaymentMethodGateway   `json:"gateway"`
 	CreatedAt time.Time              `json:"createdAt"`
 	UpdatedAt time.Time              `json:"updatedAt"`
 }
@@ -3,6 +3,8 @@ package token_product_handler
 
 import (
 	"net/http"
+	"strings"
+
 	token_product_service "postmatic-api/internal/module/app/token_product/service"
 	"postmatic-api/internal/repository/entity"
 
@@ -33,9 +35,10 @@ func (h *Handler) CalculateTokenProduct(w http.ResponseWriter, r *http.Request)
 		response.Error(w, r, err, nil)
 		return
 	}
-	currencyCode, err := utils.GetQueryEnum(r, "currencyCode", []string{"IDR"})
-	if err != nil {
-		response.Error(w, r, err, nil)
+	// currency selain IDR dikonversi dengan exchange rate yang berlaku
+	currencyCode := strings.ToUpper(r.URL.Query().Get("currencyCode"))
+	if len(currencyCode) != 3 {
+		response.ValidationFailed(w, r, map[string]string{"currencyCode": "INVALID_CURRENCY_CODE"})
 		return
 	}
 	from, err := utils.GetQueryEnum(r, "from", []string{"price", "token"})
@@ -6,16 +6,20 @@ import (
 	"database/sql"
 	"strings"
 
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+	"postmatic-api/internal/module/headless/payment_gateway"
 	"postmatic-api/internal/repository/entity"
 	"postmatic-api/pkg/errs"
 )
 
 type TokenProductService struct {
-	store entity.Store
+	store        entity.Store
+	exchangeRate *exchange_rate_service.ExchangeRateService
+	gateway      payment_gateway.Service
 }
 
-func NewTokenProductService(store entity.Store) *TokenProductService {
-	return &TokenProductService{store: store}
+func NewTokenProductService(store entity.Store, exchangeRate *exchange_rate_service.ExchangeRateService, gateway payment_gateway.Service) *TokenProductService {
+	return &TokenProductService{store: store, exchangeRate: exchangeRate, gateway: gateway}
 }
 
 // calculate token product based on price or token amount (dapat digunakan pada dashboard admin ataupun service untuk cek harga checkout sebelum tax/admin fee)
@@ -31,36 +35,92 @@ func (s *TokenProductService) CalculateTokenProduct(ctx context.Context, filter
 		return res, errs.NewValidationFailed(map[string]string{"from": "INVALID_FROM"})
 	}
 
-	data, err := s.store.GetAppTokenProductByTypeCurrency(ctx, entity.GetAppTokenProductByTypeCurrencyParams{
-		TokenType:    tokenType,
-		CurrencyCode: strings.ToUpper(filter.CurrencyCode),
-	})
-	if err == sql.ErrNoRows {
-		return res, errs.NewNotFound("TOKEN_PRODUCT_NOT_FOUND")
+	// product dengan currency pembeli dipakai langsung, jika tidak ada harga product base currency dikonversi dengan kurs yang berlaku
+	currencyCode := strings.ToUpper(filter.CurrencyCode)
+	// harga dalam currency yang tidak bisa di-charge gateway manapun tidak dikembalikan (ex: midtrans hanya IDR)
+	if !s.gateway.SupportsCurrency(currencyCode) {
+		return res, errs.NewBadRequest("PAYMENT_CURRENCY_NOT_SUPPORTED")
 	}
+	data, rate, err := s.getTokenProductForCurrency(ctx, tokenType, currencyCode)
 	if err != nil {
 		return res, err
 	}
 
 	if filter.From == "price" {
-		res.TokenAmount, err = s.convertIDRToTokens(filter.Amount, data.PriceAmount, data.TokenAmount)
+		baseAmount := filter.Amount
+		if rate != nil {
+			baseAmount, err = rate.ToBase(filter.Amount)
+			if err != nil {
+				return res, err
+			}
+		}
+		res.TokenAmount, err = s.convertIDRToTokens(baseAmount, data.PriceAmount, data.TokenAmount)
 		if err != nil {
 			return res, err
 		}
 		res.PriceAmount = filter.Amount
+		res.BasePriceAmount = baseAmount
 	} else {
 		res.TokenAmount = filter.Amount
-		res.PriceAmount, err = s.convertTokensToIDR(filter.Amount, data.PriceAmount, data.TokenAmount)
+		res.BasePriceAmount, err = s.convertTokensToIDR(filter.Amount, data.PriceAmount, data.TokenAmount)
 		if err != nil {
 			return res, err
 		}
+		res.PriceAmount = res.BasePriceAmount
+		if rate != nil {
+			res.PriceAmount, err = rate.FromBase(res.BasePriceAmount)
+			if err != nil {
+				return res, err
+			}
+		}
 	}
 
 	res.CreatedAt = data.CreatedAt
 	res.UpdatedAt = data.UpdatedAt
 	res.ID = data.ID
 	res.Type = string(data.TokenType)
-	res.CurrencyCode = data.CurrencyCode
+	res.CurrencyCode = currencyCode
+	res.BaseCurrencyCode = data.CurrencyCode
+	if rate != nil {
+		info := rate.Info()
+		res.ExchangeRate = &info
+		res.Rate = rate
+	}
 
 	return res, nil
 }
+
+// getTokenProductForCurrency returns token product dalam currency pembeli (rate nil),
+// atau product base currency beserta kurs base -> currency pembeli
+func (s *TokenProductService) getTokenProductForCurrency(ctx context.Context, tokenType entity.TokenType, currencyCode string) (entity.AppTokenProduct, *exchange_rate_service.Rate, error) {
+	data, err := s.store.GetAppTokenProductByTypeCurrency(ctx, entity.GetAppTokenProductByTypeCurrencyParams{
+		TokenType:    tokenType,
+		CurrencyCode: currencyCode,
+	})
+	if err == nil {
+		return data, nil, nil
+	}
+	if err != sql.ErrNoRows {
+		return data, nil, err
+	}
+	if currencyCode == exchange_rate_service.BaseCurrency {
+		return data, nil, errs.NewNotFound("TOKEN_PRODUCT_NOT_FOUND")
+	}
+
+	data, err = s.store.GetAppTokenProductByTypeCurrency(ctx, entity.GetAppTokenProductByTypeCurrencyParams{
+		TokenType:    tokenType,
+		CurrencyCode: exchange_rate_service.BaseCurrency,
+	})
+	if err == sql.ErrNoRows {
+		return data, nil, errs.NewNotFound("TOKEN_PRODUCT_NOT_FOUND")
+	}
+	if err != nil {
+		return data, nil, err
+	}
+
+	rate, err := s.exchangeRate.GetEffectiveRate(ctx, data.CurrencyCode, currencyCode)
+	if err != nil {
+		return data, nil, err
+	}
+	return data, &rate, nil
+}
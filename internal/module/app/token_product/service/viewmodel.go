@@ -4,6 +4,8 @@ package token_product_service
 import (
 	"time"
 
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+
 	"github.com/google/uuid"
 )
 
@@ -13,6 +15,14 @@ type TokenCalculateProductResponse struct {
 	CurrencyCode string    `json:"currencyCode"`
 	PriceAmount  int64     `json:"priceAmount"`
 	TokenAmount  int64     `json:"tokenAmount"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	// harga dalam currency product (sama dengan priceAmount jika tanpa konversi)
+	BaseCurrencyCode string `json:"baseCurrencyCode"`
+	BasePriceAmount  int64  `json:"basePriceAmount"`
+	// null jika product tersedia langsung dalam currencyCode
+	ExchangeRate *exchange_rate_service.ExchangeRateInfo `json:"exchangeRate"`
+	CreatedAt    time.Time                               `json:"createdAt"`
+	UpdatedAt    time.Time                               `json:"updatedAt"`
+
+	// kurs untuk konversi fee & diskon saat checkout
+	Rate *exchange_rate_service.Rate `json:"-"`
 }
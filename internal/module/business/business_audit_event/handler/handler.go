@@ -0,0 +1,67 @@
+// internal/module/business/business_audit_event/handler/handler.go
+package business_audit_event_handler
+
+import (
+	"net/http"
+	"postmatic-api/internal/internal_middleware"
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
+
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	auditSvc   *business_audit_event_service.BusinessAuditEventService
+	middleware *internal_middleware.OwnedBusiness
+}
+
+func NewHandler(auditSvc *business_audit_event_service.BusinessAuditEventService, ownedMw *internal_middleware.OwnedBusiness) *Handler {
+	return &Handler{auditSvc: auditSvc, middleware: ownedMw}
+}
+
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	// owned business middleware
+	r.Route("/{businessId}", func(r chi.Router) {
+		r.Use(h.middleware.OwnedBusinessMiddleware)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermAuditRead)).Get("/", h.GetAuditEventsByBusinessID)
+	})
+
+	return r
+}
+
+// GetAuditEventsByBusinessID: category = entity type (business_knowledge, business_product, ...)
+func (h *Handler) GetAuditEventsByBusinessID(w http.ResponseWriter, r *http.Request) {
+	business, _ := internal_middleware.OwnedBusinessFromContext(r.Context())
+
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+
+	if filter.Category != "" && !utils.StringInSlice(filter.Category, business_audit_event_service.EntityTypeValues) {
+		response.ValidationFailed(w, r, map[string]string{"category": "INVALID_ENTITY_TYPE"})
+		return
+	}
+
+	filterQuery := business_audit_event_service.GetBusinessAuditEventsByBusinessRootIDFilter{
+		Search:         filter.Search,
+		SortBy:         filter.SortByDB(),
+		PageOffset:     filter.Offset(),
+		PageLimit:      filter.Limit,
+		SortDir:        filter.Sort,
+		Page:           filter.Page,
+		EntityType:     filter.Category,
+		DateStart:      filter.DateStart,
+		DateEnd:        filter.DateEnd,
+		BusinessRootID: business.BusinessRootID,
+	}
+
+	res, pagination, err := h.auditSvc.GetAuditEventsByBusinessRootID(r.Context(), filterQuery)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "SUCCESS_GET_BUSINESS_AUDIT_EVENTS", res, &filter, &pagination)
+}
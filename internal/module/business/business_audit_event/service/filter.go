@@ -0,0 +1,14 @@
+package business_audit_event_service
+
+type GetBusinessAuditEventsByBusinessRootIDFilter struct {
+	Search         string  `json:"search"`
+	SortBy         string  `json:"sortBy"`
+	PageOffset     int     `json:"pageOffset"`
+	PageLimit      int     `json:"pageLimit"`
+	SortDir        string  `json:"sortDir"`
+	Page           int     `json:"page"`
+	EntityType     string  `json:"entityType"`
+	DateStart      *string `json:"dateStart"`
+	DateEnd        *string `json:"dateEnd"`
+	BusinessRootID int64   `json:"businessRootId"`
+}
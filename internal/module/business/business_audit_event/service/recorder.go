@@ -0,0 +1,118 @@
+// internal/module/business/business_audit_event/recorder.go
+package business_audit_event_service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"reflect"
+
+	"postmatic-api/internal/repository/entity"
+
+	"github.com/google/uuid"
+)
+
+// entity type yang di-audit (disimpan apa adanya di kolom entity_type)
+const (
+	EntityBusinessKnowledge       = "business_knowledge"
+	EntityBusinessProduct         = "business_product"
+	EntityBusinessMember          = "business_member"
+	EntityBusinessRssSubscription = "business_rss_subscription"
+)
+
+var EntityTypeValues = []string{
+	EntityBusinessKnowledge,
+	EntityBusinessProduct,
+	EntityBusinessMember,
+	EntityBusinessRssSubscription,
+}
+
+// field timestamp tidak dihitung sebagai perubahan
+var ignoredDiffFields = []string{"createdAt", "updatedAt"}
+
+type RecordAuditEventInput struct {
+	BusinessRootID int64
+	ProfileID      uuid.UUID
+	EntityType     string
+	EntityID       string
+	Action         entity.ActionChangeType
+	// snapshot resource (struct response / map), nil untuk create (Before) & delete (After)
+	Before any
+	After  any
+}
+
+// RecordAuditEvent menulis audit event. Panggil dengan *entity.Queries di dalam store.ExecTx
+// agar event ikut rollback bersama mutasinya. Update tanpa perubahan field tidak dicatat.
+func RecordAuditEvent(ctx context.Context, q entity.Querier, input RecordAuditEventInput) error {
+	before, err := toDiffMap(input.Before)
+	if err != nil {
+		return err
+	}
+	after, err := toDiffMap(input.After)
+	if err != nil {
+		return err
+	}
+
+	if input.Action == entity.ActionChangeTypeUpdate {
+		for _, m := range []map[string]any{before, after} {
+			for k := range m {
+				if reflect.DeepEqual(before[k], after[k]) {
+					delete(before, k)
+					delete(after, k)
+				}
+			}
+		}
+		if len(before) == 0 && len(after) == 0 {
+			return nil
+		}
+	}
+
+	beforeJSON, err := marshalNullable(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := marshalNullable(after)
+	if err != nil {
+		return err
+	}
+
+	_, err = q.CreateBusinessAuditEvent(ctx, entity.CreateBusinessAuditEventParams{
+		BusinessRootID: input.BusinessRootID,
+		ProfileID:      input.ProfileID,
+		EntityType:     input.EntityType,
+		EntityID:       input.EntityID,
+		Action:         input.Action,
+		Before:         beforeJSON,
+		After:          afterJSON,
+	})
+	return err
+}
+
+func toDiffMap(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	for _, k := range ignoredDiffFields {
+		delete(m, k)
+	}
+	return m, nil
+}
+
+func marshalNullable(m map[string]any) (sql.NullString, error) {
+	if m == nil {
+		return sql.NullString{}, nil
+	}
+	b, err := json.Marshal(m)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+	return sql.NullString{String: string(b), Valid: true}, nil
+}
@@ -0,0 +1,92 @@
+// internal/module/business/business_audit_event/service.go
+package business_audit_event_service
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/pagination"
+	"postmatic-api/pkg/utils"
+)
+
+type BusinessAuditEventService struct {
+	store entity.Store
+}
+
+func NewService(store entity.Store) *BusinessAuditEventService {
+	return &BusinessAuditEventService{
+		store: store,
+	}
+}
+
+func (s *BusinessAuditEventService) GetAuditEventsByBusinessRootID(ctx context.Context, filter GetBusinessAuditEventsByBusinessRootIDFilter) ([]BusinessAuditEventResponse, pagination.Pagination, error) {
+	entityType := sql.NullString{String: filter.EntityType, Valid: filter.EntityType != ""}
+
+	events, err := s.store.GetBusinessAuditEventsByBusinessRootID(ctx, entity.GetBusinessAuditEventsByBusinessRootIDParams{
+		BusinessRootID: filter.BusinessRootID,
+		Search:         filter.Search,
+		EntityType:     entityType,
+		DateStart:      utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:        utils.NullStringToNullTime(filter.DateEnd),
+		PageOffset:     int32(filter.PageOffset),
+		PageLimit:      int32(filter.PageLimit),
+		SortBy:         filter.SortBy,
+		SortDir:        filter.SortDir,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	result := make([]BusinessAuditEventResponse, 0, len(events))
+	for _, v := range events {
+		var image *string
+		if v.ProfileImageUrl.Valid {
+			image = &v.ProfileImageUrl.String
+		}
+		result = append(result, BusinessAuditEventResponse{
+			ID:             v.ID,
+			BusinessRootID: v.BusinessRootID,
+			EntityType:     v.EntityType,
+			EntityID:       v.EntityID,
+			Action:         string(v.Action),
+			Before:         nullStringToRawMessage(v.Before),
+			After:          nullStringToRawMessage(v.After),
+			Actor: AuditActorSub{
+				ID:    v.ProfileID,
+				Name:  v.ProfileName,
+				Email: v.ProfileEmail,
+				Image: image,
+			},
+			CreatedAt: v.CreatedAt,
+		})
+	}
+
+	count, err := s.store.CountBusinessAuditEventsByBusinessRootID(ctx, entity.CountBusinessAuditEventsByBusinessRootIDParams{
+		BusinessRootID: filter.BusinessRootID,
+		Search:         filter.Search,
+		EntityType:     entityType,
+		DateStart:      utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:        utils.NullStringToNullTime(filter.DateEnd),
+	})
+	if err != nil {
+		return nil, pagination.Pagination{}, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	return result, pag, nil
+}
+
+func nullStringToRawMessage(v sql.NullString) json.RawMessage {
+	if !v.Valid {
+		return nil
+	}
+	return json.RawMessage(v.String)
+}
@@ -0,0 +1,28 @@
+// internal/module/business/business_audit_event/viewmodel.go
+package business_audit_event_service
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BusinessAuditEventResponse struct {
+	ID             int64           `json:"id"`
+	BusinessRootID int64           `json:"businessRootId"`
+	EntityType     string          `json:"entityType"`
+	EntityID       string          `json:"entityId"`
+	Action         string          `json:"action"`
+	Before         json.RawMessage `json:"before"`
+	After          json.RawMessage `json:"after"`
+	Actor          AuditActorSub   `json:"actor"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+type AuditActorSub struct {
+	ID    uuid.UUID `json:"id"`
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Image *string   `json:"image"`
+}
@@ -0,0 +1,84 @@
+// internal/module/business/business_generate_caption/handler/handler.go
+package business_generate_caption_handler
+
+import (
+	"net/http"
+
+	"postmatic-api/internal/internal_middleware"
+	business_generate_caption_service "postmatic-api/internal/module/business/business_generate_caption/service"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc        *business_generate_caption_service.BusinessGenerateCaptionService
+	middleware *internal_middleware.OwnedBusiness
+}
+
+func NewHandler(svc *business_generate_caption_service.BusinessGenerateCaptionService, ownedMw *internal_middleware.OwnedBusiness) *Handler {
+	return &Handler{svc: svc, middleware: ownedMw}
+}
+
+// Routes di-mount pada /business/generate/caption/{businessId}
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	// owned business middleware
+	r.Route("/{businessId}", func(r chi.Router) {
+		r.Use(h.middleware.OwnedBusinessMiddleware)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentGenerate)).Post("/", h.GenerateCaption)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentWrite)).Post("/save", h.SaveCaption)
+	})
+
+	return r
+}
+
+// GenerateCaption handles POST /api/business/generate/caption/{businessId}
+func (h *Handler) GenerateCaption(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req business_generate_caption_service.GenerateCaptionInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.BusinessRootID = business.BusinessRootID
+
+	res, err := h.svc.GenerateCaption(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GENERATE_CAPTION", res)
+}
+
+// SaveCaption handles POST /api/business/generate/caption/{businessId}/save
+func (h *Handler) SaveCaption(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req business_generate_caption_service.SaveCaptionInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.BusinessRootID = business.BusinessRootID
+
+	res, err := h.svc.SaveCaption(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_SAVE_CAPTION", res)
+}
@@ -0,0 +1,19 @@
+// internal/module/business/business_generate_caption/dto.go
+package business_generate_caption_service
+
+type GenerateCaptionInput struct {
+	BusinessRootID        int64
+	GenerativeTextModelID int64  `json:"generativeTextModelId" validate:"required"`
+	BusinessProductID     *int64 `json:"businessProductId"`
+	// brief/topik konten dari user, ex: "promo akhir tahun diskon 20%"
+	Brief            string  `json:"brief" validate:"required,max=1000"`
+	Category         *string `json:"category" validate:"omitempty,max=255"`
+	NumberOfVariants int     `json:"numberOfVariants" validate:"omitempty,min=1,max=5"`
+}
+
+type SaveCaptionInput struct {
+	BusinessRootID         int64
+	BusinessImageContentID int64    `json:"businessImageContentId" validate:"required"`
+	Caption                string   `json:"caption" validate:"required"`
+	Hashtags               []string `json:"hashtags"`
+}
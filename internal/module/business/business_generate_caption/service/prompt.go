@@ -0,0 +1,117 @@
+// internal/module/business/business_generate_caption/prompt.go
+package business_generate_caption_service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"postmatic-api/internal/repository/entity"
+)
+
+const systemPrompt = `Kamu adalah social media copywriter untuk sebuah brand.
+Tulis caption yang sesuai dengan identitas brand, tone, dan target audiens yang diberikan.
+Balas HANYA dengan JSON valid tanpa markdown dengan format:
+{"variants":[{"caption":"...","hashtags":["#..."]}]}`
+
+type captionPromptInput struct {
+	Knowledge        entity.GetBusinessKnowledgeByBusinessRootIDRow
+	Role             *entity.BusinessRole
+	Product          *entity.BusinessProduct
+	Brief            string
+	Category         *string
+	NumberOfVariants int
+}
+
+// buildCaptionPrompt menyusun prompt dari business knowledge, role dan product
+func buildCaptionPrompt(input captionPromptInput) string {
+	var b strings.Builder
+
+	k := input.Knowledge
+	b.WriteString("## Brand\n")
+	fmt.Fprintf(&b, "Nama: %s\n", k.Name)
+	fmt.Fprintf(&b, "Kategori: %s\n", k.Category)
+	writeOptional(&b, "Deskripsi", k.Description.String)
+	writeOptional(&b, "Unique selling point", k.UniqueSellingPoint.String)
+	writeOptional(&b, "Visi misi", k.VisionMission.String)
+	writeOptional(&b, "Lokasi", k.Location.String)
+	writeOptional(&b, "Website", k.WebsiteUrl.String)
+
+	if r := input.Role; r != nil {
+		b.WriteString("\n## Role\n")
+		writeOptional(&b, "Tone", r.Tone)
+		writeOptional(&b, "Target audiens", r.TargetAudience)
+		writeOptional(&b, "Persona audiens", r.AudiencePersona)
+		writeOptional(&b, "Call to action", r.CallToAction)
+		writeOptional(&b, "Tujuan", r.Goals.String)
+		if len(r.Hashtags) > 0 {
+			fmt.Fprintf(&b, "Hashtag wajib: %s\n", strings.Join(r.Hashtags, " "))
+		}
+	}
+
+	if p := input.Product; p != nil {
+		b.WriteString("\n## Produk\n")
+		fmt.Fprintf(&b, "Nama: %s\n", p.Name)
+		fmt.Fprintf(&b, "Kategori: %s\n", p.Category)
+		writeOptional(&b, "Deskripsi", p.Description.String)
+		fmt.Fprintf(&b, "Harga: %s %d\n", p.Currency, p.Price)
+	}
+
+	b.WriteString("\n## Konten\n")
+	fmt.Fprintf(&b, "Brief: %s\n", input.Brief)
+	if input.Category != nil {
+		writeOptional(&b, "Kategori konten", *input.Category)
+	}
+
+	fmt.Fprintf(&b, "\nBuat %d variasi caption yang berbeda gaya pembukaannya, masing-masing dengan 3-10 hashtag relevan.", input.NumberOfVariants)
+	return b.String()
+}
+
+func writeOptional(b *strings.Builder, label, value string) {
+	if strings.TrimSpace(value) == "" {
+		return
+	}
+	fmt.Fprintf(b, "%s: %s\n", label, value)
+}
+
+type captionResult struct {
+	Variants []CaptionVariantResponse `json:"variants"`
+}
+
+// parseCaptionResult parse output model (toleran terhadap code fence markdown)
+func parseCaptionResult(text string) ([]CaptionVariantResponse, error) {
+	text = strings.TrimSpace(text)
+	if start, end := strings.Index(text, "{"), strings.LastIndex(text, "}"); start >= 0 && end > start {
+		text = text[start : end+1]
+	}
+
+	var result captionResult
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		return nil, err
+	}
+
+	variants := make([]CaptionVariantResponse, 0, len(result.Variants))
+	for _, v := range result.Variants {
+		caption := strings.TrimSpace(v.Caption)
+		if caption == "" {
+			continue
+		}
+		hashtags := make([]string, 0, len(v.Hashtags))
+		for _, h := range v.Hashtags {
+			h = strings.TrimSpace(h)
+			if h == "" {
+				continue
+			}
+			if !strings.HasPrefix(h, "#") {
+				h = "#" + h
+			}
+			hashtags = append(hashtags, h)
+		}
+		variants = append(variants, CaptionVariantResponse{Caption: caption, Hashtags: hashtags})
+	}
+	if len(variants) == 0 {
+		return nil, errors.New("empty variants")
+	}
+	return variants, nil
+}
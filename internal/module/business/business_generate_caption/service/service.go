@@ -0,0 +1,181 @@
+// internal/module/business/business_generate_caption/service.go
+package business_generate_caption_service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	business_image_content_service "postmatic-api/internal/module/business/business_image_content/service"
+	"postmatic-api/internal/module/headless/google_genai"
+	openai_svc "postmatic-api/internal/module/headless/openai"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+)
+
+const defaultNumberOfVariants = 3
+
+type BusinessGenerateCaptionService struct {
+	store        entity.Store
+	imageContent *business_image_content_service.BusinessImageContentService
+	google       google_genai.Service
+	openai       openai_svc.Service
+}
+
+func NewService(store entity.Store, imageContent *business_image_content_service.BusinessImageContentService, google google_genai.Service, openai openai_svc.Service) *BusinessGenerateCaptionService {
+	return &BusinessGenerateCaptionService{
+		store:        store,
+		imageContent: imageContent,
+		google:       google,
+		openai:       openai,
+	}
+}
+
+func (s *BusinessGenerateCaptionService) GenerateCaption(ctx context.Context, input GenerateCaptionInput) (GenerateCaptionResponse, error) {
+	log := logger.From(ctx)
+
+	// 1. validasi model
+	model, err := s.store.GetGenerativeTextModelByIdUser(ctx, input.GenerativeTextModelID)
+	if err == sql.ErrNoRows {
+		return GenerateCaptionResponse{}, errs.NewNotFound("GENERATIVE_TEXT_MODEL_NOT_FOUND")
+	}
+	if err != nil {
+		return GenerateCaptionResponse{}, errs.NewInternalServerError(err)
+	}
+
+	// 2. kumpulkan konteks brand
+	knowledge, err := s.store.GetBusinessKnowledgeByBusinessRootID(ctx, input.BusinessRootID)
+	if err == sql.ErrNoRows {
+		return GenerateCaptionResponse{}, errs.NewBadRequest("BUSINESS_KNOWLEDGE_NOT_FOUND")
+	}
+	if err != nil {
+		return GenerateCaptionResponse{}, errs.NewInternalServerError(err)
+	}
+
+	var role *entity.BusinessRole
+	r, err := s.store.GetBusinessRoleByBusinessRootID(ctx, input.BusinessRootID)
+	if err != nil && err != sql.ErrNoRows {
+		return GenerateCaptionResponse{}, errs.NewInternalServerError(err)
+	}
+	if err == nil {
+		role = &r
+	}
+
+	var product *entity.BusinessProduct
+	if input.BusinessProductID != nil {
+		p, err := s.store.GetBusinessProductByBusinessProductId(ctx, *input.BusinessProductID)
+		if err == sql.ErrNoRows || (err == nil && p.BusinessRootID != input.BusinessRootID) {
+			return GenerateCaptionResponse{}, errs.NewNotFound("BUSINESS_PRODUCT_NOT_FOUND")
+		}
+		if err != nil {
+			return GenerateCaptionResponse{}, errs.NewInternalServerError(err)
+		}
+		product = &p
+	}
+
+	numberOfVariants := input.NumberOfVariants
+	if numberOfVariants <= 0 {
+		numberOfVariants = defaultNumberOfVariants
+	}
+
+	prompt := buildCaptionPrompt(captionPromptInput{
+		Knowledge:        knowledge,
+		Role:             role,
+		Product:          product,
+		Brief:            input.Brief,
+		Category:         input.Category,
+		NumberOfVariants: numberOfVariants,
+	})
+
+	// 3. generate sesuai provider
+	text, err := s.generateByProvider(ctx, model, prompt)
+	if err != nil {
+		return GenerateCaptionResponse{}, err
+	}
+
+	variants, err := parseCaptionResult(text)
+	if err != nil {
+		log.Error("Failed to parse caption result", "model", model.Model, "error", err)
+		return GenerateCaptionResponse{}, errs.NewBadRequest("GENERATE_CAPTION_INVALID_RESULT")
+	}
+
+	// hashtag wajib dari business role selalu disertakan
+	if role != nil {
+		for i := range variants {
+			variants[i].Hashtags = mergeHashtags(role.Hashtags, variants[i].Hashtags)
+		}
+	}
+
+	return GenerateCaptionResponse{
+		GenerativeTextModelID: model.ID,
+		Model:                 model.Model,
+		Provider:              string(model.Provider),
+		Variants:              variants,
+	}, nil
+}
+
+func (s *BusinessGenerateCaptionService) SaveCaption(ctx context.Context, input SaveCaptionInput) (*business_image_content_service.BusinessImageContentResponse, error) {
+	caption := strings.TrimSpace(input.Caption)
+	if len(input.Hashtags) > 0 {
+		caption = caption + "\n\n" + strings.Join(input.Hashtags, " ")
+	}
+
+	return s.imageContent.UpdateBusinessImageContentCaption(ctx, business_image_content_service.UpdateBusinessImageContentCaptionInput{
+		BusinessRootID:         input.BusinessRootID,
+		BusinessImageContentID: input.BusinessImageContentID,
+		Caption:                caption,
+	})
+}
+
+func (s *BusinessGenerateCaptionService) generateByProvider(ctx context.Context, model entity.AppGenerativeTextModel, prompt string) (string, error) {
+	switch model.Provider {
+	case entity.AppGenerativeTextModelProviderTypeGoogle:
+		res, err := s.google.GenerateText(ctx, google_genai.GenerateTextInput{
+			Model:  model.Model,
+			Prompt: systemPrompt + "\n\n" + prompt,
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.Text, nil
+
+	case entity.AppGenerativeTextModelProviderTypeOpenai:
+		res, err := s.openai.GenerateText(ctx, openai_svc.GenerateTextInput{
+			Model: model.Model,
+			Messages: []openai_svc.ChatMessage{
+				{Role: "system", Content: systemPrompt},
+				{Role: "user", Content: prompt},
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		return res.Text, nil
+
+	default:
+		return "", errs.NewBadRequest("GENERATIVE_TEXT_PROVIDER_NOT_SUPPORTED")
+	}
+}
+
+// mergeHashtags gabungkan hashtag wajib + hasil model tanpa duplikat (case-insensitive)
+func mergeHashtags(required []string, generated []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(required)+len(generated))
+	for _, h := range append(append([]string{}, required...), generated...) {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+		if !strings.HasPrefix(h, "#") {
+			h = "#" + h
+		}
+		key := strings.ToLower(h)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, h)
+	}
+	return result
+}
@@ -0,0 +1,14 @@
+// internal/module/business/business_generate_caption/viewmodel.go
+package business_generate_caption_service
+
+type GenerateCaptionResponse struct {
+	GenerativeTextModelID int64                    `json:"generativeTextModelId"`
+	Model                 string                   `json:"model"`
+	Provider              string                   `json:"provider"`
+	Variants              []CaptionVariantResponse `json:"variants"`
+}
+
+type CaptionVariantResponse struct {
+	Caption  string   `json:"caption"`
+	Hashtags []string `json:"hashtags"`
+}
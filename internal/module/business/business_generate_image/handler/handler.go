@@ -0,0 +1,65 @@
+// internal/module/business/business_generate_image/handler/handler.go
+package business_generate_image_handler
+
+import (
+	"net/http"
+
+	"postmatic-api/internal/internal_middleware"
+	business_generate_image_service "postmatic-api/internal/module/business/business_generate_image/service"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc        *business_generate_image_service.BusinessGenerateImageService
+	middleware *internal_middleware.OwnedBusiness
+}
+
+func NewHandler(svc *business_generate_image_service.BusinessGenerateImageService, ownedMw *internal_middleware.OwnedBusiness) *Handler {
+	return &Handler{svc: svc, middleware: ownedMw}
+}
+
+// Routes di-mount pada /business/generate/image/{businessId}
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	// owned business middleware
+	r.Route("/{businessId}", func(r chi.Router) {
+		r.Use(h.middleware.OwnedBusinessMiddleware)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentGenerate)).Post("/", h.GenerateImage)
+	})
+
+	return r
+}
+
+// GenerateImage handles POST /api/business/generate/image/{businessId}
+func (h *Handler) GenerateImage(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	profile, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req business_generate_image_service.GenerateImageInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.BusinessRootID = business.BusinessRootID
+	req.ProfileID = profile.ID
+
+	res, err := h.svc.GenerateImage(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GENERATE_IMAGE", res)
+}
@@ -0,0 +1,14 @@
+// internal/module/business/business_generate_image/dto.go
+package business_generate_image_service
+
+import "github.com/google/uuid"
+
+type GenerateImageInput struct {
+	BusinessRootID         int64
+	ProfileID              uuid.UUID
+	GenerativeImageModelID int64   `json:"generativeImageModelId" validate:"required"`
+	Prompt                 string  `json:"prompt" validate:"required,max=4000"`
+	Ratio                  string  `json:"ratio" validate:"required"`
+	ImageSize              *string `json:"imageSize"`
+	NumberOfImages         int     `json:"numberOfImages" validate:"omitempty,min=1,max=4"`
+}
@@ -0,0 +1,179 @@
+// internal/module/business/business_generate_image/service.go
+package business_generate_image_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"postmatic-api/config"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
+	"postmatic-api/internal/module/headless/google_genai"
+	openai_svc "postmatic-api/internal/module/headless/openai"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+)
+
+// openaiSizeByRatio: gpt-image-* tidak menerima aspect ratio, jadi ratio dipetakan ke size
+var openaiSizeByRatio = map[string]string{
+	"1:1": "1024x1024",
+	"2:3": "1024x1536",
+	"3:2": "1536x1024",
+}
+
+type BusinessGenerateImageService struct {
+	store      entity.Store
+	cfg        config.Config
+	imageToken *token_ledger_service.TokenLedgerService
+	google     google_genai.Service
+	openai     openai_svc.Service
+}
+
+func NewService(store entity.Store, cfg config.Config, imageToken *token_ledger_service.TokenLedgerService, google google_genai.Service, openai openai_svc.Service) *BusinessGenerateImageService {
+	return &BusinessGenerateImageService{
+		store:      store,
+		cfg:        cfg,
+		imageToken: imageToken,
+		google:     google,
+		openai:     openai,
+	}
+}
+
+func (s *BusinessGenerateImageService) GenerateImage(ctx context.Context, input GenerateImageInput) (GenerateImageResponse, error) {
+	log := logger.From(ctx)
+
+	// 1. validasi model
+	model, err := s.store.GetGenerativeImageModelById(ctx, input.GenerativeImageModelID)
+	if err == sql.ErrNoRows {
+		return GenerateImageResponse{}, errs.NewNotFound("GENERATIVE_IMAGE_MODEL_NOT_FOUND")
+	}
+	if err != nil {
+		return GenerateImageResponse{}, errs.NewInternalServerError(err)
+	}
+	if !model.IsActive {
+		return GenerateImageResponse{}, errs.NewBadRequest("GENERATIVE_IMAGE_MODEL_NOT_ACTIVE")
+	}
+	if !utils.StringInSlice(input.Ratio, model.ValidRatios) {
+		return GenerateImageResponse{}, errs.NewBadRequest("INVALID_RATIO")
+	}
+	if input.ImageSize != nil {
+		// image_sizes null: model tidak support image size
+		if model.ImageSizes == nil {
+			return GenerateImageResponse{}, errs.NewBadRequest("IMAGE_SIZE_NOT_SUPPORTED")
+		}
+		if !utils.StringInSlice(*input.ImageSize, model.ImageSizes) {
+			return GenerateImageResponse{}, errs.NewBadRequest("INVALID_IMAGE_SIZE")
+		}
+	}
+
+	numberOfImages := input.NumberOfImages
+	if numberOfImages <= 0 {
+		numberOfImages = 1
+	}
+	tokenCost := int64(numberOfImages) * s.cfg.GENERATIVE_IMAGE_TOKEN_COST
+
+	// 2. reserve token sebelum memanggil provider (saldo ditahan, belum jadi transaksi 'out')
+	reservation, err := s.imageToken.ReserveToken(ctx, token_ledger_service.ReserveTokenInput{
+		TokenType:              entity.TokenTypeImageToken,
+		ProfileID:              input.ProfileID,
+		BusinessRootID:         input.BusinessRootID,
+		GenerativeImageModelID: model.ID,
+		Amount:                 tokenCost,
+	})
+	if err != nil {
+		return GenerateImageResponse{}, err
+	}
+
+	// 3. generate sesuai provider
+	images, err := s.generateByProvider(ctx, model, input, numberOfImages)
+	if err == nil && len(images) == 0 {
+		err = errs.NewInternalServerError(errors.New("GENERATE_IMAGE_EMPTY_RESULT"))
+	}
+	if err != nil {
+		// 4a. release reservation, jangan ikut cancel kalau request sudah selesai
+		if releaseErr := s.imageToken.ReleaseReservation(context.WithoutCancel(ctx), reservation.ID); releaseErr != nil {
+			log.Error("Failed to release token reservation after generate image failed", "reservationId", reservation.ID, "error", releaseErr)
+		}
+		return GenerateImageResponse{}, err
+	}
+
+	// 4b. commit reservation menjadi transaksi 'out'
+	trx, err := s.imageToken.CommitReservation(context.WithoutCancel(ctx), reservation.ID)
+	if err != nil {
+		log.Error("Failed to commit token reservation after generate image", "reservationId", reservation.ID, "error", err)
+		return GenerateImageResponse{}, err
+	}
+
+	return GenerateImageResponse{
+		TransactionID:          trx.ID,
+		GenerativeImageModelID: model.ID,
+		Model:                  model.Model,
+		Provider:               string(model.Provider),
+		Ratio:                  input.Ratio,
+		ImageSize:              input.ImageSize,
+		TokenUsed:              tokenCost,
+		Images:                 images,
+	}, nil
+}
+
+func (s *BusinessGenerateImageService) generateByProvider(ctx context.Context, model entity.AppGenerativeImageModel, input GenerateImageInput, numberOfImages int) ([]GeneratedImageResponse, error) {
+	switch model.Provider {
+	case entity.AppGenerativeImageModelProviderTypeGoogle:
+		res, err := s.google.GenerateImage(ctx, google_genai.GenerateImageInput{
+			Model:          model.Model,
+			Prompt:         input.Prompt,
+			NumberOfImages: &numberOfImages,
+			AspectRatio:    &input.Ratio,
+			ImageSize:      input.ImageSize,
+		})
+		if err != nil {
+			return nil, err
+		}
+		images := make([]GeneratedImageResponse, 0, len(res.Images))
+		for _, img := range res.Images {
+			images = append(images, GeneratedImageResponse{
+				Base64Data: nilIfEmpty(img.Base64Data),
+				MimeType:   nilIfEmpty(img.MimeType),
+			})
+		}
+		return images, nil
+
+	case entity.AppGenerativeImageModelProviderTypeOpenai:
+		size := input.ImageSize
+		if size == nil {
+			if v, ok := openaiSizeByRatio[input.Ratio]; ok {
+				size = &v
+			}
+		}
+		res, err := s.openai.GenerateImage(ctx, openai_svc.GenerateImageInput{
+			Model:  model.Model,
+			Prompt: input.Prompt,
+			N:      &numberOfImages,
+			Size:   size,
+		})
+		if err != nil {
+			return nil, err
+		}
+		images := make([]GeneratedImageResponse, 0, len(res.Images))
+		for _, img := range res.Images {
+			images = append(images, GeneratedImageResponse{
+				ImageUrl:      nilIfEmpty(img.URL),
+				Base64Data:    nilIfEmpty(img.Base64Data),
+				RevisedPrompt: nilIfEmpty(img.RevisedPrompt),
+			})
+		}
+		return images, nil
+
+	default:
+		return nil, errs.NewBadRequest("GENERATIVE_IMAGE_PROVIDER_NOT_SUPPORTED")
+	}
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,20 @@
+// internal/module/business/business_generate_image/viewmodel.go
+package business_generate_image_service
+
+type GenerateImageResponse struct {
+	TransactionID          int64                    `json:"transactionId"`
+	GenerativeImageModelID int64                    `json:"generativeImageModelId"`
+	Model                  string                   `json:"model"`
+	Provider               string                   `json:"provider"`
+	Ratio                  string                   `json:"ratio"`
+	ImageSize              *string                  `json:"imageSize"`
+	TokenUsed              int64                    `json:"tokenUsed"`
+	Images                 []GeneratedImageResponse `json:"images"`
+}
+
+type GeneratedImageResponse struct {
+	ImageUrl      *string `json:"imageUrl"`
+	Base64Data    *string `json:"base64Data"`
+	MimeType      *string `json:"mimeType"`
+	RevisedPrompt *string `json:"revisedPrompt"`
+}
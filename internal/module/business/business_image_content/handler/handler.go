@@ -29,10 +29,10 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetBusinessImageContentsByBusinessRootID)
-		r.Post("/", h.CreateBusinessImageContent)
-		r.Put("/{businessImageContentId}", h.UpdateBusinessImageContent)
-		r.Delete("/{businessImageContentId}", h.DeleteBusinessImageContent)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetBusinessImageContentsByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentWrite)).Post("/", h.CreateBusinessImageContent)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentWrite)).Put("/{businessImageContentId}", h.UpdateBusinessImageContent)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentWrite)).Delete("/{businessImageContentId}", h.DeleteBusinessImageContent)
 	})
 
 	return r
@@ -10,3 +10,9 @@ type CreateUpdateBusinessImageContentInput struct {
 	Category          string   `json:"category"`
 	BusinessProductID *int64   `json:"businessProductId"`
 }
+
+type UpdateBusinessImageContentCaptionInput struct {
+	BusinessRootID         int64
+	BusinessImageContentID int64
+	Caption                string
+}
@@ -152,6 +152,38 @@ func (s *BusinessImageContentService) UpdateBusinessImageContent(ctx context.Con
 	}, nil
 }
 
+func (s *BusinessImageContentService) UpdateBusinessImageContentCaption(ctx context.Context, input UpdateBusinessImageContentCaptionInput) (*BusinessImageContentResponse, error) {
+	updated, err := s.store.UpdateBusinessImageContentCaption(ctx, entity.UpdateBusinessImageContentCaptionParams{
+		ID:             input.BusinessImageContentID,
+		BusinessRootID: input.BusinessRootID,
+		Caption:        sql.NullString{String: input.Caption, Valid: input.Caption != ""},
+	})
+	if err == sql.ErrNoRows {
+		return nil, errs.NewNotFound("BUSINESS_IMAGE_CONTENT_NOT_FOUND")
+	}
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	var bProdId *int64
+	if updated.BusinessProductID.Valid {
+		bProdId = &updated.BusinessProductID.Int64
+	}
+
+	return &BusinessImageContentResponse{
+		BusinessRootID:    updated.BusinessRootID,
+		Category:          updated.Category,
+		ImageUrls:         updated.ImageUrls,
+		ID:                updated.ID,
+		Caption:           updated.Caption.String,
+		Type:              string(updated.Type),
+		ReadyToPost:       updated.ReadyToPost,
+		CreatedAt:         updated.CreatedAt.Time,
+		UpdatedAt:         updated.UpdatedAt.Time,
+		BusinessProductID: bProdId,
+	}, nil
+}
+
 func (s *BusinessImageContentService) DeleteBusinessImageContent(ctx context.Context, id int64) (*BusinessImageContentResponse, error) {
 	deleted, err := s.store.SoftDeleteBusinessImageContentByBusinessImageContentId(ctx, id)
 	if err == sql.ErrNoRows {
@@ -28,8 +28,9 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetBusinessById)
-		r.Delete("/", h.DeleteBusinessById)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetBusinessById)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessDelete)).Delete("/", h.DeleteBusinessById)
+		r.Get("/permissions", h.GetMyPermissions)
 	})
 	r.Route("/", func(r chi.Router) {
 		r.Get("/", h.GetJoinedBusinessesByProfileID)
@@ -100,6 +101,20 @@ func (h *Handler) GetBusinessById(w http.ResponseWriter, r *http.Request) {
 	response.OK(w, r, "SUCCESS_GET_BUSINESS", res)
 }
 
+// GetMyPermissions mengembalikan role + permission milik user login pada business (untuk FE)
+func (h *Handler) GetMyPermissions(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_BUSINESS_PERMISSIONS", internal_middleware.RolePermissionsResponse{
+		Role:        business.Role,
+		Permissions: internal_middleware.PermissionsOfRole(business.Role),
+	})
+}
+
 func (h *Handler) DeleteBusinessById(w http.ResponseWriter, r *http.Request) {
 	business, _ := internal_middleware.OwnedBusinessFromContext(r.Context())
 
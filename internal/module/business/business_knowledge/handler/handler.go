@@ -29,8 +29,8 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetBusinessKnowledgeByBusinessRootId)
-		r.Post("/", h.UpsertBusinessKnowledgeByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetBusinessKnowledgeByBusinessRootId)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSettingsWrite)).Post("/", h.UpsertBusinessKnowledgeByBusinessRootID)
 	})
 
 	return r
@@ -74,6 +74,9 @@ func (h *Handler) UpsertBusinessKnowledgeByBusinessRootID(w http.ResponseWriter,
 		return
 	}
 
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ProfileID = prof.ID
+
 	res, err := h.busInSvc.UpsertBusinessKnowledgeByBusinessRootID(r.Context(), req)
 	if err != nil {
 		response.Error(w, r, err, nil)
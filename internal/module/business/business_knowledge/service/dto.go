@@ -1,5 +1,7 @@
 package business_knowledge_service
 
+import "github.com/google/uuid"
+
 type UpsertBusinessKnowledgeInput struct {
 	PrimaryLogoUrl     string  `json:"primaryLogoUrl" validate:"required,url"`
 	Name               string  `json:"name" validate:"required"`
@@ -11,4 +13,5 @@ type UpsertBusinessKnowledgeInput struct {
 	Location           string  `json:"location" validate:"required"`
 	ColorTone          string  `json:"colorTone" validate:"required,min=6,max=6"`
 	BusinessRootID     int64   `json:"businessRootId" validate:"required"`
+	ProfileID          uuid.UUID
 }
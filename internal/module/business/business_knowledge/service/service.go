@@ -4,7 +4,9 @@ package business_knowledge_service
 import (
 	"context"
 	"database/sql"
+	"strconv"
 
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
 	"postmatic-api/internal/repository/entity"
 	"postmatic-api/pkg/errs"
 
@@ -58,24 +60,70 @@ func (s *BusinessKnowledgeService) UpsertBusinessKnowledgeByBusinessRootID(ctx c
 		websiteUrl = *input.WebsiteUrl
 	}
 
-	bk, err := s.store.UpsertBusinessKnowledgeByBusinessRootID(ctx, entity.UpsertBusinessKnowledgeByBusinessRootIDParams{
-		BusinessRootID:     input.BusinessRootID,
-		Name:               input.Name,
-		PrimaryLogoUrl:     sql.NullString{String: input.PrimaryLogoUrl, Valid: input.PrimaryLogoUrl != ""},
-		Category:           input.Category,
-		Description:        sql.NullString{String: input.Description, Valid: input.Description != ""},
-		UniqueSellingPoint: sql.NullString{String: input.UniqueSellingPoint, Valid: input.UniqueSellingPoint != ""},
-		WebsiteUrl:         sql.NullString{String: websiteUrl, Valid: websiteUrl != ""},
-		VisionMission:      sql.NullString{String: input.VisionMission, Valid: input.VisionMission != ""},
-		Location:           sql.NullString{String: input.Location, Valid: input.Location != ""},
-		ColorTone:          sql.NullString{String: input.ColorTone, Valid: input.ColorTone != ""},
+	var (
+		bk     entity.BusinessKnowledge
+		before *BusinessKnowledgeResponse
+	)
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		prev, err := q.GetBusinessKnowledgeByBusinessRootID(ctx, input.BusinessRootID)
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+		if err == nil {
+			before = &BusinessKnowledgeResponse{
+				RootBusinessId:     input.BusinessRootID,
+				Name:               prev.Name,
+				PrimaryLogoUrl:     prev.PrimaryLogoUrl.String,
+				Description:        prev.Description.String,
+				Category:           prev.Category,
+				ColorTone:          prev.ColorTone.String,
+				UniqueSellingPoint: prev.UniqueSellingPoint.String,
+				WebsiteUrl:         utils.NullStringToString(prev.WebsiteUrl),
+				VisionMission:      prev.VisionMission.String,
+				Location:           prev.Location.String,
+			}
+		}
+
+		bk, err = q.UpsertBusinessKnowledgeByBusinessRootID(ctx, entity.UpsertBusinessKnowledgeByBusinessRootIDParams{
+			BusinessRootID:     input.BusinessRootID,
+			Name:               input.Name,
+			PrimaryLogoUrl:     sql.NullString{String: input.PrimaryLogoUrl, Valid: input.PrimaryLogoUrl != ""},
+			Category:           input.Category,
+			Description:        sql.NullString{String: input.Description, Valid: input.Description != ""},
+			UniqueSellingPoint: sql.NullString{String: input.UniqueSellingPoint, Valid: input.UniqueSellingPoint != ""},
+			WebsiteUrl:         sql.NullString{String: websiteUrl, Valid: websiteUrl != ""},
+			VisionMission:      sql.NullString{String: input.VisionMission, Valid: input.VisionMission != ""},
+			Location:           sql.NullString{String: input.Location, Valid: input.Location != ""},
+			ColorTone:          sql.NullString{String: input.ColorTone, Valid: input.ColorTone != ""},
+		})
+		if err != nil {
+			return err
+		}
+
+		action := entity.ActionChangeTypeUpdate
+		if before == nil {
+			action = entity.ActionChangeTypeCreate
+		}
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessKnowledge,
+			EntityID:       strconv.FormatInt(bk.ID, 10),
+			Action:         action,
+			Before:         before,
+			After:          mapBusinessKnowledgeToResponse(input.BusinessRootID, bk),
+		})
 	})
 	if err != nil {
 		return BusinessKnowledgeResponse{}, errs.NewInternalServerError(err)
 	}
 
-	res := BusinessKnowledgeResponse{
-		RootBusinessId:     input.BusinessRootID,
+	return mapBusinessKnowledgeToResponse(input.BusinessRootID, bk), nil
+}
+
+func mapBusinessKnowledgeToResponse(businessRootID int64, bk entity.BusinessKnowledge) BusinessKnowledgeResponse {
+	return BusinessKnowledgeResponse{
+		RootBusinessId:     businessRootID,
 		Name:               bk.Name,
 		PrimaryLogoUrl:     bk.PrimaryLogoUrl.String,
 		Description:        bk.Description.String,
@@ -88,6 +136,4 @@ func (s *BusinessKnowledgeService) UpsertBusinessKnowledgeByBusinessRootID(ctx c
 		VisionMission:      bk.VisionMission.String,
 		Location:           bk.Location.String,
 	}
-
-	return res, nil
 }
@@ -5,7 +5,6 @@ import (
 	"net/http"
 	"postmatic-api/internal/internal_middleware"
 	business_member_service "postmatic-api/internal/module/business/business_member/service"
-	"postmatic-api/internal/repository/entity"
 	"strconv"
 
 	"postmatic-api/pkg/errs"
@@ -27,21 +26,28 @@ func NewHandler(busInSvc *business_member_service.BusinessMemberService, ownedMw
 func (h *Handler) Routes() chi.Router {
 	r := chi.NewRouter()
 
+	// ownership transfer confirmation (nominee belum tentu owner, tanpa owned business middleware)
+	r.Route("/transfer-ownership/{ownershipTransferToken}", func(r chi.Router) {
+		r.Get("/", h.VerifyOwnershipTransfer)
+		r.Post("/", h.ConfirmOwnershipTransfer)
+	})
+
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Group(func(r chi.Router) {
 			r.Use(h.middleware.OwnedBusinessMiddleware)
 
-			r.Get("/", h.GetMembersByBusinessID)
-			r.Post("/", h.InviteBusinessMember)
-			r.Post("/resend-invitation", h.ResendMemberInvitation)
-			r.Put("/", h.EditMember)
+			r.With(h.middleware.RequirePermission(internal_middleware.PermMembersRead)).Get("/", h.GetMembersByBusinessID)
+			r.With(h.middleware.RequirePermission(internal_middleware.PermMembersInvite)).Post("/", h.InviteBusinessMember)
+			r.With(h.middleware.RequirePermission(internal_middleware.PermMembersInvite)).Post("/resend-invitation", h.ResendMemberInvitation)
+			r.With(h.middleware.RequirePermission(internal_middleware.PermMembersManage)).Put("/", h.EditMember)
+			r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessTransfer)).Post("/transfer-ownership", h.TransferOwnership)
 		})
 
 		r.Route("/{memberInvitationTokenOrMemberId}", func(r chi.Router) {
 			r.Route("/", func(r chi.Router) {
 				r.Use(h.middleware.OwnedBusinessMiddleware)
-				r.Delete("/", h.RemoveMember)
+				r.With(h.middleware.RequirePermission(internal_middleware.PermMembersManage)).Delete("/", h.RemoveMember)
 			})
 			r.Get("/verify", h.VerifyMemberInvitation)
 			r.Post("/answer", h.AnswerMemberInvitation)
@@ -113,11 +119,6 @@ func (h *Handler) EditMember(w http.ResponseWriter, r *http.Request) {
 	req.BusinessRootID = business.BusinessRootID
 	req.ProfileID = prof.ID
 
-	if business.Role != entity.BusinessMemberRoleOwner {
-		response.Error(w, r, errs.NewForbidden("ONLY_OWNER_CAN_ACCESS"), nil)
-		return
-	}
-
 	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
 		response.ValidationFailed(w, r, appErr.ValidationErrors)
 		return
@@ -140,11 +141,6 @@ func (h *Handler) ResendMemberInvitation(w http.ResponseWriter, r *http.Request)
 	req.ProfileID = prof.ID
 	req.BusinessRootID = buss.BusinessRootID
 
-	if buss.Role != entity.BusinessMemberRoleOwner {
-		response.Error(w, r, errs.NewForbidden("ONLY_OWNER_CAN_ACCESS"), nil)
-		return
-	}
-
 	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
 		response.ValidationFailed(w, r, appErr.ValidationErrors)
 		return
@@ -176,11 +172,6 @@ func (h *Handler) RemoveMember(w http.ResponseWriter, r *http.Request) {
 	}
 	req.MemberID = intMemberID
 
-	if buss.Role != entity.BusinessMemberRoleOwner {
-		response.Error(w, r, errs.NewForbidden("ONLY_OWNER_CAN_ACCESS"), nil)
-		return
-	}
-
 	res, err := h.busInSvc.RemoveBusinessMember(r.Context(), req)
 	if err != nil {
 		response.Error(w, r, err, nil)
@@ -224,3 +215,57 @@ func (h *Handler) AnswerMemberInvitation(w http.ResponseWriter, r *http.Request)
 
 	response.OK(w, r, "SUCCESS_ANSWER_BUSINESS_MEMBER_INVITATION", res)
 }
+
+// OWNERSHIP TRANSFER
+
+func (h *Handler) TransferOwnership(w http.ResponseWriter, r *http.Request) {
+	var req business_member_service.TransferOwnershipInput
+
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	buss, _ := internal_middleware.OwnedBusinessFromContext(r.Context())
+	req.ProfileID = prof.ID
+	req.BusinessRootID = buss.BusinessRootID
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	res, err := h.busInSvc.TransferOwnership(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_REQUEST_OWNERSHIP_TRANSFER", res)
+}
+
+func (h *Handler) VerifyOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
+	var req business_member_service.VerifyOwnershipTransferInput
+
+	req.OwnershipTransferToken = chi.URLParam(r, "ownershipTransferToken")
+
+	res, err := h.busInSvc.VerifyOwnershipTransfer(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_VERIFY_OWNERSHIP_TRANSFER", res)
+}
+
+func (h *Handler) ConfirmOwnershipTransfer(w http.ResponseWriter, r *http.Request) {
+	var req business_member_service.ConfirmOwnershipTransferInput
+
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ProfileID = prof.ID
+	req.OwnershipTransferToken = chi.URLParam(r, "ownershipTransferToken")
+
+	res, err := h.busInSvc.ConfirmOwnershipTransfer(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_CONFIRM_OWNERSHIP_TRANSFER", res)
+}
@@ -41,3 +41,18 @@ type EditMemberInput struct {
 	ProfileID      uuid.UUID `json:"profileId" validate:"required"`
 	Role           string    `json:"role" validate:"required,oneof=admin member"`
 }
+
+type TransferOwnershipInput struct {
+	MemberID       int64     `json:"memberId" validate:"required"`
+	BusinessRootID int64     `json:"businessRootId" validate:"required"`
+	ProfileID      uuid.UUID `json:"profileId" validate:"required"`
+}
+
+type VerifyOwnershipTransferInput struct {
+	OwnershipTransferToken string `json:"ownershipTransferToken" validate:"required"`
+}
+
+type ConfirmOwnershipTransferInput struct {
+	OwnershipTransferToken string    `json:"ownershipTransferToken" validate:"required"`
+	ProfileID              uuid.UUID `json:"profileId" validate:"required"`
+}
@@ -0,0 +1,259 @@
+// internal/module/business/business_member/ownership_transfer.go
+package business_member_service
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/module/headless/token"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// ================= OWNERSHIP TRANSFER =================
+
+// TransferOwnership: owner menominasikan member (status accepted) sebagai owner baru.
+// Role belum berubah sampai nominee mengkonfirmasi lewat link yang dikirim via email.
+func (s *BusinessMemberService) TransferOwnership(ctx context.Context, input TransferOwnershipInput) (TransferOwnershipResponse, error) {
+	owner, err := s.store.GetMemberByProfileIdAndBusinessRootId(ctx, entity.GetMemberByProfileIdAndBusinessRootIdParams{
+		ProfileID:      input.ProfileID,
+		BusinessRootID: input.BusinessRootID,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return TransferOwnershipResponse{}, err
+	}
+	if owner.ID == 0 || owner.Role != entity.BusinessMemberRoleOwner || owner.Status != entity.BusinessMemberStatusAccepted {
+		return TransferOwnershipResponse{}, errs.NewForbidden("ONLY_OWNER_CAN_TRANSFER_OWNERSHIP")
+	}
+	if owner.ID == input.MemberID {
+		return TransferOwnershipResponse{}, errs.NewBadRequest("CANNOT_TRANSFER_OWNERSHIP_TO_YOURSELF")
+	}
+
+	ownerDetail, err := s.getOwnershipTransferMember(ctx, owner.ID, input.BusinessRootID)
+	if err != nil {
+		return TransferOwnershipResponse{}, err
+	}
+	nominee, err := s.getOwnershipTransferMember(ctx, input.MemberID, input.BusinessRootID)
+	if err != nil {
+		return TransferOwnershipResponse{}, err
+	}
+	if nominee.MemberRole == entity.BusinessMemberRoleOwner {
+		return TransferOwnershipResponse{}, errs.NewBadRequest("MEMBER_ALREADY_OWNER")
+	}
+	if nominee.MemberStatus != entity.BusinessMemberStatusAccepted {
+		return TransferOwnershipResponse{}, errs.NewBadRequest("MEMBER_ALREADY_" + strings.ToUpper(string(nominee.MemberStatus)))
+	}
+
+	tkn, exp, err := s.token.GenerateOwnershipTransferToken(token.GenerateOwnershipTransferTokenInput{
+		BusinessRootID: input.BusinessRootID,
+		FromMemberID:   owner.ID,
+		FromProfileID:  owner.ProfileID,
+		ToMemberID:     nominee.MemberID,
+		ToProfileID:    nominee.MemberProfileID,
+	})
+	if err != nil {
+		return TransferOwnershipResponse{}, errs.NewInternalServerError(err)
+	}
+	link := s.createOwnershipTransferLink(tkn)
+
+	// enqueue ownership transfer email ke nominee
+	go func(input mailer.MemberOwnershipTransferInputDTO) {
+		ctxBg, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		if err := s.queue.EnqueueOwnershipTransfer(ctxBg, input); err != nil {
+			logger.From(ctxBg).Error("failed to enqueue ownership transfer email", "error", err)
+		}
+	}(mailer.MemberOwnershipTransferInputDTO{
+		Email:        nominee.ProfileEmail,
+		ConfirmUrl:   link,
+		BusinessName: nominee.BusinessRootName,
+		OwnerName:    ownerDetail.ProfileName,
+	})
+
+	return TransferOwnershipResponse{
+		OwnershipTransferResponse: mapOwnershipTransferToResponse(ownerDetail, nominee, &exp),
+		ConfirmationLink:          link,
+	}, nil
+}
+
+// VerifyOwnershipTransfer: cek token transfer masih valid (dipakai halaman konfirmasi)
+func (s *BusinessMemberService) VerifyOwnershipTransfer(ctx context.Context, input VerifyOwnershipTransferInput) (OwnershipTransferResponse, error) {
+	claims, owner, nominee, err := s.validateOwnershipTransfer(ctx, input.OwnershipTransferToken)
+	if err != nil {
+		return OwnershipTransferResponse{}, err
+	}
+
+	var exp *time.Time
+	if claims.ExpiresAt != nil {
+		exp = &claims.ExpiresAt.Time
+	}
+	return mapOwnershipTransferToResponse(owner, nominee, exp), nil
+}
+
+// ConfirmOwnershipTransfer: nominee konfirmasi, role ditukar (nominee -> owner, owner lama -> admin)
+// dalam satu transaksi beserta status history keduanya.
+func (s *BusinessMemberService) ConfirmOwnershipTransfer(ctx context.Context, input ConfirmOwnershipTransferInput) (OwnershipTransferResponse, error) {
+	claims, owner, nominee, err := s.validateOwnershipTransfer(ctx, input.OwnershipTransferToken)
+	if err != nil {
+		return OwnershipTransferResponse{}, err
+	}
+	if claims.ToProfileID != input.ProfileID {
+		return OwnershipTransferResponse{}, errs.NewForbidden("OWNERSHIP_TRANSFER_NOT_FOR_YOU")
+	}
+
+	e := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		swaps := []struct {
+			member entity.GetBusinessMemberStatusHistoryByMemberIDRow
+			role   entity.BusinessMemberRole
+		}{
+			{member: nominee, role: entity.BusinessMemberRoleOwner},
+			{member: owner, role: entity.BusinessMemberRoleAdmin},
+		}
+		for _, swap := range swaps {
+			upMem, err := q.UpdateBusinessMemberRole(ctx, entity.UpdateBusinessMemberRoleParams{
+				Role: swap.role,
+				ID:   swap.member.MemberID,
+			})
+			if err != nil {
+				return err
+			}
+			if _, err := q.CreateBusinessMemberStatusHistory(ctx, entity.CreateBusinessMemberStatusHistoryParams{
+				MemberID: swap.member.MemberID,
+				Status:   entity.BusinessMemberStatusAccepted,
+				Role:     swap.role,
+			}); err != nil {
+				return err
+			}
+			if err := business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+				BusinessRootID: claims.BusinessRootID,
+				ProfileID:      input.ProfileID,
+				EntityType:     business_audit_event_service.EntityBusinessMember,
+				EntityID:       strconv.FormatInt(swap.member.MemberID, 10),
+				Action:         entity.ActionChangeTypeUpdate,
+				Before:         mapMemberAuditSnapshot(swap.member),
+				After: GeneralMemberResponse{
+					ID:             upMem.ID,
+					BusinessRootID: upMem.BusinessRootID,
+					ProfileID:      upMem.ProfileID,
+					Role:           string(upMem.Role),
+					Status:         string(upMem.Status),
+				},
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if e != nil {
+		return OwnershipTransferResponse{}, e
+	}
+
+	// invalidate owned business cache kedua profile (sync, agar role baru langsung berlaku)
+	for _, profileID := range []uuid.UUID{owner.MemberProfileID, nominee.MemberProfileID} {
+		if err := s.owned.DeleteOneBusiness(ctx, profileID, claims.BusinessRootID, 5*time.Minute); err != nil {
+			logger.From(ctx).Error("failed to delete owned business cache", "error", err, "profileId", profileID)
+		}
+	}
+
+	// enqueue announce role email
+	for _, announce := range []mailer.MemberAnnounceRoleInputDTO{
+		{Email: nominee.ProfileEmail, BusinessName: nominee.BusinessRootName, NewRole: string(entity.BusinessMemberRoleOwner)},
+		{Email: owner.ProfileEmail, BusinessName: owner.BusinessRootName, NewRole: string(entity.BusinessMemberRoleAdmin)},
+	} {
+		go func(input mailer.MemberAnnounceRoleInputDTO) {
+			ctxBg, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			if err := s.queue.EnqueueAnnounceRole(ctxBg, input); err != nil {
+				logger.From(ctxBg).Error("failed to enqueue announce role email", "error", err)
+			}
+		}(announce)
+	}
+
+	owner.MemberRole = entity.BusinessMemberRoleAdmin
+	nominee.MemberRole = entity.BusinessMemberRoleOwner
+	return mapOwnershipTransferToResponse(owner, nominee, nil), nil
+}
+
+// validateOwnershipTransfer memastikan token valid dan kondisi member belum berubah sejak nominasi
+func (s *BusinessMemberService) validateOwnershipTransfer(ctx context.Context, tkn string) (*token.OwnershipTransferTokenClaims, entity.GetBusinessMemberStatusHistoryByMemberIDRow, entity.GetBusinessMemberStatusHistoryByMemberIDRow, error) {
+	var empty entity.GetBusinessMemberStatusHistoryByMemberIDRow
+
+	claims, err := s.token.ValidateOwnershipTransferToken(tkn)
+	if err != nil {
+		return nil, empty, empty, errs.NewBadRequest("INVALID_OWNERSHIP_TRANSFER_TOKEN")
+	}
+
+	owner, err := s.getOwnershipTransferMember(ctx, claims.FromMemberID, claims.BusinessRootID)
+	if err != nil {
+		return nil, empty, empty, err
+	}
+	if owner.MemberProfileID != claims.FromProfileID ||
+		owner.MemberRole != entity.BusinessMemberRoleOwner ||
+		owner.MemberStatus != entity.BusinessMemberStatusAccepted {
+		return nil, empty, empty, errs.NewBadRequest("OWNERSHIP_TRANSFER_NO_LONGER_VALID")
+	}
+
+	nominee, err := s.getOwnershipTransferMember(ctx, claims.ToMemberID, claims.BusinessRootID)
+	if err != nil {
+		return nil, empty, empty, err
+	}
+	if nominee.MemberProfileID != claims.ToProfileID ||
+		nominee.MemberRole == entity.BusinessMemberRoleOwner ||
+		nominee.MemberStatus != entity.BusinessMemberStatusAccepted {
+		return nil, empty, empty, errs.NewBadRequest("OWNERSHIP_TRANSFER_NO_LONGER_VALID")
+	}
+
+	return claims, owner, nominee, nil
+}
+
+func (s *BusinessMemberService) getOwnershipTransferMember(ctx context.Context, memberID, businessRootID int64) (entity.GetBusinessMemberStatusHistoryByMemberIDRow, error) {
+	member, err := s.store.GetBusinessMemberStatusHistoryByMemberID(ctx, memberID)
+	if err != nil && err != sql.ErrNoRows {
+		return member, err
+	}
+	if member.MemberID == 0 || member.MemberBusinessRootID != businessRootID || member.MemberDeletedAt.Valid {
+		return member, errs.NewNotFound("MEMBER_NOT_FOUND")
+	}
+	return member, nil
+}
+
+func (s *BusinessMemberService) createOwnershipTransferLink(tkn string) string {
+	base := strings.TrimRight(s.cfg.AUTH_URL, "/")
+	route := strings.TrimLeft(s.cfg.OWNERSHIP_TRANSFER_ROUTE, "/")
+	return fmt.Sprintf("%s/%s/%s", base, route, tkn)
+}
+
+func mapOwnershipTransferToResponse(owner, nominee entity.GetBusinessMemberStatusHistoryByMemberIDRow, exp *time.Time) OwnershipTransferResponse {
+	sub := func(m entity.GetBusinessMemberStatusHistoryByMemberIDRow) BusinessProfileSub {
+		var image *string
+		if m.ProfileImageUrl.Valid {
+			image = &m.ProfileImageUrl.String
+		}
+		return BusinessProfileSub{
+			ID:    m.MemberProfileID,
+			Name:  m.ProfileName,
+			Email: m.ProfileEmail,
+			Image: image,
+		}
+	}
+	return OwnershipTransferResponse{
+		BusinessRootID: owner.MemberBusinessRootID,
+		BusinessName:   owner.BusinessRootName,
+		FromMemberID:   owner.MemberID,
+		ToMemberID:     nominee.MemberID,
+		FromProfile:    sub(owner),
+		ToProfile:      sub(nominee),
+		ExpiredAt:      exp,
+	}
+}
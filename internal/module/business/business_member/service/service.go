@@ -5,10 +5,12 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"postmatic-api/config"
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
 	"postmatic-api/internal/module/headless/mailer"
 	"postmatic-api/internal/module/headless/queue"
 	"postmatic-api/internal/module/headless/token"
@@ -493,7 +495,15 @@ func (s *BusinessMemberService) EditMember(ctx context.Context, input EditMember
 		if err != nil {
 			return err
 		}
-		return nil
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessMember,
+			EntityID:       strconv.FormatInt(input.MemberID, 10),
+			Action:         entity.ActionChangeTypeUpdate,
+			Before:         mapMemberAuditSnapshot(checkMember),
+			After:          res,
+		})
 	})
 	if e != nil {
 		return GeneralMemberResponse{}, e
@@ -592,7 +602,14 @@ func (s *BusinessMemberService) RemoveBusinessMember(ctx context.Context, input
 		if err != nil {
 			return err
 		}
-		return nil
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessMember,
+			EntityID:       strconv.FormatInt(input.MemberID, 10),
+			Action:         entity.ActionChangeTypeDelete,
+			Before:         mapMemberAuditSnapshot(checkMember),
+		})
 	})
 
 	if e != nil {
@@ -853,3 +870,16 @@ func (s *BusinessMemberService) addEmailWelcomeBusinessToQueue(input mailer.Memb
 	}
 	return nil
 }
+
+// mapMemberAuditSnapshot: snapshot member sebelum mutasi untuk audit event
+func mapMemberAuditSnapshot(m entity.GetBusinessMemberStatusHistoryByMemberIDRow) GeneralMemberResponse {
+	return GeneralMemberResponse{
+		ID:             m.MemberID,
+		BusinessRootID: m.MemberBusinessRootID,
+		ProfileID:      m.MemberProfileID,
+		Role:           string(m.MemberRole),
+		Status:         string(m.MemberStatus),
+		CreatedAt:      m.MemberCreatedAt,
+		UpdatedAt:      m.MemberUpdatedAt,
+	}
+}
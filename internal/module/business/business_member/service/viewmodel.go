@@ -57,3 +57,18 @@ type InviteMemberResponse struct {
 	InvitationLink string `json:"invitationLink"`
 	RetryAfter     int64  `json:"retryAfter"`
 }
+
+type OwnershipTransferResponse struct {
+	BusinessRootID int64              `json:"businessRootId"`
+	BusinessName   string             `json:"businessName"`
+	FromMemberID   int64              `json:"fromMemberId"`
+	ToMemberID     int64              `json:"toMemberId"`
+	FromProfile    BusinessProfileSub `json:"fromProfile"`
+	ToProfile      BusinessProfileSub `json:"toProfile"`
+	ExpiredAt      *time.Time         `json:"expiredAt"`
+}
+
+type TransferOwnershipResponse struct {
+	OwnershipTransferResponse
+	ConfirmationLink string `json:"confirmationLink"`
+}
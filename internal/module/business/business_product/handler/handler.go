@@ -29,10 +29,10 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetProductsByBusinessID)
-		r.Post("/", h.CreateBusinessProductByBusinessRootID)
-		r.Put("/{businessProductId}", h.UpdateBusinessProductByBusinessRootID)
-		r.Delete("/{businessProductId}", h.SoftDeleteBusinessProductByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetProductsByBusinessID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermProductWrite)).Post("/", h.CreateBusinessProductByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermProductWrite)).Put("/{businessProductId}", h.UpdateBusinessProductByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermProductWrite)).Delete("/{businessProductId}", h.SoftDeleteBusinessProductByBusinessRootID)
 	})
 
 	return r
@@ -76,6 +76,9 @@ func (h *Handler) CreateBusinessProductByBusinessRootID(w http.ResponseWriter, r
 		return
 	}
 
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ProfileID = prof.ID
+
 	res, err := h.busInSvc.CreateBusinessProduct(r.Context(), req)
 	if err != nil {
 		response.Error(w, r, err, nil)
@@ -104,6 +107,12 @@ func (h *Handler) UpdateBusinessProductByBusinessRootID(w http.ResponseWriter, r
 		response.ValidationFailed(w, r, appErr.ValidationErrors)
 		return
 	}
+
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	business, _ := internal_middleware.OwnedBusinessFromContext(r.Context())
+	req.ProfileID = prof.ID
+	req.BusinessRootID = business.BusinessRootID
+
 	res, err := h.busInSvc.UpdateBusinessProduct(r.Context(), req)
 	if err != nil {
 		response.Error(w, r, err, nil)
@@ -124,7 +133,14 @@ func (h *Handler) SoftDeleteBusinessProductByBusinessRootID(w http.ResponseWrite
 		return
 	}
 
-	res, err := h.busInSvc.SoftDeleteBusinessProductByBusinessRootID(r.Context(), intBusinessProductId)
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	business, _ := internal_middleware.OwnedBusinessFromContext(r.Context())
+
+	res, err := h.busInSvc.SoftDeleteBusinessProductByBusinessRootID(r.Context(), business_product_service.SoftDeleteBusinessProductInput{
+		ID:             intBusinessProductId,
+		BusinessRootID: business.BusinessRootID,
+		ProfileID:      prof.ID,
+	})
 	if err != nil {
 		response.Error(w, r, err, nil)
 		return
@@ -1,6 +1,8 @@
 // internal/module/business/business_product/dto.go
 package business_product_service
 
+import "github.com/google/uuid"
+
 type CreateBusinessProductInput struct {
 	Name           string   `json:"name" validate:"required"`
 	Category       string   `json:"category" validate:"required"`
@@ -9,14 +11,23 @@ type CreateBusinessProductInput struct {
 	Currency       string   `json:"currency" validate:"required"`
 	ImageUrls      []string `json:"imageUrls" validate:"required,min=1"`
 	BusinessRootID int64    `json:"businessRootId" validate:"required"`
+	ProfileID      uuid.UUID
 }
 
 type UpdateBusinessProductInput struct {
-	ID          int64    `json:"id" validate:"required"`
-	Name        string   `json:"name" validate:"required"`
-	Category    string   `json:"category" validate:"required"`
-	Description string   `json:"description" validate:"required"`
-	Price       int64    `json:"price" validate:"required"`
-	Currency    string   `json:"currency" validate:"required"`
-	ImageUrls   []string `json:"imageUrls" validate:"required,min=1"`
+	ID             int64    `json:"id" validate:"required"`
+	Name           string   `json:"name" validate:"required"`
+	Category       string   `json:"category" validate:"required"`
+	Description    string   `json:"description" validate:"required"`
+	Price          int64    `json:"price" validate:"required"`
+	Currency       string   `json:"currency" validate:"required"`
+	ImageUrls      []string `json:"imageUrls" validate:"required,min=1"`
+	BusinessRootID int64
+	ProfileID      uuid.UUID
+}
+
+type SoftDeleteBusinessProductInput struct {
+	ID             int64
+	BusinessRootID int64
+	ProfileID      uuid.UUID
 }
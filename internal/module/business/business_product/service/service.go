@@ -4,7 +4,9 @@ package business_product_service
 import (
 	"context"
 	"database/sql"
+	"strconv"
 
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
 	"postmatic-api/internal/repository/entity"
 	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/pagination"
@@ -96,26 +98,35 @@ func (s *BusinessProductService) CreateBusinessProduct(ctx context.Context, inpu
 		ImageUrls:      input.ImageUrls,
 	}
 
-	bk, err := s.store.CreateBusinessProduct(ctx, inputFilter)
+	var res BusinessProductResponse
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		bk, err := q.CreateBusinessProduct(ctx, inputFilter)
+		if err != nil {
+			return err
+		}
+		res = mapBusinessProductToResponse(bk)
+
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessProduct,
+			EntityID:       strconv.FormatInt(bk.ID, 10),
+			Action:         entity.ActionChangeTypeCreate,
+			After:          res,
+		})
+	})
 	if err != nil {
 		return BusinessProductResponse{}, errs.NewInternalServerError(err)
 	}
 
-	return BusinessProductResponse{
-		BusinessRootID: input.BusinessRootID,
-		Name:           bk.Name,
-		Category:       bk.Category,
-		Description:    bk.Description.String,
-		Price:          bk.Price,
-		Currency:       bk.Currency,
-		ImageUrls:      bk.ImageUrls,
-		CreatedAt:      bk.CreatedAt,
-		UpdatedAt:      bk.UpdatedAt,
-		ID:             bk.ID,
-	}, nil
+	return res, nil
 }
 
 func (s *BusinessProductService) UpdateBusinessProduct(ctx context.Context, input UpdateBusinessProductInput) (BusinessProductResponse, error) {
+	check, err := s.getBusinessProduct(ctx, input.ID, input.BusinessRootID)
+	if err != nil {
+		return BusinessProductResponse{}, err
+	}
 
 	inputFilter := entity.UpdateBusinessProductParams{
 		Name:        input.Name,
@@ -127,11 +138,78 @@ func (s *BusinessProductService) UpdateBusinessProduct(ctx context.Context, inpu
 		ID:          input.ID,
 	}
 
-	bk, err := s.store.UpdateBusinessProduct(ctx, inputFilter)
+	var res BusinessProductResponse
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		bk, err := q.UpdateBusinessProduct(ctx, inputFilter)
+		if err != nil {
+			return err
+		}
+		res = mapBusinessProductToResponse(bk)
+
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessProduct,
+			EntityID:       strconv.FormatInt(bk.ID, 10),
+			Action:         entity.ActionChangeTypeUpdate,
+			Before:         mapBusinessProductToResponse(check),
+			After:          res,
+		})
+	})
 	if err != nil {
 		return BusinessProductResponse{}, errs.NewInternalServerError(err)
 	}
 
+	return res, nil
+}
+
+func (s *BusinessProductService) SoftDeleteBusinessProductByBusinessRootID(ctx context.Context, input SoftDeleteBusinessProductInput) (SoftDeleteBusinessProductResponse, error) {
+	check, err := s.getBusinessProduct(ctx, input.ID, input.BusinessRootID)
+	if err != nil {
+		return SoftDeleteBusinessProductResponse{}, err
+	}
+
+	var bk int64
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		bk, err = q.SoftDeleteBusinessProductByBusinessProductId(ctx, input.ID)
+		if err != nil {
+			return err
+		}
+
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessProduct,
+			EntityID:       strconv.FormatInt(bk, 10),
+			Action:         entity.ActionChangeTypeDelete,
+			Before:         mapBusinessProductToResponse(check),
+		})
+	})
+	if err != nil {
+		return SoftDeleteBusinessProductResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return SoftDeleteBusinessProductResponse{
+		ID: bk,
+	}, nil
+}
+
+// getBusinessProduct memastikan product ada dan milik businessRootId
+func (s *BusinessProductService) getBusinessProduct(ctx context.Context, businessProductId, businessRootId int64) (entity.BusinessProduct, error) {
+	check, err := s.store.GetBusinessProductByBusinessProductId(ctx, businessProductId)
+	if err == sql.ErrNoRows {
+		return entity.BusinessProduct{}, errs.NewNotFound("")
+	}
+	if err != nil {
+		return entity.BusinessProduct{}, errs.NewInternalServerError(err)
+	}
+	if check.DeletedAt.Valid || check.BusinessRootID != businessRootId {
+		return entity.BusinessProduct{}, errs.NewNotFound("")
+	}
+	return check, nil
+}
+
+func mapBusinessProductToResponse(bk entity.BusinessProduct) BusinessProductResponse {
 	return BusinessProductResponse{
 		BusinessRootID: bk.BusinessRootID,
 		Name:           bk.Name,
@@ -143,28 +221,5 @@ func (s *BusinessProductService) UpdateBusinessProduct(ctx context.Context, inpu
 		CreatedAt:      bk.CreatedAt,
 		UpdatedAt:      bk.UpdatedAt,
 		ID:             bk.ID,
-	}, nil
-}
-
-func (s *BusinessProductService) SoftDeleteBusinessProductByBusinessRootID(ctx context.Context, businessProductId int64) (SoftDeleteBusinessProductResponse, error) {
-
-	check, err := s.store.GetBusinessProductByBusinessProductId(ctx, businessProductId)
-	if err == sql.ErrNoRows {
-		return SoftDeleteBusinessProductResponse{}, errs.NewNotFound("")
-	}
-	if err != nil && err != sql.ErrNoRows {
-		return SoftDeleteBusinessProductResponse{}, errs.NewInternalServerError(err)
 	}
-	if check.DeletedAt.Valid {
-		return SoftDeleteBusinessProductResponse{}, errs.NewNotFound("")
-	}
-
-	bk, err := s.store.SoftDeleteBusinessProductByBusinessProductId(ctx, businessProductId)
-	if err != nil {
-		return SoftDeleteBusinessProductResponse{}, errs.NewInternalServerError(err)
-	}
-
-	return SoftDeleteBusinessProductResponse{
-		ID: bk,
-	}, nil
 }
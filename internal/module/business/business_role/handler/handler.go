@@ -27,8 +27,8 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetBusinessRoleByBusinessRootId)
-		r.Post("/", h.UpsertBusinessRoleByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetBusinessRoleByBusinessRootId)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSettingsWrite)).Post("/", h.UpsertBusinessRoleByBusinessRootID)
 	})
 
 	return r
@@ -29,10 +29,10 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetAllRssSubscriptionBusinessRootId)
-		r.Post("/", h.CreateBusinessRssSubscriptionByBusinessRootID)
-		r.Put("/{businessRssSubscriptionId}", h.UpdateBusinessRssSubscriptionByBusinessRootID)
-		r.Delete("/{businessRssSubscriptionId}", h.HardDeleteBusinessRssSubscriptionByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetAllRssSubscriptionBusinessRootId)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSettingsWrite)).Post("/", h.CreateBusinessRssSubscriptionByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSettingsWrite)).Put("/{businessRssSubscriptionId}", h.UpdateBusinessRssSubscriptionByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSettingsWrite)).Delete("/{businessRssSubscriptionId}", h.HardDeleteBusinessRssSubscriptionByBusinessRootID)
 	})
 
 	return r
@@ -74,6 +74,9 @@ func (h *Handler) CreateBusinessRssSubscriptionByBusinessRootID(w http.ResponseW
 		return
 	}
 
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ProfileID = prof.ID
+
 	res, err := h.rssSvc.CreateBusinessRssSubscription(r.Context(), req)
 	if err != nil {
 		response.Error(w, r, err, nil)
@@ -105,6 +108,9 @@ func (h *Handler) UpdateBusinessRssSubscriptionByBusinessRootID(w http.ResponseW
 		return
 	}
 
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	req.ProfileID = prof.ID
+
 	res, err := h.rssSvc.UpdateBusinessRssSubscription(r.Context(), req)
 	if err != nil {
 		response.Error(w, r, err, nil)
@@ -125,7 +131,14 @@ func (h *Handler) HardDeleteBusinessRssSubscriptionByBusinessRootID(w http.Respo
 		return
 	}
 
-	res, err := h.rssSvc.DeleteBusinessRssSubscription(r.Context(), intBusinessRssSubscriptionId)
+	prof, _ := internal_middleware.GetProfileFromContext(r.Context())
+	business, _ := internal_middleware.OwnedBusinessFromContext(r.Context())
+
+	res, err := h.rssSvc.DeleteBusinessRssSubscription(r.Context(), business_rss_subscription_service.DeleteBusinessRSSSubscriptionInput{
+		ID:             intBusinessRssSubscriptionId,
+		BusinessRootID: business.BusinessRootID,
+		ProfileID:      prof.ID,
+	})
 	if err != nil {
 		response.Error(w, r, err, nil)
 		return
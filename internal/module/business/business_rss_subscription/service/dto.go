@@ -1,11 +1,14 @@
 // internal/module/business/business_rss_subscription/dto.go
 package business_rss_subscription_service
 
+import "github.com/google/uuid"
+
 type CreateBusinessRSSSubscriptionInput struct {
 	BusinessRootID int64  `json:"businessRootId" validate:"required"`
 	Title          string `json:"title" validate:"required"`
 	AppRssFeedId   int64  `json:"appRssFeedId" validate:"required"`
 	IsActive       bool   `json:"isActive" validate:"required"`
+	ProfileID      uuid.UUID
 }
 type UpdateBusinessRSSSubscriptionInput struct {
 	ID             int64  `json:"id" validate:"required"`
@@ -13,4 +16,11 @@ type UpdateBusinessRSSSubscriptionInput struct {
 	Title          string `json:"title" validate:"required"`
 	AppRssFeedId   int64  `json:"appRssFeedId" validate:"required"`
 	IsActive       bool   `json:"isActive" validate:"required"`
+	ProfileID      uuid.UUID
+}
+
+type DeleteBusinessRSSSubscriptionInput struct {
+	ID             int64
+	BusinessRootID int64
+	ProfileID      uuid.UUID
 }
@@ -4,8 +4,10 @@ package business_rss_subscription_service
 import (
 	"context"
 	"database/sql"
+	"strconv"
 
 	rss_service "postmatic-api/internal/module/app/rss/service"
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
 	"postmatic-api/internal/repository/entity"
 	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/pagination"
@@ -124,7 +126,21 @@ func (s *BusinessRssSubscriptionService) CreateBusinessRssSubscription(ctx conte
 		AppRssFeedID:   appRssFeedId,
 	}
 
-	created, err := s.store.CreateBusinessRssSubscription(ctx, inputParam)
+	var created entity.BusinessRssSubscription
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		created, err = q.CreateBusinessRssSubscription(ctx, inputParam)
+		if err != nil {
+			return err
+		}
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessRssSubscription,
+			EntityID:       strconv.FormatInt(created.ID, 10),
+			Action:         entity.ActionChangeTypeCreate,
+			After:          mapRssSubscriptionAuditSnapshot(created),
+		})
+	})
 	if err != nil {
 		return CreateUpdateDeleteResponse{}, errs.NewInternalServerError(err)
 	}
@@ -181,11 +197,25 @@ func (s *BusinessRssSubscriptionService) UpdateBusinessRssSubscription(
 	}
 
 	// ✅ Update sekali saja (baik feed sama maupun beda)
-	_, err = s.store.EditBusinessRssSubscription(ctx, entity.EditBusinessRssSubscriptionParams{
-		Title:        input.Title,
-		IsActive:     input.IsActive,
-		AppRssFeedID: input.AppRssFeedId,
-		ID:           input.ID,
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		updated, err := q.EditBusinessRssSubscription(ctx, entity.EditBusinessRssSubscriptionParams{
+			Title:        input.Title,
+			IsActive:     input.IsActive,
+			AppRssFeedID: input.AppRssFeedId,
+			ID:           input.ID,
+		})
+		if err != nil {
+			return err
+		}
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessRssSubscription,
+			EntityID:       strconv.FormatInt(updated.ID, 10),
+			Action:         entity.ActionChangeTypeUpdate,
+			Before:         mapRssSubscriptionAuditSnapshot(checkSubscription),
+			After:          mapRssSubscriptionAuditSnapshot(updated),
+		})
 	})
 	if err != nil {
 		return CreateUpdateDeleteResponse{}, errs.NewInternalServerError(err)
@@ -194,9 +224,12 @@ func (s *BusinessRssSubscriptionService) UpdateBusinessRssSubscription(
 	return CreateUpdateDeleteResponse{ID: input.ID}, nil
 }
 
-func (s *BusinessRssSubscriptionService) DeleteBusinessRssSubscription(ctx context.Context, subscriptionId int64) (CreateUpdateDeleteResponse, error) {
+func (s *BusinessRssSubscriptionService) DeleteBusinessRssSubscription(ctx context.Context, input DeleteBusinessRSSSubscriptionInput) (CreateUpdateDeleteResponse, error) {
 
-	check, err := s.store.GetBusinessRssSubscriptionById(ctx, subscriptionId)
+	check, err := s.store.GetBusinessRssSubscriptionByIDAndBusinessRootID(ctx, entity.GetBusinessRssSubscriptionByIDAndBusinessRootIDParams{
+		ID:             input.ID,
+		BusinessRootID: input.BusinessRootID,
+	})
 	if err != nil && err != sql.ErrNoRows {
 		return CreateUpdateDeleteResponse{}, err
 	}
@@ -205,7 +238,19 @@ func (s *BusinessRssSubscriptionService) DeleteBusinessRssSubscription(ctx conte
 		return CreateUpdateDeleteResponse{}, errs.NewBadRequest("SUBSCRIPTION_NOT_FOUND")
 	}
 
-	err = s.store.HardDeleteBusinessRssSubscriptionByID(ctx, subscriptionId)
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		if err := q.HardDeleteBusinessRssSubscriptionByID(ctx, check.ID); err != nil {
+			return err
+		}
+		return business_audit_event_service.RecordAuditEvent(ctx, q, business_audit_event_service.RecordAuditEventInput{
+			BusinessRootID: input.BusinessRootID,
+			ProfileID:      input.ProfileID,
+			EntityType:     business_audit_event_service.EntityBusinessRssSubscription,
+			EntityID:       strconv.FormatInt(check.ID, 10),
+			Action:         entity.ActionChangeTypeDelete,
+			Before:         mapRssSubscriptionAuditSnapshot(check),
+		})
+	})
 	if err != nil {
 		return CreateUpdateDeleteResponse{}, errs.NewInternalServerError(err)
 	}
@@ -214,3 +259,13 @@ func (s *BusinessRssSubscriptionService) DeleteBusinessRssSubscription(ctx conte
 		ID: check.ID,
 	}, nil
 }
+
+func mapRssSubscriptionAuditSnapshot(v entity.BusinessRssSubscription) BusinessRSSSubscriptionAuditSnapshot {
+	return BusinessRSSSubscriptionAuditSnapshot{
+		ID:             v.ID,
+		BusinessRootID: v.BusinessRootID,
+		Title:          v.Title,
+		AppRssId:       v.AppRssFeedID,
+		IsActive:       v.IsActive,
+	}
+}
@@ -28,3 +28,12 @@ type AppRssCategory struct {
 type CreateUpdateDeleteResponse struct {
 	ID int64 `json:"id" validate:"required"`
 }
+
+// snapshot subscription untuk audit event (before/after)
+type BusinessRSSSubscriptionAuditSnapshot struct {
+	ID             int64  `json:"id"`
+	BusinessRootID int64  `json:"businessRootId"`
+	Title          string `json:"title"`
+	AppRssId       int64  `json:"appRssId"`
+	IsActive       bool   `json:"isActive"`
+}
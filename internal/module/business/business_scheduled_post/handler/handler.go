@@ -0,0 +1,195 @@
+// internal/module/business/business_scheduled_post/handler/handler.go
+package business_scheduled_post_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"postmatic-api/internal/internal_middleware"
+	business_scheduled_post_service "postmatic-api/internal/module/business/business_scheduled_post/service"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc        *business_scheduled_post_service.BusinessScheduledPostService
+	middleware *internal_middleware.OwnedBusiness
+}
+
+func NewHandler(svc *business_scheduled_post_service.BusinessScheduledPostService, ownedMw *internal_middleware.OwnedBusiness) *Handler {
+	return &Handler{svc: svc, middleware: ownedMw}
+}
+
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	// owned business middleware
+	r.Route("/{businessId}", func(r chi.Router) {
+		r.Use(h.middleware.OwnedBusinessMiddleware)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetCalendar)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentPublish)).Post("/", h.CreateScheduledPost)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/{scheduledPostId}", h.GetScheduledPostById)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentPublish)).Put("/{scheduledPostId}", h.UpdateScheduledPost)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentPublish)).Delete("/{scheduledPostId}", h.DeleteScheduledPost)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/{scheduledPostId}/delivery-attempts", h.GetDeliveryAttempts)
+	})
+
+	return r
+}
+
+// GetCalendar handles GET /api/business/scheduled-post/{businessId}?dateStart=YYYY-MM-DD&dateEnd=YYYY-MM-DD&category=<platform>
+func (h *Handler) GetCalendar(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+
+	var platform *string
+	if filter.Category != "" {
+		platform = &filter.Category
+	}
+
+	res, err := h.svc.GetCalendar(r.Context(), business_scheduled_post_service.GetCalendarFilter{
+		BusinessRootID: business.BusinessRootID,
+		DateStart:      filter.DateStart,
+		DateEnd:        filter.DateEnd,
+		Platform:       platform,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_SCHEDULED_POST_CALENDAR", res)
+}
+
+func (h *Handler) GetScheduledPostById(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "scheduledPostId"), 10, 64)
+	if err != nil {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"scheduledPostId": "must be int64"}), nil)
+		return
+	}
+
+	res, err := h.svc.GetScheduledPostById(r.Context(), business.BusinessRootID, id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_SCHEDULED_POST", res)
+}
+
+func (h *Handler) CreateScheduledPost(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	profile, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req business_scheduled_post_service.CreateUpdateScheduledPostInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.BusinessRootID = business.BusinessRootID
+	req.ProfileID = profile.ID
+
+	res, err := h.svc.CreateScheduledPost(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_CREATE_SCHEDULED_POST", res)
+}
+
+func (h *Handler) UpdateScheduledPost(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	profile, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "scheduledPostId"), 10, 64)
+	if err != nil {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"scheduledPostId": "must be int64"}), nil)
+		return
+	}
+
+	var req business_scheduled_post_service.CreateUpdateScheduledPostInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.BusinessRootID = business.BusinessRootID
+	req.ProfileID = profile.ID
+
+	res, err := h.svc.UpdateScheduledPost(r.Context(), id, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_UPDATE_SCHEDULED_POST", res)
+}
+
+func (h *Handler) DeleteScheduledPost(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "scheduledPostId"), 10, 64)
+	if err != nil {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"scheduledPostId": "must be int64"}), nil)
+		return
+	}
+
+	res, err := h.svc.DeleteScheduledPost(r.Context(), business.BusinessRootID, id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_DELETE_SCHEDULED_POST", res)
+}
+
+func (h *Handler) GetDeliveryAttempts(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "scheduledPostId"), 10, 64)
+	if err != nil {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"scheduledPostId": "must be int64"}), nil)
+		return
+	}
+
+	res, err := h.svc.GetDeliveryAttempts(r.Context(), business.BusinessRootID, id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_SCHEDULED_POST_DELIVERY_ATTEMPTS", res)
+}
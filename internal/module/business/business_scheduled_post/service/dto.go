@@ -0,0 +1,15 @@
+// internal/module/business/business_scheduled_post/dto.go
+package business_scheduled_post_service
+
+import "github.com/google/uuid"
+
+// SCHEDULED_AT_LAYOUT: waktu lokal sesuai timezone business (tanpa offset)
+const SCHEDULED_AT_LAYOUT = "2006-01-02T15:04"
+
+type CreateUpdateScheduledPostInput struct {
+	BusinessRootID         int64
+	ProfileID              uuid.UUID
+	BusinessImageContentID int64  `json:"businessImageContentId" validate:"required"`
+	Platform               string `json:"platform" validate:"required,oneof=linked_in facebook_page instagram_business whatsapp_business tiktok youtube twitter pinterest"`
+	ScheduledAt            string `json:"scheduledAt" validate:"required,datetime=2006-01-02T15:04"`
+}
@@ -0,0 +1,12 @@
+// internal/module/business/business_scheduled_post/filter.go
+package business_scheduled_post_service
+
+// maksimal range calendar (hari)
+const MAX_CALENDAR_RANGE_DAYS = 93
+
+type GetCalendarFilter struct {
+	BusinessRootID int64
+	DateStart      *string // YYYY-MM-DD (timezone business), default awal bulan ini
+	DateEnd        *string // YYYY-MM-DD (timezone business, inclusive), default akhir bulan ini
+	Platform       *string
+}
@@ -0,0 +1,230 @@
+// internal/module/business/business_scheduled_post/publish.go
+package business_scheduled_post_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"postmatic-api/internal/module/headless/queue"
+	"postmatic-api/internal/module/headless/social_publisher"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+)
+
+// PublishScheduledPost dipanggil worker saat scheduled_at tiba:
+// claim jadwal lalu serahkan pengiriman ke task deliver (yang punya retry + backoff).
+// Jika worker crash di antara claim & enqueue, post tertahan di 'publishing' dan dibereskan SweepStuckScheduledPosts.
+func (s *BusinessScheduledPostService) PublishScheduledPost(ctx context.Context, payload queue.PublishScheduledPostPayload) error {
+	log := logger.From(ctx)
+
+	// claim jadwal; task basi (sudah diubah/dibatalkan/dipublish) akan di-skip
+	post, err := s.store.MarkBusinessScheduledPostPublishing(ctx, entity.MarkBusinessScheduledPostPublishingParams{
+		ID:          payload.ScheduledPostID,
+		ScheduledAt: payload.ScheduledAt,
+	})
+	if err == sql.ErrNoRows {
+		log.Info("Skip stale scheduled post task", "scheduledPostId", payload.ScheduledPostID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := s.queue.EnqueueDeliverScheduledPost(ctx, queue.DeliverScheduledPostPayload{ScheduledPostID: post.ID}); err != nil {
+		// status sudah 'publishing', retry task ini akan di-skip -> tandai failed
+		log.Error("Failed to enqueue scheduled post delivery", "scheduledPostId", post.ID, "error", err)
+		return s.markFailed(ctx, post.ID, "DELIVERY_ENQUEUE_FAILED")
+	}
+
+	return nil
+}
+
+// SweepStuckScheduledPosts dijalankan periodik oleh worker (asynq scheduler) untuk post yang tertahan
+// di 'publishing' lebih dari StuckAfter. Post ditandai failed (bukan di-publish ulang) karena
+// tidak bisa dipastikan apakah platform sudah menerima post tersebut; user bisa menjadwalkan ulang.
+func (s *BusinessScheduledPostService) SweepStuckScheduledPosts(ctx context.Context, payload queue.SweepStuckScheduledPostsPayload) error {
+	posts, err := s.store.FailStuckBusinessScheduledPosts(ctx, entity.FailStuckBusinessScheduledPostsParams{
+		StuckBefore: time.Now().Add(-payload.StuckAfter),
+		RowLimit:    payload.Limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	log := logger.From(ctx)
+	for _, post := range posts {
+		log.Warn("Scheduled post stuck in publishing marked failed", "scheduledPostId", post.ID, "businessRootId", post.BusinessRootID, "platform", post.Platform)
+	}
+	return nil
+}
+
+// DeliverScheduledPost mengirim post ke platform. Setiap percobaan dicatat di post_delivery_attempts.
+// Error transient dikembalikan ke asynq (retry dengan exponential backoff),
+// error permanen / retry terakhir langsung menandai post 'failed'.
+func (s *BusinessScheduledPostService) DeliverScheduledPost(ctx context.Context, payload queue.DeliverScheduledPostPayload, attempt queue.DeliveryAttempt) error {
+	log := logger.From(ctx)
+
+	post, err := s.store.GetBusinessScheduledPostById(ctx, payload.ScheduledPostID)
+	if err == sql.ErrNoRows {
+		log.Info("Skip delivery, scheduled post not found", "scheduledPostId", payload.ScheduledPostID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if post.Status != entity.BusinessScheduledPostStatusPublishing {
+		log.Info("Skip delivery, scheduled post is not publishing", "scheduledPostId", post.ID, "status", post.Status)
+		return nil
+	}
+
+	startedAt := time.Now()
+	res, pubErr := s.deliver(ctx, post)
+	finishedAt := time.Now()
+
+	params := entity.CreatePostDeliveryAttemptParams{
+		BusinessScheduledPostID: post.ID,
+		BusinessRootID:          post.BusinessRootID,
+		Platform:                post.Platform,
+		AttemptNumber:           int32(attempt.Number),
+		StartedAt:               startedAt,
+		FinishedAt:              finishedAt,
+	}
+
+	// 1) berhasil -> catat attempt + tandai published
+	if pubErr == nil {
+		params.Status = entity.PostDeliveryAttemptStatusSucceeded
+		params.IsFinal = true
+		params.ResponseCode = sql.NullInt32{Int32: int32(res.StatusCode), Valid: res.StatusCode != 0}
+		params.ResponseBody = sql.NullString{String: res.RawResponse, Valid: res.RawResponse != ""}
+		params.ExternalPostID = sql.NullString{String: res.ExternalPostID, Valid: res.ExternalPostID != ""}
+
+		err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+			if _, err := q.CreatePostDeliveryAttempt(ctx, params); err != nil {
+				return err
+			}
+			_, err := q.MarkBusinessScheduledPostPublished(ctx, entity.MarkBusinessScheduledPostPublishedParams{
+				ID:             post.ID,
+				ExternalPostID: params.ExternalPostID,
+			})
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		log.Info("Scheduled post published", "scheduledPostId", post.ID, "platform", post.Platform, "attempt", attempt.Number, "externalPostId", res.ExternalPostID)
+		return nil
+	}
+
+	// 2) gagal -> catat attempt, retry hanya jika transient dan masih ada sisa retry
+	final := attempt.IsFinal || !social_publisher.IsRetryable(pubErr)
+
+	params.Status = entity.PostDeliveryAttemptStatusFailed
+	params.IsFinal = final
+	params.ErrorMessage = sql.NullString{String: pubErr.Error(), Valid: true}
+	var publishErr *social_publisher.PublishError
+	if errors.As(pubErr, &publishErr) {
+		params.ResponseCode = sql.NullInt32{Int32: int32(publishErr.StatusCode), Valid: publishErr.StatusCode != 0}
+		params.ResponseBody = sql.NullString{String: publishErr.Response, Valid: publishErr.Response != ""}
+	}
+
+	log.Error("Failed to deliver scheduled post", "scheduledPostId", post.ID, "platform", post.Platform, "attempt", attempt.Number, "final", final, "error", pubErr)
+
+	if !final {
+		if _, err := s.store.CreatePostDeliveryAttempt(ctx, params); err != nil {
+			log.Error("Failed to record delivery attempt", "scheduledPostId", post.ID, "error", err)
+		}
+		return pubErr
+	}
+
+	return s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		if _, err := q.CreatePostDeliveryAttempt(ctx, params); err != nil {
+			return err
+		}
+		_, err := q.MarkBusinessScheduledPostFailed(ctx, entity.MarkBusinessScheduledPostFailedParams{
+			ID:            post.ID,
+			FailureReason: params.ErrorMessage,
+		})
+		return err
+	})
+}
+
+func (s *BusinessScheduledPostService) GetDeliveryAttempts(ctx context.Context, businessRootID int64, id int64) ([]DeliveryAttemptResponse, error) {
+	if _, err := s.GetScheduledPostById(ctx, businessRootID, id); err != nil {
+		return nil, err
+	}
+
+	attempts, err := s.store.GetPostDeliveryAttemptsByScheduledPostId(ctx, entity.GetPostDeliveryAttemptsByScheduledPostIdParams{
+		BusinessScheduledPostID: id,
+		BusinessRootID:          businessRootID,
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	result := make([]DeliveryAttemptResponse, 0, len(attempts))
+	for _, a := range attempts {
+		result = append(result, mapDeliveryAttemptToResponse(a))
+	}
+	return result, nil
+}
+
+// deliver ambil konten + access token akun sosial lalu publish lewat publisher platform
+func (s *BusinessScheduledPostService) deliver(ctx context.Context, post entity.BusinessScheduledPost) (*social_publisher.PublishResponse, error) {
+	content, err := s.store.GetBusinessImageContentByIdAndBusinessRootId(ctx, entity.GetBusinessImageContentByIdAndBusinessRootIdParams{
+		ID:             post.BusinessImageContentID,
+		BusinessRootID: post.BusinessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return nil, errs.NewNotFound("BUSINESS_IMAGE_CONTENT_NOT_FOUND")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken, err := s.socialAccount.GetValidAccessToken(ctx, post.BusinessRootID, post.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.publisher.Publish(ctx, social_publisher.PublishInput{
+		Platform:       post.Platform,
+		BusinessRootID: post.BusinessRootID,
+		Caption:        content.Caption.String,
+		ImageUrls:      content.ImageUrls,
+		AccessToken:    accessToken,
+	})
+}
+
+func (s *BusinessScheduledPostService) markFailed(ctx context.Context, id int64, reason string) error {
+	_, err := s.store.MarkBusinessScheduledPostFailed(ctx, entity.MarkBusinessScheduledPostFailedParams{
+		ID:            id,
+		FailureReason: sql.NullString{String: reason, Valid: true},
+	})
+	return err
+}
+
+func mapDeliveryAttemptToResponse(a entity.PostDeliveryAttempt) DeliveryAttemptResponse {
+	var responseCode *int32
+	if a.ResponseCode.Valid {
+		responseCode = &a.ResponseCode.Int32
+	}
+
+	return DeliveryAttemptResponse{
+		ID:             a.ID,
+		AttemptNumber:  a.AttemptNumber,
+		Platform:       string(a.Platform),
+		Status:         string(a.Status),
+		IsFinal:        a.IsFinal,
+		ResponseCode:   responseCode,
+		ResponseBody:   utils.NullStringToString(a.ResponseBody),
+		ExternalPostID: utils.NullStringToString(a.ExternalPostID),
+		ErrorMessage:   utils.NullStringToString(a.ErrorMessage),
+		StartedAt:      a.StartedAt,
+		FinishedAt:     a.FinishedAt,
+	}
+}
@@ -0,0 +1,297 @@
+// internal/module/business/business_scheduled_post/service.go
+package business_scheduled_post_service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	_ "time/tzdata" // embed IANA timezone database (container tanpa zoneinfo)
+
+	business_social_account_service "postmatic-api/internal/module/business/business_social_account/service"
+	business_timezone_pref_service "postmatic-api/internal/module/business/business_timezone_pref/service"
+	"postmatic-api/internal/module/headless/queue"
+	"postmatic-api/internal/module/headless/social_publisher"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+)
+
+type BusinessScheduledPostService struct {
+	store         entity.Store
+	queue         queue.ScheduledPostProducer
+	timezonePref  *business_timezone_pref_service.BusinessTimezonePrefService
+	socialAccount *business_social_account_service.BusinessSocialAccountService
+	publisher     social_publisher.Service
+}
+
+func NewService(store entity.Store, queue queue.ScheduledPostProducer, timezonePref *business_timezone_pref_service.BusinessTimezonePrefService, socialAccount *business_social_account_service.BusinessSocialAccountService, publisher social_publisher.Service) *BusinessScheduledPostService {
+	return &BusinessScheduledPostService{
+		store:         store,
+		queue:         queue,
+		timezonePref:  timezonePref,
+		socialAccount: socialAccount,
+		publisher:     publisher,
+	}
+}
+
+func (s *BusinessScheduledPostService) GetCalendar(ctx context.Context, filter GetCalendarFilter) (CalendarResponse, error) {
+	loc, err := s.businessLocation(ctx, filter.BusinessRootID)
+	if err != nil {
+		return CalendarResponse{}, err
+	}
+
+	now := time.Now().In(loc)
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	end := start.AddDate(0, 1, 0)
+	if filter.DateStart != nil {
+		if start, err = time.ParseInLocation("2006-01-02", *filter.DateStart, loc); err != nil {
+			return CalendarResponse{}, errs.NewBadRequest("INVALID_DATE_START")
+		}
+		if filter.DateEnd == nil {
+			end = start.AddDate(0, 1, 0)
+		}
+	}
+	if filter.DateEnd != nil {
+		dateEnd, err := time.ParseInLocation("2006-01-02", *filter.DateEnd, loc)
+		if err != nil {
+			return CalendarResponse{}, errs.NewBadRequest("INVALID_DATE_END")
+		}
+		// dateEnd inclusive
+		end = dateEnd.AddDate(0, 0, 1)
+	}
+	if !end.After(start) {
+		return CalendarResponse{}, errs.NewBadRequest("INVALID_DATE_RANGE")
+	}
+	if end.Sub(start) > MAX_CALENDAR_RANGE_DAYS*24*time.Hour {
+		return CalendarResponse{}, errs.NewBadRequest("CALENDAR_RANGE_TOO_LONG")
+	}
+
+	var platform entity.NullSocialPlatformType
+	if filter.Platform != nil && *filter.Platform != "" {
+		platform = entity.NullSocialPlatformType{SocialPlatformType: entity.SocialPlatformType(*filter.Platform), Valid: true}
+	}
+
+	posts, err := s.store.GetBusinessScheduledPostsByRange(ctx, entity.GetBusinessScheduledPostsByRangeParams{
+		BusinessRootID: filter.BusinessRootID,
+		RangeStart:     start,
+		RangeEnd:       end,
+		Platform:       platform,
+	})
+	if err != nil {
+		return CalendarResponse{}, errs.NewInternalServerError(err)
+	}
+
+	result := make([]ScheduledPostResponse, 0, len(posts))
+	for _, p := range posts {
+		result = append(result, mapScheduledPostToResponse(p))
+	}
+
+	return CalendarResponse{
+		Timezone:       loc.String(),
+		DateStart:      start.Format("2006-01-02"),
+		DateEnd:        end.AddDate(0, 0, -1).Format("2006-01-02"),
+		ScheduledPosts: result,
+	}, nil
+}
+
+func (s *BusinessScheduledPostService) GetScheduledPostById(ctx context.Context, businessRootID int64, id int64) (ScheduledPostResponse, error) {
+	post, err := s.store.GetBusinessScheduledPostByIdAndBusinessRootId(ctx, entity.GetBusinessScheduledPostByIdAndBusinessRootIdParams{
+		ID:             id,
+		BusinessRootID: businessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return ScheduledPostResponse{}, errs.NewNotFound("SCHEDULED_POST_NOT_FOUND")
+	}
+	if err != nil {
+		return ScheduledPostResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapScheduledPostToResponse(post), nil
+}
+
+func (s *BusinessScheduledPostService) CreateScheduledPost(ctx context.Context, input CreateUpdateScheduledPostInput) (ScheduledPostResponse, error) {
+	scheduledAt, loc, err := s.validateInput(ctx, input)
+	if err != nil {
+		return ScheduledPostResponse{}, err
+	}
+
+	var post entity.BusinessScheduledPost
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		post, err = q.CreateBusinessScheduledPost(ctx, entity.CreateBusinessScheduledPostParams{
+			BusinessRootID:         input.BusinessRootID,
+			BusinessImageContentID: input.BusinessImageContentID,
+			Platform:               entity.SocialPlatformType(input.Platform),
+			ScheduledAt:            scheduledAt,
+			Timezone:               loc.String(),
+			ProfileID:              input.ProfileID,
+		})
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		// enqueue di dalam tx: kalau gagal, jadwal tidak tersimpan
+		return s.enqueuePublish(ctx, post)
+	})
+	if err != nil {
+		return ScheduledPostResponse{}, err
+	}
+
+	return mapScheduledPostToResponse(post), nil
+}
+
+func (s *BusinessScheduledPostService) UpdateScheduledPost(ctx context.Context, id int64, input CreateUpdateScheduledPostInput) (ScheduledPostResponse, error) {
+	existing, err := s.GetScheduledPostById(ctx, input.BusinessRootID, id)
+	if err != nil {
+		return ScheduledPostResponse{}, err
+	}
+	if existing.Status != string(entity.BusinessScheduledPostStatusScheduled) {
+		return ScheduledPostResponse{}, errs.NewBadRequest("SCHEDULED_POST_CANNOT_BE_UPDATED")
+	}
+
+	scheduledAt, loc, err := s.validateInput(ctx, input)
+	if err != nil {
+		return ScheduledPostResponse{}, err
+	}
+
+	var post entity.BusinessScheduledPost
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		post, err = q.UpdateBusinessScheduledPost(ctx, entity.UpdateBusinessScheduledPostParams{
+			ID:                     id,
+			BusinessRootID:         input.BusinessRootID,
+			BusinessImageContentID: input.BusinessImageContentID,
+			Platform:               entity.SocialPlatformType(input.Platform),
+			ScheduledAt:            scheduledAt,
+			Timezone:               loc.String(),
+		})
+		if err == sql.ErrNoRows {
+			return errs.NewBadRequest("SCHEDULED_POST_CANNOT_BE_UPDATED")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		// task lama tetap ada di queue, tapi akan di-skip karena scheduledAt/status sudah berbeda
+		return s.enqueuePublish(ctx, post)
+	})
+	if err != nil {
+		return ScheduledPostResponse{}, err
+	}
+
+	return mapScheduledPostToResponse(post), nil
+}
+
+func (s *BusinessScheduledPostService) DeleteScheduledPost(ctx context.Context, businessRootID int64, id int64) (ScheduledPostResponse, error) {
+	if _, err := s.GetScheduledPostById(ctx, businessRootID, id); err != nil {
+		return ScheduledPostResponse{}, err
+	}
+
+	post, err := s.store.CancelBusinessScheduledPost(ctx, entity.CancelBusinessScheduledPostParams{
+		ID:             id,
+		BusinessRootID: businessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return ScheduledPostResponse{}, errs.NewBadRequest("SCHEDULED_POST_CANNOT_BE_CANCELED")
+	}
+	if err != nil {
+		return ScheduledPostResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapScheduledPostToResponse(post), nil
+}
+
+// PublishScheduledPost dipanggil worker saat jadwal jatuh tempo
+// validateInput cek image content, platform, dan konversi scheduledAt lokal -> UTC
+func (s *BusinessScheduledPostService) validateInput(ctx context.Context, input CreateUpdateScheduledPostInput) (time.Time, *time.Location, error) {
+	content, err := s.store.GetBusinessImageContentByIdAndBusinessRootId(ctx, entity.GetBusinessImageContentByIdAndBusinessRootIdParams{
+		ID:             input.BusinessImageContentID,
+		BusinessRootID: input.BusinessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return time.Time{}, nil, errs.NewNotFound("BUSINESS_IMAGE_CONTENT_NOT_FOUND")
+	}
+	if err != nil {
+		return time.Time{}, nil, errs.NewInternalServerError(err)
+	}
+	if !content.ReadyToPost {
+		return time.Time{}, nil, errs.NewBadRequest("BUSINESS_IMAGE_CONTENT_NOT_READY_TO_POST")
+	}
+
+	platform, err := s.store.GetAppSocialPlatformByPlatformCode(ctx, entity.SocialPlatformType(input.Platform))
+	if err == sql.ErrNoRows || (err == nil && !platform.IsActive) {
+		return time.Time{}, nil, errs.NewBadRequest("SOCIAL_PLATFORM_NOT_ACTIVE")
+	}
+	if err != nil {
+		return time.Time{}, nil, errs.NewInternalServerError(err)
+	}
+
+	loc, err := s.businessLocation(ctx, input.BusinessRootID)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	scheduledAt, err := time.ParseInLocation(SCHEDULED_AT_LAYOUT, input.ScheduledAt, loc)
+	if err != nil {
+		return time.Time{}, nil, errs.NewValidationFailed(map[string]string{"scheduledAt": "must be " + SCHEDULED_AT_LAYOUT})
+	}
+	if !scheduledAt.After(time.Now()) {
+		return time.Time{}, nil, errs.NewBadRequest("SCHEDULED_AT_MUST_BE_IN_FUTURE")
+	}
+
+	return scheduledAt.UTC(), loc, nil
+}
+
+func (s *BusinessScheduledPostService) businessLocation(ctx context.Context, businessRootID int64) (*time.Location, error) {
+	tz, err := s.timezonePref.GetBusinessTimezonePrefByBusinessRootID(ctx, businessRootID)
+	if err != nil {
+		return nil, err
+	}
+	loc, err := time.LoadLocation(tz.Timezone)
+	if err != nil {
+		return nil, errs.NewBadRequest("TIMEZONE_NOT_VALID")
+	}
+	return loc, nil
+}
+
+func (s *BusinessScheduledPostService) enqueuePublish(ctx context.Context, post entity.BusinessScheduledPost) error {
+	ctxQ, cancelQ := context.WithTimeout(ctx, 5*time.Second)
+	defer cancelQ()
+
+	err := s.queue.EnqueuePublishScheduledPost(ctxQ, queue.PublishScheduledPostPayload{
+		ScheduledPostID: post.ID,
+		ScheduledAt:     post.ScheduledAt,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to enqueue scheduled post", "scheduledPostId", post.ID, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func mapScheduledPostToResponse(p entity.BusinessScheduledPost) ScheduledPostResponse {
+	var publishedAt *time.Time
+	if p.PublishedAt.Valid {
+		publishedAt = &p.PublishedAt.Time
+	}
+
+	scheduledAtLocal := p.ScheduledAt.Format(SCHEDULED_AT_LAYOUT)
+	if loc, err := time.LoadLocation(p.Timezone); err == nil {
+		scheduledAtLocal = p.ScheduledAt.In(loc).Format(SCHEDULED_AT_LAYOUT)
+	}
+
+	return ScheduledPostResponse{
+		ID:                     p.ID,
+		BusinessRootID:         p.BusinessRootID,
+		BusinessImageContentID: p.BusinessImageContentID,
+		Platform:               string(p.Platform),
+		Status:                 string(p.Status),
+		ScheduledAt:            p.ScheduledAt,
+		ScheduledAtLocal:       scheduledAtLocal,
+		Timezone:               p.Timezone,
+		PublishedAt:            publishedAt,
+		ExternalPostID:         utils.NullStringToString(p.ExternalPostID),
+		FailureReason:          utils.NullStringToString(p.FailureReason),
+		CreatedAt:              p.CreatedAt,
+		UpdatedAt:              p.UpdatedAt,
+	}
+}
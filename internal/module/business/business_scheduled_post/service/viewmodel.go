@@ -0,0 +1,41 @@
+// internal/module/business/business_scheduled_post/viewmodel.go
+package business_scheduled_post_service
+
+import "time"
+
+type ScheduledPostResponse struct {
+	ID                     int64      `json:"id"`
+	BusinessRootID         int64      `json:"businessRootId"`
+	BusinessImageContentID int64      `json:"businessImageContentId"`
+	Platform               string     `json:"platform"`
+	Status                 string     `json:"status"`
+	ScheduledAt            time.Time  `json:"scheduledAt"`
+	ScheduledAtLocal       string     `json:"scheduledAtLocal"`
+	Timezone               string     `json:"timezone"`
+	PublishedAt            *time.Time `json:"publishedAt"`
+	ExternalPostID         *string    `json:"externalPostId"`
+	FailureReason          *string    `json:"failureReason"`
+	CreatedAt              time.Time  `json:"createdAt"`
+	UpdatedAt              time.Time  `json:"updatedAt"`
+}
+
+type CalendarResponse struct {
+	Timezone       string                  `json:"timezone"`
+	DateStart      string                  `json:"dateStart"`
+	DateEnd        string                  `json:"dateEnd"`
+	ScheduledPosts []ScheduledPostResponse `json:"scheduledPosts"`
+}
+
+type DeliveryAttemptResponse struct {
+	ID             int64     `json:"id"`
+	AttemptNumber  int32     `json:"attemptNumber"`
+	Platform       string    `json:"platform"`
+	Status         string    `json:"status"`
+	IsFinal        bool      `json:"isFinal"`
+	ResponseCode   *int32    `json:"responseCode"`
+	ResponseBody   *string   `json:"responseBody"`
+	ExternalPostID *string   `json:"externalPostId"`
+	ErrorMessage   *string   `json:"errorMessage"`
+	StartedAt      time.Time `json:"startedAt"`
+	FinishedAt     time.Time `json:"finishedAt"`
+}
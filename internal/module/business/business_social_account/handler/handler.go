@@ -0,0 +1,173 @@
+// internal/module/business/business_social_account/handler/handler.go
+package business_social_account_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"postmatic-api/internal/internal_middleware"
+	business_social_account_service "postmatic-api/internal/module/business/business_social_account/service"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc        *business_social_account_service.BusinessSocialAccountService
+	middleware *internal_middleware.OwnedBusiness
+}
+
+func NewHandler(svc *business_social_account_service.BusinessSocialAccountService, ownedMw *internal_middleware.OwnedBusiness) *Handler {
+	return &Handler{svc: svc, middleware: ownedMw}
+}
+
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	// owned business middleware
+	r.Route("/{businessId}", func(r chi.Router) {
+		r.Use(h.middleware.OwnedBusinessMiddleware)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetSocialAccounts)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSocialAccount)).Get("/platform/{platform}/connect", h.GetConnectURL)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSocialAccount)).Post("/platform/{platform}/callback", h.ConnectCallback)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSocialAccount)).Post("/{socialAccountId}/refresh", h.RefreshSocialAccount)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSocialAccount)).Delete("/{socialAccountId}", h.DisconnectSocialAccount)
+	})
+
+	return r
+}
+
+func (h *Handler) GetSocialAccounts(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.GetSocialAccountsByBusinessRootID(r.Context(), business.BusinessRootID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_SOCIAL_ACCOUNTS", res)
+}
+
+// GetConnectURL handles GET /api/business/social-account/{businessId}/platform/{platform}/connect
+func (h *Handler) GetConnectURL(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	profile, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	platform, ok := platformParam(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.svc.GetConnectURL(r.Context(), business_social_account_service.GetConnectURLInput{
+		BusinessRootID: business.BusinessRootID,
+		ProfileID:      profile.ID,
+		Platform:       platform,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_GET_SOCIAL_ACCOUNT_CONNECT_URL", res)
+}
+
+// ConnectCallback handles POST /api/business/social-account/{businessId}/platform/{platform}/callback
+// body: { code, state } diteruskan FE dari redirect provider
+func (h *Handler) ConnectCallback(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	profile, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	platform, ok := platformParam(w, r)
+	if !ok {
+		return
+	}
+
+	var req business_social_account_service.ConnectCallbackInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.BusinessRootID = business.BusinessRootID
+	req.ProfileID = profile.ID
+	req.Platform = platform
+
+	res, err := h.svc.ConnectCallback(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_CONNECT_SOCIAL_ACCOUNT", res)
+}
+
+func (h *Handler) RefreshSocialAccount(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "socialAccountId"), 10, 64)
+	if err != nil {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"socialAccountId": "must be int64"}), nil)
+		return
+	}
+
+	res, err := h.svc.RefreshSocialAccount(r.Context(), business.BusinessRootID, id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_REFRESH_SOCIAL_ACCOUNT", res)
+}
+
+func (h *Handler) DisconnectSocialAccount(w http.ResponseWriter, r *http.Request) {
+	business, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+	id, err := strconv.ParseInt(chi.URLParam(r, "socialAccountId"), 10, 64)
+	if err != nil {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"socialAccountId": "must be int64"}), nil)
+		return
+	}
+
+	res, err := h.svc.DisconnectSocialAccount(r.Context(), business.BusinessRootID, id)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "SUCCESS_DISCONNECT_SOCIAL_ACCOUNT", res)
+}
+
+func platformParam(w http.ResponseWriter, r *http.Request) (string, bool) {
+	platform := chi.URLParam(r, "platform")
+	if !utils.StringInSlice(platform, business_social_account_service.SocialPlatformValues) {
+		response.Error(w, r, errs.NewValidationFailed(map[string]string{"platform": "invalid social platform"}), nil)
+		return "", false
+	}
+	return platform, true
+}
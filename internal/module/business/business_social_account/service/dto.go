@@ -0,0 +1,21 @@
+// internal/module/business/business_social_account/dto.go
+package business_social_account_service
+
+import "github.com/google/uuid"
+
+// platform diambil dari URL param dan divalidasi di handler
+var SocialPlatformValues = []string{"linked_in", "facebook_page", "instagram_business", "whatsapp_business", "tiktok", "youtube", "twitter", "pinterest"}
+
+type GetConnectURLInput struct {
+	BusinessRootID int64
+	ProfileID      uuid.UUID
+	Platform       string
+}
+
+type ConnectCallbackInput struct {
+	BusinessRootID int64
+	ProfileID      uuid.UUID
+	Platform       string
+	Code           string `json:"code" validate:"required"`
+	State          string `json:"state" validate:"required"`
+}
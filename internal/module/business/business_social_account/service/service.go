@@ -0,0 +1,326 @@
+// internal/module/business/business_social_account/service.go
+package business_social_account_service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"postmatic-api/config"
+	"postmatic-api/internal/module/headless/social_oauth"
+	"postmatic-api/internal/repository/entity"
+	stateRepo "postmatic-api/internal/repository/redis/social_oauth_state_repository"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// token dianggap perlu di-refresh jika akan expired dalam window ini
+const refreshBeforeExpiry = 5 * time.Minute
+
+type BusinessSocialAccountService struct {
+	store      entity.Store
+	cfg        config.Config
+	oauth      social_oauth.Service
+	stateRepo  *stateRepo.SocialOAuthStateRepo
+	encryptKey []byte
+	stateKey   []byte
+}
+
+func NewService(store entity.Store, cfg config.Config, oauth social_oauth.Service, stateRepo *stateRepo.SocialOAuthStateRepo) *BusinessSocialAccountService {
+	return &BusinessSocialAccountService{
+		store:      store,
+		cfg:        cfg,
+		oauth:      oauth,
+		stateRepo:  stateRepo,
+		encryptKey: utils.DeriveKey(cfg.SOCIAL_ACCOUNT_SECRET, "social_account:credential"),
+		stateKey:   utils.DeriveKey(cfg.SOCIAL_ACCOUNT_SECRET, "social_account:state"),
+	}
+}
+
+func (s *BusinessSocialAccountService) GetSocialAccountsByBusinessRootID(ctx context.Context, businessRootID int64) ([]SocialAccountResponse, error) {
+	accounts, err := s.store.GetBusinessSocialAccountsByBusinessRootId(ctx, businessRootID)
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	result := make([]SocialAccountResponse, 0, len(accounts))
+	for _, a := range accounts {
+		result = append(result, mapSocialAccountToResponse(a))
+	}
+	return result, nil
+}
+
+func (s *BusinessSocialAccountService) GetConnectURL(ctx context.Context, input GetConnectURLInput) (ConnectURLResponse, error) {
+	provider, err := s.activeProvider(ctx, input.Platform)
+	if err != nil {
+		return ConnectURLResponse{}, err
+	}
+
+	state, err := s.signState(connectStatePayload{
+		BusinessRootID: input.BusinessRootID,
+		ProfileID:      input.ProfileID,
+		Platform:       input.Platform,
+		Exp:            time.Now().Add(10 * time.Minute).Unix(),
+		N:              uuid.NewString(),
+	})
+	if err != nil {
+		return ConnectURLResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return ConnectURLResponse{
+		Platform: input.Platform,
+		AuthURL: provider.AuthCodeURL(social_oauth.AuthCodeURLInput{
+			State:       state,
+			RedirectURL: s.cfg.SOCIAL_ACCOUNT_REDIRECT_URL,
+		}),
+	}, nil
+}
+
+func (s *BusinessSocialAccountService) ConnectCallback(ctx context.Context, input ConnectCallbackInput) (SocialAccountResponse, error) {
+	// 1) state harus milik business, profile dan platform yang sama
+	st, err := s.verifyState(input.State)
+	if err != nil || st.BusinessRootID != input.BusinessRootID || st.ProfileID != input.ProfileID || st.Platform != input.Platform {
+		return SocialAccountResponse{}, errs.NewBadRequest("SOCIAL_OAUTH_INVALID_STATE")
+	}
+
+	// state sekali pakai: nonce ditandai sampai state expired
+	consumed, err := s.stateRepo.ConsumeState(ctx, st.N, time.Until(time.Unix(st.Exp, 0)))
+	if err != nil {
+		return SocialAccountResponse{}, errs.NewInternalServerError(err)
+	}
+	if !consumed {
+		return SocialAccountResponse{}, errs.NewBadRequest("SOCIAL_OAUTH_INVALID_STATE")
+	}
+
+	// 2) cek ulang platform masih aktif
+	provider, err := s.activeProvider(ctx, input.Platform)
+	if err != nil {
+		return SocialAccountResponse{}, err
+	}
+
+	// 3) exchange code -> token
+	tok, err := provider.Exchange(ctx, social_oauth.ExchangeInput{
+		Code:        input.Code,
+		RedirectURL: s.cfg.SOCIAL_ACCOUNT_REDIRECT_URL,
+	})
+	if err != nil {
+		return SocialAccountResponse{}, err
+	}
+
+	accessEnc, refreshEnc, err := s.encryptToken(tok)
+	if err != nil {
+		return SocialAccountResponse{}, errs.NewInternalServerError(err)
+	}
+
+	scopes := tok.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	// 4) simpan (connect ulang menimpa credential lama)
+	account, err := s.store.UpsertBusinessSocialAccount(ctx, entity.UpsertBusinessSocialAccountParams{
+		BusinessRootID:        input.BusinessRootID,
+		Platform:              entity.SocialPlatformType(input.Platform),
+		ExternalAccountID:     tok.ExternalAccountID,
+		AccountName:           tok.AccountName,
+		AccountImageUrl:       utils.StringToNullString(tok.AccountImageUrl),
+		AccessTokenEncrypted:  accessEnc,
+		RefreshTokenEncrypted: refreshEnc,
+		TokenExpiresAt:        timePtrToNullTime(tok.ExpiresAt),
+		Scopes:                scopes,
+		ProfileID:             input.ProfileID,
+	})
+	if err != nil {
+		return SocialAccountResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapSocialAccountToResponse(account), nil
+}
+
+func (s *BusinessSocialAccountService) RefreshSocialAccount(ctx context.Context, businessRootID int64, id int64) (SocialAccountResponse, error) {
+	account, err := s.store.GetBusinessSocialAccountByIdAndBusinessRootId(ctx, entity.GetBusinessSocialAccountByIdAndBusinessRootIdParams{
+		ID:             id,
+		BusinessRootID: businessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return SocialAccountResponse{}, errs.NewNotFound("SOCIAL_ACCOUNT_NOT_FOUND")
+	}
+	if err != nil {
+		return SocialAccountResponse{}, errs.NewInternalServerError(err)
+	}
+
+	refreshed, err := s.refresh(ctx, account)
+	if err != nil {
+		return SocialAccountResponse{}, err
+	}
+	return mapSocialAccountToResponse(refreshed), nil
+}
+
+// GetValidAccessToken mengembalikan access token (plaintext) yang masih berlaku,
+// refresh otomatis jika mendekati expired. Dipakai oleh publisher.
+func (s *BusinessSocialAccountService) GetValidAccessToken(ctx context.Context, businessRootID int64, platform entity.SocialPlatformType) (string, error) {
+	account, err := s.store.GetBusinessSocialAccountByPlatform(ctx, entity.GetBusinessSocialAccountByPlatformParams{
+		BusinessRootID: businessRootID,
+		Platform:       platform,
+	})
+	if err == sql.ErrNoRows {
+		return "", errs.NewNotFound("SOCIAL_ACCOUNT_NOT_CONNECTED")
+	}
+	if err != nil {
+		return "", errs.NewInternalServerError(err)
+	}
+
+	if account.TokenExpiresAt.Valid && time.Until(account.TokenExpiresAt.Time) < refreshBeforeExpiry {
+		if account, err = s.refresh(ctx, account); err != nil {
+			return "", err
+		}
+	}
+
+	if account.Status != entity.BusinessSocialAccountStatusConnected {
+		return "", errs.NewBadRequest("SOCIAL_ACCOUNT_TOKEN_EXPIRED")
+	}
+
+	token, err := utils.DecryptString(s.encryptKey, account.AccessTokenEncrypted)
+	if err != nil {
+		return "", errs.NewInternalServerError(err)
+	}
+	return token, nil
+}
+
+func (s *BusinessSocialAccountService) DisconnectSocialAccount(ctx context.Context, businessRootID int64, id int64) (SocialAccountResponse, error) {
+	account, err := s.store.DisconnectBusinessSocialAccount(ctx, entity.DisconnectBusinessSocialAccountParams{
+		ID:             id,
+		BusinessRootID: businessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return SocialAccountResponse{}, errs.NewNotFound("SOCIAL_ACCOUNT_NOT_FOUND")
+	}
+	if err != nil {
+		return SocialAccountResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return mapSocialAccountToResponse(account), nil
+}
+
+// refresh token ke provider; jika gagal akun ditandai 'expired' (user harus connect ulang)
+func (s *BusinessSocialAccountService) refresh(ctx context.Context, account entity.BusinessSocialAccount) (entity.BusinessSocialAccount, error) {
+	log := logger.From(ctx)
+
+	markExpired := func() (entity.BusinessSocialAccount, error) {
+		if _, err := s.store.MarkBusinessSocialAccountExpired(ctx, account.ID); err != nil {
+			log.Error("Failed to mark social account expired", "socialAccountId", account.ID, "error", err)
+		}
+		return entity.BusinessSocialAccount{}, errs.NewBadRequest("SOCIAL_ACCOUNT_TOKEN_EXPIRED")
+	}
+
+	if !account.RefreshTokenEncrypted.Valid {
+		return markExpired()
+	}
+
+	provider, err := s.oauth.Provider(account.Platform)
+	if err != nil {
+		return entity.BusinessSocialAccount{}, err
+	}
+
+	refreshToken, err := utils.DecryptString(s.encryptKey, account.RefreshTokenEncrypted.String)
+	if err != nil {
+		return entity.BusinessSocialAccount{}, errs.NewInternalServerError(err)
+	}
+
+	tok, err := provider.Refresh(ctx, refreshToken)
+	if err != nil {
+		log.Error("Failed to refresh social account token", "socialAccountId", account.ID, "platform", account.Platform, "error", err)
+		return markExpired()
+	}
+
+	// beberapa provider tidak mengembalikan refresh token baru
+	if tok.RefreshToken == nil {
+		tok.RefreshToken = &refreshToken
+	}
+
+	accessEnc, refreshEnc, err := s.encryptToken(tok)
+	if err != nil {
+		return entity.BusinessSocialAccount{}, errs.NewInternalServerError(err)
+	}
+
+	updated, err := s.store.UpdateBusinessSocialAccountToken(ctx, entity.UpdateBusinessSocialAccountTokenParams{
+		ID:                    account.ID,
+		AccessTokenEncrypted:  accessEnc,
+		RefreshTokenEncrypted: refreshEnc,
+		TokenExpiresAt:        timePtrToNullTime(tok.ExpiresAt),
+	})
+	if err != nil {
+		return entity.BusinessSocialAccount{}, errs.NewInternalServerError(err)
+	}
+
+	log.Info("Social account token refreshed", "socialAccountId", account.ID, "platform", account.Platform)
+	return updated, nil
+}
+
+// activeProvider cek platform aktif (app_social_platforms.is_active) lalu ambil provider OAuth
+func (s *BusinessSocialAccountService) activeProvider(ctx context.Context, platform string) (social_oauth.Provider, error) {
+	p, err := s.store.GetAppSocialPlatformByPlatformCode(ctx, entity.SocialPlatformType(platform))
+	if err == sql.ErrNoRows || (err == nil && !p.IsActive) {
+		return nil, errs.NewBadRequest("SOCIAL_PLATFORM_NOT_ACTIVE")
+	}
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	return s.oauth.Provider(entity.SocialPlatformType(platform))
+}
+
+func (s *BusinessSocialAccountService) encryptToken(tok *social_oauth.OAuthToken) (string, sql.NullString, error) {
+	accessEnc, err := utils.EncryptString(s.encryptKey, tok.AccessToken)
+	if err != nil {
+		return "", sql.NullString{}, err
+	}
+
+	var refreshEnc sql.NullString
+	if tok.RefreshToken != nil && *tok.RefreshToken != "" {
+		enc, err := utils.EncryptString(s.encryptKey, *tok.RefreshToken)
+		if err != nil {
+			return "", sql.NullString{}, err
+		}
+		refreshEnc = sql.NullString{String: enc, Valid: true}
+	}
+
+	return accessEnc, refreshEnc, nil
+}
+
+func timePtrToNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func mapSocialAccountToResponse(a entity.BusinessSocialAccount) SocialAccountResponse {
+	var tokenExpiresAt *time.Time
+	if a.TokenExpiresAt.Valid {
+		tokenExpiresAt = &a.TokenExpiresAt.Time
+	}
+	var lastRefreshedAt *time.Time
+	if a.LastRefreshedAt.Valid {
+		lastRefreshedAt = &a.LastRefreshedAt.Time
+	}
+
+	return SocialAccountResponse{
+		ID:                a.ID,
+		BusinessRootID:    a.BusinessRootID,
+		Platform:          string(a.Platform),
+		ExternalAccountID: a.ExternalAccountID,
+		AccountName:       a.AccountName,
+		AccountImageUrl:   utils.NullStringToString(a.AccountImageUrl),
+		Scopes:            a.Scopes,
+		Status:            string(a.Status),
+		TokenExpiresAt:    tokenExpiresAt,
+		IsTokenExpired:    a.Status == entity.BusinessSocialAccountStatusExpired || (tokenExpiresAt != nil && tokenExpiresAt.Before(time.Now())),
+		LastRefreshedAt:   lastRefreshedAt,
+		CreatedAt:         a.CreatedAt,
+		UpdatedAt:         a.UpdatedAt,
+	}
+}
@@ -0,0 +1,69 @@
+// internal/module/business/business_social_account/state.go
+package business_social_account_service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+/* -----------------------------
+   STATE SIGN/VERIFY (HMAC)
+------------------------------ */
+
+type connectStatePayload struct {
+	BusinessRootID int64     `json:"b"`
+	ProfileID      uuid.UUID `json:"p"`
+	Platform       string    `json:"pl"`
+	Exp            int64     `json:"exp"`
+	N              string    `json:"n"` // nonce
+}
+
+func (s *BusinessSocialAccountService) signState(p connectStatePayload) (string, error) {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	msg := base64.RawURLEncoding.EncodeToString(b)
+
+	mac := hmac.New(sha256.New, s.stateKey)
+	mac.Write([]byte(msg))
+	sig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+
+	return msg + "." + sig, nil
+}
+
+func (s *BusinessSocialAccountService) verifyState(state string) (connectStatePayload, error) {
+	parts := strings.Split(state, ".")
+	if len(parts) != 2 {
+		return connectStatePayload{}, errors.New("bad format")
+	}
+	msg, sig := parts[0], parts[1]
+
+	mac := hmac.New(sha256.New, s.stateKey)
+	mac.Write([]byte(msg))
+	expect := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	if !hmac.Equal([]byte(sig), []byte(expect)) {
+		return connectStatePayload{}, errors.New("bad signature")
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(msg)
+	if err != nil {
+		return connectStatePayload{}, err
+	}
+
+	var p connectStatePayload
+	if err := json.Unmarshal(raw, &p); err != nil {
+		return connectStatePayload{}, err
+	}
+	if p.Exp <= time.Now().Unix() {
+		return connectStatePayload{}, errors.New("expired")
+	}
+	return p, nil
+}
@@ -0,0 +1,26 @@
+// internal/module/business/business_social_account/viewmodel.go
+package business_social_account_service
+
+import "time"
+
+// credential (access/refresh token) tidak pernah dikembalikan ke client
+type SocialAccountResponse struct {
+	ID                int64      `json:"id"`
+	BusinessRootID    int64      `json:"businessRootId"`
+	Platform          string     `json:"platform"`
+	ExternalAccountID string     `json:"externalAccountId"`
+	AccountName       string     `json:"accountName"`
+	AccountImageUrl   *string    `json:"accountImageUrl"`
+	Scopes            []string   `json:"scopes"`
+	Status            string     `json:"status"`
+	TokenExpiresAt    *time.Time `json:"tokenExpiresAt"`
+	IsTokenExpired    bool       `json:"isTokenExpired"`
+	LastRefreshedAt   *time.Time `json:"lastRefreshedAt"`
+	CreatedAt         time.Time  `json:"createdAt"`
+	UpdatedAt         time.Time  `json:"updatedAt"`
+}
+
+type ConnectURLResponse struct {
+	Platform string `json:"platform"`
+	AuthURL  string `json:"authUrl"`
+}
@@ -27,8 +27,8 @@ func (h *Handler) Routes() chi.Router {
 	// owned business middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
-		r.Get("/", h.GetBusinessTimezonePrefByBusinessRootId)
-		r.Post("/", h.UpsertBusinessTimezonePrefByBusinessRootID)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetBusinessTimezonePrefByBusinessRootId)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermSettingsWrite)).Post("/", h.UpsertBusinessTimezonePrefByBusinessRootID)
 	})
 
 	return r
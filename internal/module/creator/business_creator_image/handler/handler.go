@@ -31,9 +31,10 @@ func (h *Handler) Routes() chi.Router {
 		r.Use(func(next http.Handler) http.Handler {
 			return internal_middleware.ReqFilterMiddleware(next, business_creator_image_service.SORT_BY)
 		})
-		r.Get("/", h.GetSavedCreatorImages)
-		r.Post("/", h.CreateSavedCreatorImage)
-		r.Delete("/{creatorImageId}", h.DeleteSavedCreatorImage)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/", h.GetSavedCreatorImages)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentWrite)).Post("/", h.CreateSavedCreatorImage)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBillingRead)).Get("/license", h.GetCreatorImageLicenses)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermContentWrite)).Delete("/{creatorImageId}", h.DeleteSavedCreatorImage)
 	})
 
 	return r
@@ -146,3 +147,37 @@ func (h *Handler) DeleteSavedCreatorImage(w http.ResponseWriter, r *http.Request
 
 	response.OK(w, r, "DELETE_SAVED_CREATOR_IMAGE_SUCCESS", res)
 }
+
+// GetCreatorImageLicenses: category = status lisensi (active, revoked)
+func (h *Handler) GetCreatorImageLicenses(w http.ResponseWriter, r *http.Request) {
+	bus, err := internal_middleware.OwnedBusinessFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+	if filter.Category != "" && !utils.StringInSlice(filter.Category, business_creator_image_service.LicenseStatusValues) {
+		response.ValidationFailed(w, r, map[string]string{"category": "INVALID_LICENSE_STATUS"})
+		return
+	}
+
+	res, pag, err := h.svc.GetLicenses(r.Context(), business_creator_image_service.GetLicenseFilter{
+		BusinessRootID: bus.BusinessRootID,
+		Status:         filter.Category,
+		Search:         filter.Search,
+		SortBy:         filter.SortByDB(),
+		SortDir:        filter.Sort,
+		PageOffset:     filter.Offset(),
+		PageLimit:      filter.Limit,
+		Page:           filter.Page,
+		DateStart:      filter.DateStart,
+		DateEnd:        filter.DateEnd,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "GET_CREATOR_IMAGE_LICENSES_SUCCESS", res, &filter, pag)
+}
@@ -24,3 +24,16 @@ type DeleteSavedInput struct {
 	BusinessRootID int64 `json:"-"`
 	CreatorImageID int64 `json:"-"`
 }
+
+type GetLicenseFilter struct {
+	BusinessRootID int64
+	Status         string
+	Search         string
+	SortBy         string
+	SortDir        string
+	PageOffset     int
+	PageLimit      int
+	Page           int
+	DateStart      *string
+	DateEnd        *string
+}
@@ -2,3 +2,6 @@
 package business_creator_image_service
 
 var SORT_BY = []string{"id", "created_at", "updated_at", "name"}
+
+// LicenseStatusValues: filter category untuk list lisensi
+var LicenseStatusValues = []string{"active", "revoked"}
@@ -0,0 +1,145 @@
+// internal/module/creator/business_creator_image/service/license.go
+package business_creator_image_service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/pagination"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// HasActiveLicense: business sudah membeli (lisensi aktif) creator image tersebut
+func (s *BusinessCreatorImageService) HasActiveLicense(ctx context.Context, businessRootID int64, creatorImageID int64) (bool, error) {
+	exists, err := s.store.CheckActiveCreatorImageLicenseExists(ctx, entity.CheckActiveCreatorImageLicenseExistsParams{
+		BusinessRootID: businessRootID,
+		CreatorImageID: creatorImageID,
+	})
+	if err != nil {
+		return false, errs.NewInternalServerError(err)
+	}
+	return exists, nil
+}
+
+// GrantLicenseFromPayment membuat lisensi dari payment creator image yang success dan otomatis menyimpan template ke business.
+// Dipanggil di dalam transaksi update status payment, idempotent per payment.
+// Return false jika lisensi tidak dibuat (sudah pernah dibuat untuk payment ini atau business sudah punya lisensi aktif).
+func (s *BusinessCreatorImageService) GrantLicenseFromPayment(ctx context.Context, q *entity.Queries, payment entity.PaymentHistory) (bool, error) {
+	log := logger.From(ctx)
+
+	if !payment.ReferenceCreatorImageID.Valid {
+		return false, errs.NewBadRequest("PAYMENT_CREATOR_IMAGE_NOT_FOUND")
+	}
+	creatorImageID := payment.ReferenceCreatorImageID.Int64
+
+	_, err := q.CreateBusinessCreatorImageLicense(ctx, entity.CreateBusinessCreatorImageLicenseParams{
+		BusinessRootID:   payment.BusinessRootID,
+		CreatorImageID:   creatorImageID,
+		PaymentHistoryID: payment.ID,
+		ProfileID:        payment.ProfileID,
+	})
+	if err == sql.ErrNoRows {
+		log.Warn("Creator image license not granted, already exists", "paymentID", payment.ID, "businessRootId", payment.BusinessRootID, "creatorImageId", creatorImageID)
+		return false, nil
+	}
+	if err != nil {
+		return false, errs.NewInternalServerError(err)
+	}
+
+	if err := q.CreateSavedCreatorImageIfNotExists(ctx, entity.CreateSavedCreatorImageIfNotExistsParams{
+		BusinessRootID: payment.BusinessRootID,
+		CreatorImageID: creatorImageID,
+	}); err != nil {
+		return false, errs.NewInternalServerError(err)
+	}
+
+	log.Info("Creator image license granted", "paymentID", payment.ID, "businessRootId", payment.BusinessRootID, "creatorImageId", creatorImageID)
+	return true, nil
+}
+
+// RevokeLicenseFromPayment mencabut lisensi saat payment di-refund (template yang tersimpan tidak dihapus,
+// tapi tidak bisa disimpan ulang tanpa membeli lagi)
+func (s *BusinessCreatorImageService) RevokeLicenseFromPayment(ctx context.Context, q *entity.Queries, paymentID uuid.UUID) error {
+	_, err := q.RevokeBusinessCreatorImageLicenseByPaymentId(ctx, paymentID)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	logger.From(ctx).Info("Creator image license revoked", "paymentID", paymentID)
+	return nil
+}
+
+func (s *BusinessCreatorImageService) GetLicenses(ctx context.Context, filter GetLicenseFilter) ([]CreatorImageLicenseResponse, *pagination.Pagination, error) {
+	var status entity.NullCreatorImageLicenseStatus
+	if filter.Status != "" {
+		status = entity.NullCreatorImageLicenseStatus{
+			CreatorImageLicenseStatus: entity.CreatorImageLicenseStatus(filter.Status),
+			Valid:                     true,
+		}
+	}
+
+	rows, err := s.store.GetAllBusinessCreatorImageLicenses(ctx, entity.GetAllBusinessCreatorImageLicensesParams{
+		BusinessRootID: filter.BusinessRootID,
+		Status:         status,
+		Search:         filter.Search,
+		DateStart:      utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:        utils.NullStringToNullTime(filter.DateEnd),
+		SortBy:         filter.SortBy,
+		SortDir:        filter.SortDir,
+		PageOffset:     int32(filter.PageOffset),
+		PageLimit:      int32(filter.PageLimit),
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	total, err := s.store.CountAllBusinessCreatorImageLicenses(ctx, entity.CountAllBusinessCreatorImageLicensesParams{
+		BusinessRootID: filter.BusinessRootID,
+		Status:         status,
+		Search:         filter.Search,
+		DateStart:      utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:        utils.NullStringToNullTime(filter.DateEnd),
+	})
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(total),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	res := make([]CreatorImageLicenseResponse, 0, len(rows))
+	for _, r := range rows {
+		var revokedAt *time.Time
+		if r.RevokedAt.Valid {
+			revokedAt = &r.RevokedAt.Time
+		}
+		res = append(res, CreatorImageLicenseResponse{
+			ID:               r.ID,
+			BusinessRootID:   r.BusinessRootID,
+			CreatorImageID:   r.CreatorImageID,
+			CreatorImageName: r.CreatorImageName,
+			CreatorImageURL:  r.CreatorImageUrl,
+			PaymentHistoryID: r.PaymentHistoryID.String(),
+			ProfileID:        r.ProfileID.String(),
+			Price:            r.Price,
+			Currency:         r.Currency,
+			Status:           string(r.Status),
+			RevokedAt:        revokedAt,
+			CreatedAt:        r.CreatedAt,
+		})
+	}
+
+	return res, &pag, nil
+}
@@ -14,8 +14,8 @@ import (
 )
 
 // NotShowingReason constants
+// (creator image banned tidak ikut ter-query)
 const (
-	ReasonBanned       = "CONTENT_IMAGE_BANNED"
 	ReasonNotPublished = "CONTENT_IMAGE_CURRENTLY_NOT_PUBLISHED"
 	ReasonDeleted      = "CONTENT_IMAGE_DELETED"
 )
@@ -140,15 +140,11 @@ func (s *BusinessCreatorImageService) GetSavedCreatorImages(
 		var notShowingReason *string
 		var imageUrl *string
 
-		// Check in priority order: deleted > banned > not published
+		// Check in priority order: deleted > not published
 		if r.CreatorImageDeletedAt.Valid {
 			reason := ReasonDeleted
 			notShowingReason = &reason
 			imageUrl = nil
-		} else if r.IsBanned {
-			reason := ReasonBanned
-			notShowingReason = &reason
-			imageUrl = nil
 		} else if !r.IsPublished {
 			reason := ReasonNotPublished
 			notShowingReason = &reason
@@ -206,7 +202,18 @@ func (s *BusinessCreatorImageService) CreateSavedCreatorImage(
 		return SavedCreatorImageActionResponse{}, errs.NewBadRequest("CREATOR_IMAGE_NOT_PUBLISHED")
 	}
 
-	// 5. Check if already saved
+	// 5. Template berbayar hanya bisa disimpan jika business sudah punya lisensi (dibeli via payment)
+	if detail.Price > 0 {
+		licensed, err := s.HasActiveLicense(ctx, input.BusinessRootID, input.CreatorImageID)
+		if err != nil {
+			return SavedCreatorImageActionResponse{}, err
+		}
+		if !licensed {
+			return SavedCreatorImageActionResponse{}, errs.NewBadRequest("CREATOR_IMAGE_LICENSE_REQUIRED")
+		}
+	}
+
+	// 6. Check if already saved
 	exists, err := s.store.CheckSavedCreatorImageExists(ctx, entity.CheckSavedCreatorImageExistsParams{
 		BusinessRootID: input.BusinessRootID,
 		CreatorImageID: input.CreatorImageID,
@@ -218,7 +225,7 @@ func (s *BusinessCreatorImageService) CreateSavedCreatorImage(
 		return SavedCreatorImageActionResponse{}, errs.NewBadRequest("CREATOR_IMAGE_ALREADY_SAVED")
 	}
 
-	// 6. Create saved
+	// 7. Create saved
 	saved, err := s.store.CreateSavedCreatorImage(ctx, entity.CreateSavedCreatorImageParams{
 		BusinessRootID: input.BusinessRootID,
 		CreatorImageID: input.CreatorImageID,
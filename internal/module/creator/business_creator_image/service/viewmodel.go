@@ -25,6 +25,21 @@ type SavedCreatorImageActionResponse struct {
 	CreatedAt      time.Time `json:"createdAt"`
 }
 
+type CreatorImageLicenseResponse struct {
+	ID               int64      `json:"id"`
+	BusinessRootID   int64      `json:"businessRootId"`
+	CreatorImageID   int64      `json:"creatorImageId"`
+	CreatorImageName string     `json:"creatorImageName"`
+	CreatorImageURL  string     `json:"creatorImageUrl"`
+	PaymentHistoryID string     `json:"paymentHistoryId"`
+	ProfileID        string     `json:"profileId"` // profile yang membeli
+	Price            int64      `json:"price"`
+	Currency         string     `json:"currency"`
+	Status           string     `json:"status"` // active, revoked
+	RevokedAt        *time.Time `json:"revokedAt"`
+	CreatedAt        time.Time  `json:"createdAt"`
+}
+
 // Sub-structs for response
 type TypeCategorySub struct {
 	ID   int64  `json:"id"`
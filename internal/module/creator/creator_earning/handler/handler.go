@@ -0,0 +1,81 @@
+// internal/module/creator/creator_earning/handler/handler.go
+package creator_earning_handler
+
+import (
+	"net/http"
+
+	"postmatic-api/internal/internal_middleware"
+	creator_earning_service "postmatic-api/internal/module/creator/creator_earning/service"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type Handler struct {
+	svc *creator_earning_service.CreatorEarningService
+}
+
+func NewHandler(svc *creator_earning_service.CreatorEarningService) *Handler {
+	return &Handler{svc: svc}
+}
+
+func (h *Handler) Routes() chi.Router {
+	r := chi.NewRouter()
+
+	r.Get("/", h.GetEarningSummary)
+	r.With(func(next http.Handler) http.Handler {
+		return internal_middleware.ReqFilterMiddleware(next, creator_earning_service.SORT_BY)
+	}).Get("/transaction", h.GetEarningTransactions)
+
+	return r
+}
+
+func (h *Handler) GetEarningSummary(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	res, err := h.svc.GetEarningSummary(r.Context(), prof.ID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GET_CREATOR_EARNING_SUMMARY_SUCCESS", res)
+}
+
+// GetEarningTransactions: category = type transaksi (sale, clawback)
+func (h *Handler) GetEarningTransactions(w http.ResponseWriter, r *http.Request) {
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+	if filter.Category != "" && !utils.StringInSlice(filter.Category, creator_earning_service.TransactionTypeValues) {
+		response.ValidationFailed(w, r, map[string]string{"category": "INVALID_TRANSACTION_TYPE"})
+		return
+	}
+
+	res, pag, err := h.svc.GetEarningTransactions(r.Context(), creator_earning_service.GetEarningTransactionsFilter{
+		ProfileID:  prof.ID,
+		Type:       filter.Category,
+		DateStart:  filter.DateStart,
+		DateEnd:    filter.DateEnd,
+		SortBy:     filter.SortBy,
+		SortDir:    filter.Sort,
+		Page:       filter.Page,
+		PageOffset: filter.Offset(),
+		PageLimit:  filter.Limit,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "GET_CREATOR_EARNING_TRANSACTIONS_SUCCESS", res, &filter, pag)
+}
@@ -0,0 +1,20 @@
+// internal/module/creator/creator_earning/service/filter.go
+package creator_earning_service
+
+import "github.com/google/uuid"
+
+var SORT_BY = []string{"id", "created_at", "amount"}
+
+var TransactionTypeValues = []string{"sale", "clawback"}
+
+type GetEarningTransactionsFilter struct {
+	ProfileID  uuid.UUID `json:"profileId"`
+	Type       string    `json:"type"`
+	DateStart  *string   `json:"dateStart"`
+	DateEnd    *string   `json:"dateEnd"`
+	SortBy     string    `json:"sortBy"`
+	SortDir    string    `json:"sortDir"`
+	Page       int       `json:"page"`
+	PageOffset int       `json:"pageOffset"`
+	PageLimit  int       `json:"pageLimit"`
+}
@@ -0,0 +1,150 @@
+// internal/module/creator/creator_earning/service/sale.go
+package creator_earning_service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// RecordSale mencatat pendapatan creator dari payment creator image yang success.
+// Dipanggil di dalam transaksi update status payment, idempotent per payment.
+// Return nil jika tidak ada yang dicatat (creator image tanpa owner / sale sudah pernah dicatat).
+func (s *CreatorEarningService) RecordSale(ctx context.Context, q *entity.Queries, payment entity.PaymentHistory) (*entity.CreatorEarningTransaction, error) {
+	log := logger.From(ctx)
+
+	if !payment.ReferenceCreatorImageID.Valid {
+		return nil, errs.NewBadRequest("PAYMENT_CREATOR_IMAGE_NOT_FOUND")
+	}
+
+	creatorImage, err := q.GetCreatorImageById(ctx, payment.ReferenceCreatorImageID.Int64)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errs.NewNotFound("CREATOR_IMAGE_NOT_FOUND")
+		}
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	// creator image milik platform (tanpa owner), tidak ada earning
+	if !creatorImage.ProfileID.Valid {
+		log.Info("Creator image has no owner, skip earning", "paymentID", payment.ID, "creatorImageId", creatorImage.ID)
+		return nil, nil
+	}
+
+	// gross = harga item setelah diskon, admin fee & tax bukan milik creator
+	gross := payment.SubtotalItemAmount - payment.DiscountAmount
+	if gross < 0 {
+		gross = 0
+	}
+	commission := gross * s.commissionPercentage / 100
+
+	sale, err := q.CreateCreatorEarningTransaction(ctx, entity.CreateCreatorEarningTransactionParams{
+		ProfileID:            creatorImage.ProfileID.UUID,
+		Type:                 entity.CreatorEarningTransactionTypeSale,
+		CreatorImageID:       creatorImage.ID,
+		PaymentHistoryID:     payment.ID,
+		GrossAmount:          gross,
+		CommissionPercentage: int32(s.commissionPercentage),
+		CommissionAmount:     commission,
+		NetAmount:            gross - commission,
+		Currency:             payment.Currency,
+	})
+	if err == sql.ErrNoRows {
+		log.Warn("Creator earning sale already recorded", "paymentID", payment.ID)
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	log.Info("Creator earning sale recorded", "paymentID", payment.ID, "profileId", sale.ProfileID, "netAmount", sale.NetAmount)
+	return &sale, nil
+}
+
+// ClawbackSale menarik kembali pendapatan creator saat payment di-refund (full net amount, sekali per payment).
+// Dipanggil di dalam transaksi refund.
+func (s *CreatorEarningService) ClawbackSale(ctx context.Context, q *entity.Queries, paymentID uuid.UUID) error {
+	log := logger.From(ctx)
+
+	sale, err := q.GetCreatorEarningTransactionByPaymentIdAndType(ctx, entity.GetCreatorEarningTransactionByPaymentIdAndTypeParams{
+		PaymentHistoryID: paymentID,
+		Type:             entity.CreatorEarningTransactionTypeSale,
+	})
+	if err == sql.ErrNoRows {
+		// tidak ada sale (creator image tanpa owner)
+		return nil
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	_, err = q.CreateCreatorEarningTransaction(ctx, entity.CreateCreatorEarningTransactionParams{
+		ProfileID:            sale.ProfileID,
+		Type:                 entity.CreatorEarningTransactionTypeClawback,
+		CreatorImageID:       sale.CreatorImageID,
+		PaymentHistoryID:     sale.PaymentHistoryID,
+		GrossAmount:          sale.GrossAmount,
+		CommissionPercentage: sale.CommissionPercentage,
+		CommissionAmount:     sale.CommissionAmount,
+		NetAmount:            sale.NetAmount,
+		Currency:             sale.Currency,
+	})
+	if err == sql.ErrNoRows {
+		log.Warn("Creator earning already clawed back", "paymentID", paymentID)
+		return nil
+	}
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	log.Info("Creator earning clawed back", "paymentID", paymentID, "profileId", sale.ProfileID, "netAmount", sale.NetAmount)
+	return nil
+}
+
+// SendSaleNotification mengirim email ke creator saat template terjual (async via queue).
+// Dipanggil setelah transaksi commit.
+func (s *CreatorEarningService) SendSaleNotification(ctx context.Context, sale *entity.CreatorEarningTransaction) {
+	if sale == nil {
+		return
+	}
+	saleCopy := *sale
+
+	go func() {
+		ctxBg, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		profile, err := s.store.GetProfileById(ctxBg, saleCopy.ProfileID)
+		if err != nil {
+			logger.L().Error("Failed to get creator profile for sale email", "profileId", saleCopy.ProfileID, "error", err)
+			return
+		}
+
+		creatorImage, err := s.store.GetCreatorImageById(ctxBg, saleCopy.CreatorImageID)
+		if err != nil {
+			logger.L().Error("Failed to get creator image for sale email", "creatorImageId", saleCopy.CreatorImageID, "error", err)
+			return
+		}
+
+		err = s.queue.EnqueueCreatorSale(ctxBg, mailer.CreatorSaleInputDTO{
+			Email:                profile.Email,
+			Name:                 profile.Name,
+			CreatorImageName:     creatorImage.Name,
+			GrossAmount:          saleCopy.GrossAmount,
+			CommissionPercentage: saleCopy.CommissionPercentage,
+			CommissionAmount:     saleCopy.CommissionAmount,
+			NetAmount:            saleCopy.NetAmount,
+			Currency:             saleCopy.Currency,
+			SoldAt:               saleCopy.CreatedAt,
+		})
+		if err != nil {
+			logger.L().Error("Failed to enqueue creator sale email", "paymentID", saleCopy.PaymentHistoryID, "error", err)
+		}
+	}()
+}
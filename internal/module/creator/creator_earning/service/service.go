@@ -0,0 +1,110 @@
+// internal/module/creator/creator_earning/service/service.go
+package creator_earning_service
+
+import (
+	"context"
+	"database/sql"
+
+	"postmatic-api/internal/module/headless/queue"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/pagination"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// defaultEarningCurrency: harga creator image saat ini hanya IDR
+const defaultEarningCurrency = "IDR"
+
+type CreatorEarningService struct {
+	store                entity.Store
+	queue                queue.MailerProducer
+	commissionPercentage int64
+}
+
+// NewService: commissionPercentage adalah potongan platform (0-100) dari setiap penjualan template
+func NewService(store entity.Store, queue queue.MailerProducer, commissionPercentage int64) *CreatorEarningService {
+	return &CreatorEarningService{
+		store:                store,
+		queue:                queue,
+		commissionPercentage: commissionPercentage,
+	}
+}
+
+func (s *CreatorEarningService) GetEarningSummary(ctx context.Context, profileID uuid.UUID) (EarningSummaryResponse, error) {
+	summary, err := s.store.GetCreatorEarningSummaryByProfileId(ctx, profileID)
+	if err != nil {
+		return EarningSummaryResponse{}, errs.NewInternalServerError(err)
+	}
+
+	return EarningSummaryResponse{
+		Currency:             defaultEarningCurrency,
+		CommissionPercentage: s.commissionPercentage,
+		TotalSales:           summary.TotalSales,
+		TotalGross:           summary.TotalGross,
+		TotalCommission:      summary.TotalCommission,
+		TotalEarned:          summary.TotalEarned,
+		TotalClawedBack:      summary.TotalClawedBack,
+		Balance:              summary.TotalEarned - summary.TotalClawedBack,
+	}, nil
+}
+
+func (s *CreatorEarningService) GetEarningTransactions(ctx context.Context, filter GetEarningTransactionsFilter) ([]EarningTransactionResponse, *pagination.Pagination, error) {
+	var typeFilter entity.NullCreatorEarningTransactionType
+	if filter.Type != "" {
+		typeFilter = entity.NullCreatorEarningTransactionType{
+			CreatorEarningTransactionType: entity.CreatorEarningTransactionType(filter.Type),
+			Valid:                         true,
+		}
+	}
+
+	count, err := s.store.CountAllCreatorEarningTransactionsByProfileId(ctx, entity.CountAllCreatorEarningTransactionsByProfileIdParams{
+		ProfileID: filter.ProfileID,
+		Type:      typeFilter,
+		DateStart: utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:   utils.NullStringToNullTime(filter.DateEnd),
+	})
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	trxs, err := s.store.GetAllCreatorEarningTransactionsByProfileId(ctx, entity.GetAllCreatorEarningTransactionsByProfileIdParams{
+		ProfileID:  filter.ProfileID,
+		Type:       typeFilter,
+		DateStart:  utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:    utils.NullStringToNullTime(filter.DateEnd),
+		SortBy:     filter.SortBy,
+		SortDir:    filter.SortDir,
+		PageOffset: int32(filter.PageOffset),
+		PageLimit:  int32(filter.PageLimit),
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	result := make([]EarningTransactionResponse, 0, len(trxs))
+	for _, t := range trxs {
+		result = append(result, EarningTransactionResponse{
+			ID:                   t.ID,
+			Type:                 string(t.Type),
+			CreatorImageID:       t.CreatorImageID,
+			CreatorImageName:     t.CreatorImageName,
+			PaymentHistoryID:     t.PaymentHistoryID.String(),
+			GrossAmount:          t.GrossAmount,
+			CommissionPercentage: t.CommissionPercentage,
+			CommissionAmount:     t.CommissionAmount,
+			NetAmount:            t.NetAmount,
+			Currency:             t.Currency,
+			CreatedAt:            t.CreatedAt,
+		})
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	return result, &pag, nil
+}
@@ -0,0 +1,31 @@
+// internal/module/creator/creator_earning/service/viewmodel.go
+package creator_earning_service
+
+import "time"
+
+type EarningSummaryResponse struct {
+	Currency string `json:"currency"`
+	// komisi platform yang berlaku saat ini (persen), penjualan lama memakai komisi saat transaksi
+	CommissionPercentage int64 `json:"commissionPercentage"`
+	TotalSales           int64 `json:"totalSales"`
+	TotalGross           int64 `json:"totalGross"`
+	TotalCommission      int64 `json:"totalCommission"`
+	TotalEarned          int64 `json:"totalEarned"`
+	TotalClawedBack      int64 `json:"totalClawedBack"`
+	// balance = totalEarned - totalClawedBack
+	Balance int64 `json:"balance"`
+}
+
+type EarningTransactionResponse struct {
+	ID                   int64     `json:"id"`
+	Type                 string    `json:"type"`
+	CreatorImageID       int64     `json:"creatorImageId"`
+	CreatorImageName     string    `json:"creatorImageName"`
+	PaymentHistoryID     string    `json:"paymentHistoryId"`
+	GrossAmount          int64     `json:"grossAmount"`
+	CommissionPercentage int32     `json:"commissionPercentage"`
+	CommissionAmount     int64     `json:"commissionAmount"`
+	NetAmount            int64     `json:"netAmount"`
+	Currency             string    `json:"currency"`
+	CreatedAt            time.Time `json:"createdAt"`
+}
@@ -22,7 +22,7 @@ func NewHandler(creatorImageSvc *creator_image_service.CreatorImageService) *Han
 	return &Handler{creatorImageSvc: creatorImageSvc}
 }
 
-func (h *Handler) Routes() chi.Router {
+func (h *Handler) Routes(adminOnly func(http.Handler) http.Handler) chi.Router {
 	r := chi.NewRouter()
 
 	r.Get("/", h.GetCreatorImageByProfileId)
@@ -30,6 +30,16 @@ func (h *Handler) Routes() chi.Router {
 	r.Put("/{creatorImageId}", h.UpdateCreatorImage)
 	r.Delete("/{creatorImageId}", h.SoftDeleteCreatorImage)
 
+	// Admin only routes (moderation queue)
+	r.Route("/admin/moderation", func(r chi.Router) {
+		r.Use(adminOnly)
+		r.Get("/", h.GetModerationQueue)
+		r.Get("/{creatorImageId}", h.GetModerationHistory)
+		r.Post("/{creatorImageId}/approve", h.ApproveCreatorImage)
+		r.Post("/{creatorImageId}/ban", h.BanCreatorImage)
+		r.Post("/{creatorImageId}/unban", h.UnbanCreatorImage)
+	})
+
 	return r
 }
 
@@ -160,3 +170,114 @@ func (h *Handler) SoftDeleteCreatorImage(w http.ResponseWriter, r *http.Request)
 
 	response.OK(w, r, "DELETE_CREATOR_IMAGE_SUCCESS", res)
 }
+
+// GetModerationQueue: category = moderation status (pending, approved, banned), default pending
+func (h *Handler) GetModerationQueue(w http.ResponseWriter, r *http.Request) {
+	filter := internal_middleware.GetFilterFromContext(r.Context())
+	if filter.Category != "" && !utils.StringInSlice(filter.Category, creator_image_service.ModerationStatusValues) {
+		response.ValidationFailed(w, r, map[string]string{"category": "INVALID_MODERATION_STATUS"})
+		return
+	}
+
+	res, pag, err := h.creatorImageSvc.GetModerationQueue(r.Context(), creator_image_service.GetModerationQueueFilter{
+		Status:     filter.Category,
+		Search:     filter.Search,
+		DateStart:  filter.DateStart,
+		DateEnd:    filter.DateEnd,
+		SortBy:     filter.SortByDB(),
+		SortDir:    filter.Sort,
+		Page:       filter.Page,
+		PageOffset: filter.Offset(),
+		PageLimit:  filter.Limit,
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.LIST(w, r, "GET_CREATOR_IMAGE_MODERATION_QUEUE_SUCCESS", res, &filter, pag)
+}
+
+func (h *Handler) GetModerationHistory(w http.ResponseWriter, r *http.Request) {
+	creatorImageId, err := strconv.ParseInt(chi.URLParam(r, "creatorImageId"), 10, 64)
+	if err != nil {
+		response.ValidationFailed(w, r, map[string]string{"creatorImageId": "CREATOR_IMAGE_MUST_BE_INTEGER_64"})
+		return
+	}
+
+	res, err := h.creatorImageSvc.GetModerationHistory(r.Context(), creatorImageId)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "GET_CREATOR_IMAGE_MODERATION_HISTORY_SUCCESS", res)
+}
+
+func (h *Handler) ApproveCreatorImage(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.parseModerateRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.creatorImageSvc.ApproveCreatorImage(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "APPROVE_CREATOR_IMAGE_SUCCESS", res)
+}
+
+func (h *Handler) BanCreatorImage(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.parseModerateRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.creatorImageSvc.BanCreatorImage(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "BAN_CREATOR_IMAGE_SUCCESS", res)
+}
+
+func (h *Handler) UnbanCreatorImage(w http.ResponseWriter, r *http.Request) {
+	req, ok := h.parseModerateRequest(w, r)
+	if !ok {
+		return
+	}
+
+	res, err := h.creatorImageSvc.UnbanCreatorImage(r.Context(), req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "UNBAN_CREATOR_IMAGE_SUCCESS", res)
+}
+
+func (h *Handler) parseModerateRequest(w http.ResponseWriter, r *http.Request) (creator_image_service.ModerateCreatorImageInput, bool) {
+	var req creator_image_service.ModerateCreatorImageInput
+
+	prof, err := internal_middleware.GetProfileFromContext(r.Context())
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return req, false
+	}
+	creatorImageId, err := strconv.ParseInt(chi.URLParam(r, "creatorImageId"), 10, 64)
+	if err != nil {
+		response.ValidationFailed(w, r, map[string]string{"creatorImageId": "CREATOR_IMAGE_MUST_BE_INTEGER_64"})
+		return req, false
+	}
+
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return req, false
+	}
+	req.CreatorImageID = creatorImageId
+	req.AdminProfileID = prof.ID
+	return req, true
+}
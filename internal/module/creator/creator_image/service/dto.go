@@ -1,6 +1,8 @@
 // internal/module/creator/creator_image/dto.go
 package creator_image_service
 
+import "github.com/google/uuid"
+
 type CreateCreatorImageInput struct {
 	ProfileID          string  `json:"profileId"`
 	Name               string  `json:"name" validate:"required"`
@@ -20,3 +22,10 @@ type UpdateCreatorImageInput struct {
 	TypeCategoryIds    []int64 `json:"typeCategoryIds" validate:"required,min=1,unique,gte=1"`
 	ProductCategoryIds []int64 `json:"productCategoryIds" validate:"required,min=1,unique,gte=1"`
 }
+
+// ModerateCreatorImageInput: reason wajib untuk ban & unban (divalidasi di service)
+type ModerateCreatorImageInput struct {
+	Reason         *string `json:"reason" validate:"omitempty,max=1000"`
+	CreatorImageID int64
+	AdminProfileID uuid.UUID
+}
@@ -17,3 +17,18 @@ type GetCreatorImageFilter struct {
 }
 
 var SORT_BY = []string{"name", "created_at", "updated_at", "id"}
+
+// MODERATION (sort by memakai SORT_BY)
+var ModerationStatusValues = []string{"pending", "approved", "banned"}
+
+type GetModerationQueueFilter struct {
+	Status     string  `json:"status"`
+	Search     string  `json:"search"`
+	DateStart  *string `json:"dateStart"`
+	DateEnd    *string `json:"dateEnd"`
+	SortBy     string  `json:"sortBy"`
+	SortDir    string  `json:"sortDir"`
+	Page       int     `json:"page"`
+	PageOffset int     `json:"pageOffset"`
+	PageLimit  int     `json:"pageLimit"`
+}
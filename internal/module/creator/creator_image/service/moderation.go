@@ -0,0 +1,267 @@
+// internal/module/creator/creator_image/service/moderation.go
+package creator_image_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/pagination"
+	"postmatic-api/pkg/utils"
+)
+
+// GetModerationQueue returns published creator images by moderation status (default pending) for admin review
+func (s *CreatorImageService) GetModerationQueue(ctx context.Context, filter GetModerationQueueFilter) ([]ModerationQueueResponse, *pagination.Pagination, error) {
+	status := entity.CreatorImageModerationStatusPending
+	if filter.Status != "" {
+		status = entity.CreatorImageModerationStatus(filter.Status)
+	}
+
+	rows, err := s.store.GetAllCreatorImageModerationQueue(ctx, entity.GetAllCreatorImageModerationQueueParams{
+		ModerationStatus: status,
+		Search:           filter.Search,
+		DateStart:        utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:          utils.NullStringToNullTime(filter.DateEnd),
+		SortBy:           filter.SortBy,
+		SortDir:          filter.SortDir,
+		PageOffset:       int32(filter.PageOffset),
+		PageLimit:        int32(filter.PageLimit),
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	total, err := s.store.CountAllCreatorImageModerationQueue(ctx, entity.CountAllCreatorImageModerationQueueParams{
+		ModerationStatus: status,
+		Search:           filter.Search,
+		DateStart:        utils.NullStringToNullTime(filter.DateStart),
+		DateEnd:          utils.NullStringToNullTime(filter.DateEnd),
+	})
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(total),
+		Page:  filter.Page,
+		Limit: filter.PageLimit,
+	})
+
+	res := make([]ModerationQueueResponse, 0, len(rows))
+	for _, r := range rows {
+		var publisher *PublisherSub
+		if r.ProfileID.Valid {
+			publisher = &PublisherSub{
+				ID:   r.ProfileID.UUID.String(),
+				Name: utils.NullStringToStringVal(r.PublisherName),
+			}
+		}
+		var moderatedAt *time.Time
+		if r.ModeratedAt.Valid {
+			moderatedAt = &r.ModeratedAt.Time
+		}
+
+		res = append(res, ModerationQueueResponse{
+			ID:               r.ID,
+			Name:             r.Name,
+			ImageURL:         r.ImageUrl,
+			Price:            r.Price,
+			IsBanned:         r.IsBanned,
+			BannedReason:     utils.NullStringToString(r.BannedReason),
+			ModerationStatus: string(r.ModerationStatus),
+			ModeratedAt:      moderatedAt,
+			Publisher:        publisher,
+			PublisherEmail:   utils.NullStringToString(r.PublisherEmail),
+			CreatedAt:        r.CreatedAt.Time,
+			UpdatedAt:        r.UpdatedAt.Time,
+		})
+	}
+
+	return res, &pag, nil
+}
+
+// GetModerationHistory returns moderation history of a creator image (newest first)
+func (s *CreatorImageService) GetModerationHistory(ctx context.Context, creatorImageID int64) ([]ModerationHistoryResponse, error) {
+	if _, err := s.store.GetCreatorImageById(ctx, creatorImageID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, errs.NewNotFound("CREATOR_IMAGE_NOT_FOUND")
+		}
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	rows, err := s.store.GetCreatorImageModerationsByCreatorImageId(ctx, creatorImageID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	res := make([]ModerationHistoryResponse, 0, len(rows))
+	for _, r := range rows {
+		res = append(res, ModerationHistoryResponse{
+			ID:             r.ID,
+			CreatorImageID: r.CreatorImageID,
+			Action:         string(r.Action),
+			PreviousStatus: string(r.PreviousStatus),
+			Reason:         utils.NullStringToString(r.Reason),
+			ModeratorID:    r.ModeratorProfileID.String(),
+			ModeratorName:  r.ModeratorName,
+			ModeratorEmail: r.ModeratorEmail,
+			CreatedAt:      r.CreatedAt,
+		})
+	}
+	return res, nil
+}
+
+// ApproveCreatorImage: pending -> approved
+func (s *CreatorImageService) ApproveCreatorImage(ctx context.Context, input ModerateCreatorImageInput) (ModerationResponse, error) {
+	return s.moderate(ctx, input, entity.CreatorImageModerationActionApprove)
+}
+
+// BanCreatorImage: pending / approved -> banned (reason wajib)
+func (s *CreatorImageService) BanCreatorImage(ctx context.Context, input ModerateCreatorImageInput) (ModerationResponse, error) {
+	return s.moderate(ctx, input, entity.CreatorImageModerationActionBan)
+}
+
+// UnbanCreatorImage: banned -> approved (reason wajib)
+func (s *CreatorImageService) UnbanCreatorImage(ctx context.Context, input ModerateCreatorImageInput) (ModerationResponse, error) {
+	return s.moderate(ctx, input, entity.CreatorImageModerationActionUnban)
+}
+
+// moderate locks creator image, validates status transition, updates status & inserts history in one transaction,
+// lalu kirim email ke creator (async)
+func (s *CreatorImageService) moderate(ctx context.Context, input ModerateCreatorImageInput, action entity.CreatorImageModerationAction) (ModerationResponse, error) {
+	var reason string
+	if input.Reason != nil {
+		reason = strings.TrimSpace(*input.Reason)
+	}
+	if reason == "" && action != entity.CreatorImageModerationActionApprove {
+		return ModerationResponse{}, errs.NewValidationFailed(map[string]string{"reason": "REQUIRED"})
+	}
+	var reasonPtr *string
+	if reason != "" {
+		reasonPtr = &reason
+	}
+
+	var updated entity.CreatorImage
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		current, err := q.GetCreatorImageByIdForUpdate(ctx, input.CreatorImageID)
+		if err == sql.ErrNoRows {
+			return errs.NewNotFound("CREATOR_IMAGE_NOT_FOUND")
+		}
+		if err != nil {
+			return err
+		}
+
+		newStatus, err := nextModerationStatus(current.ModerationStatus, action)
+		if err != nil {
+			return err
+		}
+
+		updated, err = q.UpdateCreatorImageModeration(ctx, entity.UpdateCreatorImageModerationParams{
+			ID:               current.ID,
+			ModerationStatus: newStatus,
+			BannedReason:     utils.StringToNullString(reasonPtr),
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = q.CreateCreatorImageModeration(ctx, entity.CreateCreatorImageModerationParams{
+			CreatorImageID:     current.ID,
+			Action:             action,
+			PreviousStatus:     current.ModerationStatus,
+			Reason:             utils.StringToNullString(reasonPtr),
+			ModeratorProfileID: input.AdminProfileID,
+		})
+		return err
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return ModerationResponse{}, appErr
+		}
+		return ModerationResponse{}, errs.NewInternalServerError(err)
+	}
+
+	logger.From(ctx).Info("Creator image moderated", "creatorImageId", updated.ID, "action", action, "status", updated.ModerationStatus, "adminProfileId", input.AdminProfileID)
+
+	s.sendModerationEmail(updated, action, reason)
+
+	var moderatedAt *time.Time
+	if updated.ModeratedAt.Valid {
+		moderatedAt = &updated.ModeratedAt.Time
+	}
+	return ModerationResponse{
+		ID:               updated.ID,
+		Name:             updated.Name,
+		IsBanned:         updated.IsBanned,
+		BannedReason:     utils.NullStringToString(updated.BannedReason),
+		ModerationStatus: string(updated.ModerationStatus),
+		ModeratedAt:      moderatedAt,
+	}, nil
+}
+
+// nextModerationStatus validates transition per action
+func nextModerationStatus(current entity.CreatorImageModerationStatus, action entity.CreatorImageModerationAction) (entity.CreatorImageModerationStatus, error) {
+	switch action {
+	case entity.CreatorImageModerationActionApprove:
+		switch current {
+		case entity.CreatorImageModerationStatusApproved:
+			return "", errs.NewBadRequest("CREATOR_IMAGE_ALREADY_APPROVED")
+		case entity.CreatorImageModerationStatusBanned:
+			return "", errs.NewBadRequest("CREATOR_IMAGE_BANNED")
+		}
+		return entity.CreatorImageModerationStatusApproved, nil
+	case entity.CreatorImageModerationActionBan:
+		if current == entity.CreatorImageModerationStatusBanned {
+			return "", errs.NewBadRequest("CREATOR_IMAGE_ALREADY_BANNED")
+		}
+		return entity.CreatorImageModerationStatusBanned, nil
+	case entity.CreatorImageModerationActionUnban:
+		if current != entity.CreatorImageModerationStatusBanned {
+			return "", errs.NewBadRequest("CREATOR_IMAGE_NOT_BANNED")
+		}
+		return entity.CreatorImageModerationStatusApproved, nil
+	}
+	return "", errs.NewBadRequest("INVALID_MODERATION_ACTION")
+}
+
+// sendModerationEmail notifies creator (owner) about moderation result, template milik platform di-skip
+func (s *CreatorImageService) sendModerationEmail(creatorImage entity.CreatorImage, action entity.CreatorImageModerationAction, reason string) {
+	if !creatorImage.ProfileID.Valid {
+		return
+	}
+
+	go func() {
+		ctxBg, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		profile, err := s.store.GetProfileById(ctxBg, creatorImage.ProfileID.UUID)
+		if err != nil {
+			logger.L().Error("Failed to get creator profile for moderation email", "profileId", creatorImage.ProfileID.UUID, "error", err)
+			return
+		}
+
+		moderatedAt := time.Now()
+		if creatorImage.ModeratedAt.Valid {
+			moderatedAt = creatorImage.ModeratedAt.Time
+		}
+
+		err = s.queue.EnqueueCreatorModeration(ctxBg, mailer.CreatorModerationInputDTO{
+			Email:            profile.Email,
+			Name:             profile.Name,
+			CreatorImageName: creatorImage.Name,
+			Action:           string(action),
+			Reason:           reason,
+			ModeratedAt:      moderatedAt,
+		})
+		if err != nil {
+			logger.L().Error("Failed to enqueue creator moderation email", "creatorImageId", creatorImage.ID, "error", err)
+		}
+	}()
+}
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 
 	category_creator_image_service "postmatic-api/internal/module/app/category_creator_image/service"
+	"postmatic-api/internal/module/headless/queue"
 	"postmatic-api/internal/repository/entity"
 	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/pagination"
@@ -18,17 +19,19 @@ import (
 type CreatorImageService struct {
 	store entity.Store
 	cat   *category_creator_image_service.CategoryCreatorImageService
+	queue queue.MailerProducer
 }
 
-func NewService(store entity.Store, cat *category_creator_image_service.CategoryCreatorImageService) *CreatorImageService {
+func NewService(store entity.Store, cat *category_creator_image_service.CategoryCreatorImageService, queue queue.MailerProducer) *CreatorImageService {
 	return &CreatorImageService{
 		store: store,
 		cat:   cat,
+		queue: queue,
 	}
 }
 
 // GetCreatorImageDetailById returns creator image detail for validation purposes
-// Used by BusinessCreatorImage service to validate before saving
+// Used by BusinessCreatorImage service to validate before saving and by template checkout
 func (s *CreatorImageService) GetCreatorImageDetailById(ctx context.Context, id int64) (*CreatorImageDetail, error) {
 	data, err := s.store.GetCreatorImageById(ctx, id)
 	if err == sql.ErrNoRows {
@@ -38,8 +41,17 @@ func (s *CreatorImageService) GetCreatorImageDetailById(ctx context.Context, id
 		return nil, errs.NewInternalServerError(err)
 	}
 
+	var profileID *uuid.UUID
+	if data.ProfileID.Valid {
+		profileID = &data.ProfileID.UUID
+	}
+
 	return &CreatorImageDetail{
 		ID:          data.ID,
+		Name:        data.Name,
+		ImageURL:    data.ImageUrl,
+		Price:       data.Price,
+		ProfileID:   profileID,
 		IsPublished: data.IsPublished,
 		IsBanned:    data.IsBanned,
 		IsDeleted:   data.DeletedAt.Valid,
@@ -263,6 +275,11 @@ func (s *CreatorImageService) UpdateCreatorImage(ctx context.Context, input Upda
 		return CreatorImageCreateUpdateDeleteResponse{}, errs.NewForbidden("")
 	}
 
+	// creator image yang dibanned tidak bisa diubah (unban hanya via admin)
+	if checkCreatorImage.IsBanned {
+		return CreatorImageCreateUpdateDeleteResponse{}, errs.NewBadRequest("CREATOR_IMAGE_BANNED")
+	}
+
 	checkTypeCategoryIds, err := s.cat.GetCategoryCreatorImageTypeByIds(ctx, input.TypeCategoryIds)
 	if err != nil {
 		return CreatorImageCreateUpdateDeleteResponse{}, errs.NewInternalServerError(err)
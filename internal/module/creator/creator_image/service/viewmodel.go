@@ -1,7 +1,11 @@
 // internal/module/creator/creator_image/viewmodel.go
 package creator_image_service
 
-import "time"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type CreatorImageCreateUpdateDeleteResponse struct {
 	ID          int64     `json:"id"`
@@ -46,8 +50,49 @@ type PublisherSub struct {
 
 // CreatorImageDetail for validation purposes (used by BusinessCreatorImage)
 type CreatorImageDetail struct {
-	ID          int64 `json:"id"`
-	IsPublished bool  `json:"isPublished"`
-	IsBanned    bool  `json:"isBanned"`
-	IsDeleted   bool  `json:"isDeleted"`
+	ID          int64      `json:"id"`
+	Name        string     `json:"name"`
+	ImageURL    string     `json:"imageUrl"`
+	Price       int64      `json:"price"`
+	ProfileID   *uuid.UUID `json:"profileId"` // creator (owner), nil = template milik platform
+	IsPublished bool       `json:"isPublished"`
+	IsBanned    bool       `json:"isBanned"`
+	IsDeleted   bool       `json:"isDeleted"`
+}
+
+// MODERATION RESPONSE
+type ModerationQueueResponse struct {
+	ID               int64         `json:"id"`
+	Name             string        `json:"name"`
+	ImageURL         string        `json:"imageUrl"`
+	Price            int64         `json:"price"`
+	IsBanned         bool          `json:"isBanned"`
+	BannedReason     *string       `json:"bannedReason"`
+	ModerationStatus string        `json:"moderationStatus"`
+	ModeratedAt      *time.Time    `json:"moderatedAt"`
+	Publisher        *PublisherSub `json:"publisher"`
+	PublisherEmail   *string       `json:"publisherEmail"`
+	CreatedAt        time.Time     `json:"createdAt"`
+	UpdatedAt        time.Time     `json:"updatedAt"`
+}
+
+type ModerationResponse struct {
+	ID               int64      `json:"id"`
+	Name             string     `json:"name"`
+	IsBanned         bool       `json:"isBanned"`
+	BannedReason     *string    `json:"bannedReason"`
+	ModerationStatus string     `json:"moderationStatus"`
+	ModeratedAt      *time.Time `json:"moderatedAt"`
+}
+
+type ModerationHistoryResponse struct {
+	ID             int64     `json:"id"`
+	CreatorImageID int64     `json:"creatorImageId"`
+	Action         string    `json:"action"`
+	PreviousStatus string    `json:"previousStatus"`
+	Reason         *string   `json:"reason"`
+	ModeratorID    string    `json:"moderatorId"`
+	ModeratorName  string    `json:"moderatorName"`
+	ModeratorEmail string    `json:"moderatorEmail"`
+	CreatedAt      time.Time `json:"createdAt"`
 }
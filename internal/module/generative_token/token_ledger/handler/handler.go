@@ -1,60 +1,63 @@
-// internal/module/generative_token/image_token/handler/handler.go
-package image_token_handler
+// internal/module/generative_token/token_ledger/handler/handler.go
+package token_ledger_handler
 
 import (
 	"net/http"
 
 	"postmatic-api/internal/internal_middleware"
-	image_token_service "postmatic-api/internal/module/generative_token/image_token/service"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/filter"
 	"postmatic-api/pkg/response"
 
 	"github.com/go-chi/chi/v5"
 )
 
-// Handler handles generative token image token HTTP requests
+// Handler handles generative token ledger HTTP requests (all token types)
 type Handler struct {
-	svc        *image_token_service.ImageTokenService
+	svc        *token_ledger_service.TokenLedgerService
 	middleware *internal_middleware.OwnedBusiness
 }
 
 // NewHandler creates a new Handler
-func NewHandler(svc *image_token_service.ImageTokenService, middleware *internal_middleware.OwnedBusiness) *Handler {
+func NewHandler(svc *token_ledger_service.TokenLedgerService, middleware *internal_middleware.OwnedBusiness) *Handler {
 	return &Handler{
 		svc:        svc,
 		middleware: middleware,
 	}
 }
 
-// Routes returns the routes for generative token image token
+// Routes returns the routes for generative token ledger, mounted under /generative-token/{tokenType}
 func (h *Handler) Routes() chi.Router {
 	r := chi.NewRouter()
 
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
 		r.Use(func(next http.Handler) http.Handler {
-			return internal_middleware.ReqFilterMiddleware(next, image_token_service.SORT_BY)
+			return internal_middleware.ReqFilterMiddleware(next, token_ledger_service.SORT_BY)
 		})
-		r.Get("/", h.GetTokenTransactions)
-		r.Get("/status", h.GetTokenStatus)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBillingRead)).Get("/", h.GetTokenTransactions)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBusinessRead)).Get("/status", h.GetTokenStatus)
 	})
 
 	return r
 }
 
-// GetTokenTransactions handles GET /api/app/generative-token/{businessId}/image-token/
+// GetTokenTransactions handles GET /api/app/generative-token/{tokenType}/{businessId}
 // @Summary Get token transaction history
 // @Tags GenerativeToken
 // @Accept json
 // @Produce json
+// @Param tokenType path string true "Token type (image-token, video-token, livestream-token)"
 // @Param businessId path int true "Business Root ID"
 // @Param category query string false "Filter by type (in, out)"
 // @Param sortBy query string false "Sort by field (created_at, amount)"
 // @Param sort query string false "Sort direction (asc, desc)"
 // @Param page query int false "Page number"
 // @Param limit query int false "Items per page"
-// @Success 200 {object} response.Response{data=[]image_token_service.TokenTransactionResponse}
-// @Router /api/app/generative-token/{businessId}/image-token [get]
+// @Success 200 {object} response.Response{data=[]token_ledger_service.TokenTransactionResponse}
+// @Router /api/app/generative-token/{tokenType}/{businessId} [get]
 func (h *Handler) GetTokenTransactions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -65,6 +68,12 @@ func (h *Handler) GetTokenTransactions(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tokenType, err := parseTokenType(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
 	// Get filter from middleware
 	reqFilter := internal_middleware.GetFilterFromContext(ctx)
 
@@ -75,7 +84,8 @@ func (h *Handler) GetTokenTransactions(w http.ResponseWriter, r *http.Request) {
 		typeFilter = &category
 	}
 
-	svcFilter := image_token_service.GetTokenTransactionsFilter{
+	svcFilter := token_ledger_service.GetTokenTransactionsFilter{
+		TokenType:      tokenType,
 		BusinessRootID: ownedBusiness.BusinessRootID,
 		Type:           typeFilter,
 		DateStart:      reqFilter.DateStart,
@@ -102,14 +112,15 @@ func (h *Handler) GetTokenTransactions(w http.ResponseWriter, r *http.Request) {
 	}, pag)
 }
 
-// GetTokenStatus handles GET /api/app/generative-token/{businessId}/image-token/status
+// GetTokenStatus handles GET /api/app/generative-token/{tokenType}/{businessId}/status
 // @Summary Get token status
 // @Tags GenerativeToken
 // @Accept json
 // @Produce json
+// @Param tokenType path string true "Token type (image-token, video-token, livestream-token)"
 // @Param businessId path int true "Business Root ID"
-// @Success 200 {object} response.Response{data=image_token_service.TokenStatusResponse}
-// @Router /api/app/generative-token/{businessId}/image-token/status [get]
+// @Success 200 {object} response.Response{data=token_ledger_service.TokenStatusResponse}
+// @Router /api/app/generative-token/{tokenType}/{businessId}/status [get]
 func (h *Handler) GetTokenStatus(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -120,7 +131,13 @@ func (h *Handler) GetTokenStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := h.svc.GetTokenStatus(ctx, ownedBusiness.BusinessRootID)
+	tokenType, err := parseTokenType(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	result, err := h.svc.GetTokenStatus(ctx, ownedBusiness.BusinessRootID, tokenType)
 	if err != nil {
 		response.Error(w, r, err, nil)
 		return
@@ -128,3 +145,12 @@ func (h *Handler) GetTokenStatus(w http.ResponseWriter, r *http.Request) {
 
 	response.OK(w, r, "TOKEN_STATUS_RETRIEVED", result)
 }
+
+// parseTokenType reads {tokenType} path param (image-token, video-token, livestream-token)
+func parseTokenType(r *http.Request) (entity.TokenType, error) {
+	tokenType, ok := token_ledger_service.TokenTypeFromSlug(chi.URLParam(r, "tokenType"))
+	if !ok {
+		return "", errs.NewNotFound("TOKEN_TYPE_NOT_FOUND")
+	}
+	return tokenType, nil
+}
@@ -0,0 +1,68 @@
+// internal/module/generative_token/token_ledger/service/dto.go
+package token_ledger_service
+
+import (
+	"postmatic-api/internal/repository/entity"
+
+	"github.com/google/uuid"
+)
+
+// CreateTokenTransactionInput is input for crediting token from payment
+type CreateTokenTransactionInput struct {
+	TokenType        entity.TokenType
+	ProfileID        uuid.UUID
+	BusinessRootID   int64
+	PaymentHistoryID uuid.UUID
+	Amount           int64
+}
+
+// TokenClawbackResult is the result of pulling back token from a refunded payment
+type TokenClawbackResult struct {
+	// ClawedBack can be lower than requested when token was already spent
+	ClawedBack    int64
+	TransactionID *int64
+}
+
+// DebitTokenInput is input for spending token (type 'out') to generate content
+type DebitTokenInput struct {
+	TokenType              entity.TokenType
+	ProfileID              uuid.UUID
+	BusinessRootID         int64
+	GenerativeImageModelID int64
+	Amount                 int64
+}
+
+// ReserveTokenInput is input for holding token before calling provider
+type ReserveTokenInput struct {
+	TokenType              entity.TokenType
+	ProfileID              uuid.UUID
+	BusinessRootID         int64
+	GenerativeImageModelID int64
+	Amount                 int64
+}
+
+// ReconcileBalancesInput is input for recomputing balance snapshots
+type ReconcileBalancesInput struct {
+	// Fix overwrites drifted snapshots with recomputed values
+	Fix bool
+	// ReleaseExpired releases reservations past expires_at before recomputing
+	ReleaseExpired bool
+}
+
+// SyncMissingTokenInput is input for bulk sync missing token transactions
+type SyncMissingTokenInput struct {
+	PaymentIDs []uuid.UUID
+}
+
+// GetTokenTransactionsFilter is input for filtering token transactions
+type GetTokenTransactionsFilter struct {
+	TokenType      entity.TokenType
+	BusinessRootID int64
+	Type           *string // "in" or "out", nil for all
+	DateStart      *string // YYYY-MM-DD format
+	DateEnd        *string // YYYY-MM-DD format
+	SortBy         string
+	SortDir        string
+	Page           int
+	Limit          int
+}
@@ -1,5 +1,5 @@
-// internal/module/generative_token/image_token/service/filter.go
-package image_token_service
+// internal/module/generative_token/token_ledger/service/filter.go
+package token_ledger_service
 
 // SORT_BY defines allowed sort fields for token transactions
 var SORT_BY = []string{"id", "created_at", "amount"}
@@ -0,0 +1,330 @@
+// internal/module/generative_token/token_ledger/service/ledger.go
+package token_ledger_service
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// tokenReservationTTL is how long a reservation may stay 'reserved' before reconcile releases it
+const tokenReservationTTL = 15 * time.Minute
+
+// ReserveToken holds token from available balance before calling provider
+// Reservation must be followed by CommitReservation (success) or ReleaseReservation (failed)
+func (s *TokenLedgerService) ReserveToken(ctx context.Context, input ReserveTokenInput) (TokenReservationResponse, error) {
+	log := logger.From(ctx)
+
+	if input.Amount <= 0 {
+		return TokenReservationResponse{}, errs.NewBadRequest("INVALID_TOKEN_AMOUNT")
+	}
+
+	var reservation entity.GenerativeTokenReservation
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		_, err := q.ReserveGenerativeTokenBalance(ctx, entity.ReserveGenerativeTokenBalanceParams{
+			Amount:         input.Amount,
+			BusinessRootID: input.BusinessRootID,
+			TokenType:      input.TokenType,
+		})
+		if err == sql.ErrNoRows {
+			return errs.NewBadRequest("INSUFFICIENT_TOKEN")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		reservation, err = q.CreateGenerativeTokenReservation(ctx, entity.CreateGenerativeTokenReservationParams{
+			Amount:                 input.Amount,
+			ProfileID:              input.ProfileID,
+			BusinessRootID:         input.BusinessRootID,
+			TokenType:              input.TokenType,
+			GenerativeImageModelID: sql.NullInt64{Int64: input.GenerativeImageModelID, Valid: input.GenerativeImageModelID != 0},
+			ExpiresAt:              time.Now().Add(tokenReservationTTL),
+		})
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return TokenReservationResponse{}, err
+	}
+
+	log.Info("Token reserved", "businessRootId", input.BusinessRootID, "tokenType", input.TokenType, "reservationId", reservation.ID, "amount", input.Amount)
+	return mapTokenReservationToResponse(reservation), nil
+}
+
+// CommitReservation turns a reservation into token transaction type 'out'
+func (s *TokenLedgerService) CommitReservation(ctx context.Context, reservationID int64) (TokenTransactionResponse, error) {
+	log := logger.From(ctx)
+
+	var trx entity.GenerativeTokenTransaction
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		reservation, err := q.GetGenerativeTokenReservationByIdForUpdate(ctx, reservationID)
+		if err == sql.ErrNoRows {
+			return errs.NewNotFound("TOKEN_RESERVATION_NOT_FOUND")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		if reservation.Status != entity.TokenReservationStatusReserved {
+			return errs.NewBadRequest("TOKEN_RESERVATION_ALREADY_" + strings.ToUpper(string(reservation.Status)))
+		}
+
+		if _, err := q.CommitGenerativeTokenBalance(ctx, entity.CommitGenerativeTokenBalanceParams{
+			Amount:         reservation.Amount,
+			BusinessRootID: reservation.BusinessRootID,
+			TokenType:      reservation.TokenType,
+		}); err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		trx, err = q.CreateGenerativeTokenTransaction(ctx, entity.CreateGenerativeTokenTransactionParams{
+			Type:                   entity.TokenTransactionTypeOut,
+			Amount:                 reservation.Amount,
+			ProfileID:              reservation.ProfileID,
+			BusinessRootID:         reservation.BusinessRootID,
+			TokenType:              reservation.TokenType,
+			GenerativeImageModelID: reservation.GenerativeImageModelID,
+		})
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		if _, err := q.UpdateGenerativeTokenReservationStatus(ctx, entity.UpdateGenerativeTokenReservationStatusParams{
+			Status:                       entity.TokenReservationStatusCommitted,
+			GenerativeTokenTransactionID: sql.NullInt64{Int64: trx.ID, Valid: true},
+			ID:                           reservation.ID,
+		}); err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return TokenTransactionResponse{}, err
+	}
+
+	log.Info("Token reservation committed", "reservationId", reservationID, "transactionId", trx.ID)
+	return mapTokenTransactionToResponse(trx), nil
+}
+
+// ReleaseReservation returns reserved token to available balance (ex: provider failed to generate)
+// Releasing a reservation that is no longer 'reserved' is a no-op
+func (s *TokenLedgerService) ReleaseReservation(ctx context.Context, reservationID int64) error {
+	log := logger.From(ctx)
+
+	released, err := s.releaseReservation(ctx, reservationID)
+	if err != nil {
+		log.Error("Failed to release token reservation", "reservationId", reservationID, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	if !released {
+		log.Info("Token reservation already settled", "reservationId", reservationID)
+		return nil
+	}
+
+	log.Info("Token reservation released", "reservationId", reservationID)
+	return nil
+}
+
+// ClawbackTokenFromPayment writes a compensating 'out' transaction for a refunded payment.
+// Only available token is pulled back (token already spent or reserved stays), so the result may be partial.
+// This method accepts *entity.Queries to be used within a transaction
+func (s *TokenLedgerService) ClawbackTokenFromPayment(ctx context.Context, q *entity.Queries, input CreateTokenTransactionInput) (TokenClawbackResult, error) {
+	log := logger.From(ctx)
+
+	var result TokenClawbackResult
+	if input.Amount <= 0 {
+		return result, nil
+	}
+
+	if err := q.LockGenerativeTokenBalanceByBusinessRootId(ctx, entity.LockGenerativeTokenBalanceByBusinessRootIdParams{
+		BusinessRootID: input.BusinessRootID,
+		TokenType:      input.TokenType,
+	}); err != nil {
+		return result, errs.NewInternalServerError(err)
+	}
+	balance, err := q.GetGenerativeTokenBalanceByBusinessRootId(ctx, entity.GetGenerativeTokenBalanceByBusinessRootIdParams{
+		BusinessRootID: input.BusinessRootID,
+		TokenType:      input.TokenType,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return result, errs.NewInternalServerError(err)
+	}
+
+	available := balance.TotalIn - balance.TotalOut - balance.Reserved
+	amount := min(input.Amount, available)
+	if amount <= 0 {
+		log.Info("No available token to claw back", "paymentHistoryId", input.PaymentHistoryID, "requested", input.Amount)
+		return result, nil
+	}
+
+	if _, err := q.DebitGenerativeTokenBalance(ctx, entity.DebitGenerativeTokenBalanceParams{
+		Amount:         amount,
+		BusinessRootID: input.BusinessRootID,
+		TokenType:      input.TokenType,
+	}); err != nil {
+		return result, errs.NewInternalServerError(err)
+	}
+
+	trx, err := q.CreateGenerativeTokenTransaction(ctx, entity.CreateGenerativeTokenTransactionParams{
+		Type:             entity.TokenTransactionTypeOut,
+		Amount:           amount,
+		ProfileID:        input.ProfileID,
+		BusinessRootID:   input.BusinessRootID,
+		TokenType:        input.TokenType,
+		PaymentHistoryID: uuid.NullUUID{UUID: input.PaymentHistoryID, Valid: true},
+	})
+	if err != nil {
+		return result, errs.NewInternalServerError(err)
+	}
+
+	result.ClawedBack = amount
+	result.TransactionID = &trx.ID
+	log.Info("Token clawed back from refunded payment", "paymentHistoryId", input.PaymentHistoryID, "requested", input.Amount, "clawedBack", amount)
+	return result, nil
+}
+
+// ReconcileBalances recomputes balance snapshots from transactions & active reservations
+// and reports businesses whose snapshot drifted. With input.Fix the snapshot is overwritten.
+func (s *TokenLedgerService) ReconcileBalances(ctx context.Context, input ReconcileBalancesInput) ([]BalanceDriftResponse, error) {
+	log := logger.From(ctx)
+
+	if input.ReleaseExpired {
+		ids, err := s.store.GetExpiredGenerativeTokenReservationIds(ctx)
+		if err != nil {
+			return nil, errs.NewInternalServerError(err)
+		}
+		for _, id := range ids {
+			if _, err := s.releaseReservation(ctx, id); err != nil {
+				log.Error("Failed to release expired token reservation", "reservationId", id, "error", err)
+			}
+		}
+		log.Info("Released expired token reservations", "count", len(ids))
+	}
+
+	drifts, err := s.store.GetGenerativeTokenBalanceDrifts(ctx)
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	result := make([]BalanceDriftResponse, 0, len(drifts))
+	for _, d := range drifts {
+		res := BalanceDriftResponse{
+			BusinessRootID: d.BusinessRootID,
+			TokenType:      string(d.TokenType),
+			Expected: TokenBalanceSnapshot{
+				TotalIn:  d.ExpectedTotalIn,
+				TotalOut: d.ExpectedTotalOut,
+				Reserved: d.ExpectedReserved,
+			},
+			Actual: TokenBalanceSnapshot{
+				TotalIn:  d.ActualTotalIn,
+				TotalOut: d.ActualTotalOut,
+				Reserved: d.ActualReserved,
+			},
+		}
+
+		if input.Fix {
+			if err := s.fixBalance(ctx, d.BusinessRootID, d.TokenType); err != nil {
+				log.Error("Failed to fix token balance", "businessRootId", d.BusinessRootID, "tokenType", d.TokenType, "error", err)
+			} else {
+				res.Fixed = true
+			}
+		}
+
+		result = append(result, res)
+	}
+
+	log.Info("Token balance reconciliation finished", "drift", len(result), "fix", input.Fix)
+	return result, nil
+}
+
+// fixBalance recomputes & overwrites one snapshot while holding the snapshot row lock,
+// so concurrent reserve/commit/credit on the same business & token type wait until it is done
+func (s *TokenLedgerService) fixBalance(ctx context.Context, businessRootID int64, tokenType entity.TokenType) error {
+	return s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		if err := q.LockGenerativeTokenBalanceByBusinessRootId(ctx, entity.LockGenerativeTokenBalanceByBusinessRootIdParams{
+			BusinessRootID: businessRootID,
+			TokenType:      tokenType,
+		}); err != nil {
+			return err
+		}
+		expected, err := q.GetGenerativeTokenExpectedBalanceByBusinessRootId(ctx, entity.GetGenerativeTokenExpectedBalanceByBusinessRootIdParams{
+			BusinessRootID: businessRootID,
+			TokenType:      tokenType,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = q.SetGenerativeTokenBalance(ctx, entity.SetGenerativeTokenBalanceParams{
+			BusinessRootID: businessRootID,
+			TokenType:      tokenType,
+			TotalIn:        expected.TotalIn,
+			TotalOut:       expected.TotalOut,
+			Reserved:       expected.Reserved,
+		})
+		return err
+	})
+}
+
+// releaseReservation returns false when reservation is not 'reserved' anymore
+func (s *TokenLedgerService) releaseReservation(ctx context.Context, reservationID int64) (bool, error) {
+	released := false
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		reservation, err := q.UpdateGenerativeTokenReservationStatus(ctx, entity.UpdateGenerativeTokenReservationStatusParams{
+			Status: entity.TokenReservationStatusReleased,
+			ID:     reservationID,
+		})
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if _, err := q.ReleaseGenerativeTokenBalance(ctx, entity.ReleaseGenerativeTokenBalanceParams{
+			Amount:         reservation.Amount,
+			BusinessRootID: reservation.BusinessRootID,
+			TokenType:      reservation.TokenType,
+		}); err != nil {
+			return err
+		}
+		released = true
+		return nil
+	})
+	return released, err
+}
+
+// mapTokenReservationToResponse maps entity to response
+func mapTokenReservationToResponse(r entity.GenerativeTokenReservation) TokenReservationResponse {
+	var generativeImageModelID *int64
+	if r.GenerativeImageModelID.Valid {
+		generativeImageModelID = &r.GenerativeImageModelID.Int64
+	}
+
+	var transactionID *int64
+	if r.GenerativeTokenTransactionID.Valid {
+		transactionID = &r.GenerativeTokenTransactionID.Int64
+	}
+
+	return TokenReservationResponse{
+		ID:                     r.ID,
+		TokenType:              string(r.TokenType),
+		Status:                 string(r.Status),
+		Amount:                 r.Amount,
+		ProfileID:              r.ProfileID,
+		BusinessRootID:         r.BusinessRootID,
+		GenerativeImageModelID: generativeImageModelID,
+		TransactionID:          transactionID,
+		ExpiresAt:              r.ExpiresAt,
+		CreatedAt:              r.CreatedAt,
+	}
+}
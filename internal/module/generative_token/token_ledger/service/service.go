@@ -0,0 +1,321 @@
+// internal/module/generative_token/token_ledger/service/service.go
+package token_ledger_service
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+// TokenLedgerService handles generative token ledger (balance, transactions, reservations) for every token type
+type TokenLedgerService struct {
+	store entity.Store
+}
+
+// NewService creates a new TokenLedgerService
+func NewService(store entity.Store) *TokenLedgerService {
+	return &TokenLedgerService{
+		store: store,
+	}
+}
+
+// CreditTokenFromPayment creates a token transaction for successful payment
+// This method accepts *entity.Queries to be used within a transaction
+func (s *TokenLedgerService) CreditTokenFromPayment(ctx context.Context, q *entity.Queries, input CreateTokenTransactionInput) error {
+	log := logger.From(ctx)
+
+	// Check if already credited
+	existing, err := q.GetGenerativeTokenTransactionByPaymentHistoryId(ctx, uuid.NullUUID{
+		UUID:  input.PaymentHistoryID,
+		Valid: true,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		log.Error("Failed to check existing token transaction", "paymentHistoryId", input.PaymentHistoryID, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	if existing.ID != 0 {
+		log.Info("Token already credited for payment", "paymentHistoryId", input.PaymentHistoryID)
+		return nil // Already credited, skip
+	}
+
+	// Create token transaction + update balance snapshot
+	if err := creditToken(ctx, q, input); err != nil {
+		log.Error("Failed to create token transaction", "paymentHistoryId", input.PaymentHistoryID, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+
+	log.Info("Token credited successfully", "paymentHistoryId", input.PaymentHistoryID, "tokenType", input.TokenType, "amount", input.Amount)
+	return nil
+}
+
+// DebitToken creates a token transaction type 'out' if available token is sufficient
+// Balance snapshot is decremented atomically (conditional update), never goes negative
+func (s *TokenLedgerService) DebitToken(ctx context.Context, input DebitTokenInput) (TokenTransactionResponse, error) {
+	log := logger.From(ctx)
+
+	if input.Amount <= 0 {
+		return TokenTransactionResponse{}, errs.NewBadRequest("INVALID_TOKEN_AMOUNT")
+	}
+
+	var trx entity.GenerativeTokenTransaction
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		_, err := q.DebitGenerativeTokenBalance(ctx, entity.DebitGenerativeTokenBalanceParams{
+			Amount:         input.Amount,
+			BusinessRootID: input.BusinessRootID,
+			TokenType:      input.TokenType,
+		})
+		if err == sql.ErrNoRows {
+			return errs.NewBadRequest("INSUFFICIENT_TOKEN")
+		}
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+
+		trx, err = q.CreateGenerativeTokenTransaction(ctx, entity.CreateGenerativeTokenTransactionParams{
+			Type:                   entity.TokenTransactionTypeOut,
+			Amount:                 input.Amount,
+			ProfileID:              input.ProfileID,
+			BusinessRootID:         input.BusinessRootID,
+			TokenType:              input.TokenType,
+			GenerativeImageModelID: sql.NullInt64{Int64: input.GenerativeImageModelID, Valid: true},
+		})
+		if err != nil {
+			return errs.NewInternalServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return TokenTransactionResponse{}, err
+	}
+
+	log.Info("Token debited successfully", "businessRootId", input.BusinessRootID, "tokenType", input.TokenType, "transactionId", trx.ID, "amount", input.Amount)
+	return mapTokenTransactionToResponse(trx), nil
+}
+
+// RefundToken reverts a token transaction type 'out' (ex: provider failed to generate)
+func (s *TokenLedgerService) RefundToken(ctx context.Context, transactionID int64) error {
+	log := logger.From(ctx)
+
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		trx, err := q.RefundGenerativeTokenTransaction(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		_, err = q.RevertGenerativeTokenBalanceOut(ctx, entity.RevertGenerativeTokenBalanceOutParams{
+			Amount:         trx.Amount,
+			BusinessRootID: trx.BusinessRootID,
+			TokenType:      trx.TokenType,
+		})
+		return err
+	})
+	if err == sql.ErrNoRows {
+		log.Info("Token transaction already refunded", "transactionId", transactionID)
+		return nil
+	}
+	if err != nil {
+		log.Error("Failed to refund token transaction", "transactionId", transactionID, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+
+	log.Info("Token refunded successfully", "transactionId", transactionID)
+	return nil
+}
+
+// SyncMissingTokenTransactions syncs token transactions for successful payments that are missing
+// This runs in background goroutine, so it uses store directly (not transaction)
+func (s *TokenLedgerService) SyncMissingTokenTransactions(ctx context.Context, paymentIDs []uuid.UUID) {
+	if len(paymentIDs) == 0 {
+		return
+	}
+
+	log := logger.From(ctx)
+	log.Info("Starting sync missing token transactions", "count", len(paymentIDs))
+
+	// Find payments that are success but don't have token transaction
+	missingPayments, err := s.store.GetSuccessPaymentIdsWithoutTokenTransaction(ctx, paymentIDs)
+	if err != nil {
+		log.Error("Failed to get missing payments", "error", err)
+		return
+	}
+
+	if len(missingPayments) == 0 {
+		log.Info("No missing token transactions to sync")
+		return
+	}
+
+	log.Info("Found missing token transactions", "count", len(missingPayments))
+
+	// Create token transactions for each missing payment (transaction + balance per payment)
+	for _, payment := range missingPayments {
+		tokenType, ok := TokenTypeFromProductType(payment.RecordProductType)
+		if !ok {
+			continue
+		}
+		err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+			return creditToken(ctx, q, CreateTokenTransactionInput{
+				TokenType:        tokenType,
+				ProfileID:        payment.ProfileID,
+				BusinessRootID:   payment.BusinessRootID,
+				PaymentHistoryID: payment.ID,
+				Amount:           payment.ProductAmount,
+			})
+		})
+		if err != nil {
+			log.Error("Failed to sync token transaction", "paymentId", payment.ID, "error", err)
+			continue
+		}
+		log.Info("Synced token transaction", "paymentId", payment.ID, "tokenType", tokenType, "amount", payment.ProductAmount)
+	}
+
+	log.Info("Finished sync missing token transactions")
+}
+
+// GetTokenStatus returns token status of one token type for a business (from balance snapshot)
+func (s *TokenLedgerService) GetTokenStatus(ctx context.Context, businessRootID int64, tokenType entity.TokenType) (TokenStatusResponse, error) {
+	response := TokenStatusResponse{TokenType: string(tokenType)}
+
+	balance, err := s.store.GetGenerativeTokenBalanceByBusinessRootId(ctx, entity.GetGenerativeTokenBalanceByBusinessRootIdParams{
+		BusinessRootID: businessRootID,
+		TokenType:      tokenType,
+	})
+	if err != nil && err != sql.ErrNoRows {
+		return response, errs.NewInternalServerError(err)
+	}
+
+	response.TotalToken = balance.TotalIn
+	response.UsedToken = balance.TotalOut
+	response.ReservedToken = balance.Reserved
+	response.AvailableToken = balance.TotalIn - balance.TotalOut - balance.Reserved
+	response.IsExhausted = response.AvailableToken <= 0
+
+	return response, nil
+}
+
+// GetTokenTransactions returns paginated token transactions for a business
+func (s *TokenLedgerService) GetTokenTransactions(ctx context.Context, filter GetTokenTransactionsFilter) ([]TokenTransactionResponse, *pagination.Pagination, error) {
+	// Convert type string to NullTokenTransactionType
+	var typeFilter entity.NullTokenTransactionType
+	if filter.Type != nil && (*filter.Type == "in" || *filter.Type == "out") {
+		typeFilter = entity.NullTokenTransactionType{
+			TokenTransactionType: entity.TokenTransactionType(*filter.Type),
+			Valid:                true,
+		}
+	}
+
+	// Convert date strings to sql.NullTime
+	dateStart := parseDateToNullTime(filter.DateStart)
+	dateEnd := parseDateToNullTime(filter.DateEnd)
+
+	// Count total
+	count, err := s.store.CountAllTokenTransactionsByBusiness(ctx, entity.CountAllTokenTransactionsByBusinessParams{
+		BusinessRootID: filter.BusinessRootID,
+		TokenType:      filter.TokenType,
+		Type:           typeFilter,
+		DateStart:      dateStart,
+		DateEnd:        dateEnd,
+	})
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	pag := pagination.NewPagination(&pagination.PaginationParams{
+		Total: int(count),
+		Page:  filter.Page,
+		Limit: filter.Limit,
+	})
+
+	// Calculate offset
+	offset := (filter.Page - 1) * filter.Limit
+	if offset < 0 {
+		offset = 0
+	}
+
+	// Get data
+	data, err := s.store.GetAllTokenTransactionsByBusiness(ctx, entity.GetAllTokenTransactionsByBusinessParams{
+		BusinessRootID: filter.BusinessRootID,
+		TokenType:      filter.TokenType,
+		Type:           typeFilter,
+		DateStart:      dateStart,
+		DateEnd:        dateEnd,
+		SortBy:         filter.SortBy,
+		SortDir:        filter.SortDir,
+		PageLimit:      int32(filter.Limit),
+		PageOffset:     int32(offset),
+	})
+	if err != nil {
+		return nil, nil, errs.NewInternalServerError(err)
+	}
+
+	responses := make([]TokenTransactionResponse, len(data))
+	for i, d := range data {
+		responses[i] = mapTokenTransactionToResponse(d)
+	}
+
+	return responses, &pag, nil
+}
+
+// creditToken creates token transaction type 'in' and adds it to balance snapshot
+// Must be called within a transaction
+func creditToken(ctx context.Context, q *entity.Queries, input CreateTokenTransactionInput) error {
+	_, err := q.CreateGenerativeTokenTransaction(ctx, entity.CreateGenerativeTokenTransactionParams{
+		Type:             entity.TokenTransactionTypeIn,
+		Amount:           input.Amount,
+		ProfileID:        input.ProfileID,
+		BusinessRootID:   input.BusinessRootID,
+		TokenType:        input.TokenType,
+		PaymentHistoryID: uuid.NullUUID{UUID: input.PaymentHistoryID, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = q.CreditGenerativeTokenBalance(ctx, entity.CreditGenerativeTokenBalanceParams{
+		BusinessRootID: input.BusinessRootID,
+		TokenType:      input.TokenType,
+		Amount:         input.Amount,
+	})
+	return err
+}
+
+// mapTokenTransactionToResponse maps entity to response
+func mapTokenTransactionToResponse(t entity.GenerativeTokenTransaction) TokenTransactionResponse {
+	var paymentHistoryID *uuid.UUID
+	if t.PaymentHistoryID.Valid {
+		paymentHistoryID = &t.PaymentHistoryID.UUID
+	}
+
+	var generativeImageModelID *int64
+	if t.GenerativeImageModelID.Valid {
+		generativeImageModelID = &t.GenerativeImageModelID.Int64
+	}
+
+	return TokenTransactionResponse{
+		ID:                     t.ID,
+		TokenType:              string(t.TokenType),
+		Type:                   string(t.Type),
+		Amount:                 t.Amount,
+		ProfileID:              t.ProfileID,
+		BusinessRootID:         t.BusinessRootID,
+		PaymentHistoryID:       paymentHistoryID,
+		GenerativeImageModelID: generativeImageModelID,
+		CreatedAt:              t.CreatedAt,
+	}
+}
+
+// parseDateToNullTime converts a date string (YYYY-MM-DD) to sql.NullTime
+func parseDateToNullTime(dateStr *string) sql.NullTime {
+	if dateStr == nil || *dateStr == "" {
+		return sql.NullTime{Valid: false}
+	}
+	t, err := time.Parse("2006-01-02", *dateStr)
+	if err != nil {
+		return sql.NullTime{Valid: false}
+	}
+	return sql.NullTime{Time: t, Valid: true}
+}
@@ -0,0 +1,50 @@
+// internal/module/generative_token/token_ledger/service/token_type.go
+package token_ledger_service
+
+import (
+	"strings"
+
+	"postmatic-api/internal/repository/entity"
+)
+
+// TokenTypes are all token types that can be bought and spent (one balance per business per type)
+var TokenTypes = []entity.TokenType{
+	entity.TokenTypeImageToken,
+	entity.TokenTypeVideoToken,
+	entity.TokenTypeLivestreamToken,
+}
+
+// TokenTypeSlug returns path segment of a token type (image_token -> image-token)
+func TokenTypeSlug(tokenType entity.TokenType) string {
+	return strings.ReplaceAll(string(tokenType), "_", "-")
+}
+
+// TokenTypeFromSlug parses path segment {tokenType} (image-token -> image_token)
+func TokenTypeFromSlug(slug string) (entity.TokenType, bool) {
+	for _, t := range TokenTypes {
+		if TokenTypeSlug(t) == slug {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// TokenTypeFromProductType returns token type credited by a payment product, false if product is not a token
+func TokenTypeFromProductType(productType entity.PaymentProductType) (entity.TokenType, bool) {
+	for _, t := range TokenTypes {
+		if string(t) == string(productType) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+// TokenTypeRoutePattern is chi route pattern for {tokenType} that only matches known token types
+// (ex: "/{tokenType:image-token|video-token|livestream-token}") so it does not shadow sibling routes
+func TokenTypeRoutePattern() string {
+	slugs := make([]string, len(TokenTypes))
+	for i, t := range TokenTypes {
+		slugs[i] = TokenTypeSlug(t)
+	}
+	return "/{tokenType:" + strings.Join(slugs, "|") + "}"
+}
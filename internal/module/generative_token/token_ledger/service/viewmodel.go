@@ -0,0 +1,61 @@
+// internal/module/generative_token/token_ledger/service/viewmodel.go
+package token_ledger_service
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TokenStatusResponse is response for GET /status endpoint
+type TokenStatusResponse struct {
+	TokenType      string `json:"tokenType"`
+	AvailableToken int64  `json:"availableToken"`
+	UsedToken      int64  `json:"usedToken"`
+	ReservedToken  int64  `json:"reservedToken"`
+	TotalToken     int64  `json:"totalToken"`
+	IsExhausted    bool   `json:"isExhausted"`
+}
+
+// TokenTransactionResponse is response for token transaction item
+type TokenTransactionResponse struct {
+	ID                     int64      `json:"id"`
+	TokenType              string     `json:"tokenType"`
+	Type                   string     `json:"type"`
+	Amount                 int64      `json:"amount"`
+	ProfileID              uuid.UUID  `json:"profileId"`
+	BusinessRootID         int64      `json:"businessRootId"`
+	PaymentHistoryID       *uuid.UUID `json:"paymentHistoryId"`       // null if type is 'out'
+	GenerativeImageModelID *int64     `json:"generativeImageModelId"` // null if type is 'in'
+	CreatedAt              time.Time  `json:"createdAt"`
+}
+
+// TokenReservationResponse is response for reserved token (before generate)
+type TokenReservationResponse struct {
+	ID                     int64     `json:"id"`
+	TokenType              string    `json:"tokenType"`
+	Status                 string    `json:"status"`
+	Amount                 int64     `json:"amount"`
+	ProfileID              uuid.UUID `json:"profileId"`
+	BusinessRootID         int64     `json:"businessRootId"`
+	GenerativeImageModelID *int64    `json:"generativeImageModelId"`
+	TransactionID          *int64    `json:"transactionId"` // filled after commit
+	ExpiresAt              time.Time `json:"expiresAt"`
+	CreatedAt              time.Time `json:"createdAt"`
+}
+
+// TokenBalanceSnapshot is token balance (in, out, reserved) of a business
+type TokenBalanceSnapshot struct {
+	TotalIn  int64 `json:"totalIn"`
+	TotalOut int64 `json:"totalOut"`
+	Reserved int64 `json:"reserved"`
+}
+
+// BalanceDriftResponse is result of reconciliation for one business
+type BalanceDriftResponse struct {
+	BusinessRootID int64                `json:"businessRootId"`
+	TokenType      string               `json:"tokenType"`
+	Expected       TokenBalanceSnapshot `json:"expected"` // recomputed from transactions & active reservations
+	Actual         TokenBalanceSnapshot `json:"actual"`   // stored snapshot
+	Fixed          bool                 `json:"fixed"`
+}
@@ -21,4 +21,5 @@ type GenerateImageInput struct {
 	// Optional parameters
 	NumberOfImages *int    `json:"numberOfImages"` // 1-4
 	AspectRatio    *string `json:"aspectRatio"`    // e.g., "1:1", "16:9", "9:16"
+	ImageSize      *string `json:"imageSize"`      // e.g., "1K", "2K" (only for supported models)
 }
@@ -3,6 +3,7 @@ package google_genai
 
 import (
 	"context"
+	"encoding/base64"
 
 	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/logger"
@@ -106,6 +107,9 @@ func (s *googleGenAIService) GenerateImage(ctx context.Context, input GenerateIm
 	if input.AspectRatio != nil {
 		config.AspectRatio = *input.AspectRatio
 	}
+	if input.ImageSize != nil {
+		config.ImageSize = *input.ImageSize
+	}
 
 	// Generate images
 	result, err := s.client.Models.GenerateImages(ctx, input.Model, input.Prompt, config)
@@ -119,7 +123,7 @@ func (s *googleGenAIService) GenerateImage(ctx context.Context, input GenerateIm
 	for _, img := range result.GeneratedImages {
 		if img.Image != nil {
 			images = append(images, GeneratedImage{
-				Base64Data: string(img.Image.ImageBytes),
+				Base64Data: base64.StdEncoding.EncodeToString(img.Image.ImageBytes),
 				MimeType:   img.Image.MIMEType,
 			})
 		}
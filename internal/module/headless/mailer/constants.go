@@ -9,17 +9,27 @@ const (
 	ResetPasswordTemplate EmailTemplate = "reset_password.html"
 	VerificationTemplate  EmailTemplate = "verification.html"
 	WelcomeTemplate       EmailTemplate = "welcome.html"
+	AccountLockedTemplate EmailTemplate = "account_locked.html"
+
+	EmailChangeConfirmTemplate EmailTemplate = "email_change_confirm.html"
+	EmailChangeNoticeTemplate  EmailTemplate = "email_change_notice.html"
 
 	// Member
-	MemberInvitationTemplate      EmailTemplate = "member_invitation.html"
-	MemberAnnounceKickTemplate    EmailTemplate = "member_announce_kick.html"
-	MemberAnnounceRoleTemplate    EmailTemplate = "member_announce_role.html"
-	MemberWelcomeBusinessTemplate EmailTemplate = "member_welcome_business.html"
+	MemberInvitationTemplate        EmailTemplate = "member_invitation.html"
+	MemberAnnounceKickTemplate      EmailTemplate = "member_announce_kick.html"
+	MemberAnnounceRoleTemplate      EmailTemplate = "member_announce_role.html"
+	MemberWelcomeBusinessTemplate   EmailTemplate = "member_welcome_business.html"
+	MemberOwnershipTransferTemplate EmailTemplate = "member_ownership_transfer.html"
 
 	// Payment
 	PaymentCheckoutTemplate EmailTemplate = "payment_checkout.html"
 	PaymentSuccessTemplate  EmailTemplate = "payment_success.html"
 	PaymentCanceledTemplate EmailTemplate = "payment_canceled.html"
+	PaymentRefundedTemplate EmailTemplate = "payment_refunded.html"
+
+	// Creator
+	CreatorSaleTemplate       EmailTemplate = "creator_sale.html"
+	CreatorModerationTemplate EmailTemplate = "creator_moderation.html"
 
 	// Layout
 	LayoutTemplate EmailTemplate = "layout.html"
@@ -28,9 +38,11 @@ const (
 // Validasi Helper
 func (e EmailTemplate) IsValid() bool {
 	switch e {
-	case MemberInvitationTemplate, MemberAnnounceKickTemplate, MemberAnnounceRoleTemplate, MemberWelcomeBusinessTemplate,
-		ResetPasswordTemplate, VerificationTemplate, WelcomeTemplate,
-		PaymentCheckoutTemplate, PaymentSuccessTemplate, PaymentCanceledTemplate:
+	case MemberInvitationTemplate, MemberAnnounceKickTemplate, MemberAnnounceRoleTemplate, MemberWelcomeBusinessTemplate, MemberOwnershipTransferTemplate,
+		ResetPasswordTemplate, VerificationTemplate, WelcomeTemplate, AccountLockedTemplate,
+		EmailChangeConfirmTemplate, EmailChangeNoticeTemplate,
+		PaymentCheckoutTemplate, PaymentSuccessTemplate, PaymentCanceledTemplate, PaymentRefundedTemplate,
+		CreatorSaleTemplate, CreatorModerationTemplate:
 		return true
 	}
 	return false
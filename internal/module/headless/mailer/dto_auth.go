@@ -1,6 +1,8 @@
 // internal/module/headless/mailer/dto_auth.go
 package mailer
 
+import "time"
+
 // VERIFICATION EMAIL
 type verificationInput struct {
 	Name       string `json:"Name"`
@@ -26,3 +28,70 @@ type WelcomeInputDTO struct {
 	Email string `json:"Email"`
 	From  string `json:"From"`
 }
+
+// RESET PASSWORD EMAIL
+type resetPasswordInput struct {
+	Name     string `json:"Name"`
+	ResetUrl string `json:"ResetUrl"`
+}
+
+type ResetPasswordInputDTO struct {
+	Name  string `json:"Name"`
+	To    string `json:"To" validate:"required,email"`
+	Token string `json:"Token"`
+	From  string `json:"From"`
+}
+
+// ACCOUNT LOCKED EMAIL
+// Sent when credential login is temporarily locked after too many failed attempts
+type accountLockedInput struct {
+	Name          string `json:"Name"`
+	ClientIP      string `json:"ClientIP"`
+	Device        string `json:"Device"`
+	LockedMinutes int64  `json:"LockedMinutes"`
+	LockedUntil   string `json:"LockedUntil"` // formatted datetime
+	AuthUrl       string `json:"AuthUrl"`
+}
+
+type AccountLockedInputDTO struct {
+	Name string `json:"Name"`
+	To   string `json:"To" validate:"required,email"`
+
+	// percobaan terakhir yang memicu lockout
+	ClientIP string `json:"ClientIP"`
+	Device   string `json:"Device"`
+
+	LockedMinutes int64     `json:"LockedMinutes"`
+	LockedUntil   time.Time `json:"LockedUntil"`
+}
+
+// EMAIL CHANGE
+// Confirm dikirim ke email baru, notice + link revert dikirim ke email lama
+type emailChangeConfirmInput struct {
+	Name       string `json:"Name"`
+	NewEmail   string `json:"NewEmail"`
+	ConfirmUrl string `json:"ConfirmUrl"`
+}
+
+type EmailChangeConfirmInputDTO struct {
+	Name     string `json:"Name"`
+	To       string `json:"To" validate:"required,email"` // email baru
+	NewEmail string `json:"NewEmail"`
+	Token    string `json:"Token"`
+	From     string `json:"From"`
+}
+
+type emailChangeNoticeInput struct {
+	Name      string `json:"Name"`
+	OldEmail  string `json:"OldEmail"`
+	NewEmail  string `json:"NewEmail"`
+	RevertUrl string `json:"RevertUrl"`
+}
+
+type EmailChangeNoticeInputDTO struct {
+	Name     string `json:"Name"`
+	To       string `json:"To" validate:"required,email"` // email lama
+	NewEmail string `json:"NewEmail"`
+	Token    string `json:"Token"`
+	From     string `json:"From"`
+}
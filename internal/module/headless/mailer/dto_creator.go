@@ -0,0 +1,53 @@
+// internal/module/headless/mailer/dto_creator.go
+package mailer
+
+import "time"
+
+// CREATOR SALE EMAIL
+// Sent to creator when their template (creator image) is purchased
+type creatorSaleInput struct {
+	Name                 string `json:"Name"`
+	CreatorImageName     string `json:"CreatorImageName"`
+	GrossAmount          string `json:"GrossAmount"`
+	CommissionPercentage int32  `json:"CommissionPercentage"`
+	CommissionAmount     string `json:"CommissionAmount"`
+	NetAmount            string `json:"NetAmount"`
+	SoldAt               string `json:"SoldAt"` // formatted datetime
+}
+
+type CreatorSaleInputDTO struct {
+	// recipient (creator)
+	Email string `json:"Email"`
+	Name  string `json:"Name"`
+
+	// sale info
+	CreatorImageName     string    `json:"CreatorImageName"`
+	GrossAmount          int64     `json:"GrossAmount"`
+	CommissionPercentage int32     `json:"CommissionPercentage"`
+	CommissionAmount     int64     `json:"CommissionAmount"`
+	NetAmount            int64     `json:"NetAmount"`
+	Currency             string    `json:"Currency"`
+	SoldAt               time.Time `json:"SoldAt"`
+}
+
+// CREATOR MODERATION EMAIL
+// Sent to creator when admin approves, bans or unbans their template (creator image)
+type creatorModerationInput struct {
+	Name             string `json:"Name"`
+	CreatorImageName string `json:"CreatorImageName"`
+	Action           string `json:"Action"` // approve, ban, unban
+	Reason           string `json:"Reason"`
+	ModeratedAt      string `json:"ModeratedAt"` // formatted datetime
+}
+
+type CreatorModerationInputDTO struct {
+	// recipient (creator)
+	Email string `json:"Email"`
+	Name  string `json:"Name"`
+
+	// moderation info
+	CreatorImageName string    `json:"CreatorImageName"`
+	Action           string    `json:"Action"` // approve, ban, unban
+	Reason           string    `json:"Reason"`
+	ModeratedAt      time.Time `json:"ModeratedAt"`
+}
@@ -9,6 +9,14 @@ type MemberInvitationInputDTO struct {
 	BusinessName string `json:"BusinessName"`
 }
 
+// MEMBER OWNERSHIP TRANSFER EMAIL (ke member yang dinominasikan)
+type MemberOwnershipTransferInputDTO struct {
+	Email        string `json:"Email"`
+	ConfirmUrl   string `json:"ConfirmUrl"`
+	BusinessName string `json:"BusinessName"`
+	OwnerName    string `json:"OwnerName"`
+}
+
 // MEMBER ANNOUNCE ROLE EMAIL
 type MemberAnnounceRoleInputDTO struct {
 	Email        string `json:"Email"`
@@ -100,3 +100,35 @@ type PaymentCanceledInputDTO struct {
 	PaymentMethod string    `json:"PaymentMethod"`
 	CanceledAt    time.Time `json:"CanceledAt"`
 }
+
+// Sent when payment is refunded (full or partial) by admin or from Midtrans dashboard
+type paymentRefundedInput struct {
+	Name          string `json:"Name"`
+	OrderID       string `json:"OrderID"`
+	ProductName   string `json:"ProductName"`
+	TotalAmount   string `json:"TotalAmount"`
+	RefundAmount  string `json:"RefundAmount"`
+	TokenAmount   int64  `json:"TokenAmount"`
+	PaymentMethod string `json:"PaymentMethod"`
+	Reason        string `json:"Reason"`
+	RefundedAt    string `json:"RefundedAt"` // formatted datetime
+}
+
+type PaymentRefundedInputDTO struct {
+	// recipient
+	Email string `json:"Email"`
+	Name  string `json:"Name"`
+
+	// order info
+	OrderID       string `json:"OrderID"`
+	ProductName   string `json:"ProductName"`
+	TotalAmount   int64  `json:"TotalAmount"`
+	Currency      string `json:"Currency"`
+	PaymentMethod string `json:"PaymentMethod"`
+
+	// refund info
+	RefundAmount int64     `json:"RefundAmount"`
+	TokenAmount  int64     `json:"TokenAmount"` // token yang ditarik kembali
+	Reason       string    `json:"Reason"`
+	RefundedAt   time.Time `json:"RefundedAt"`
+}
@@ -58,6 +58,107 @@ func (s *MailerService) SendVerificationEmail(ctx context.Context, input Verific
 	return nil
 }
 
+func (s *MailerService) SendResetPasswordEmail(ctx context.Context, input ResetPasswordInputDTO) error {
+	u, err := url.Parse(s.cfg.AUTH_URL + s.cfg.RESET_PASSWORD_ROUTE + "/" + input.Token)
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	q := u.Query()
+	q.Set("from", input.From)
+	u.RawQuery = q.Encode()
+
+	err = s.sendEmail(ctx, SendEmailInput{
+		To:           input.To,
+		Subject:      "Reset Password Akun",
+		TemplateName: ResetPasswordTemplate,
+		Data: resetPasswordInput{
+			Name:     input.Name,
+			ResetUrl: u.String(),
+		},
+	})
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (s *MailerService) SendAccountLockedEmail(ctx context.Context, input AccountLockedInputDTO) error {
+	logger.From(ctx).Info("SendAccountLockedEmail", "email", input.To, "clientIP", input.ClientIP)
+
+	err := s.sendEmail(ctx, SendEmailInput{
+		To:           input.To,
+		Subject:      "Akun Dikunci Sementara",
+		TemplateName: AccountLockedTemplate,
+		Data: accountLockedInput{
+			Name:          input.Name,
+			ClientIP:      input.ClientIP,
+			Device:        input.Device,
+			LockedMinutes: input.LockedMinutes,
+			LockedUntil:   input.LockedUntil.Format("02 Jan 2006, 15:04 WIB"),
+			AuthUrl:       s.cfg.AUTH_URL,
+		},
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to send account locked email", "email", input.To, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (s *MailerService) SendEmailChangeConfirmEmail(ctx context.Context, input EmailChangeConfirmInputDTO) error {
+	u, err := url.Parse(s.cfg.AUTH_URL + s.cfg.EMAIL_CHANGE_ROUTE + "/confirm/" + input.Token)
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	q := u.Query()
+	q.Set("from", input.From)
+	u.RawQuery = q.Encode()
+
+	err = s.sendEmail(ctx, SendEmailInput{
+		To:           input.To,
+		Subject:      "Konfirmasi Perubahan Email",
+		TemplateName: EmailChangeConfirmTemplate,
+		Data: emailChangeConfirmInput{
+			Name:       input.Name,
+			NewEmail:   input.NewEmail,
+			ConfirmUrl: u.String(),
+		},
+	})
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (s *MailerService) SendEmailChangeNoticeEmail(ctx context.Context, input EmailChangeNoticeInputDTO) error {
+	u, err := url.Parse(s.cfg.AUTH_URL + s.cfg.EMAIL_CHANGE_ROUTE + "/revert/" + input.Token)
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+
+	q := u.Query()
+	q.Set("from", input.From)
+	u.RawQuery = q.Encode()
+
+	err = s.sendEmail(ctx, SendEmailInput{
+		To:           input.To,
+		Subject:      "Permintaan Perubahan Email Akun",
+		TemplateName: EmailChangeNoticeTemplate,
+		Data: emailChangeNoticeInput{
+			Name:      input.Name,
+			OldEmail:  input.To,
+			NewEmail:  input.NewEmail,
+			RevertUrl: u.String(),
+		},
+	})
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
 func (s *MailerService) SendInvitationEmail(ctx context.Context, input MemberInvitationInputDTO) error {
 	logger.From(ctx).Info("SendInvitationEmail", "input", input)
 	err := s.sendEmail(ctx, SendEmailInput{
@@ -72,6 +173,20 @@ func (s *MailerService) SendInvitationEmail(ctx context.Context, input MemberInv
 	return nil
 }
 
+func (s *MailerService) SendOwnershipTransferEmail(ctx context.Context, input MemberOwnershipTransferInputDTO) error {
+	logger.From(ctx).Info("SendOwnershipTransferEmail", "email", input.Email, "businessName", input.BusinessName)
+	err := s.sendEmail(ctx, SendEmailInput{
+		To:           input.Email,
+		Subject:      "Nominasi Owner Baru",
+		TemplateName: MemberOwnershipTransferTemplate,
+		Data:         input,
+	})
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
 func (s *MailerService) SendAnnounceRoleEmail(ctx context.Context, input MemberAnnounceRoleInputDTO) error {
 	logger.From(ctx).Info("SendAnnounceRoleEmail", "input", input)
 	err := s.sendEmail(ctx, SendEmailInput{
@@ -213,6 +328,112 @@ func (s *MailerService) SendPaymentCanceledEmail(ctx context.Context, input Paym
 	return nil
 }
 
+func (s *MailerService) SendPaymentRefundedEmail(ctx context.Context, input PaymentRefundedInputDTO) error {
+	logger.From(ctx).Info("SendPaymentRefundedEmail", "orderID", input.OrderID, "email", input.Email)
+
+	// Format amount with currency
+	formatCurrency := func(amount int64, currency string) string {
+		if currency == "IDR" {
+			return "Rp " + formatNumber(amount)
+		}
+		return currency + " " + formatNumber(amount)
+	}
+
+	templateData := paymentRefundedInput{
+		Name:          input.Name,
+		OrderID:       input.OrderID,
+		ProductName:   input.ProductName,
+		TotalAmount:   formatCurrency(input.TotalAmount, input.Currency),
+		RefundAmount:  formatCurrency(input.RefundAmount, input.Currency),
+		TokenAmount:   input.TokenAmount,
+		PaymentMethod: input.PaymentMethod,
+		Reason:        input.Reason,
+		RefundedAt:    input.RefundedAt.Format("02 Jan 2006, 15:04 WIB"),
+	}
+
+	err := s.sendEmail(ctx, SendEmailInput{
+		To:           input.Email,
+		Subject:      "Pembayaran Di-refund #" + input.OrderID,
+		TemplateName: PaymentRefundedTemplate,
+		Data:         templateData,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to send refunded email", "orderID", input.OrderID, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (s *MailerService) SendCreatorSaleEmail(ctx context.Context, input CreatorSaleInputDTO) error {
+	logger.From(ctx).Info("SendCreatorSaleEmail", "creatorImageName", input.CreatorImageName, "email", input.Email)
+
+	// Format amount with currency
+	formatCurrency := func(amount int64, currency string) string {
+		if currency == "IDR" {
+			return "Rp " + formatNumber(amount)
+		}
+		return currency + " " + formatNumber(amount)
+	}
+
+	templateData := creatorSaleInput{
+		Name:                 input.Name,
+		CreatorImageName:     input.CreatorImageName,
+		GrossAmount:          formatCurrency(input.GrossAmount, input.Currency),
+		CommissionPercentage: input.CommissionPercentage,
+		CommissionAmount:     formatCurrency(input.CommissionAmount, input.Currency),
+		NetAmount:            formatCurrency(input.NetAmount, input.Currency),
+		SoldAt:               input.SoldAt.Format("02 Jan 2006, 15:04 WIB"),
+	}
+
+	err := s.sendEmail(ctx, SendEmailInput{
+		To:           input.Email,
+		Subject:      "Template Terjual: " + input.CreatorImageName,
+		TemplateName: CreatorSaleTemplate,
+		Data:         templateData,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to send creator sale email", "creatorImageName", input.CreatorImageName, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+func (s *MailerService) SendCreatorModerationEmail(ctx context.Context, input CreatorModerationInputDTO) error {
+	logger.From(ctx).Info("SendCreatorModerationEmail", "creatorImageName", input.CreatorImageName, "action", input.Action, "email", input.Email)
+
+	var subject string
+	switch input.Action {
+	case "approve":
+		subject = "Template Disetujui: " + input.CreatorImageName
+	case "ban":
+		subject = "Template Diblokir: " + input.CreatorImageName
+	case "unban":
+		subject = "Blokir Template Dibuka: " + input.CreatorImageName
+	default:
+		return errs.NewBadRequest("INVALID_MODERATION_ACTION")
+	}
+
+	templateData := creatorModerationInput{
+		Name:             input.Name,
+		CreatorImageName: input.CreatorImageName,
+		Action:           input.Action,
+		Reason:           input.Reason,
+		ModeratedAt:      input.ModeratedAt.Format("02 Jan 2006, 15:04 WIB"),
+	}
+
+	err := s.sendEmail(ctx, SendEmailInput{
+		To:           input.Email,
+		Subject:      subject,
+		TemplateName: CreatorModerationTemplate,
+		Data:         templateData,
+	})
+	if err != nil {
+		logger.From(ctx).Error("Failed to send creator moderation email", "creatorImageName", input.CreatorImageName, "error", err)
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
 // Helper function to format number with thousand separator
 func formatNumber(n int64) string {
 	if n == 0 {
@@ -30,15 +30,24 @@ type Mailer interface {
 	// AUTH / WELCOME
 	SendWelcomeEmail(ctx context.Context, input WelcomeInputDTO) error
 	SendVerificationEmail(ctx context.Context, input VerificationInputDTO) error
+	SendResetPasswordEmail(ctx context.Context, input ResetPasswordInputDTO) error
+	SendAccountLockedEmail(ctx context.Context, input AccountLockedInputDTO) error
+	SendEmailChangeConfirmEmail(ctx context.Context, input EmailChangeConfirmInputDTO) error
+	SendEmailChangeNoticeEmail(ctx context.Context, input EmailChangeNoticeInputDTO) error
 	// MEMBER
 	SendInvitationEmail(ctx context.Context, input MemberInvitationInputDTO) error
 	SendAnnounceRoleEmail(ctx context.Context, input MemberAnnounceRoleInputDTO) error
 	SendAnnounceKickEmail(ctx context.Context, input MemberAnnounceKickInputDTO) error
 	SendWelcomeBusinessEmail(ctx context.Context, input MemberWelcomeBusinessInputDTO) error
+	SendOwnershipTransferEmail(ctx context.Context, input MemberOwnershipTransferInputDTO) error
 	// PAYMENT
 	SendPaymentCheckoutEmail(ctx context.Context, input PaymentCheckoutInputDTO) error
 	SendPaymentSuccessEmail(ctx context.Context, input PaymentSuccessInputDTO) error
 	SendPaymentCanceledEmail(ctx context.Context, input PaymentCanceledInputDTO) error
+	SendPaymentRefundedEmail(ctx context.Context, input PaymentRefundedInputDTO) error
+	// CREATOR
+	SendCreatorSaleEmail(ctx context.Context, input CreatorSaleInputDTO) error
+	SendCreatorModerationEmail(ctx context.Context, input CreatorModerationInputDTO) error
 }
 
 func NewService(cfg *config.Config) Mailer {
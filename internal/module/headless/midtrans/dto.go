@@ -37,6 +37,14 @@ type ChargeBankTransferInput struct {
 	Items           []ItemDetail    `json:"items"`
 }
 
+// RefundInput is the input DTO for refunding a transaction
+type RefundInput struct {
+	// RefundKey is unique per refund (idempotency key di sisi Midtrans)
+	RefundKey string `json:"refundKey" validate:"required"`
+	Amount    int64  `json:"amount" validate:"required,min=1"`
+	Reason    string `json:"reason"`
+}
+
 // ================== OUTPUT DTOs ==================
 
 // ChargeResponse is the output DTO for charge operations
@@ -85,3 +93,15 @@ type TransactionStatusResponse struct {
 	StatusCode        string `json:"statusCode"`
 	StatusMessage     string `json:"statusMessage"`
 }
+
+// RefundResponse is the output DTO for refund operations
+type RefundResponse struct {
+	TransactionID     string `json:"transactionId"`
+	OrderID           string `json:"orderId"`
+	GrossAmount       string `json:"grossAmount"`
+	TransactionStatus string `json:"transactionStatus"`
+	StatusCode        string `json:"statusCode"`
+	StatusMessage     string `json:"statusMessage"`
+	RefundKey         string `json:"refundKey"`
+	RefundAmount      string `json:"refundAmount"`
+}
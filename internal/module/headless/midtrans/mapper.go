@@ -124,3 +124,21 @@ func mapExpireResponse(res *coreapi.ExpireResponse) *TransactionStatusResponse {
 		StatusMessage:     res.StatusMessage,
 	}
 }
+
+// mapRefundResponse maps SDK RefundResponse to our DTO
+func mapRefundResponse(res *coreapi.RefundResponse) *RefundResponse {
+	if res == nil {
+		return nil
+	}
+
+	return &RefundResponse{
+		TransactionID:     res.TransactionID,
+		OrderID:           res.OrderID,
+		GrossAmount:       res.GrossAmount,
+		TransactionStatus: res.TransactionStatus,
+		StatusCode:        res.StatusCode,
+		StatusMessage:     res.StatusMessage,
+		RefundKey:         res.RefundKey,
+		RefundAmount:      res.RefundAmount,
+	}
+}
@@ -21,6 +21,7 @@ type Service interface {
 	CheckStatus(ctx context.Context, orderID string) (*TransactionStatusResponse, error)
 	CancelTransaction(ctx context.Context, orderID string) (*TransactionStatusResponse, error)
 	ExpireTransaction(ctx context.Context, orderID string) (*TransactionStatusResponse, error)
+	RefundTransaction(ctx context.Context, orderID string, req RefundInput) (*RefundResponse, error)
 
 	// Security -> implementation in helper.go
 	VerifySignature(orderID, statusCode, grossAmount, signatureKey string) bool
@@ -82,3 +83,22 @@ func (s *midtransService) ExpireTransaction(ctx context.Context, orderID string)
 	log.Info("Transaction expired", "orderID", orderID, "status", res.TransactionStatus)
 	return mapExpireResponse(res), nil
 }
+
+// RefundTransaction refunds a settled transaction (full or partial) by orderID
+func (s *midtransService) RefundTransaction(ctx context.Context, orderID string, req RefundInput) (*RefundResponse, error) {
+	log := logger.From(ctx)
+	log.Info("Refunding transaction", "orderID", orderID, "refundKey", req.RefundKey, "amount", req.Amount)
+
+	res, err := s.client.RefundTransaction(orderID, &coreapi.RefundReq{
+		RefundKey: req.RefundKey,
+		Amount:    req.Amount,
+		Reason:    req.Reason,
+	})
+	if err != nil {
+		log.Error("Failed to refund transaction", "orderID", orderID, "error", err)
+		return nil, errs.NewBadRequest("MIDTRANS_REFUND_TRANSACTION_FAILED")
+	}
+
+	log.Info("Transaction refunded", "orderID", orderID, "status", res.TransactionStatus, "refundAmount", res.RefundAmount)
+	return mapRefundResponse(res), nil
+}
@@ -138,6 +138,7 @@ func (s *openaiService) GenerateImage(ctx context.Context, input GenerateImageIn
 	for i, img := range result.Data {
 		images[i] = GeneratedImage{
 			URL:           img.URL,
+			Base64Data:    img.B64JSON,
 			RevisedPrompt: img.RevisedPrompt,
 		}
 	}
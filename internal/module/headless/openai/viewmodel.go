@@ -22,5 +22,6 @@ type GenerateImageResponse struct {
 // GeneratedImage represents a single generated image
 type GeneratedImage struct {
 	URL           string `json:"url"`
+	Base64Data    string `json:"base64Data"` // gpt-image-* return b64_json instead of url
 	RevisedPrompt string `json:"revisedPrompt"`
 }
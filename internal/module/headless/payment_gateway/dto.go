@@ -0,0 +1,91 @@
+// internal/module/headless/payment_gateway/dto.go
+package payment_gateway
+
+import "postmatic-api/internal/repository/entity"
+
+// payment_status internal hasil normalisasi status gateway
+const (
+	PaymentStatusPending  = string(entity.PaymentStatusPending)
+	PaymentStatusSuccess  = string(entity.PaymentStatusSuccess)
+	PaymentStatusFailed   = string(entity.PaymentStatusFailed)
+	PaymentStatusCanceled = string(entity.PaymentStatusCanceled)
+	PaymentStatusRefunded = string(entity.PaymentStatusRefunded)
+	PaymentStatusExpired  = string(entity.PaymentStatusExpired)
+	PaymentStatusDenied   = string(entity.PaymentStatusDenied)
+)
+
+// ================== INPUT DTOs ==================
+
+type CustomerDetails struct {
+	Name  string
+	Email string
+}
+
+type ItemDetail struct {
+	ID       string
+	Name     string
+	Price    int64
+	Quantity int32
+}
+
+// ChargeInput: charge payment sesuai payment method (bank transfer / e-wallet)
+type ChargeInput struct {
+	OrderID           string
+	GrossAmount       int64
+	Currency          string
+	PaymentMethodCode string
+	PaymentMethodType entity.AppPaymentMethodType
+	CustomerDetails   CustomerDetails
+	Items             []ItemDetail
+}
+
+type RefundInput struct {
+	// RefundKey unik per refund (idempotency key di sisi gateway)
+	RefundKey string
+	Amount    int64
+	Reason    string
+}
+
+// ================== OUTPUT DTOs ==================
+
+// ChargeAction instruksi pembayaran (QR code, deeplink, virtual account, dll) yang disimpan ke payment_history_actions
+type ChargeAction struct {
+	Name        string
+	Label       string
+	Value       string
+	ValueType   entity.PaymentActionValueType
+	PaymentType entity.AppPaymentMethodType
+	Method      string
+	IsPublic    bool
+}
+
+type ChargeResult struct {
+	TransactionID string
+	Actions       []ChargeAction
+}
+
+type StatusResult struct {
+	TransactionID string
+	// Status payment_status internal, RawStatus status asli dari gateway (untuk log)
+	Status    string
+	RawStatus string
+}
+
+type RefundResult struct {
+	TransactionID string
+	RefundKey     string
+	// RefundAmount total refund kumulatif di gateway (0 jika tidak diketahui)
+	RefundAmount int64
+}
+
+// WebhookNotification notifikasi webhook yang sudah diverifikasi & dinormalisasi
+type WebhookNotification struct {
+	TransactionID string
+	OrderID       string
+	Status        string
+	RawStatus     string
+	// RefundAmount total refund kumulatif (hanya untuk Status refunded)
+	RefundAmount int64
+	// FullRefund: refund penuh tanpa nominal (nominal = total payment)
+	FullRefund bool
+}
@@ -0,0 +1,136 @@
+// internal/module/headless/payment_gateway/fake.go
+package payment_gateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// fakeGateway implements Gateway without calling any payment provider (local / testing).
+// Stateless: transaksi selalu pending sampai webhook simulasi dikirim ke /payment/webhook/fake.
+type fakeGateway struct {
+	secret []byte
+}
+
+// NewFakeGateway creates a gateway whose webhook is signed with HMAC-SHA256(secret)
+func NewFakeGateway(secret string) Gateway {
+	return &fakeGateway{secret: []byte(secret)}
+}
+
+// fakeNotification is the webhook payload for fake gateway
+type fakeNotification struct {
+	TransactionID string `json:"transaction_id"`
+	OrderID       string `json:"order_id"`
+	// payment_status internal: success, failed, canceled, expired, denied, refunded
+	Status string `json:"status"`
+	// total refund kumulatif, 0 = refund penuh
+	RefundAmount int64 `json:"refund_amount"`
+	// hex(HMAC-SHA256(secret, transaction_id + status + refund_amount))
+	Signature string `json:"signature"`
+}
+
+var fakeWebhookStatuses = map[string]bool{
+	PaymentStatusPending:  true,
+	PaymentStatusSuccess:  true,
+	PaymentStatusFailed:   true,
+	PaymentStatusCanceled: true,
+	PaymentStatusExpired:  true,
+	PaymentStatusDenied:   true,
+	PaymentStatusRefunded: true,
+}
+
+func (g *fakeGateway) Charge(ctx context.Context, input ChargeInput) (*ChargeResult, error) {
+	transactionID := "fake-" + uuid.NewString()
+	logger.From(ctx).Info("Fake gateway charge created", "orderID", input.OrderID, "transactionID", transactionID, "amount", input.GrossAmount)
+
+	return &ChargeResult{
+		TransactionID: transactionID,
+		Actions: []ChargeAction{
+			{
+				Name:        "fake-payment",
+				Label:       "Fake Payment ID",
+				Value:       transactionID,
+				ValueType:   entity.PaymentActionValueTypeText,
+				PaymentType: input.PaymentMethodType,
+				Method:      "POST",
+				IsPublic:    true,
+			},
+		},
+	}, nil
+}
+
+func (g *fakeGateway) CheckStatus(ctx context.Context, transactionID string) (*StatusResult, error) {
+	return fakeStatus(transactionID, PaymentStatusPending), nil
+}
+
+func (g *fakeGateway) Cancel(ctx context.Context, transactionID string) (*StatusResult, error) {
+	return fakeStatus(transactionID, PaymentStatusCanceled), nil
+}
+
+func (g *fakeGateway) Expire(ctx context.Context, transactionID string) (*StatusResult, error) {
+	return fakeStatus(transactionID, PaymentStatusExpired), nil
+}
+
+func (g *fakeGateway) Refund(ctx context.Context, transactionID string, input RefundInput) (*RefundResult, error) {
+	if input.Amount <= 0 {
+		return nil, errs.NewBadRequest("FAKE_REFUND_AMOUNT_INVALID")
+	}
+	return &RefundResult{
+		TransactionID: transactionID,
+		RefundKey:     input.RefundKey,
+	}, nil
+}
+
+func (g *fakeGateway) VerifyWebhook(ctx context.Context, body []byte) (*WebhookNotification, error) {
+	var n fakeNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, errs.NewBadRequest("INVALID_WEBHOOK_PAYLOAD")
+	}
+	if n.TransactionID == "" || !fakeWebhookStatuses[n.Status] {
+		return nil, errs.NewBadRequest("INVALID_WEBHOOK_PAYLOAD")
+	}
+
+	if !hmac.Equal([]byte(n.Signature), []byte(g.sign(n.TransactionID, n.Status, n.RefundAmount))) {
+		logger.From(ctx).Error("Invalid fake webhook signature", "transactionID", n.TransactionID)
+		return nil, errs.NewUnauthorized("INVALID_SIGNATURE")
+	}
+
+	return &WebhookNotification{
+		TransactionID: n.TransactionID,
+		OrderID:       n.OrderID,
+		Status:        n.Status,
+		RawStatus:     n.Status,
+		RefundAmount:  n.RefundAmount,
+		FullRefund:    n.Status == PaymentStatusRefunded && n.RefundAmount <= 0,
+	}, nil
+}
+
+// SupportsCurrency: fake gateway menerima semua currency
+func (g *fakeGateway) SupportsCurrency(currency string) bool {
+	return true
+}
+
+// sign: hex(HMAC-SHA256(secret, transaction_id + status + refund_amount))
+func (g *fakeGateway) sign(transactionID, status string, refundAmount int64) string {
+	mac := hmac.New(sha256.New, g.secret)
+	mac.Write([]byte(transactionID + status + strconv.FormatInt(refundAmount, 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func fakeStatus(transactionID, status string) *StatusResult {
+	return &StatusResult{
+		TransactionID: transactionID,
+		Status:        status,
+		RawStatus:     status,
+	}
+}
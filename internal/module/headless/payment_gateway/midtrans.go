@@ -0,0 +1,245 @@
+// internal/module/headless/payment_gateway/midtrans.go
+package payment_gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"postmatic-api/internal/module/headless/midtrans"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+)
+
+// midtransGateway implements Gateway on top of headless midtrans service (Core API)
+type midtransGateway struct {
+	midtrans midtrans.Service
+}
+
+// NewMidtransGateway creates Gateway for Midtrans
+func NewMidtransGateway(midtransSvc midtrans.Service) Gateway {
+	return &midtransGateway{midtrans: midtransSvc}
+}
+
+// midtransNotification is the webhook payload from Midtrans
+type midtransNotification struct {
+	TransactionTime   string `json:"transaction_time"`
+	TransactionStatus string `json:"transaction_status"`
+	TransactionID     string `json:"transaction_id"`
+	StatusMessage     string `json:"status_message"`
+	StatusCode        string `json:"status_code"`
+	SignatureKey      string `json:"signature_key"`
+	OrderID           string `json:"order_id"`
+	MerchantID        string `json:"merchant_id"`
+	GrossAmount       string `json:"gross_amount"`
+	FraudStatus       string `json:"fraud_status"`
+	Currency          string `json:"currency"`
+	// terisi untuk status refund / partial_refund (total yang sudah di-refund)
+	RefundAmount string `json:"refund_amount"`
+}
+
+// Charge: e-wallet (Gopay) atau bank transfer (VA) sesuai payment method
+func (g *midtransGateway) Charge(ctx context.Context, input ChargeInput) (*ChargeResult, error) {
+	if !g.SupportsCurrency(input.Currency) {
+		return nil, errs.NewBadRequest("PAYMENT_CURRENCY_NOT_SUPPORTED")
+	}
+
+	customerDetails := midtrans.CustomerDetails{
+		FirstName: input.CustomerDetails.Name,
+		Email:     input.CustomerDetails.Email,
+	}
+	items := make([]midtrans.ItemDetail, len(input.Items))
+	for i, item := range input.Items {
+		items[i] = midtrans.ItemDetail{
+			ID:       item.ID,
+			Name:     item.Name,
+			Price:    item.Price,
+			Quantity: item.Quantity,
+		}
+	}
+
+	result := &ChargeResult{}
+
+	if input.PaymentMethodType == entity.AppPaymentMethodTypeEwallet || strings.ToLower(input.PaymentMethodCode) == "gopay" {
+		// E-wallet (Gopay)
+		res, err := g.midtrans.ChargeGopay(ctx, midtrans.ChargeGopayInput{
+			OrderID:         input.OrderID,
+			GrossAmount:     input.GrossAmount,
+			CustomerDetails: customerDetails,
+			Items:           items,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.TransactionID = res.TransactionID
+
+		for _, action := range res.Actions {
+			label, valueType, isPublic := mapGopayActionToLabel(action.Name)
+			result.Actions = append(result.Actions, ChargeAction{
+				Name:        action.Name,
+				Label:       label,
+				Value:       action.URL,
+				ValueType:   valueType,
+				PaymentType: entity.AppPaymentMethodTypeEwallet,
+				Method:      action.Method,
+				IsPublic:    isPublic,
+			})
+		}
+		return result, nil
+	}
+
+	// Bank transfer
+	res, err := g.midtrans.ChargeBankTransfer(ctx, midtrans.ChargeBankTransferInput{
+		OrderID:         input.OrderID,
+		GrossAmount:     input.GrossAmount,
+		Bank:            strings.ToLower(input.PaymentMethodCode),
+		CustomerDetails: customerDetails,
+		Items:           items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result.TransactionID = res.TransactionID
+
+	for _, va := range res.VANumbers {
+		result.Actions = append(result.Actions, ChargeAction{
+			Name:        "virtual-account",
+			Label:       fmt.Sprintf("Virtual Account %s", strings.ToUpper(va.Bank)),
+			Value:       va.VANumber,
+			ValueType:   entity.PaymentActionValueTypeText,
+			PaymentType: entity.AppPaymentMethodTypeBank,
+			Method:      "GET",
+			IsPublic:    true,
+		})
+	}
+	return result, nil
+}
+
+func (g *midtransGateway) CheckStatus(ctx context.Context, transactionID string) (*StatusResult, error) {
+	res, err := g.midtrans.CheckStatus(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return mapMidtransStatusResult(res), nil
+}
+
+func (g *midtransGateway) Cancel(ctx context.Context, transactionID string) (*StatusResult, error) {
+	res, err := g.midtrans.CancelTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return mapMidtransStatusResult(res), nil
+}
+
+func (g *midtransGateway) Expire(ctx context.Context, transactionID string) (*StatusResult, error) {
+	res, err := g.midtrans.ExpireTransaction(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	return mapMidtransStatusResult(res), nil
+}
+
+func (g *midtransGateway) Refund(ctx context.Context, transactionID string, input RefundInput) (*RefundResult, error) {
+	res, err := g.midtrans.RefundTransaction(ctx, transactionID, midtrans.RefundInput{
+		RefundKey: input.RefundKey,
+		Amount:    input.Amount,
+		Reason:    input.Reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RefundResult{
+		TransactionID: res.TransactionID,
+		RefundKey:     res.RefundKey,
+		RefundAmount:  parseMidtransAmount(res.RefundAmount),
+	}, nil
+}
+
+// VerifyWebhook: signature = SHA512(orderId + statusCode + grossAmount + serverKey)
+func (g *midtransGateway) VerifyWebhook(ctx context.Context, body []byte) (*WebhookNotification, error) {
+	var n midtransNotification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, errs.NewBadRequest("INVALID_WEBHOOK_PAYLOAD")
+	}
+
+	if !g.midtrans.VerifySignature(n.OrderID, n.StatusCode, n.GrossAmount, n.SignatureKey) {
+		logger.From(ctx).Error("Invalid midtrans webhook signature", "orderID", n.OrderID)
+		return nil, errs.NewUnauthorized("INVALID_SIGNATURE")
+	}
+
+	return &WebhookNotification{
+		TransactionID: n.TransactionID,
+		OrderID:       n.OrderID,
+		Status:        mapMidtransStatusToPaymentStatus(n.TransactionStatus),
+		RawStatus:     n.TransactionStatus,
+		RefundAmount:  parseMidtransAmount(n.RefundAmount),
+		FullRefund:    n.TransactionStatus == "refund",
+	}, nil
+}
+
+// SupportsCurrency: Core API bank transfer & Gopay hanya menerima IDR
+func (g *midtransGateway) SupportsCurrency(currency string) bool {
+	return currency == "IDR"
+}
+
+func mapMidtransStatusResult(res *midtrans.TransactionStatusResponse) *StatusResult {
+	return &StatusResult{
+		TransactionID: res.TransactionID,
+		Status:        mapMidtransStatusToPaymentStatus(res.TransactionStatus),
+		RawStatus:     res.TransactionStatus,
+	}
+}
+
+func mapMidtransStatusToPaymentStatus(midtransStatus string) string {
+	switch midtransStatus {
+	case "capture", "settlement":
+		return PaymentStatusSuccess
+	case "pending":
+		return PaymentStatusPending
+	case "deny":
+		return PaymentStatusDenied
+	case "cancel":
+		return PaymentStatusCanceled
+	case "expire":
+		return PaymentStatusExpired
+	case "refund", "partial_refund":
+		return PaymentStatusRefunded
+	case "failure":
+		return PaymentStatusFailed
+	default:
+		return PaymentStatusPending
+	}
+}
+
+// mapGopayActionToLabel maps Midtrans action name to human readable label and metadata
+func mapGopayActionToLabel(name string) (label string, valueType entity.PaymentActionValueType, isPublic bool) {
+	switch name {
+	case "generate-qr-code":
+		return "QR Code", entity.PaymentActionValueTypeImage, true
+	case "generate-qr-code-v2":
+		return "QR Code V2", entity.PaymentActionValueTypeImage, true
+	case "deeplink-redirect":
+		return "Deeplink Redirect", entity.PaymentActionValueTypeLink, true
+	case "get-status":
+		return "Get Status", entity.PaymentActionValueTypeLink, false
+	case "cancel":
+		return "Cancel Transaction", entity.PaymentActionValueTypeLink, false
+	default:
+		return name, entity.PaymentActionValueTypeLink, false
+	}
+}
+
+// parseMidtransAmount parses Midtrans amount string (ex: "10000.00")
+func parseMidtransAmount(amount string) int64 {
+	if amount == "" {
+		return 0
+	}
+	v, err := strconv.ParseFloat(amount, 64)
+	if err != nil {
+		return 0
+	}
+	return int64(v)
+}
@@ -0,0 +1,69 @@
+// internal/module/headless/payment_gateway/service.go
+package payment_gateway
+
+import (
+	"context"
+
+	"postmatic-api/config"
+	"postmatic-api/internal/module/headless/midtrans"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+)
+
+// Gateway defines payment operations for a single payment gateway.
+// Status yang dikembalikan sudah dinormalisasi ke payment_status internal (lihat PaymentStatus*).
+type Gateway interface {
+	Charge(ctx context.Context, input ChargeInput) (*ChargeResult, error)
+	CheckStatus(ctx context.Context, transactionID string) (*StatusResult, error)
+	Cancel(ctx context.Context, transactionID string) (*StatusResult, error)
+	Expire(ctx context.Context, transactionID string) (*StatusResult, error)
+	Refund(ctx context.Context, transactionID string, input RefundInput) (*RefundResult, error)
+	// VerifyWebhook parse & verifikasi signature raw body webhook dari gateway
+	VerifyWebhook(ctx context.Context, body []byte) (*WebhookNotification, error)
+	// SupportsCurrency cek currency (ISO 4217, uppercase) dapat di-charge oleh gateway
+	SupportsCurrency(currency string) bool
+}
+
+// Service resolves Gateway by gateway type
+type Service interface {
+	Gateway(gateway entity.PaymentGatewayType) (Gateway, error)
+	// SupportsCurrency cek minimal satu gateway aktif dapat men-charge currency
+	SupportsCurrency(currency string) bool
+}
+
+type paymentGatewayService struct {
+	gateways map[entity.PaymentGatewayType]Gateway
+}
+
+// NewService creates a new payment gateway registry
+func NewService(gateways map[entity.PaymentGatewayType]Gateway) Service {
+	return &paymentGatewayService{gateways: gateways}
+}
+
+// NewDefaultService: midtrans selalu aktif, fake gateway hanya aktif jika PAYMENT_FAKE_GATEWAY_SECRET diisi
+func NewDefaultService(cfg *config.Config, midtransSvc midtrans.Service) Service {
+	gateways := map[entity.PaymentGatewayType]Gateway{
+		entity.PaymentGatewayTypeMidtrans: NewMidtransGateway(midtransSvc),
+	}
+	if cfg.PAYMENT_FAKE_GATEWAY_SECRET != "" {
+		gateways[entity.PaymentGatewayTypeFake] = NewFakeGateway(cfg.PAYMENT_FAKE_GATEWAY_SECRET)
+	}
+	return NewService(gateways)
+}
+
+func (s *paymentGatewayService) Gateway(gateway entity.PaymentGatewayType) (Gateway, error) {
+	g, ok := s.gateways[gateway]
+	if !ok || g == nil {
+		return nil, errs.NewBadRequest("PAYMENT_GATEWAY_NOT_SUPPORTED")
+	}
+	return g, nil
+}
+
+func (s *paymentGatewayService) SupportsCurrency(currency string) bool {
+	for _, g := range s.gateways {
+		if g != nil && g.SupportsCurrency(currency) {
+			return true
+		}
+	}
+	return false
+}
@@ -18,15 +18,24 @@ type MailerProducer interface {
 	// AUTH
 	EnqueueWelcomeEmail(ctx context.Context, payload mailer.WelcomeInputDTO) error
 	EnqueueUserVerification(ctx context.Context, payload mailer.VerificationInputDTO) error
+	EnqueueResetPassword(ctx context.Context, payload mailer.ResetPasswordInputDTO) error
+	EnqueueAccountLocked(ctx context.Context, payload mailer.AccountLockedInputDTO) error
+	EnqueueEmailChangeConfirm(ctx context.Context, payload mailer.EmailChangeConfirmInputDTO) error
+	EnqueueEmailChangeNotice(ctx context.Context, payload mailer.EmailChangeNoticeInputDTO) error
 	// MEMBER
 	EnqueueInvitation(ctx context.Context, payload mailer.MemberInvitationInputDTO) error
 	EnqueueAnnounceRole(ctx context.Context, payload mailer.MemberAnnounceRoleInputDTO) error
 	EnqueueAnnounceKick(ctx context.Context, payload mailer.MemberAnnounceKickInputDTO) error
 	EnqueueWelcomeBusiness(ctx context.Context, payload mailer.MemberWelcomeBusinessInputDTO) error
+	EnqueueOwnershipTransfer(ctx context.Context, payload mailer.MemberOwnershipTransferInputDTO) error
 	// PAYMENT
 	EnqueuePaymentCheckout(ctx context.Context, payload mailer.PaymentCheckoutInputDTO) error
 	EnqueuePaymentSuccess(ctx context.Context, payload mailer.PaymentSuccessInputDTO) error
 	EnqueuePaymentCanceled(ctx context.Context, payload mailer.PaymentCanceledInputDTO) error
+	EnqueuePaymentRefunded(ctx context.Context, payload mailer.PaymentRefundedInputDTO) error
+	// CREATOR
+	EnqueueCreatorSale(ctx context.Context, payload mailer.CreatorSaleInputDTO) error
+	EnqueueCreatorModeration(ctx context.Context, payload mailer.CreatorModerationInputDTO) error
 }
 
 // MailerService adalah kontrak yang dipakai oleh worker (consumer) untuk MENGEKSEKUSI job.
@@ -37,19 +46,29 @@ type MailerService = mailer.Mailer
 // Dibuat private (lowercase) agar tidak menjadi public API package queue.
 const (
 	// AUTH / WELCOME
-	taskMailerWelcome      = "queue:mailer:welcome"
-	taskMailerVerification = "queue:mailer:verification"
+	taskMailerWelcome            = "queue:mailer:welcome"
+	taskMailerVerification       = "queue:mailer:verification"
+	taskMailerResetPassword      = "queue:mailer:reset_password"
+	taskMailerAccountLocked      = "queue:mailer:account_locked"
+	taskMailerEmailChangeConfirm = "queue:mailer:email_change_confirm"
+	taskMailerEmailChangeNotice  = "queue:mailer:email_change_notice"
 
 	// BUSINESS
-	taskMailerInvitation      = "queue:mailer:invitation"
-	taskMailerAnnounceRole    = "queue:mailer:announce:role"
-	taskMailerAnnounceKick    = "queue:mailer:announce:kick"
-	taskMailerWelcomeBusiness = "queue:mailer:welcome:business"
+	taskMailerInvitation        = "queue:mailer:invitation"
+	taskMailerAnnounceRole      = "queue:mailer:announce:role"
+	taskMailerAnnounceKick      = "queue:mailer:announce:kick"
+	taskMailerWelcomeBusiness   = "queue:mailer:welcome:business"
+	taskMailerOwnershipTransfer = "queue:mailer:ownership:transfer"
 
 	// PAYMENT
 	taskMailerPaymentCheckout = "queue:mailer:payment:checkout"
 	taskMailerPaymentSuccess  = "queue:mailer:payment:success"
 	taskMailerPaymentCanceled = "queue:mailer:payment:canceled"
+	taskMailerPaymentRefunded = "queue:mailer:payment:refunded"
+
+	// CREATOR
+	taskMailerCreatorSale       = "queue:mailer:creator:sale"
+	taskMailerCreatorModeration = "queue:mailer:creator:moderation"
 )
 
 // EnqueueWelcomeEmail adalah API producer untuk mengantrikan email welcome.
@@ -88,6 +107,74 @@ func (p *Producer) EnqueueUserVerification(ctx context.Context, payload mailer.V
 	)
 }
 
+// EnqueueResetPassword adalah API producer untuk mengantrikan email reset password.
+func (p *Producer) EnqueueResetPassword(ctx context.Context, payload mailer.ResetPasswordInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerResetPassword, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(10*time.Second),
+	)
+}
+
+// EnqueueAccountLocked adalah API producer untuk mengantrikan email notifikasi lockout login.
+func (p *Producer) EnqueueAccountLocked(ctx context.Context, payload mailer.AccountLockedInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerAccountLocked, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(10*time.Second),
+	)
+}
+
+// EnqueueEmailChangeConfirm mengantrikan link konfirmasi ke email baru.
+func (p *Producer) EnqueueEmailChangeConfirm(ctx context.Context, payload mailer.EmailChangeConfirmInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerEmailChangeConfirm, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(10*time.Second),
+	)
+}
+
+// EnqueueEmailChangeNotice mengantrikan notifikasi + link revert ke email lama.
+func (p *Producer) EnqueueEmailChangeNotice(ctx context.Context, payload mailer.EmailChangeNoticeInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerEmailChangeNotice, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(10*time.Second),
+	)
+}
+
 func (p *Producer) EnqueueInvitation(ctx context.Context, payload mailer.MemberInvitationInputDTO) error {
 	b, err := json.Marshal(payload)
 	if err != nil {
@@ -152,6 +239,22 @@ func (p *Producer) EnqueueWelcomeBusiness(ctx context.Context, payload mailer.Me
 	)
 }
 
+func (p *Producer) EnqueueOwnershipTransfer(ctx context.Context, payload mailer.MemberOwnershipTransferInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerOwnershipTransfer, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(10*time.Second),
+	)
+}
+
 // registerMailerHandlers mendaftarkan consumer handler ke Asynq mux.
 // Ini dipanggil dari Worker.RegisterMailer(...).
 // Handler akan:
@@ -175,6 +278,38 @@ func registerMailerHandlers(mux *asynq.ServeMux, mailerSvc MailerService) {
 		return mailerSvc.SendVerificationEmail(ctx, p)
 	})
 
+	mux.HandleFunc(taskMailerResetPassword, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.ResetPasswordInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendResetPasswordEmail(ctx, p)
+	})
+
+	mux.HandleFunc(taskMailerAccountLocked, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.AccountLockedInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendAccountLockedEmail(ctx, p)
+	})
+
+	mux.HandleFunc(taskMailerEmailChangeConfirm, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.EmailChangeConfirmInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendEmailChangeConfirmEmail(ctx, p)
+	})
+
+	mux.HandleFunc(taskMailerEmailChangeNotice, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.EmailChangeNoticeInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendEmailChangeNoticeEmail(ctx, p)
+	})
+
 	mux.HandleFunc(taskMailerInvitation, func(ctx context.Context, t *asynq.Task) error {
 		var p mailer.MemberInvitationInputDTO
 		if err := json.Unmarshal(t.Payload(), &p); err != nil {
@@ -207,6 +342,14 @@ func registerMailerHandlers(mux *asynq.ServeMux, mailerSvc MailerService) {
 		return mailerSvc.SendWelcomeBusinessEmail(ctx, p)
 	})
 
+	mux.HandleFunc(taskMailerOwnershipTransfer, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.MemberOwnershipTransferInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendOwnershipTransferEmail(ctx, p)
+	})
+
 	// PAYMENT HANDLERS
 	mux.HandleFunc(taskMailerPaymentCheckout, func(ctx context.Context, t *asynq.Task) error {
 		var p mailer.PaymentCheckoutInputDTO
@@ -231,6 +374,30 @@ func registerMailerHandlers(mux *asynq.ServeMux, mailerSvc MailerService) {
 		}
 		return mailerSvc.SendPaymentCanceledEmail(ctx, p)
 	})
+
+	mux.HandleFunc(taskMailerPaymentRefunded, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.PaymentRefundedInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendPaymentRefundedEmail(ctx, p)
+	})
+
+	mux.HandleFunc(taskMailerCreatorSale, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.CreatorSaleInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendCreatorSaleEmail(ctx, p)
+	})
+
+	mux.HandleFunc(taskMailerCreatorModeration, func(ctx context.Context, t *asynq.Task) error {
+		var p mailer.CreatorModerationInputDTO
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return mailerSvc.SendCreatorModerationEmail(ctx, p)
+	})
 }
 
 // ==================== PAYMENT PRODUCER ====================
@@ -282,3 +449,53 @@ func (p *Producer) EnqueuePaymentCanceled(ctx context.Context, payload mailer.Pa
 		asynq.Timeout(15*time.Second),
 	)
 }
+
+func (p *Producer) EnqueuePaymentRefunded(ctx context.Context, payload mailer.PaymentRefundedInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerPaymentRefunded, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(15*time.Second),
+	)
+}
+
+// ==================== CREATOR PRODUCER ====================
+
+func (p *Producer) EnqueueCreatorSale(ctx context.Context, payload mailer.CreatorSaleInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerCreatorSale, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(15*time.Second),
+	)
+}
+
+func (p *Producer) EnqueueCreatorModeration(ctx context.Context, payload mailer.CreatorModerationInputDTO) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskMailerCreatorModeration, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(3),
+		asynq.Timeout(15*time.Second),
+	)
+}
@@ -0,0 +1,51 @@
+// internal/module/headless/queue/payment_reconcile.go
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// ReconcilePendingPaymentsPayload adalah payload task periodik reconcile payment pending.
+// PendingAfter = umur minimal payment pending sebelum dicek ulang ke Midtrans.
+type ReconcilePendingPaymentsPayload struct {
+	PendingAfter time.Duration `json:"pendingAfter"`
+	Limit        int32         `json:"limit"`
+}
+
+// PaymentReconcileExecutor adalah kontrak yang dipakai worker untuk MENGEKSEKUSI reconcile payment pending.
+type PaymentReconcileExecutor interface {
+	ReconcilePendingPayments(ctx context.Context, payload ReconcilePendingPaymentsPayload) error
+}
+
+const taskPaymentReconcilePending = "queue:payment:reconcile_pending"
+
+func newReconcilePendingPaymentsTask(payload ReconcilePendingPaymentsPayload, interval time.Duration) (*asynq.Task, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	// Unique: beberapa instance API menjalankan scheduler yang sama, cukup satu task per interval
+	return asynq.NewTask(
+		taskPaymentReconcilePending,
+		b,
+		asynq.Queue("default"),
+		asynq.MaxRetry(0),
+		asynq.Timeout(interval),
+		asynq.Unique(interval),
+	), nil
+}
+
+func registerPaymentReconcileHandlers(mux *asynq.ServeMux, executor PaymentReconcileExecutor) {
+	mux.HandleFunc(taskPaymentReconcilePending, func(ctx context.Context, t *asynq.Task) error {
+		var p ReconcilePendingPaymentsPayload
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return executor.ReconcilePendingPayments(ctx, p)
+	})
+}
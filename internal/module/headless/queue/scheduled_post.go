@@ -0,0 +1,167 @@
+// internal/module/headless/queue/scheduled_post.go
+package queue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// PublishScheduledPostPayload adalah payload task publish scheduled post.
+// ScheduledAt dipakai untuk mendeteksi task basi (jadwal sudah diubah setelah task di-enqueue).
+type PublishScheduledPostPayload struct {
+	ScheduledPostID int64     `json:"scheduledPostId"`
+	ScheduledAt     time.Time `json:"scheduledAt"`
+}
+
+// DeliverScheduledPostPayload adalah payload task pengiriman post ke platform (di-retry dengan exponential backoff).
+type DeliverScheduledPostPayload struct {
+	ScheduledPostID int64 `json:"scheduledPostId"`
+}
+
+// SweepStuckScheduledPostsPayload adalah payload task periodik sweep post yang tertahan di status publishing.
+// StuckAfter = lama minimal di status publishing, harus lebih lama dari seluruh retry task deliver.
+type SweepStuckScheduledPostsPayload struct {
+	StuckAfter time.Duration `json:"stuckAfter"`
+	Limit      int32         `json:"limit"`
+}
+
+// DeliveryAttempt adalah info percobaan ke-berapa dari task deliver (diambil dari asynq).
+type DeliveryAttempt struct {
+	Number  int
+	IsFinal bool
+}
+
+// ScheduledPostProducer adalah kontrak untuk MENJADWALKAN task publish post.
+type ScheduledPostProducer interface {
+	EnqueuePublishScheduledPost(ctx context.Context, payload PublishScheduledPostPayload) error
+	EnqueueDeliverScheduledPost(ctx context.Context, payload DeliverScheduledPostPayload) error
+}
+
+// ScheduledPostExecutor adalah kontrak yang dipakai worker untuk MENGEKSEKUSI publish post.
+type ScheduledPostExecutor interface {
+	PublishScheduledPost(ctx context.Context, payload PublishScheduledPostPayload) error
+	DeliverScheduledPost(ctx context.Context, payload DeliverScheduledPostPayload, attempt DeliveryAttempt) error
+	SweepStuckScheduledPosts(ctx context.Context, payload SweepStuckScheduledPostsPayload) error
+}
+
+const (
+	taskScheduledPostPublish = "queue:scheduled_post:publish"
+	taskScheduledPostDeliver = "queue:scheduled_post:deliver"
+	taskScheduledPostSweep   = "queue:scheduled_post:sweep_stuck"
+)
+
+const (
+	// DeliverScheduledPostMaxRetry adalah jumlah retry task deliver (total percobaan = retry + 1)
+	DeliverScheduledPostMaxRetry = 5
+
+	deliverRetryBaseDelay = 30 * time.Second
+	deliverRetryMaxDelay  = 30 * time.Minute
+)
+
+// EnqueuePublishScheduledPost mengantrikan task yang baru diproses pada payload.ScheduledAt.
+func (p *Producer) EnqueuePublishScheduledPost(ctx context.Context, payload PublishScheduledPostPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskScheduledPostPublish, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.ProcessAt(payload.ScheduledAt),
+		asynq.MaxRetry(3),
+		asynq.Timeout(60*time.Second),
+	)
+}
+
+// EnqueueDeliverScheduledPost mengantrikan pengiriman post ke platform (langsung diproses).
+func (p *Producer) EnqueueDeliverScheduledPost(ctx context.Context, payload DeliverScheduledPostPayload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	task := asynq.NewTask(taskScheduledPostDeliver, b)
+
+	return p.enqueue(
+		ctx,
+		task,
+		asynq.Queue("default"),
+		asynq.MaxRetry(DeliverScheduledPostMaxRetry),
+		asynq.Timeout(60*time.Second),
+	)
+}
+
+func newSweepStuckScheduledPostsTask(payload SweepStuckScheduledPostsPayload, interval time.Duration) (*asynq.Task, error) {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	// Unique: beberapa instance API menjalankan scheduler yang sama, cukup satu task per interval
+	return asynq.NewTask(
+		taskScheduledPostSweep,
+		b,
+		asynq.Queue("default"),
+		asynq.MaxRetry(0),
+		asynq.Timeout(interval),
+		asynq.Unique(interval),
+	), nil
+}
+
+// RetryDelay dipasang ke asynq.Config.RetryDelayFunc.
+// Task deliver memakai exponential backoff (30s, 1m, 2m, ... max 30m) + jitter,
+// task lain tetap memakai default asynq.
+func RetryDelay(n int, err error, t *asynq.Task) time.Duration {
+	if t.Type() != taskScheduledPostDeliver {
+		return asynq.DefaultRetryDelayFunc(n, err, t)
+	}
+
+	delay := deliverRetryMaxDelay
+	if n < 16 {
+		delay = min(deliverRetryBaseDelay<<n, deliverRetryMaxDelay)
+	}
+	// jitter 0-20% supaya retry tidak serempak
+	return delay + time.Duration(rand.Int64N(int64(delay)/5+1))
+}
+
+func registerScheduledPostHandlers(mux *asynq.ServeMux, executor ScheduledPostExecutor) {
+	mux.HandleFunc(taskScheduledPostPublish, func(ctx context.Context, t *asynq.Task) error {
+		var p PublishScheduledPostPayload
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return executor.PublishScheduledPost(ctx, p)
+	})
+
+	mux.HandleFunc(taskScheduledPostDeliver, func(ctx context.Context, t *asynq.Task) error {
+		var p DeliverScheduledPostPayload
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+
+		retried, _ := asynq.GetRetryCount(ctx)
+		maxRetry, ok := asynq.GetMaxRetry(ctx)
+		if !ok {
+			maxRetry = DeliverScheduledPostMaxRetry
+		}
+
+		return executor.DeliverScheduledPost(ctx, p, DeliveryAttempt{
+			Number:  retried + 1,
+			IsFinal: retried >= maxRetry,
+		})
+	})
+
+	mux.HandleFunc(taskScheduledPostSweep, func(ctx context.Context, t *asynq.Task) error {
+		var p SweepStuckScheduledPostsPayload
+		if err := json.Unmarshal(t.Payload(), &p); err != nil {
+			return fmt.Errorf("invalid payload: %v: %w", err, asynq.SkipRetry)
+		}
+		return executor.SweepStuckScheduledPosts(ctx, p)
+	})
+}
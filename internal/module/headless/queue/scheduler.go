@@ -0,0 +1,47 @@
+// internal/module/headless/queue/scheduler.go
+package queue
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+// Scheduler mengantrikan task periodik (cron) yang kemudian diproses oleh Worker.
+type Scheduler struct {
+	scheduler *asynq.Scheduler
+}
+
+func NewScheduler(scheduler *asynq.Scheduler) *Scheduler {
+	return &Scheduler{scheduler: scheduler}
+}
+
+// RegisterPaymentReconcile menjadwalkan reconcile payment pending setiap interval.
+func (s *Scheduler) RegisterPaymentReconcile(interval time.Duration, payload ReconcilePendingPaymentsPayload) error {
+	task, err := newReconcilePendingPaymentsTask(payload, interval)
+	if err != nil {
+		return err
+	}
+	_, err = s.scheduler.Register(fmt.Sprintf("@every %s", interval), task)
+	return err
+}
+
+// RegisterScheduledPostSweep menjadwalkan sweep scheduled post yang tertahan di status publishing setiap interval.
+func (s *Scheduler) RegisterScheduledPostSweep(interval time.Duration, payload SweepStuckScheduledPostsPayload) error {
+	task, err := newSweepStuckScheduledPostsTask(payload, interval)
+	if err != nil {
+		return err
+	}
+	_, err = s.scheduler.Register(fmt.Sprintf("@every %s", interval), task)
+	return err
+}
+
+// Start menjalankan scheduler di background (non-blocking).
+func (s *Scheduler) Start() error {
+	return s.scheduler.Start()
+}
+
+func (s *Scheduler) Shutdown() {
+	s.scheduler.Shutdown()
+}
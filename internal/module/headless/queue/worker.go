@@ -16,6 +16,14 @@ func (w *Worker) RegisterMailer(mailerSvc MailerService) {
 	registerMailerHandlers(w.mux, mailerSvc) // welcome + verification sama-sama di sini
 }
 
+func (w *Worker) RegisterScheduledPost(executor ScheduledPostExecutor) {
+	registerScheduledPostHandlers(w.mux, executor)
+}
+
+func (w *Worker) RegisterPaymentReconcile(executor PaymentReconcileExecutor) {
+	registerPaymentReconcileHandlers(w.mux, executor)
+}
+
 func (w *Worker) Run() error {
 	return w.server.Run(w.mux)
 }
@@ -7,3 +7,9 @@ type PresignUploadImageInput struct {
 	ContentType string `json:"contentType" validate:"required"` // contoh: image/png
 	Size        int64  `json:"size" validate:"required"`        // opsional (buat validasi tambahan nanti)
 }
+
+type PutObjectInput struct {
+	ObjectKey   string
+	ContentType string // contoh: application/pdf
+	Body        []byte
+}
@@ -2,9 +2,11 @@
 package s3_uploader
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"postmatic-api/config"
 	"postmatic-api/pkg/errs"
 	"strings"
@@ -36,6 +38,11 @@ func (s *S3UploaderService) objectKeyBuilder(hash string, format string) string
 	return fmt.Sprintf("%s/images/%s.%s", s.cfg.APP_NAME, hash, format)
 }
 
+// InvoiceObjectKey: path file invoice pdf, contoh: postmatic/invoices/2026/INV-2026-000001.pdf
+func (s *S3UploaderService) InvoiceObjectKey(year int, invoiceNumber string) string {
+	return fmt.Sprintf("%s/invoices/%d/%s.pdf", s.cfg.APP_NAME, year, invoiceNumber)
+}
+
 func (s *S3UploaderService) BuildObjectURL(objectKey string) string {
 	key := strings.TrimLeft(objectKey, "/")
 
@@ -103,3 +110,47 @@ func (s *S3UploaderService) ObjectExists(ctx context.Context, objectKey string)
 
 	return false, err
 }
+
+// PutObject upload file dari server (bukan presign), object lama dengan key yang sama akan ditimpa
+func (s *S3UploaderService) PutObject(ctx context.Context, input PutObjectInput) error {
+	if input.ObjectKey == "" || input.ContentType == "" {
+		return errs.NewBadRequest("OBJECTKEY_CONTENTTYPE_REQUIRED")
+	}
+
+	_, err := s.s3.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:        aws.String(s.cfg.S3_BUCKET),
+		Key:           aws.String(input.ObjectKey),
+		ContentType:   aws.String(input.ContentType),
+		ContentLength: aws.Int64(int64(len(input.Body))),
+		Body:          bytes.NewReader(input.Body),
+	})
+	if err != nil {
+		return errs.NewInternalServerError(err)
+	}
+	return nil
+}
+
+// GetObject download isi file, return errs NotFound jika object tidak ada
+func (s *S3UploaderService) GetObject(ctx context.Context, objectKey string) ([]byte, error) {
+	out, err := s.s3.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.cfg.S3_BUCKET),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil {
+		var apiErr smithy.APIError
+		if errors.As(err, &apiErr) {
+			code := apiErr.ErrorCode()
+			if code == "NotFound" || code == "NoSuchKey" {
+				return nil, errs.NewNotFound("OBJECT_NOT_FOUND")
+			}
+		}
+		return nil, errs.NewInternalServerError(err)
+	}
+	defer out.Body.Close()
+
+	body, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+	return body, nil
+}
@@ -0,0 +1,14 @@
+// internal/module/headless/social_oauth/dto.go
+package social_oauth
+
+// AuthCodeURLInput is the input DTO for building provider consent URL
+type AuthCodeURLInput struct {
+	State       string `json:"state"`
+	RedirectURL string `json:"redirectUrl"`
+}
+
+// ExchangeInput is the input DTO for exchanging authorization code
+type ExchangeInput struct {
+	Code        string `json:"code"`
+	RedirectURL string `json:"redirectUrl"`
+}
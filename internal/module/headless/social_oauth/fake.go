@@ -0,0 +1,75 @@
+// internal/module/headless/social_oauth/fake.go
+package social_oauth
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// fakeProvider implements Provider without calling any platform (local / testing).
+// AuthCodeURL langsung mengarah ke redirect URL dengan code "fake-<uuid>".
+type fakeProvider struct {
+	platform entity.SocialPlatformType
+	ttl      time.Duration
+}
+
+// NewFakeProvider creates a provider that issues fake tokens for platform
+func NewFakeProvider(platform entity.SocialPlatformType) Provider {
+	return &fakeProvider{platform: platform, ttl: time.Hour}
+}
+
+// NewFakeProviders creates fake provider for every platform
+func NewFakeProviders(platforms ...entity.SocialPlatformType) map[entity.SocialPlatformType]Provider {
+	m := make(map[entity.SocialPlatformType]Provider, len(platforms))
+	for _, p := range platforms {
+		m[p] = NewFakeProvider(p)
+	}
+	return m
+}
+
+func (p *fakeProvider) AuthCodeURL(input AuthCodeURLInput) string {
+	q := url.Values{}
+	q.Set("code", "fake-"+uuid.NewString())
+	q.Set("state", input.State)
+	q.Set("platform", string(p.platform))
+
+	sep := "?"
+	if strings.Contains(input.RedirectURL, "?") {
+		sep = "&"
+	}
+	return input.RedirectURL + sep + q.Encode()
+}
+
+func (p *fakeProvider) Exchange(ctx context.Context, input ExchangeInput) (*OAuthToken, error) {
+	if !strings.HasPrefix(input.Code, "fake-") {
+		return nil, errs.NewBadRequest("SOCIAL_OAUTH_EXCHANGE_FAILED")
+	}
+	return p.issue("fake-account-" + strings.TrimPrefix(input.Code, "fake-")), nil
+}
+
+func (p *fakeProvider) Refresh(ctx context.Context, refreshToken string) (*OAuthToken, error) {
+	if !strings.HasPrefix(refreshToken, "fake-refresh-") {
+		return nil, errs.NewBadRequest("SOCIAL_OAUTH_REFRESH_FAILED")
+	}
+	return p.issue(""), nil
+}
+
+func (p *fakeProvider) issue(accountID string) *OAuthToken {
+	refresh := "fake-refresh-" + uuid.NewString()
+	exp := time.Now().Add(p.ttl)
+	return &OAuthToken{
+		AccessToken:       "fake-access-" + uuid.NewString(),
+		RefreshToken:      &refresh,
+		ExpiresAt:         &exp,
+		Scopes:            []string{"publish"},
+		ExternalAccountID: accountID,
+		AccountName:       "Fake " + string(p.platform),
+	}
+}
@@ -0,0 +1,76 @@
+// internal/module/headless/social_oauth/service.go
+package social_oauth
+
+import (
+	"context"
+	"slices"
+
+	"postmatic-api/config"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+)
+
+// Provider defines OAuth flow for a single social platform
+type Provider interface {
+	AuthCodeURL(input AuthCodeURLInput) string
+	Exchange(ctx context.Context, input ExchangeInput) (*OAuthToken, error)
+	Refresh(ctx context.Context, refreshToken string) (*OAuthToken, error)
+}
+
+// Service resolves Provider by platform
+type Service interface {
+	Provider(platform entity.SocialPlatformType) (Provider, error)
+}
+
+type socialOAuthService struct {
+	providers map[entity.SocialPlatformType]Provider
+}
+
+// NewService creates a new social OAuth registry
+func NewService(providers map[entity.SocialPlatformType]Provider) Service {
+	return &socialOAuthService{providers: providers}
+}
+
+func (s *socialOAuthService) Provider(platform entity.SocialPlatformType) (Provider, error) {
+	p, ok := s.providers[platform]
+	if !ok || p == nil {
+		return nil, errs.NewBadRequest("SOCIAL_PLATFORM_OAUTH_NOT_SUPPORTED")
+	}
+	return p, nil
+}
+
+// platforms yang boleh dipakai SOCIAL_OAUTH_FAKE_PLATFORMS
+var supportedPlatforms = []entity.SocialPlatformType{
+	entity.SocialPlatformTypeLinkedIn,
+	entity.SocialPlatformTypeFacebookPage,
+	entity.SocialPlatformTypeInstagramBusiness,
+	entity.SocialPlatformTypeWhatsappBusiness,
+	entity.SocialPlatformTypeTiktok,
+	entity.SocialPlatformTypeYoutube,
+	entity.SocialPlatformTypeTwitter,
+	entity.SocialPlatformTypePinterest,
+}
+
+// NewDefaultService creates registry sesuai SOCIAL_OAUTH_PROVIDER (sudah divalidasi saat config.Load).
+// Platform tanpa provider tidak didaftarkan, sehingga Provider mengembalikan SOCIAL_PLATFORM_OAUTH_NOT_SUPPORTED.
+func NewDefaultService(cfg *config.Config) Service {
+	switch cfg.SOCIAL_OAUTH_PROVIDER {
+	case "":
+		return NewService(map[entity.SocialPlatformType]Provider{})
+	case "fake":
+		platforms := make([]entity.SocialPlatformType, 0, len(cfg.SOCIAL_OAUTH_FAKE_PLATFORMS))
+		for _, p := range cfg.SOCIAL_OAUTH_FAKE_PLATFORMS {
+			platform := entity.SocialPlatformType(p)
+			if !slices.Contains(supportedPlatforms, platform) {
+				panic("unsupported SOCIAL_OAUTH_FAKE_PLATFORMS item: " + p)
+			}
+			platforms = append(platforms, platform)
+		}
+		logger.L().Warn("social OAuth memakai fake provider, connect akun sosial tidak menghubungi platform", "platforms", cfg.SOCIAL_OAUTH_FAKE_PLATFORMS)
+		return NewService(NewFakeProviders(platforms...))
+	default:
+		panic("unsupported SOCIAL_OAUTH_PROVIDER: " + cfg.SOCIAL_OAUTH_PROVIDER)
+	}
+}
@@ -0,0 +1,16 @@
+// internal/module/headless/social_oauth/viewmodel.go
+package social_oauth
+
+import "time"
+
+// OAuthToken is the credential + account info returned by provider
+type OAuthToken struct {
+	AccessToken  string     `json:"accessToken"`
+	RefreshToken *string    `json:"refreshToken"`
+	ExpiresAt    *time.Time `json:"expiresAt"`
+	Scopes       []string   `json:"scopes"`
+	// account info
+	ExternalAccountID string  `json:"externalAccountId"`
+	AccountName       string  `json:"accountName"`
+	AccountImageUrl   *string `json:"accountImageUrl"`
+}
@@ -0,0 +1,14 @@
+// internal/module/headless/social_publisher/dto.go
+package social_publisher
+
+import "postmatic-api/internal/repository/entity"
+
+// PublishInput is the input DTO for publishing a post to social platform
+type PublishInput struct {
+	Platform       entity.SocialPlatformType `json:"platform"`
+	BusinessRootID int64                     `json:"businessRootId"`
+	Caption        string                    `json:"caption"`
+	ImageUrls      []string                  `json:"imageUrls"`
+	// access token (plaintext) dari business_social_accounts, tidak pernah di-serialize
+	AccessToken string `json:"-"`
+}
@@ -0,0 +1,121 @@
+// internal/module/headless/social_publisher/http_stub.go
+package social_publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+)
+
+// maksimal response body yang disimpan ke delivery log
+const maxResponseBody = 4096
+
+// httpStubPublisher implements Publisher by POSTing the post to a stub server,
+// dipakai untuk menguji flow publish secara offline (mock server lokal).
+//
+// Request : POST {baseURL}/{platform}/posts  (Authorization: Bearer <access token>)
+// Response: 2xx {"id": "<external post id>"}
+type httpStubPublisher struct {
+	platform entity.SocialPlatformType
+	baseURL  string
+	client   *http.Client
+}
+
+// NewHTTPStubPublisher creates a publisher that talks to stub server at baseURL
+func NewHTTPStubPublisher(platform entity.SocialPlatformType, baseURL string, client *http.Client) Publisher {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return &httpStubPublisher{
+		platform: platform,
+		baseURL:  strings.TrimRight(baseURL, "/"),
+		client:   client,
+	}
+}
+
+// NewHTTPStubPublishers creates HTTP stub publisher for every platform
+func NewHTTPStubPublishers(baseURL string, platforms ...entity.SocialPlatformType) map[entity.SocialPlatformType]Publisher {
+	client := &http.Client{Timeout: 30 * time.Second}
+	m := make(map[entity.SocialPlatformType]Publisher, len(platforms))
+	for _, p := range platforms {
+		m[p] = NewHTTPStubPublisher(p, baseURL, client)
+	}
+	return m
+}
+
+type httpStubRequest struct {
+	BusinessRootID int64    `json:"businessRootId"`
+	Caption        string   `json:"caption"`
+	ImageUrls      []string `json:"imageUrls"`
+}
+
+type httpStubResponse struct {
+	ID string `json:"id"`
+}
+
+func (p *httpStubPublisher) Publish(ctx context.Context, input PublishInput) (*PublishResponse, error) {
+	if len(input.ImageUrls) == 0 {
+		return nil, errs.NewBadRequest("PUBLISH_IMAGE_URLS_REQUIRED")
+	}
+
+	b, err := json.Marshal(httpStubRequest{
+		BusinessRootID: input.BusinessRootID,
+		Caption:        input.Caption,
+		ImageUrls:      input.ImageUrls,
+	})
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+"/"+string(p.platform)+"/posts", bytes.NewReader(b))
+	if err != nil {
+		return nil, errs.NewInternalServerError(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if input.AccessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+input.AccessToken)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		// network / timeout -> retryable
+		return nil, &PublishError{Message: err.Error(), Retryable: true}
+	}
+	defer resp.Body.Close()
+
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseBody))
+	body := string(raw)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, &PublishError{
+			StatusCode: resp.StatusCode,
+			Response:   body,
+			Message:    http.StatusText(resp.StatusCode),
+			Retryable:  resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500,
+		}
+	}
+
+	var parsed httpStubResponse
+	if err := json.Unmarshal(raw, &parsed); err != nil || parsed.ID == "" {
+		return nil, &PublishError{
+			StatusCode: resp.StatusCode,
+			Response:   body,
+			Message:    "INVALID_PUBLISH_RESPONSE",
+		}
+	}
+
+	return &PublishResponse{
+		Platform:       p.platform,
+		ExternalPostID: parsed.ID,
+		PublishedAt:    time.Now(),
+		StatusCode:     resp.StatusCode,
+		RawResponse:    body,
+	}, nil
+}
@@ -0,0 +1,92 @@
+// internal/module/headless/social_publisher/memory.go
+package social_publisher
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+// InMemoryPublisher implements Publisher without calling any platform (local / testing).
+// Post yang berhasil disimpan di memory dan bisa dibaca lewat Posts().
+type InMemoryPublisher struct {
+	platform entity.SocialPlatformType
+	mu       sync.Mutex
+	posts    []PublishedPost
+	// jumlah percobaan awal yang sengaja digagalkan (simulasi error transient)
+	failFirst int
+	attempts  int
+}
+
+// NewInMemoryPublisher creates a publisher that only logs and keeps the post in memory
+func NewInMemoryPublisher(platform entity.SocialPlatformType) *InMemoryPublisher {
+	return &InMemoryPublisher{platform: platform}
+}
+
+// NewInMemoryPublishers creates in-memory publisher for every platform
+func NewInMemoryPublishers(platforms ...entity.SocialPlatformType) map[entity.SocialPlatformType]Publisher {
+	m := make(map[entity.SocialPlatformType]Publisher, len(platforms))
+	for _, p := range platforms {
+		m[p] = NewInMemoryPublisher(p)
+	}
+	return m
+}
+
+// FailFirst makes the first n publish calls fail with a retryable error
+func (p *InMemoryPublisher) FailFirst(n int) *InMemoryPublisher {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.failFirst = n
+	return p
+}
+
+// Posts returns copy of published posts
+func (p *InMemoryPublisher) Posts() []PublishedPost {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]PublishedPost(nil), p.posts...)
+}
+
+func (p *InMemoryPublisher) Publish(ctx context.Context, input PublishInput) (*PublishResponse, error) {
+	if len(input.ImageUrls) == 0 {
+		return nil, errs.NewBadRequest("PUBLISH_IMAGE_URLS_REQUIRED")
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.attempts++
+	if p.attempts <= p.failFirst {
+		return nil, &PublishError{
+			StatusCode: 503,
+			Response:   `{"error":"service unavailable"}`,
+			Message:    "SIMULATED_TRANSIENT_FAILURE",
+			Retryable:  true,
+		}
+	}
+
+	externalID := "mem-" + uuid.NewString()
+	res := PublishResponse{
+		Platform:       p.platform,
+		ExternalPostID: externalID,
+		PublishedAt:    time.Now(),
+		StatusCode:     200,
+		RawResponse:    `{"id":"` + externalID + `"}`,
+	}
+	p.posts = append(p.posts, PublishedPost{Input: input, Response: res})
+
+	logger.From(ctx).Info("In-memory publish post",
+		"platform", p.platform,
+		"businessRootId", input.BusinessRootID,
+		"images", len(input.ImageUrls),
+		"externalPostId", externalID,
+	)
+
+	return &res, nil
+}
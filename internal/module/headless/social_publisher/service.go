@@ -0,0 +1,106 @@
+// internal/module/headless/social_publisher/service.go
+package social_publisher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"postmatic-api/config"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+)
+
+// Publisher defines the contract for publishing post to a single social platform
+type Publisher interface {
+	Publish(ctx context.Context, input PublishInput) (*PublishResponse, error)
+}
+
+// Service resolves Publisher by platform and publishes through it
+type Service interface {
+	Publisher(platform entity.SocialPlatformType) (Publisher, error)
+	Publish(ctx context.Context, input PublishInput) (*PublishResponse, error)
+}
+
+type socialPublisherService struct {
+	publishers map[entity.SocialPlatformType]Publisher
+}
+
+// NewService creates a new social publisher registry
+func NewService(publishers map[entity.SocialPlatformType]Publisher) Service {
+	return &socialPublisherService{publishers: publishers}
+}
+
+func (s *socialPublisherService) Publisher(platform entity.SocialPlatformType) (Publisher, error) {
+	p, ok := s.publishers[platform]
+	if !ok || p == nil {
+		return nil, errs.NewBadRequest("SOCIAL_PLATFORM_PUBLISHER_NOT_SUPPORTED")
+	}
+	return p, nil
+}
+
+func (s *socialPublisherService) Publish(ctx context.Context, input PublishInput) (*PublishResponse, error) {
+	p, err := s.Publisher(input.Platform)
+	if err != nil {
+		return nil, err
+	}
+	return p.Publish(ctx, input)
+}
+
+// PublishError is returned by Publisher when platform rejects the post.
+// Retryable menentukan apakah percobaan berikutnya masih masuk akal (5xx / rate limit).
+type PublishError struct {
+	StatusCode int
+	Response   string
+	Message    string
+	Retryable  bool
+}
+
+func (e *PublishError) Error() string {
+	return fmt.Sprintf("publish failed (status %d): %s", e.StatusCode, e.Message)
+}
+
+// IsRetryable reports whether publish error is transient.
+// AppError (validasi / data tidak valid) tidak akan berhasil walau di-retry;
+// error lain (network, timeout) dianggap transient.
+func IsRetryable(err error) bool {
+	var pubErr *PublishError
+	if errors.As(err, &pubErr) {
+		return pubErr.Retryable
+	}
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) {
+		return appErr.Code >= 500
+	}
+	return true
+}
+
+// platforms yang didukung publisher default
+var defaultPlatforms = []entity.SocialPlatformType{
+	entity.SocialPlatformTypeLinkedIn,
+	entity.SocialPlatformTypeFacebookPage,
+	entity.SocialPlatformTypeInstagramBusiness,
+	entity.SocialPlatformTypeWhatsappBusiness,
+	entity.SocialPlatformTypeTiktok,
+	entity.SocialPlatformTypeYoutube,
+	entity.SocialPlatformTypeTwitter,
+	entity.SocialPlatformTypePinterest,
+}
+
+// NewDefaultService creates registry for all platforms sesuai SOCIAL_PUBLISHER (sudah divalidasi saat config.Load).
+func NewDefaultService(cfg *config.Config) Service {
+	var svc Service
+	switch cfg.SOCIAL_PUBLISHER {
+	case "memory":
+		svc = NewService(NewInMemoryPublishers(defaultPlatforms...))
+	case "http_stub":
+		svc = NewService(NewHTTPStubPublishers(cfg.SOCIAL_PUBLISHER_STUB_URL, defaultPlatforms...))
+	default:
+		panic("unsupported SOCIAL_PUBLISHER: " + cfg.SOCIAL_PUBLISHER)
+	}
+	if cfg.MODE == "production" {
+		logger.L().Warn("social publisher tidak mengirim ke platform", "publisher", cfg.SOCIAL_PUBLISHER)
+	}
+	return svc
+}
@@ -0,0 +1,24 @@
+// internal/module/headless/social_publisher/viewmodel.go
+package social_publisher
+
+import (
+	"time"
+
+	"postmatic-api/internal/repository/entity"
+)
+
+// PublishResponse is the output DTO after post published
+type PublishResponse struct {
+	Platform       entity.SocialPlatformType `json:"platform"`
+	ExternalPostID string                    `json:"externalPostId"`
+	PublishedAt    time.Time                 `json:"publishedAt"`
+	// raw response dari platform (untuk delivery log)
+	StatusCode  int    `json:"statusCode"`
+	RawResponse string `json:"rawResponse"`
+}
+
+// PublishedPost is a post recorded by the in-memory publisher
+type PublishedPost struct {
+	Input    PublishInput    `json:"input"`
+	Response PublishResponse `json:"response"`
+}
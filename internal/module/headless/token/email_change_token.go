@@ -0,0 +1,81 @@
+// internal/module/headless/token/email_change_token.go
+package token
+
+import (
+	"postmatic-api/pkg/errs"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type EmailChangeTokenPurpose string
+
+const (
+	// dikirim ke email baru, menerapkan perubahan
+	EmailChangeTokenPurposeConfirm EmailChangeTokenPurpose = "confirm"
+	// dikirim ke email lama, membatalkan / mengembalikan perubahan
+	EmailChangeTokenPurposeRevert EmailChangeTokenPurpose = "revert"
+)
+
+// EmailChangeTokenClaims: pasangan token confirm & revert berbagi jti (RegisteredClaims.ID) = id request
+type EmailChangeTokenClaims struct {
+	// Profile ID
+	ProfileID uuid.UUID               `json:"profileId"`
+	OldEmail  string                  `json:"oldEmail"`
+	NewEmail  string                  `json:"newEmail"`
+	Purpose   EmailChangeTokenPurpose `json:"purpose"`
+	jwt.RegisteredClaims
+}
+
+type GenerateEmailChangeTokenInput struct {
+	ProfileID uuid.UUID
+	OldEmail  string
+	NewEmail  string
+	RequestID string
+	Purpose   EmailChangeTokenPurpose
+}
+
+func (tm *TokenMaker) GenerateEmailChangeToken(input GenerateEmailChangeTokenInput) (string, error) {
+	expirationTime := time.Now().Add(tm.EmailChangeTTL(input.Purpose))
+	claims := &EmailChangeTokenClaims{
+		ProfileID: input.ProfileID,
+		OldEmail:  input.OldEmail,
+		NewEmail:  input.NewEmail,
+		Purpose:   input.Purpose,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        input.RequestID,
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(tm.emailChangeSecret)
+}
+
+// ValidateEmailChangeToken memastikan purpose sesuai, token revert tidak bisa dipakai untuk confirm & sebaliknya
+func (tm *TokenMaker) ValidateEmailChangeToken(tokenString string, purpose EmailChangeTokenPurpose) (*EmailChangeTokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &EmailChangeTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return tm.emailChangeSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errs.NewBadRequest("INVALID_EMAIL_CHANGE_TOKEN")
+	}
+
+	claims := token.Claims.(*EmailChangeTokenClaims)
+	if claims.Purpose != purpose {
+		return nil, errs.NewBadRequest("INVALID_EMAIL_CHANGE_TOKEN")
+	}
+	return claims, nil
+}
+
+// EmailChangeTTL lama berlaku token sesuai purpose
+func (tm *TokenMaker) EmailChangeTTL(purpose EmailChangeTokenPurpose) time.Duration {
+	if purpose == EmailChangeTokenPurposeRevert {
+		return tm.emailChangeRevertTTL
+	}
+	return tm.emailChangeTTL
+}
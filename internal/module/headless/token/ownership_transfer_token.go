@@ -0,0 +1,60 @@
+// internal/module/headless/token/ownership_transfer_token.go
+package token
+
+import (
+	"postmatic-api/pkg/errs"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type OwnershipTransferTokenClaims struct {
+	BusinessRootID int64 `json:"businessRootId"`
+	// owner saat ini
+	FromMemberID  int64     `json:"fromMemberId"`
+	FromProfileID uuid.UUID `json:"fromProfileId"`
+	// member yang dinominasikan
+	ToMemberID  int64     `json:"toMemberId"`
+	ToProfileID uuid.UUID `json:"toProfileId"`
+	jwt.RegisteredClaims
+}
+
+type GenerateOwnershipTransferTokenInput struct {
+	BusinessRootID int64
+	FromMemberID   int64
+	FromProfileID  uuid.UUID
+	ToMemberID     int64
+	ToProfileID    uuid.UUID
+}
+
+func (tm *TokenMaker) GenerateOwnershipTransferToken(input GenerateOwnershipTransferTokenInput) (string, time.Time, error) {
+	expirationTime := time.Now().Add(tm.ownershipTransferTTL)
+	claims := &OwnershipTransferTokenClaims{
+		BusinessRootID: input.BusinessRootID,
+		FromMemberID:   input.FromMemberID,
+		FromProfileID:  input.FromProfileID,
+		ToMemberID:     input.ToMemberID,
+		ToProfileID:    input.ToProfileID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString(tm.ownershipTransferSecret)
+	return signed, expirationTime, err
+}
+
+func (tm *TokenMaker) ValidateOwnershipTransferToken(tokenString string) (*OwnershipTransferTokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &OwnershipTransferTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return tm.ownershipTransferSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errs.NewBadRequest("INVALID_OWNERSHIP_TRANSFER_TOKEN")
+	}
+	return token.Claims.(*OwnershipTransferTokenClaims), nil
+}
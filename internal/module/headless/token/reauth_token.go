@@ -0,0 +1,55 @@
+// internal/module/headless/token/reauth_token.go
+package token
+
+import (
+	"postmatic-api/pkg/errs"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ReauthClaims: bukti user baru saja memasukkan ulang password (+ kode 2FA),
+// syarat aksi sensitif seperti link / unlink login provider. Berlaku singkat (JWT_REAUTH_TOKEN_EXPIRED).
+type ReauthClaims struct {
+	// Profile ID
+	ProfileID uuid.UUID `json:"profileId"`
+	jwt.RegisteredClaims
+}
+
+type GenerateReauthTokenInput struct {
+	ProfileID uuid.UUID
+}
+
+func (tm *TokenMaker) GenerateReauthToken(input GenerateReauthTokenInput) (string, error) {
+	expirationTime := time.Now().Add(tm.reauthTTL)
+	claims := &ReauthClaims{
+		ProfileID: input.ProfileID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(tm.reauthSecret)
+}
+
+func (tm *TokenMaker) ValidateReauthToken(tokenString string) (*ReauthClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &ReauthClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return tm.reauthSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errs.NewBadRequest("INVALID_REAUTH_TOKEN")
+	}
+	return token.Claims.(*ReauthClaims), nil
+}
+
+// ReauthTTL lama berlaku reauth token
+func (tm *TokenMaker) ReauthTTL() time.Duration {
+	return tm.reauthTTL
+}
@@ -26,6 +26,9 @@ func (tm *TokenMaker) GenerateRefreshToken(input GenerateRefreshTokenInput) (str
 		ID:    input.ID,
 		Email: input.Email,
 		RegisteredClaims: jwt.RegisteredClaims{
+			// jti unik: token hasil rotasi tidak pernah sama dengan token sebelumnya
+			ID:        uuid.NewString(),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
 	}
@@ -0,0 +1,65 @@
+// internal/module/headless/token/reset_password_token.go
+package token
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"postmatic-api/pkg/errs"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+type ResetPasswordTokenClaims struct {
+	// Profile ID
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"userId"`
+	Email  string    `json:"email"`
+	// fingerprint hash password saat token dibuat;
+	// token otomatis tidak berlaku lagi setelah password berubah (sekali pakai)
+	PasswordFingerprint string `json:"pwd"`
+	jwt.RegisteredClaims
+}
+
+type GenerateResetPasswordTokenInput struct {
+	ID             uuid.UUID
+	UserID         uuid.UUID
+	Email          string
+	HashedPassword string
+}
+
+func (tm *TokenMaker) GenerateResetPasswordToken(input GenerateResetPasswordTokenInput) (string, error) {
+	expirationTime := time.Now().Add(tm.resetPasswordTTL)
+	claims := &ResetPasswordTokenClaims{
+		ID:                  input.ID,
+		UserID:              input.UserID,
+		Email:               input.Email,
+		PasswordFingerprint: PasswordFingerprint(input.HashedPassword),
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(tm.resetPasswordSecret)
+}
+
+func (tm *TokenMaker) ValidateResetPasswordToken(tokenString string) (*ResetPasswordTokenClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &ResetPasswordTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return tm.resetPasswordSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errs.NewBadRequest("INVALID_RESET_PASSWORD_TOKEN")
+	}
+	return token.Claims.(*ResetPasswordTokenClaims), nil
+}
+
+// PasswordFingerprint mengembalikan sidik jari pendek dari hash password (bukan password asli)
+func PasswordFingerprint(hashedPassword string) string {
+	sum := sha256.Sum256([]byte(hashedPassword))
+	return hex.EncodeToString(sum[:8])
+}
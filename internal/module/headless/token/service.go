@@ -19,17 +19,44 @@ type TokenMaker struct {
 	// INVITATION
 	invitationSecret []byte
 	invitationTTL    time.Duration
+	// RESET PASSWORD
+	resetPasswordSecret []byte
+	resetPasswordTTL    time.Duration
+	// OWNERSHIP TRANSFER
+	ownershipTransferSecret []byte
+	ownershipTransferTTL    time.Duration
+	// TWO FACTOR CHALLENGE
+	twoFactorChallengeSecret []byte
+	twoFactorChallengeTTL    time.Duration
+	// REAUTH (konfirmasi ulang sebelum aksi sensitif)
+	reauthSecret []byte
+	reauthTTL    time.Duration
+	// EMAIL CHANGE (confirm ke email baru, revert ke email lama)
+	emailChangeSecret    []byte
+	emailChangeTTL       time.Duration
+	emailChangeRevertTTL time.Duration
 }
 
 func NewTokenMaker(cfg *config.Config) *TokenMaker {
 	return &TokenMaker{
-		accessSecret:        []byte(cfg.JWT_ACCESS_TOKEN_SECRET),
-		refreshSecret:       []byte(cfg.JWT_REFRESH_TOKEN_SECRET),
-		createAccountSecret: []byte(cfg.JWT_CREATE_ACCOUNT_TOKEN_SECRET),
-		accessTTL:           cfg.JWT_ACCESS_TOKEN_EXPIRED,
-		refreshTTL:          cfg.JWT_REFRESH_TOKEN_EXPIRED,
-		createAccountTTL:    cfg.JWT_CREATE_ACCOUNT_TOKEN_EXPIRED,
-		invitationSecret:    []byte(cfg.JWT_INVITATION_TOKEN_SECRET),
-		invitationTTL:       cfg.JWT_INVITATION_TOKEN_EXPIRED,
+		accessSecret:             []byte(cfg.JWT_ACCESS_TOKEN_SECRET),
+		refreshSecret:            []byte(cfg.JWT_REFRESH_TOKEN_SECRET),
+		createAccountSecret:      []byte(cfg.JWT_CREATE_ACCOUNT_TOKEN_SECRET),
+		accessTTL:                cfg.JWT_ACCESS_TOKEN_EXPIRED,
+		refreshTTL:               cfg.JWT_REFRESH_TOKEN_EXPIRED,
+		createAccountTTL:         cfg.JWT_CREATE_ACCOUNT_TOKEN_EXPIRED,
+		invitationSecret:         []byte(cfg.JWT_INVITATION_TOKEN_SECRET),
+		invitationTTL:            cfg.JWT_INVITATION_TOKEN_EXPIRED,
+		resetPasswordSecret:      []byte(cfg.JWT_RESET_PASSWORD_TOKEN_SECRET),
+		resetPasswordTTL:         cfg.JWT_RESET_PASSWORD_TOKEN_EXPIRED,
+		ownershipTransferSecret:  []byte(cfg.JWT_OWNERSHIP_TRANSFER_TOKEN_SECRET),
+		ownershipTransferTTL:     cfg.JWT_OWNERSHIP_TRANSFER_TOKEN_EXPIRED,
+		twoFactorChallengeSecret: []byte(cfg.JWT_TWO_FACTOR_CHALLENGE_SECRET),
+		twoFactorChallengeTTL:    cfg.JWT_TWO_FACTOR_CHALLENGE_EXPIRED,
+		reauthSecret:             []byte(cfg.JWT_REAUTH_TOKEN_SECRET),
+		reauthTTL:                cfg.JWT_REAUTH_TOKEN_EXPIRED,
+		emailChangeSecret:        []byte(cfg.JWT_EMAIL_CHANGE_TOKEN_SECRET),
+		emailChangeTTL:           cfg.JWT_EMAIL_CHANGE_TOKEN_EXPIRED,
+		emailChangeRevertTTL:     cfg.JWT_EMAIL_CHANGE_REVERT_EXPIRED,
 	}
 }
@@ -0,0 +1,65 @@
+// internal/module/headless/token/two_factor_challenge_token.go
+package token
+
+import (
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// TwoFactorChallengeClaims: bukti password / google login sudah lolos, menunggu kode 2FA.
+// Tidak bisa dipakai sebagai access token (secret berbeda), sekali pakai via jti (RegisteredClaims.ID).
+type TwoFactorChallengeClaims struct {
+	// Profile ID
+	ProfileID uuid.UUID           `json:"profileId"`
+	Email     string              `json:"email"`
+	Provider  entity.AuthProvider `json:"provider"`
+	// redirect setelah verifikasi (google login)
+	From string `json:"from"`
+	jwt.RegisteredClaims
+}
+
+type GenerateTwoFactorChallengeTokenInput struct {
+	ProfileID uuid.UUID
+	Email     string
+	Provider  entity.AuthProvider
+	From      string
+}
+
+func (tm *TokenMaker) GenerateTwoFactorChallengeToken(input GenerateTwoFactorChallengeTokenInput) (string, error) {
+	expirationTime := time.Now().Add(tm.twoFactorChallengeTTL)
+	claims := &TwoFactorChallengeClaims{
+		ProfileID: input.ProfileID,
+		Email:     input.Email,
+		Provider:  input.Provider,
+		From:      input.From,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	return token.SignedString(tm.twoFactorChallengeSecret)
+}
+
+func (tm *TokenMaker) ValidateTwoFactorChallengeToken(tokenString string) (*TwoFactorChallengeClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &TwoFactorChallengeClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return tm.twoFactorChallengeSecret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !token.Valid {
+		return nil, errs.NewBadRequest("INVALID_TWO_FACTOR_CHALLENGE_TOKEN")
+	}
+	return token.Claims.(*TwoFactorChallengeClaims), nil
+}
+
+// TwoFactorChallengeTTL lama berlaku challenge token (juga window limiter percobaan kode)
+func (tm *TokenMaker) TwoFactorChallengeTTL() time.Duration {
+	return tm.twoFactorChallengeTTL
+}
@@ -2,10 +2,15 @@
 package payment_common_handler
 
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 
 	"postmatic-api/internal/internal_middleware"
 	payment_common_service "postmatic-api/internal/module/payment/common/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/filter"
 	"postmatic-api/pkg/response"
 	"postmatic-api/pkg/utils"
@@ -25,28 +30,41 @@ func NewHandler(service *payment_common_service.PaymentCommonService, middleware
 	}
 }
 
-func (h *PaymentCommonHandler) Routes(allAllowedMiddleware func(http.Handler) http.Handler) http.Handler {
+func (h *PaymentCommonHandler) Routes(allAllowedMiddleware, adminOnlyMiddleware func(http.Handler) http.Handler) http.Handler {
 	r := chi.NewRouter()
 	r.Use(allAllowedMiddleware)
 
+	// Admin only routes
+	r.Route("/admin", func(r chi.Router) {
+		r.Use(adminOnlyMiddleware)
+		r.Post("/{id}/refund", h.RefundPaymentByAdmin)
+	})
+
 	// Profile-based routes
 	r.Get("/profile", h.GetPaymentHistoriesByProfile)
 
+	// Business document routes (invoice) with OwnedBusiness middleware
+	r.Route("/business/{businessId}", func(r chi.Router) {
+		r.Use(h.middleware.OwnedBusinessMiddleware)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBillingRead)).Get("/{id}/invoice", h.DownloadInvoiceByBusiness)
+	})
+
 	// Business-based routes with OwnedBusiness middleware
 	r.Route("/{businessId}", func(r chi.Router) {
 		r.Use(h.middleware.OwnedBusinessMiddleware)
 		r.Use(func(next http.Handler) http.Handler {
 			return internal_middleware.ReqFilterMiddleware(next, payment_common_service.SORT_BY)
 		})
-		r.Get("/", h.GetPaymentHistoriesByBusiness)
-		r.Get("/{id}", h.GetPaymentHistoryByIdAndBusiness)
-		r.Post("/{id}/cancel", h.CancelPaymentByBusiness)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBillingRead)).Get("/", h.GetPaymentHistoriesByBusiness)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBillingRead)).Get("/{id}", h.GetPaymentHistoryByIdAndBusiness)
+		r.With(h.middleware.RequirePermission(internal_middleware.PermBillingPurchase)).Post("/{id}/cancel", h.CancelPaymentByBusiness)
 	})
 
 	return r
 }
 
-// WebhookRoute returns the handler for webhook (no auth)
+// WebhookRoute returns the handler for webhook (no auth).
+// Dipasang di /payment/webhook (Midtrans, legacy) dan /payment/webhook/{gateway}
 func (h *PaymentCommonHandler) WebhookRoute() http.HandlerFunc {
 	return h.HandleWebhook
 }
@@ -207,6 +225,43 @@ func (h *PaymentCommonHandler) GetPaymentHistoryByIdAndBusiness(w http.ResponseW
 	response.OK(w, r, "PAYMENT_HISTORY_RETRIEVED", data)
 }
 
+// DownloadInvoiceByBusiness godoc
+// @Summary Download invoice pdf of a paid payment
+// @Tags Payment
+// @Produce application/pdf
+// @Param businessId path int true "Business Root ID"
+// @Param id path string true "Payment ID"
+// @Success 200 {file} file
+// @Router /api/payment/business/{businessId}/{id}/invoice [get]
+func (h *PaymentCommonHandler) DownloadInvoiceByBusiness(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.ValidationFailed(w, r, map[string]string{"id": "REQUIRED"})
+		return
+	}
+
+	// Get business context from middleware
+	ownedBusiness, err := internal_middleware.OwnedBusinessFromContext(ctx)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	file, err := h.service.GetInvoiceFileByBusiness(ctx, id, ownedBusiness.BusinessRootID)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	w.Header().Set("Content-Type", file.ContentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", file.FileName))
+	w.Header().Set("Content-Length", strconv.Itoa(len(file.Body)))
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(file.Body)
+}
+
 // CancelPaymentByBusiness godoc
 // @Summary Cancel a pending payment by business
 // @Tags Payment
@@ -241,26 +296,73 @@ func (h *PaymentCommonHandler) CancelPaymentByBusiness(w http.ResponseWriter, r
 	response.OK(w, r, "PAYMENT_CANCELED", data)
 }
 
+// RefundPaymentByAdmin godoc
+// @Summary Refund a success payment (full or partial)
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param id path string true "Payment ID"
+// @Param body body payment_common_service.RefundPaymentInput true "Refund amount (optional) and reason"
+// @Success 200 {object} response.Response{data=payment_common_service.RefundPaymentResponse}
+// @Router /api/payment/admin/{id}/refund [post]
+func (h *PaymentCommonHandler) RefundPaymentByAdmin(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	id := chi.URLParam(r, "id")
+	if id == "" {
+		response.ValidationFailed(w, r, map[string]string{"id": "REQUIRED"})
+		return
+	}
+
+	claims, err := internal_middleware.GetProfileFromContext(ctx)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var req payment_common_service.RefundPaymentInput
+	if appErr := utils.ValidateStruct(r.Body, &req); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+	req.PaymentID = id
+	req.AdminProfileID = claims.ID
+
+	data, err := h.service.RefundPaymentByAdmin(ctx, req)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "PAYMENT_REFUNDED", data)
+}
+
 // HandleWebhook godoc
-// @Summary Handle Midtrans webhook
+// @Summary Handle payment gateway webhook
+// @Description Raw body diverifikasi oleh gateway terkait (Midtrans: signature_key, fake: HMAC-SHA256). Tanpa {gateway} = midtrans.
 // @Tags Payment
 // @Accept json
 // @Produce json
-// @Param body body payment_common_service.MidtransNotification true "Midtrans notification"
+// @Param gateway path string false "Payment gateway (midtrans, fake)"
+// @Param body body object true "Gateway notification payload"
 // @Success 200 {object} response.Response
 // @Router /api/payment/webhook [post]
+// @Router /api/payment/webhook/{gateway} [post]
 func (h *PaymentCommonHandler) HandleWebhook(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var notification payment_common_service.MidtransNotification
+	gateway := entity.PaymentGatewayTypeMidtrans
+	if param := chi.URLParam(r, "gateway"); param != "" {
+		gateway = entity.PaymentGatewayType(param)
+	}
 
-	// Validate request body with utils.ValidateStruct
-	if appErr := utils.ValidateStruct(r.Body, &notification); appErr != nil {
-		response.ValidationFailed(w, r, appErr.ValidationErrors)
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		response.Error(w, r, errs.NewBadRequest("INVALID_WEBHOOK_PAYLOAD"), nil)
 		return
 	}
 
-	err := h.service.HandleWebhook(ctx, notification)
+	err = h.service.HandleWebhook(ctx, gateway, body)
 	if err != nil {
 		response.Error(w, r, err, nil)
 		return
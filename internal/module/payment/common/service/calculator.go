@@ -1,5 +1,5 @@
-// internal/module/payment/image_token/service/calculator.go
-package image_token_service
+// internal/module/payment/common/service/calculator.go
+package payment_common_service
 
 import "math"
 
@@ -0,0 +1,160 @@
+// internal/module/payment/common/service/checkout.go
+package payment_common_service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/module/headless/payment_gateway"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+)
+
+// ChargePayment charges a created (pending) payment history via payment gateway (sesuai gateway payment method),
+// lalu simpan actions, gateway transaction id dan kirim email checkout.
+// Dipakai oleh semua checkout product (token, creator image) setelah payment history dibuat.
+func (s *PaymentCommonService) ChargePayment(ctx context.Context, input ChargePaymentInput) (ChargePaymentResult, error) {
+	var result ChargePaymentResult
+	payment := input.Payment
+
+	// 1. Get profile for customer details
+	profile, err := s.store.GetProfileById(ctx, payment.ProfileID)
+	if err != nil {
+		logger.From(ctx).Error("Failed to get profile for payment", "profileID", payment.ProfileID, "error", err)
+		return result, errs.NewBadRequest("PROFILE_NOT_FOUND")
+	}
+
+	gateway, err := s.gateway.Gateway(payment.Gateway)
+	if err != nil {
+		return result, err
+	}
+
+	// 2. Charge via gateway based on payment method type
+	res, err := gateway.Charge(ctx, payment_gateway.ChargeInput{
+		OrderID:           input.OrderID,
+		GrossAmount:       payment.TotalAmount,
+		Currency:          payment.Currency,
+		PaymentMethodCode: input.PaymentMethodCode,
+		PaymentMethodType: entity.AppPaymentMethodType(input.PaymentMethodType),
+		CustomerDetails: payment_gateway.CustomerDetails{
+			Name:  profile.Name,
+			Email: profile.Email,
+		},
+		Items: []payment_gateway.ItemDetail{
+			{
+				ID:       input.ItemID,
+				Name:     input.ItemName,
+				Price:    payment.TotalAmount,
+				Quantity: 1,
+			},
+		},
+	})
+	if err != nil {
+		return result, err
+	}
+	result.GatewayTransactionID = res.TransactionID
+
+	// 3. Save actions to DB
+	for _, action := range res.Actions {
+		_, err := s.store.CreatePaymentHistoryAction(ctx, entity.CreatePaymentHistoryActionParams{
+			PaymentHistoryID: payment.ID,
+			Name:             action.Name,
+			Label:            action.Label,
+			Value:            action.Value,
+			ValueType:        action.ValueType,
+			PaymentType:      action.PaymentType,
+			ActionMethod:     action.Method,
+			IsPublic:         action.IsPublic,
+		})
+		if err != nil {
+			// Log but don't fail - payment is already created
+			continue
+		}
+	}
+
+	// 4. Update payment history with gateway transaction ID
+	_, _ = s.store.UpdatePaymentHistoryMidtransId(ctx, entity.UpdatePaymentHistoryMidtransIdParams{
+		ID:                    payment.ID,
+		MidtransTransactionID: utils.StringToNullString(&result.GatewayTransactionID),
+	})
+
+	// 5. Fetch public actions for response
+	publicActions, _ := s.store.GetPublicPaymentHistoryActionsByPaymentId(ctx, payment.ID)
+
+	result.Actions = make([]PaymentActionResponse, len(publicActions))
+	for i, action := range publicActions {
+		result.Actions[i] = PaymentActionResponse{
+			Name:      action.Name,
+			Label:     action.Label,
+			Value:     action.Value,
+			ValueType: string(action.ValueType),
+			Method:    action.ActionMethod,
+		}
+	}
+
+	// 6. Send checkout confirmation email (async via queue)
+	go func() {
+		ctxBg, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// Build actions for email
+		emailActions := make([]mailer.PaymentActionInput, len(publicActions))
+		for i, action := range publicActions {
+			emailActions[i] = mailer.PaymentActionInput{
+				Label:     action.Label,
+				Value:     action.Value,
+				ValueType: string(action.ValueType),
+			}
+		}
+
+		// Format expiry time
+		var expiresAtStr string
+		if payment.MidtransExpiredAt.Valid {
+			expiresAtStr = payment.MidtransExpiredAt.Time.Format("02 Jan 2006, 15:04 WIB")
+		}
+
+		// Format total amount
+		totalAmountStr := formatCurrencyAmount(payment.Currency, payment.TotalAmount)
+
+		err := s.queue.EnqueuePaymentCheckout(ctxBg, mailer.PaymentCheckoutInputDTO{
+			Email:         profile.Email,
+			Name:          profile.Name,
+			OrderID:       input.OrderID,
+			ProductName:   input.ItemName,
+			PaymentMethod: input.PaymentMethodName,
+			TotalAmount:   totalAmountStr,
+			ExpiresAt:     expiresAtStr,
+			Actions:       emailActions,
+		})
+		if err != nil {
+			logger.L().Error("Failed to enqueue checkout email", "orderID", input.OrderID, "error", err)
+		}
+	}()
+
+	return result, nil
+}
+
+// ValidatePaymentCurrency checks the payment method gateway can charge the checkout currency
+func (s *PaymentCommonService) ValidatePaymentCurrency(gatewayType entity.PaymentGatewayType, currency string) error {
+	gateway, err := s.gateway.Gateway(gatewayType)
+	if err != nil {
+		return err
+	}
+	if !gateway.SupportsCurrency(strings.ToUpper(currency)) {
+		return errs.NewBadRequest("PAYMENT_CURRENCY_NOT_SUPPORTED")
+	}
+	return nil
+}
+
+// formatCurrencyAmount formats amount (minor unit) for email (IDR -> "Rp 10.000", lainnya -> "USD 10,50")
+func formatCurrencyAmount(currency string, amount int64) string {
+	if currency == "IDR" {
+		return "Rp " + exchange_rate_service.FormatMinorAmount(currency, amount)
+	}
+	return currency + " " + exchange_rate_service.FormatMinorAmount(currency, amount)
+}
@@ -0,0 +1,60 @@
+// internal/module/payment/common/service/dto.go
+package payment_common_service
+
+import (
+	"postmatic-api/internal/repository/entity"
+
+	"github.com/google/uuid"
+)
+
+// RefundPaymentInput is the input for admin refund (full or partial)
+type RefundPaymentInput struct {
+	// Amount nil = refund seluruh sisa yang belum di-refund
+	Amount *int64 `json:"amount" validate:"omitempty,min=1"`
+	Reason string `json:"reason" validate:"required,max=255"`
+
+	PaymentID      string
+	AdminProfileID uuid.UUID
+}
+
+// applyRefundInput is the input for recording a refund that already happened in the payment gateway
+type applyRefundInput struct {
+	Source entity.PaymentRefundSource
+	// RefundKey (admin) = row pending yang diselesaikan, kosong untuk refund dari webhook
+	RefundKey string
+	// CumulativeAmount (webhook) total refund menurut gateway (bukan delta), agar webhook idempotent
+	CumulativeAmount int64
+}
+
+// ReconcilePendingPaymentsResult ringkasan satu kali run reconcile (untuk log)
+type ReconcilePendingPaymentsResult struct {
+	Checked int
+	Updated int
+	Expired int
+	Failed  int
+}
+
+// PriceCalculationInput is internal input for price calculation
+type PriceCalculationInput struct {
+	BasePrice     int64  // harga asli product
+	DiscountType  string // "fixed" atau "percentage"
+	DiscountValue int64  // nilai diskon (nominal atau %)
+	MaxDiscount   int64  // max cap untuk percentage
+	AdminFeeType  string // "fixed" atau "percentage"
+	AdminFeeValue int64  // nilai admin fee
+	TaxPercentage int64  // tax percentage
+}
+
+// ChargePaymentInput is the input for charging a created (pending) payment history via payment gateway
+type ChargePaymentInput struct {
+	Payment entity.PaymentHistory
+	OrderID string
+
+	PaymentMethodCode string
+	PaymentMethodName string
+	PaymentMethodType string
+
+	// item yang dikirim ke payment gateway & email checkout
+	ItemID   string
+	ItemName string
+}
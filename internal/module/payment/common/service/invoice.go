@@ -0,0 +1,194 @@
+// internal/module/payment/common/service/invoice.go
+package payment_common_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"postmatic-api/internal/module/headless/s3_uploader"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+
+	"github.com/google/uuid"
+)
+
+const invoiceContentType = "application/pdf"
+
+// tanggal & tahun penomoran invoice mengikuti WIB (sama dengan email payment)
+var invoiceLocation = time.FixedZone("WIB", 7*60*60)
+
+// IssueInvoice menerbitkan invoice (nomor berurutan per tahun + file pdf di s3) untuk payment yang sudah dibayar.
+// Idempotent per payment: jika invoice sudah ada, invoice tersebut yang dikembalikan.
+func (s *PaymentCommonService) IssueInvoice(ctx context.Context, paymentID uuid.UUID) (entity.PaymentInvoice, error) {
+	var invoice entity.PaymentInvoice
+	var payment entity.PaymentHistory
+	issued := false
+
+	txErr := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		// lock payment agar invoice untuk payment yang sama tidak diterbitkan dua kali
+		var err error
+		payment, err = q.GetPaymentHistoryByIdForUpdate(ctx, paymentID)
+		if err == sql.ErrNoRows {
+			return errs.NewNotFound("PAYMENT_NOT_FOUND")
+		}
+		if err != nil {
+			return err
+		}
+		if !isInvoiceablePayment(payment) {
+			return errs.NewBadRequest("PAYMENT_NOT_PAID")
+		}
+
+		existing, err := q.GetPaymentInvoiceByPaymentHistoryId(ctx, payment.ID)
+		if err == nil {
+			invoice = existing
+			return nil
+		}
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		billTo, err := q.GetPaymentInvoiceBillTo(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+
+		issuedAt := time.Now().In(invoiceLocation)
+		year := issuedAt.Year()
+		sequence, err := q.NextPaymentInvoiceSequence(ctx, int32(year))
+		if err != nil {
+			return err
+		}
+		invoiceNumber := formatInvoiceNumber(year, sequence)
+
+		invoice, err = q.CreatePaymentInvoice(ctx, entity.CreatePaymentInvoiceParams{
+			PaymentHistoryID:   payment.ID,
+			BusinessRootID:     payment.BusinessRootID,
+			InvoiceNumber:      invoiceNumber,
+			InvoiceYear:        int32(year),
+			InvoiceSequence:    sequence,
+			BillToName:         billTo.ProfileName,
+			BillToEmail:        billTo.ProfileEmail,
+			BillToBusinessName: billTo.BusinessName,
+			ObjectKey:          s.s3.InvoiceObjectKey(year, invoiceNumber),
+			IssuedAt:           issuedAt,
+		})
+		if err != nil {
+			return err
+		}
+		issued = true
+		return nil
+	})
+	if txErr != nil {
+		var appErr *errs.AppError
+		if errors.As(txErr, &appErr) {
+			return entity.PaymentInvoice{}, appErr
+		}
+		return entity.PaymentInvoice{}, errs.NewInternalServerError(txErr)
+	}
+
+	if !issued {
+		return invoice, nil
+	}
+
+	// nomor invoice sudah di-commit sebelum upload agar lock sequence per tahun tidak ditahan selama network I/O,
+	// jika upload gagal file dibuat ulang saat invoice di-download (GetInvoiceFileByBusiness)
+	if err := s.uploadInvoicePDF(ctx, invoice, payment); err != nil {
+		return entity.PaymentInvoice{}, err
+	}
+
+	logger.From(ctx).Info("Payment invoice issued", "paymentID", paymentID, "invoiceNumber", invoice.InvoiceNumber)
+	return invoice, nil
+}
+
+// GetInvoiceFileByBusiness returns invoice pdf of a paid payment owned by business,
+// invoice diterbitkan saat itu juga jika belum ada (ex: payment success sebelum fitur invoice)
+func (s *PaymentCommonService) GetInvoiceFileByBusiness(ctx context.Context, id string, businessRootID int64) (InvoiceFileResponse, error) {
+	paymentID, err := uuid.Parse(id)
+	if err != nil {
+		return InvoiceFileResponse{}, errs.NewBadRequest("INVALID_PAYMENT_ID")
+	}
+
+	payment, err := s.store.GetPaymentHistoryByIdAndBusiness(ctx, entity.GetPaymentHistoryByIdAndBusinessParams{
+		ID:             paymentID,
+		BusinessRootID: businessRootID,
+	})
+	if err == sql.ErrNoRows {
+		return InvoiceFileResponse{}, errs.NewNotFound("PAYMENT_NOT_FOUND")
+	}
+	if err != nil {
+		return InvoiceFileResponse{}, errs.NewInternalServerError(err)
+	}
+	if !isInvoiceablePayment(payment) {
+		return InvoiceFileResponse{}, errs.NewBadRequest("PAYMENT_NOT_PAID")
+	}
+
+	invoice, err := s.store.GetPaymentInvoiceByPaymentHistoryId(ctx, payment.ID)
+	if err == sql.ErrNoRows {
+		invoice, err = s.IssueInvoice(ctx, payment.ID)
+		if err != nil {
+			return InvoiceFileResponse{}, err
+		}
+	} else if err != nil {
+		return InvoiceFileResponse{}, errs.NewInternalServerError(err)
+	}
+
+	body, err := s.s3.GetObject(ctx, invoice.ObjectKey)
+	var appErr *errs.AppError
+	if errors.As(err, &appErr) && appErr.Code == http.StatusNotFound {
+		// file hilang dari storage: render ulang dari record invoice (nomor & bill to tetap sama)
+		logger.From(ctx).Warn("Invoice file not found, regenerating", "paymentID", payment.ID, "invoiceNumber", invoice.InvoiceNumber)
+		if err := s.uploadInvoicePDF(ctx, invoice, payment); err != nil {
+			return InvoiceFileResponse{}, err
+		}
+		body, err = s.s3.GetObject(ctx, invoice.ObjectKey)
+	}
+	if err != nil {
+		return InvoiceFileResponse{}, err
+	}
+
+	return InvoiceFileResponse{
+		InvoiceNumber: invoice.InvoiceNumber,
+		FileName:      invoice.InvoiceNumber + ".pdf",
+		ContentType:   invoiceContentType,
+		Body:          body,
+	}, nil
+}
+
+// issueInvoiceAsync menerbitkan invoice di background setelah payment success,
+// kegagalan hanya di-log karena invoice tetap bisa diterbitkan saat pertama kali di-download
+func (s *PaymentCommonService) issueInvoiceAsync(payment entity.PaymentHistory) {
+	go func() {
+		ctxBg, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if _, err := s.IssueInvoice(ctxBg, payment.ID); err != nil {
+			logger.L().Error("Failed to issue payment invoice", "paymentID", payment.ID, "error", err)
+		}
+	}()
+}
+
+func (s *PaymentCommonService) uploadInvoicePDF(ctx context.Context, invoice entity.PaymentInvoice, payment entity.PaymentHistory) error {
+	return s.s3.PutObject(ctx, s3_uploader.PutObjectInput{
+		ObjectKey:   invoice.ObjectKey,
+		ContentType: invoiceContentType,
+		Body:        renderInvoicePDF(s.appName, invoice, payment),
+	})
+}
+
+// isInvoiceablePayment: payment pernah success (payment yang sudah di-refund tetap punya invoice penjualan aslinya)
+func isInvoiceablePayment(payment entity.PaymentHistory) bool {
+	if !payment.PaymentSuccessAt.Valid {
+		return false
+	}
+	return payment.Status == entity.PaymentStatusSuccess || payment.Status == entity.PaymentStatusRefunded
+}
+
+// formatInvoiceNumber ex: INV-2026-000001
+func formatInvoiceNumber(year int, sequence int32) string {
+	return fmt.Sprintf("INV-%d-%06d", year, sequence)
+}
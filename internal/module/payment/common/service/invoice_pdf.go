@@ -0,0 +1,135 @@
+// internal/module/payment/common/service/invoice_pdf.go
+package payment_common_service
+
+import (
+	"fmt"
+	"strings"
+
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/pdf"
+)
+
+const (
+	invoiceMarginX   = 50.0
+	invoiceDateFmt   = "02 Jan 2006, 15:04 WIB"
+	invoiceRowHeight = 24.0
+	invoiceLineGap   = 12.0
+	invoiceBillToX   = 340.0
+	// kolom amount selebar "- IDR 999.999.999.999"
+	invoiceAmountWidth = 120.0
+)
+
+// invoiceLineItem satu baris rincian invoice, Amount negatif untuk potongan (diskon)
+type invoiceLineItem struct {
+	Description string
+	Amount      int64
+}
+
+// buildInvoiceLineItems menyusun rincian dari field yang tercatat di payment history
+func buildInvoiceLineItems(payment entity.PaymentHistory) []invoiceLineItem {
+	items := []invoiceLineItem{
+		{Description: payment.RecordProductName, Amount: payment.SubtotalItemAmount},
+	}
+
+	if payment.DiscountAmount > 0 {
+		label := "Discount"
+		if payment.DiscountPercentage.Valid {
+			label = fmt.Sprintf("Discount (%d%%)", payment.DiscountPercentage.Int32)
+		}
+		items = append(items, invoiceLineItem{Description: label, Amount: -payment.DiscountAmount})
+	}
+
+	if payment.AdminFeeAmount > 0 {
+		label := "Admin Fee"
+		if payment.AdminFeePercentage.Valid {
+			label = fmt.Sprintf("Admin Fee (%d%%)", payment.AdminFeePercentage.Int32)
+		}
+		items = append(items, invoiceLineItem{Description: label, Amount: payment.AdminFeeAmount})
+	}
+
+	items = append(items, invoiceLineItem{
+		Description: fmt.Sprintf("Tax (%d%%)", payment.TaxPercentage),
+		Amount:      payment.TaxAmount,
+	})
+
+	return items
+}
+
+// renderInvoicePDF membuat file pdf invoice (satu halaman A4)
+func renderInvoicePDF(appName string, invoice entity.PaymentInvoice, payment entity.PaymentHistory) []byte {
+	doc := pdf.New("Invoice " + invoice.InvoiceNumber)
+	page := doc.AddPage()
+	right := pdf.PageWidth - invoiceMarginX
+
+	// header
+	page.Text(invoiceMarginX, 70, pdf.FontBold, 24, "INVOICE")
+	page.TextRight(right, 62, pdf.FontBold, 14, appName)
+	page.TextRight(right, 78, pdf.FontRegular, 10, invoice.InvoiceNumber)
+	page.Line(invoiceMarginX, 95, right, 95, 1)
+
+	// detail invoice (kiri) & bill to (kanan)
+	y := 120.0
+	details := [][2]string{
+		{"Invoice Number", invoice.InvoiceNumber},
+		{"Issued At", invoice.IssuedAt.In(invoiceLocation).Format(invoiceDateFmt)},
+		{"Paid At", payment.PaymentSuccessAt.Time.In(invoiceLocation).Format(invoiceDateFmt)},
+		{"Order ID", payment.MidtransTransactionID.String},
+		{"Payment Method", payment.PaymentMethod},
+	}
+	detailValueX := invoiceMarginX + 90
+	for i, d := range details {
+		page.Text(invoiceMarginX, y+float64(i)*16, pdf.FontBold, 9, d[0])
+		page.Text(detailValueX, y+float64(i)*16, pdf.FontRegular, 9,
+			pdf.ClipText(pdf.FontRegular, 9, invoiceBillToX-10-detailValueX, d[1]))
+	}
+
+	page.Text(invoiceBillToX, y, pdf.FontBold, 9, "Bill To")
+	billTo := []string{}
+	if invoice.BillToBusinessName.Valid {
+		billTo = append(billTo, invoice.BillToBusinessName.String)
+	}
+	billTo = append(billTo, invoice.BillToName, invoice.BillToEmail)
+	for i, line := range billTo {
+		page.Text(invoiceBillToX, y+float64(i+1)*16, pdf.FontRegular, 9,
+			pdf.ClipText(pdf.FontRegular, 9, right-invoiceBillToX, line))
+	}
+
+	// tabel rincian
+	y = 230.0
+	page.FillRect(invoiceMarginX, y, right-invoiceMarginX, invoiceRowHeight, 0.92)
+	page.Text(invoiceMarginX+10, y+16, pdf.FontBold, 10, "Description")
+	page.TextRight(right-10, y+16, pdf.FontBold, 10, "Amount")
+	y += invoiceRowHeight
+
+	// deskripsi panjang (ex: nama produk) di-wrap agar tidak menabrak kolom amount
+	descriptionWidth := right - 10 - invoiceAmountWidth - (invoiceMarginX + 10)
+	for _, item := range buildInvoiceLineItems(payment) {
+		lines := pdf.WrapText(pdf.FontRegular, 10, descriptionWidth, item.Description)
+		for i, line := range lines {
+			page.Text(invoiceMarginX+10, y+16+float64(i)*invoiceLineGap, pdf.FontRegular, 10, line)
+		}
+		page.TextRight(right-10, y+16, pdf.FontRegular, 10, formatInvoiceAmount(payment.Currency, item.Amount))
+		y += invoiceRowHeight + float64(len(lines)-1)*invoiceLineGap
+		page.Line(invoiceMarginX, y, right, y, 0.5)
+	}
+
+	// total
+	page.Text(invoiceMarginX+10, y+20, pdf.FontBold, 12, "Total")
+	page.TextRight(right-10, y+20, pdf.FontBold, 12, formatInvoiceAmount(payment.Currency, payment.TotalAmount))
+	page.Line(invoiceMarginX, y+30, right, y+30, 1)
+
+	page.Text(invoiceMarginX, y+60, pdf.FontBold, 10, "Status: PAID")
+	page.Text(invoiceMarginX, pdf.PageHeight-50, pdf.FontRegular, 8,
+		fmt.Sprintf("This invoice was generated electronically by %s and is valid without a signature.", appName))
+
+	return doc.Bytes()
+}
+
+// formatInvoiceAmount ex: IDR 10.000 / - IDR 5.000 / USD 10,50
+func formatInvoiceAmount(currency string, amount int64) string {
+	if amount < 0 {
+		return fmt.Sprintf("- %s %s", strings.ToUpper(currency), exchange_rate_service.FormatMinorAmount(currency, -amount))
+	}
+	return fmt.Sprintf("%s %s", strings.ToUpper(currency), exchange_rate_service.FormatMinorAmount(currency, amount))
+}
@@ -1,17 +0,0 @@
-// internal/module/payment/common/service/midtrans.go
-package payment_common_service
-
-// MidtransNotification is the webhook payload from Midtrans
-type MidtransNotification struct {
-	TransactionTime   string `json:"transaction_time"`
-	TransactionStatus string `json:"transaction_status"`
-	TransactionID     string `json:"transaction_id"`
-	StatusMessage     string `json:"status_message"`
-	StatusCode        string `json:"status_code"`
-	SignatureKey      string `json:"signature_key"`
-	OrderID           string `json:"order_id"`
-	MerchantID        string `json:"merchant_id"`
-	GrossAmount       string `json:"gross_amount"`
-	FraudStatus       string `json:"fraud_status"`
-	Currency          string `json:"currency"`
-}
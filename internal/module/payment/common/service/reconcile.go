@@ -0,0 +1,112 @@
+// internal/module/payment/common/service/reconcile.go
+package payment_common_service
+
+import (
+	"context"
+	"time"
+
+	"postmatic-api/internal/module/headless/queue"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/logger"
+)
+
+// ReconcilePendingPayments dijalankan periodik oleh worker (asynq scheduler) untuk payment
+// yang masih pending lebih dari PendingAfter, misal karena webhook gateway hilang.
+// Status dicek ulang ke payment gateway lalu diproses lewat jalur yang sama dengan webhook;
+// payment yang masih pending setelah MidtransExpiredAt di-expire.
+func (s *PaymentCommonService) ReconcilePendingPayments(ctx context.Context, payload queue.ReconcilePendingPaymentsPayload) error {
+	log := logger.From(ctx)
+
+	payments, err := s.store.GetStalePendingPaymentHistories(ctx, entity.GetStalePendingPaymentHistoriesParams{
+		PendingBefore: time.Now().Add(-payload.PendingAfter),
+		RowLimit:      payload.Limit,
+	})
+	if err != nil {
+		return err
+	}
+	if len(payments) == 0 {
+		return nil
+	}
+
+	var result ReconcilePendingPaymentsResult
+	for _, payment := range payments {
+		if ctx.Err() != nil {
+			break
+		}
+		result.Checked++
+
+		newStatus, err := s.reconcilePendingPayment(ctx, payment)
+		if err != nil {
+			result.Failed++
+			log.Error("Failed to reconcile pending payment", "paymentID", payment.ID, "error", err)
+			continue
+		}
+		switch newStatus {
+		case "pending":
+		case "expired":
+			result.Expired++
+		default:
+			result.Updated++
+		}
+	}
+
+	log.Info("Pending payments reconciled",
+		"checked", result.Checked,
+		"updated", result.Updated,
+		"expired", result.Expired,
+		"failed", result.Failed,
+	)
+	return nil
+}
+
+// reconcilePendingPayment returns status akhir payment setelah dicek ke payment gateway
+func (s *PaymentCommonService) reconcilePendingPayment(ctx context.Context, payment entity.PaymentHistory) (string, error) {
+	log := logger.From(ctx)
+
+	gateway, err := s.gateway.Gateway(payment.Gateway)
+	if err != nil {
+		return "", err
+	}
+
+	if payment.MidtransTransactionID.Valid {
+		gatewayStatus, err := gateway.CheckStatus(ctx, payment.MidtransTransactionID.String)
+		if err != nil {
+			// status di gateway tidak diketahui: jangan expire lokal, bisa saja sudah dibayar
+			return "", err
+		}
+
+		newStatus := gatewayStatus.Status
+		if newStatus == "refunded" {
+			// refund untuk payment yang belum tercatat success dicatat lewat webhook / admin
+			log.Warn("Pending payment already refunded in gateway", "paymentID", payment.ID, "gateway", payment.Gateway)
+			return string(payment.Status), nil
+		}
+		if newStatus != string(payment.Status) {
+			log.Info("Updating payment status from reconcile", "paymentID", payment.ID, "oldStatus", payment.Status, "newStatus", newStatus)
+			updated, err := s.applyStatusChange(ctx, payment, newStatus)
+			if err != nil {
+				return "", err
+			}
+			return string(updated.Status), nil
+		}
+	}
+
+	// Masih pending di gateway (atau belum punya transaksi gateway) dan sudah lewat batas bayar
+	if !payment.MidtransExpiredAt.Valid || time.Now().Before(payment.MidtransExpiredAt.Time) {
+		return string(payment.Status), nil
+	}
+
+	if payment.MidtransTransactionID.Valid {
+		if _, err := gateway.Expire(ctx, payment.MidtransTransactionID.String); err != nil {
+			log.Error("Failed to expire in gateway", "paymentID", payment.ID, "gateway", payment.Gateway, "error", err)
+			// Continue anyway, expire lokal tetap dilakukan
+		}
+	}
+
+	log.Info("Expiring pending payment past gateway expiry", "paymentID", payment.ID, "expiredAt", payment.MidtransExpiredAt.Time)
+	updated, err := s.applyStatusChange(ctx, payment, string(entity.PaymentStatusExpired))
+	if err != nil {
+		return "", err
+	}
+	return string(updated.Status), nil
+}
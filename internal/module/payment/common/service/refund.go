@@ -0,0 +1,437 @@
+// internal/module/payment/common/service/refund.go
+package payment_common_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/module/headless/payment_gateway"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+	"postmatic-api/pkg/logger"
+	"postmatic-api/pkg/utils"
+
+	"github.com/google/uuid"
+)
+
+// RefundPaymentByAdmin refunds a success payment via its payment gateway then records it locally
+// Amount nil = refund seluruh sisa nominal yang belum di-refund
+func (s *PaymentCommonService) RefundPaymentByAdmin(ctx context.Context, input RefundPaymentInput) (RefundPaymentResponse, error) {
+	log := logger.From(ctx)
+
+	paymentID, err := uuid.Parse(input.PaymentID)
+	if err != nil {
+		return RefundPaymentResponse{}, errs.NewBadRequest("INVALID_PAYMENT_ID")
+	}
+
+	// 1. Reserve refund (row pending di bawah lock payment) sebelum memanggil gateway,
+	// refund admin bersamaan untuk payment yang sama tidak bisa melebihi sisa nominal
+	refundKey := fmt.Sprintf("RF-%s-%d", paymentID.String()[:8], time.Now().UnixMilli())
+	payment, pending, err := s.reserveRefund(ctx, paymentID, refundKey, input)
+	if err != nil {
+		return RefundPaymentResponse{}, err
+	}
+
+	// 2. Refund di gateway (refund key unik per request)
+	gateway, err := s.gateway.Gateway(payment.Gateway)
+	if err == nil {
+		_, err = gateway.Refund(ctx, payment.MidtransTransactionID.String, payment_gateway.RefundInput{
+			RefundKey: refundKey,
+			Amount:    pending.Amount,
+			Reason:    input.Reason,
+		})
+	}
+	if err != nil {
+		// refund yang ternyata tetap diproses gateway akan tercatat lewat webhook
+		if failErr := s.store.FailPaymentHistoryRefund(context.WithoutCancel(ctx), pending.ID); failErr != nil {
+			log.Error("Failed to mark pending refund failed", "paymentID", payment.ID, "refundKey", refundKey, "error", failErr)
+		}
+		return RefundPaymentResponse{}, err
+	}
+
+	// 3. Catat refund sesuai row pending (refund key), bukan snapshot kumulatif
+	updated, refunds, err := s.applyRefund(ctx, payment.ID, applyRefundInput{
+		Source:    entity.PaymentRefundSourceAdmin,
+		RefundKey: refundKey,
+	})
+	if err != nil {
+		log.Error("Gateway refund succeeded but failed to record refund", "paymentID", payment.ID, "refundKey", refundKey, "error", err)
+		return RefundPaymentResponse{}, err
+	}
+	if len(refunds) == 0 {
+		// sudah tercatat oleh webhook
+		return RefundPaymentResponse{Payment: mapPaymentHistoryToResponse(updated)}, nil
+	}
+
+	s.sendPaymentRefundedEmail(ctx, updated, refunds[0])
+
+	refundRes := mapPaymentRefundToResponse(refunds[0])
+	return RefundPaymentResponse{
+		Payment: mapPaymentHistoryToResponse(updated),
+		Refund:  &refundRes,
+	}, nil
+}
+
+// reserveRefund validates refund amount against sisa nominal (dikurangi refund yang masih pending)
+// and inserts pending refund row within one transaction holding payment lock.
+func (s *PaymentCommonService) reserveRefund(ctx context.Context, paymentID uuid.UUID, refundKey string, input RefundPaymentInput) (entity.PaymentHistory, entity.PaymentHistoryRefund, error) {
+	var payment entity.PaymentHistory
+	var pending entity.PaymentHistoryRefund
+
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		var err error
+		payment, err = q.GetPaymentHistoryByIdForUpdate(ctx, paymentID)
+		if err == sql.ErrNoRows {
+			return errs.NewNotFound("PAYMENT_NOT_FOUND")
+		}
+		if err != nil {
+			return err
+		}
+
+		if !isRefundableStatus(payment.Status) {
+			return errs.NewBadRequest("PAYMENT_CANNOT_BE_REFUNDED")
+		}
+		if !payment.MidtransTransactionID.Valid {
+			return errs.NewBadRequest("PAYMENT_HAS_NO_GATEWAY_TRANSACTION")
+		}
+
+		pendings, err := q.GetPendingPaymentHistoryRefundsByPaymentHistoryId(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		var pendingAmount int64
+		for _, p := range pendings {
+			pendingAmount += p.Amount
+		}
+
+		remaining := payment.TotalAmount - payment.RefundedAmount - pendingAmount
+		if remaining <= 0 {
+			if pendingAmount > 0 {
+				return errs.NewBadRequest("REFUND_IN_PROGRESS")
+			}
+			return errs.NewBadRequest("PAYMENT_ALREADY_FULLY_REFUNDED")
+		}
+		amount := remaining
+		if input.Amount != nil {
+			amount = *input.Amount
+		}
+		if amount > remaining {
+			return errs.NewBadRequest("REFUND_AMOUNT_EXCEEDS_REMAINING")
+		}
+
+		var reason sql.NullString
+		if input.Reason != "" {
+			reason = sql.NullString{String: input.Reason, Valid: true}
+		}
+		pending, err = q.CreatePaymentHistoryRefund(ctx, entity.CreatePaymentHistoryRefundParams{
+			PaymentHistoryID: payment.ID,
+			Source:           entity.PaymentRefundSourceAdmin,
+			RefundKey:        sql.NullString{String: refundKey, Valid: true},
+			Amount:           amount,
+			Currency:         payment.Currency,
+			Reason:           reason,
+			ActorProfileID:   uuid.NullUUID{UUID: input.AdminProfileID, Valid: true},
+			Status:           entity.PaymentRefundStatusPending,
+		})
+		return err
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return payment, pending, appErr
+		}
+		return payment, pending, errs.NewInternalServerError(err)
+	}
+	return payment, pending, nil
+}
+
+// handleRefundNotification records refund / partial refund from payment gateway webhook
+// (refund dari admin endpoint atau langsung dari dashboard gateway)
+func (s *PaymentCommonService) handleRefundNotification(ctx context.Context, payment entity.PaymentHistory, notification payment_gateway.WebhookNotification) error {
+	log := logger.From(ctx)
+
+	if !isRefundableStatus(payment.Status) {
+		log.Warn("Ignoring refund notification for non refundable payment", "paymentID", payment.ID, "status", payment.Status)
+		return nil
+	}
+
+	// refund_amount = total refund kumulatif, refund penuh tanpa refund_amount = total payment
+	cumulative := notification.RefundAmount
+	if cumulative <= 0 && notification.FullRefund {
+		cumulative = payment.TotalAmount
+	}
+	if cumulative <= 0 {
+		log.Warn("Refund notification without refund amount", "paymentID", payment.ID, "status", notification.RawStatus)
+		return nil
+	}
+
+	updated, refunds, err := s.applyRefund(ctx, payment.ID, applyRefundInput{
+		Source:           entity.PaymentRefundSourceWebhook,
+		CumulativeAmount: cumulative,
+	})
+	if err != nil {
+		return err
+	}
+	if len(refunds) == 0 {
+		log.Info("Refund already recorded", "paymentID", payment.ID, "refundedAmount", updated.RefundedAmount)
+		return nil
+	}
+
+	for _, refund := range refunds {
+		s.sendPaymentRefundedEmail(ctx, updated, refund)
+	}
+	return nil
+}
+
+// applyRefund records refund within one transaction holding payment lock.
+//   - RefundKey di-set (admin): menyelesaikan row pending dengan refund key tersebut
+//   - webhook: delta (cumulative - refunded_amount) dicocokkan dulu ke row pending admin
+//     (gateway sukses tapi belum tercatat), sisanya dicatat sebagai refund webhook
+//
+// Returns refunds newly recorded, empty when nothing new to record (idempotent).
+func (s *PaymentCommonService) applyRefund(ctx context.Context, paymentID uuid.UUID, input applyRefundInput) (entity.PaymentHistory, []entity.PaymentHistoryRefund, error) {
+	var updated entity.PaymentHistory
+	var refunds []entity.PaymentHistoryRefund
+
+	err := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		refunds = refunds[:0]
+
+		payment, err := q.GetPaymentHistoryByIdForUpdate(ctx, paymentID)
+		if err == sql.ErrNoRows {
+			return errs.NewNotFound("PAYMENT_NOT_FOUND")
+		}
+		if err != nil {
+			return err
+		}
+		updated = payment
+
+		if !isRefundableStatus(payment.Status) {
+			return errs.NewBadRequest("PAYMENT_CANNOT_BE_REFUNDED")
+		}
+
+		if input.RefundKey != "" {
+			pending, err := q.GetPaymentHistoryRefundByRefundKeyForUpdate(ctx, sql.NullString{String: input.RefundKey, Valid: true})
+			if err == sql.ErrNoRows {
+				return errs.NewNotFound("REFUND_NOT_FOUND")
+			}
+			if err != nil {
+				return err
+			}
+			if pending.Status != entity.PaymentRefundStatusPending {
+				return nil
+			}
+			refund, err := s.recordRefund(ctx, q, &updated, &pending, pending.Amount)
+			if err != nil {
+				return err
+			}
+			if refund != nil {
+				refunds = append(refunds, *refund)
+			}
+			return nil
+		}
+
+		delta := min(input.CumulativeAmount, payment.TotalAmount) - payment.RefundedAmount
+		if delta <= 0 {
+			return nil
+		}
+
+		pendings, err := q.GetPendingPaymentHistoryRefundsByPaymentHistoryId(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		for i := range pendings {
+			if pendings[i].Amount > delta {
+				continue
+			}
+			refund, err := s.recordRefund(ctx, q, &updated, &pendings[i], pendings[i].Amount)
+			if err != nil {
+				return err
+			}
+			if refund != nil {
+				refunds = append(refunds, *refund)
+				delta -= refund.Amount
+			}
+		}
+		if delta <= 0 {
+			return nil
+		}
+
+		refund, err := s.recordRefund(ctx, q, &updated, nil, delta)
+		if err != nil {
+			return err
+		}
+		if refund != nil {
+			refunds = append(refunds, *refund)
+		}
+		return nil
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return updated, nil, appErr
+		}
+		return updated, nil, errs.NewInternalServerError(err)
+	}
+
+	for _, refund := range refunds {
+		logger.From(ctx).Info("Payment refund recorded",
+			"paymentID", paymentID,
+			"source", refund.Source,
+			"refundKey", refund.RefundKey.String,
+			"amount", refund.Amount,
+			"refundedAmount", updated.RefundedAmount,
+			"tokenClawedBack", refund.TokenAmountClawedBack,
+		)
+	}
+	return updated, refunds, nil
+}
+
+// recordRefund records one refund of amount on locked payment (payment diperbarui in-place):
+// claw back token proportionally, set payment refunded, reverse referral reward / template license,
+// then complete pending row (admin) or insert webhook refund row.
+// Returns nil when payment has no remaining amount to record.
+func (s *PaymentCommonService) recordRefund(ctx context.Context, q *entity.Queries, payment *entity.PaymentHistory, pending *entity.PaymentHistoryRefund, amount int64) (*entity.PaymentHistoryRefund, error) {
+	amount = min(amount, payment.TotalAmount-payment.RefundedAmount)
+	if amount <= 0 {
+		if pending != nil {
+			// nominal sudah tercatat dari sumber lain, perlu dicek manual ke gateway
+			logger.From(ctx).Error("Pending refund exceeds payment total, marking failed", "paymentID", payment.ID, "refundKey", pending.RefundKey.String)
+			return nil, q.FailPaymentHistoryRefund(ctx, pending.ID)
+		}
+		return nil, nil
+	}
+	cumulative := payment.RefundedAmount + amount
+
+	// token ditarik proporsional terhadap nominal refund (hanya product token)
+	var tokenExpected int64
+	var clawback token_ledger_service.TokenClawbackResult
+	if tokenType, ok := token_ledger_service.TokenTypeFromProductType(payment.RecordProductType); ok {
+		if payment.TotalAmount > 0 {
+			tokenExpected = payment.ProductAmount*cumulative/payment.TotalAmount -
+				payment.ProductAmount*payment.RefundedAmount/payment.TotalAmount
+		}
+		var err error
+		clawback, err = s.generativeToken.ClawbackTokenFromPayment(ctx, q, token_ledger_service.CreateTokenTransactionInput{
+			TokenType:        tokenType,
+			ProfileID:        payment.ProfileID,
+			BusinessRootID:   payment.BusinessRootID,
+			PaymentHistoryID: payment.ID,
+			Amount:           tokenExpected,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	previousStatus := payment.Status
+	updated, err := q.UpdatePaymentHistoryRefund(ctx, entity.UpdatePaymentHistoryRefundParams{
+		RefundedAmount: cumulative,
+		ID:             payment.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	*payment = updated
+
+	// Referral record -> refunded + clawback reward affiliator,
+	// lisensi template dicabut + clawback earning creator (sekali, saat refund pertama)
+	if previousStatus != entity.PaymentStatusRefunded {
+		if err := s.syncReferralRecord(ctx, q, updated, "refunded"); err != nil {
+			return nil, err
+		}
+		if _, err := s.syncCreatorImagePurchase(ctx, q, updated, "refunded"); err != nil {
+			return nil, err
+		}
+	}
+
+	var trxID sql.NullInt64
+	if clawback.TransactionID != nil {
+		trxID = sql.NullInt64{Int64: *clawback.TransactionID, Valid: true}
+	}
+
+	var refund entity.PaymentHistoryRefund
+	if pending != nil {
+		refund, err = q.CompletePaymentHistoryRefund(ctx, entity.CompletePaymentHistoryRefundParams{
+			ID:                           pending.ID,
+			Amount:                       amount,
+			TokenAmountExpected:          tokenExpected,
+			TokenAmountClawedBack:        clawback.ClawedBack,
+			GenerativeTokenTransactionID: trxID,
+		})
+	} else {
+		refund, err = q.CreatePaymentHistoryRefund(ctx, entity.CreatePaymentHistoryRefundParams{
+			PaymentHistoryID:             payment.ID,
+			Source:                       entity.PaymentRefundSourceWebhook,
+			Amount:                       amount,
+			Currency:                     payment.Currency,
+			TokenAmountExpected:          tokenExpected,
+			TokenAmountClawedBack:        clawback.ClawedBack,
+			GenerativeTokenTransactionID: trxID,
+			Status:                       entity.PaymentRefundStatusSucceeded,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+// isRefundableStatus: success atau refunded (partial refund berikutnya)
+func isRefundableStatus(status entity.PaymentStatus) bool {
+	return status == entity.PaymentStatusSuccess || status == entity.PaymentStatusRefunded
+}
+
+func mapPaymentRefundToResponse(r entity.PaymentHistoryRefund) PaymentRefundResponse {
+	return PaymentRefundResponse{
+		ID:                    r.ID,
+		Source:                string(r.Source),
+		Status:                string(r.Status),
+		RefundKey:             utils.NullStringToString(r.RefundKey),
+		Amount:                r.Amount,
+		Currency:              r.Currency,
+		Reason:                utils.NullStringToString(r.Reason),
+		TokenAmountExpected:   r.TokenAmountExpected,
+		TokenAmountClawedBack: r.TokenAmountClawedBack,
+		CreatedAt:             r.CreatedAt,
+	}
+}
+
+// sendPaymentRefundedEmail enqueues refund notification email to payer
+func (s *PaymentCommonService) sendPaymentRefundedEmail(ctx context.Context, payment entity.PaymentHistory, refund entity.PaymentHistoryRefund) {
+	log := logger.From(ctx)
+
+	// Get profile for email
+	profile, err := s.store.GetProfileById(ctx, payment.ProfileID)
+	if err != nil {
+		log.Error("Failed to get profile for refunded email", "profileID", payment.ProfileID, "error", err)
+		return
+	}
+
+	// Enqueue email via goroutine with background context
+	go func() {
+		ctxBg, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		err := s.queue.EnqueuePaymentRefunded(ctxBg, mailer.PaymentRefundedInputDTO{
+			Email:         profile.Email,
+			Name:          profile.Name,
+			OrderID:       payment.MidtransTransactionID.String,
+			ProductName:   payment.RecordProductName,
+			TotalAmount:   payment.TotalAmount,
+			Currency:      payment.Currency,
+			PaymentMethod: payment.PaymentMethod,
+			RefundAmount:  refund.Amount,
+			TokenAmount:   refund.TokenAmountClawedBack,
+			Reason:        refund.Reason.String,
+			RefundedAt:    refund.CreatedAt,
+		})
+		if err != nil {
+			logger.L().Error("Failed to enqueue refunded email", "paymentID", payment.ID, "error", err)
+		}
+	}()
+}
@@ -6,10 +6,14 @@ import (
 	"database/sql"
 	"time"
 
-	image_token_service "postmatic-api/internal/module/generative_token/image_token/service"
+	affiliator_wallet_service "postmatic-api/internal/module/affiliator/affiliator_wallet/service"
+	business_creator_image_service "postmatic-api/internal/module/creator/business_creator_image/service"
+	creator_earning_service "postmatic-api/internal/module/creator/creator_earning/service"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
 	"postmatic-api/internal/module/headless/mailer"
-	"postmatic-api/internal/module/headless/midtrans"
+	"postmatic-api/internal/module/headless/payment_gateway"
 	"postmatic-api/internal/module/headless/queue"
+	"postmatic-api/internal/module/headless/s3_uploader"
 	"postmatic-api/internal/repository/entity"
 	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/logger"
@@ -21,24 +25,41 @@ import (
 
 // PaymentCommonService handles common payment operations
 type PaymentCommonService struct {
-	store           entity.Store
-	midtrans        midtrans.Service
-	queue           queue.MailerProducer
-	generativeToken *image_token_service.ImageTokenService
+	store            entity.Store
+	gateway          payment_gateway.Service
+	queue            queue.MailerProducer
+	generativeToken  *token_ledger_service.TokenLedgerService
+	affiliatorWallet *affiliator_wallet_service.AffiliatorWalletService
+	// lisensi template & earning creator untuk product creator_image
+	businessCreatorImage *business_creator_image_service.BusinessCreatorImageService
+	creatorEarning       *creator_earning_service.CreatorEarningService
+	// penyimpanan file invoice pdf, appName dipakai sebagai nama penerbit invoice
+	s3      *s3_uploader.S3UploaderService
+	appName string
 }
 
 // NewService creates a new PaymentCommonService
 func NewService(
 	store entity.Store,
-	midtrans midtrans.Service,
+	gateway payment_gateway.Service,
 	queue queue.MailerProducer,
-	generativeToken *image_token_service.ImageTokenService,
+	generativeToken *token_ledger_service.TokenLedgerService,
+	affiliatorWallet *affiliator_wallet_service.AffiliatorWalletService,
+	businessCreatorImage *business_creator_image_service.BusinessCreatorImageService,
+	creatorEarning *creator_earning_service.CreatorEarningService,
+	s3 *s3_uploader.S3UploaderService,
+	appName string,
 ) *PaymentCommonService {
 	return &PaymentCommonService{
-		store:           store,
-		midtrans:        midtrans,
-		queue:           queue,
-		generativeToken: generativeToken,
+		store:                store,
+		gateway:              gateway,
+		queue:                queue,
+		generativeToken:      generativeToken,
+		affiliatorWallet:     affiliatorWallet,
+		businessCreatorImage: businessCreatorImage,
+		creatorEarning:       creatorEarning,
+		s3:                   s3,
+		appName:              appName,
 	}
 }
 
@@ -117,10 +138,8 @@ func (s *PaymentCommonService) GetPaymentHistoriesByProfile(ctx context.Context,
 }
 
 // GetPaymentHistoryById returns a single payment history by ID
-// If status is pending, it will check midtrans and update the status
+// If status is pending, it will check payment gateway and update the status
 func (s *PaymentCommonService) GetPaymentHistoryById(ctx context.Context, id string, profileID string) (PaymentHistoryResponse, error) {
-	log := logger.From(ctx)
-
 	paymentID, err := uuid.Parse(id)
 	if err != nil {
 		return PaymentHistoryResponse{}, errs.NewBadRequest("INVALID_PAYMENT_ID")
@@ -142,70 +161,8 @@ func (s *PaymentCommonService) GetPaymentHistoryById(ctx context.Context, id str
 		return PaymentHistoryResponse{}, errs.NewInternalServerError(err)
 	}
 
-	// If pending, check midtrans status and update
-	if payment.Status == entity.PaymentStatusPending && payment.MidtransTransactionID.Valid {
-		log.Info("Payment is pending, checking midtrans status", "paymentID", id, "midtransID", payment.MidtransTransactionID.String)
-
-		midtransStatus, err := s.midtrans.CheckStatus(ctx, payment.MidtransTransactionID.String)
-		if err != nil {
-			log.Error("Failed to check midtrans status", "error", err)
-			// Continue with current data, don't fail
-		} else {
-			// Map midtrans status to our status
-			newStatus := mapMidtransStatusToPaymentStatus(midtransStatus.TransactionStatus)
-			if newStatus != string(payment.Status) {
-				log.Info("Updating payment status from midtrans", "oldStatus", payment.Status, "newStatus", newStatus)
-
-				// Use transaction for status update + token credit
-				var updatedPayment entity.PaymentHistory
-				txErr := s.store.ExecTx(ctx, func(q *entity.Queries) error {
-					var err error
-					updatedPayment, err = q.UpdatePaymentHistoryStatus(ctx, entity.UpdatePaymentHistoryStatusParams{
-						ID:     payment.ID,
-						Status: entity.PaymentStatus(newStatus),
-					})
-					if err != nil {
-						return err
-					}
-
-					// Update referral record status if applicable
-					if payment.ReferralRecordID.Valid {
-						_, _ = q.UpdateReferralRecordStatus(ctx, entity.UpdateReferralRecordStatusParams{
-							ID:     payment.ReferralRecordID.Int64,
-							Status: entity.ReferralRecordStatus(newStatus),
-						})
-					}
-
-					// Credit token if status changed to success
-					if newStatus == "success" {
-						err = s.generativeToken.CreditTokenFromPayment(ctx, q, image_token_service.CreateTokenTransactionInput{
-							ProfileID:        payment.ProfileID,
-							BusinessRootID:   payment.BusinessRootID,
-							PaymentHistoryID: payment.ID,
-							Amount:           payment.ProductAmount,
-						})
-						if err != nil {
-							log.Error("Failed to credit token", "paymentID", payment.ID, "error", err)
-							// Don't fail transaction for token credit error
-						}
-					}
-
-					return nil
-				})
-
-				if txErr != nil {
-					log.Error("Failed to update payment status in transaction", "error", txErr)
-				} else {
-					payment = updatedPayment
-
-					// Send success email if status changed to success (async)
-					if newStatus == "success" {
-						s.sendPaymentSuccessEmail(ctx, payment)
-					}
-				}
-			}
-		}
-	}
+	// If pending, check gateway status and update
+	payment = s.syncPendingStatus(ctx, payment)
 
 	return mapPaymentHistoryToResponse(payment), nil
 }
@@ -280,10 +237,8 @@ func (s *PaymentCommonService) GetPaymentHistoriesByBusiness(ctx context.Context
 }
 
 // GetPaymentHistoryByIdAndBusiness returns a single payment history by ID and business
-// If status is pending, it will check midtrans and update the status
+// If status is pending, it will check payment gateway and update the status
 func (s *PaymentCommonService) GetPaymentHistoryByIdAndBusiness(ctx context.Context, id string, businessRootID int64) (PaymentHistoryResponse, error) {
-	log := logger.From(ctx)
-
 	paymentID, err := uuid.Parse(id)
 	if err != nil {
 		return PaymentHistoryResponse{}, errs.NewBadRequest("INVALID_PAYMENT_ID")
@@ -300,70 +255,8 @@ func (s *PaymentCommonService) GetPaymentHistoryByIdAndBusiness(ctx context.Cont
 		return PaymentHistoryResponse{}, errs.NewInternalServerError(err)
 	}
 
-	// If pending, check midtrans status and update
-	if payment.Status == entity.PaymentStatusPending && payment.MidtransTransactionID.Valid {
-		log.Info("Payment is pending, checking midtrans status", "paymentID", id, "midtransID", payment.MidtransTransactionID.String)
-
-		midtransStatus, err := s.midtrans.CheckStatus(ctx, payment.MidtransTransactionID.String)
-		if err != nil {
-			log.Error("Failed to check midtrans status", "error", err)
-			// Continue with current data, don't fail
-		} else {
-			// Map midtrans status to our status
-			newStatus := mapMidtransStatusToPaymentStatus(midtransStatus.TransactionStatus)
-			if newStatus != string(payment.Status) {
-				log.Info("Updating payment status from midtrans", "oldStatus", payment.Status, "newStatus", newStatus)
-
-				// Use transaction for status update + token credit
-				var updatedPayment entity.PaymentHistory
-				txErr := s.store.ExecTx(ctx, func(q *entity.Queries) error {
-					var err error
-					updatedPayment, err = q.UpdatePaymentHistoryStatus(ctx, entity.UpdatePaymentHistoryStatusParams{
-						ID:     payment.ID,
-						Status: entity.PaymentStatus(newStatus),
-					})
-					if err != nil {
-						return err
-					}
-
-					// Update referral record status if applicable
-					if payment.ReferralRecordID.Valid {
-						_, _ = q.UpdateReferralRecordStatus(ctx, entity.UpdateReferralRecordStatusParams{
-							ID:     payment.ReferralRecordID.Int64,
-							Status: entity.ReferralRecordStatus(newStatus),
-						})
-					}
-
-					// Credit token if status changed to success
-					if newStatus == "success" {
-						err = s.generativeToken.CreditTokenFromPayment(ctx, q, image_token_service.CreateTokenTransactionInput{
-							ProfileID:        payment.ProfileID,
-							BusinessRootID:   payment.BusinessRootID,
-							PaymentHistoryID: payment.ID,
-							Amount:           payment.ProductAmount,
-						})
-						if err != nil {
-							log.Error("Failed to credit token", "paymentID", payment.ID, "error", err)
-							// Don't fail transaction for token credit error
-						}
-					}
-
-					return nil
-				})
-
-				if txErr != nil {
-					log.Error("Failed to update payment status in transaction", "error", txErr)
-				} else {
-					payment = updatedPayment
-
-					// Send success email if status changed to success (async)
-					if newStatus == "success" {
-						s.sendPaymentSuccessEmail(ctx, payment)
-					}
-				}
-			}
-		}
-	}
+	// If pending, check gateway status and update
+	payment = s.syncPendingStatus(ctx, payment)
 
 	return mapPaymentHistoryToResponse(payment), nil
 }
@@ -393,12 +286,15 @@ func (s *PaymentCommonService) CancelPaymentByBusiness(ctx context.Context, id s
 		return PaymentHistoryResponse{}, errs.NewBadRequest("PAYMENT_CANNOT_BE_CANCELED")
 	}
 
-	// Cancel in midtrans if has transaction ID
+	// Cancel in gateway if has transaction ID
 	if payment.MidtransTransactionID.Valid {
-		log.Info("Canceling payment in midtrans", "paymentID", id, "midtransID", payment.MidtransTransactionID.String)
-		_, err := s.midtrans.CancelTransaction(ctx, payment.MidtransTransactionID.String)
+		log.Info("Canceling payment in gateway", "paymentID", id, "gateway", payment.Gateway, "transactionID", payment.MidtransTransactionID.String)
+		gateway, err := s.gateway.Gateway(payment.Gateway)
+		if err == nil {
+			_, err = gateway.Cancel(ctx, payment.MidtransTransactionID.String)
+		}
 		if err != nil {
-			log.Error("Failed to cancel in midtrans", "error", err)
+			log.Error("Failed to cancel in gateway", "error", err)
 			// Continue anyway
 		}
 	}
@@ -426,107 +322,245 @@ func (s *PaymentCommonService) CancelPaymentByBusiness(ctx context.Context, id s
 	return mapPaymentHistoryToResponse(updated), nil
 }
 
-// HandleWebhook handles midtrans webhook notification
-func (s *PaymentCommonService) HandleWebhook(ctx context.Context, notification MidtransNotification) error {
+// HandleWebhook handles webhook notification from payment gateway (raw body diverifikasi oleh gateway)
+func (s *PaymentCommonService) HandleWebhook(ctx context.Context, gatewayType entity.PaymentGatewayType, body []byte) error {
 	log := logger.From(ctx)
 
-	// 1. Verify signature
-	isValid := s.midtrans.VerifySignature(
-		notification.OrderID,
-		notification.StatusCode,
-		notification.GrossAmount,
-		notification.SignatureKey,
-	)
-	if !isValid {
-		log.Error("Invalid webhook signature", "orderID", notification.OrderID)
-		return errs.NewUnauthorized("INVALID_SIGNATURE")
+	// 1. Verify signature & normalize payload
+	gateway, err := s.gateway.Gateway(gatewayType)
+	if err != nil {
+		return err
+	}
+	notification, err := gateway.VerifyWebhook(ctx, body)
+	if err != nil {
+		return err
 	}
 
-	log.Info("Webhook received", "orderID", notification.OrderID, "status", notification.TransactionStatus)
+	log.Info("Webhook received", "gateway", gatewayType, "orderID", notification.OrderID, "status", notification.RawStatus)
 
-	// 2. Find payment by midtrans transaction ID
-	payment, err := s.store.GetPaymentHistoryByMidtransTransactionId(ctx, utils.StringToNullString(&notification.TransactionID))
+	// 2. Find payment by gateway transaction ID
+	payment, err := s.store.GetPaymentHistoryByGatewayTransactionId(ctx, entity.GetPaymentHistoryByGatewayTransactionIdParams{
+		Gateway:       gatewayType,
+		TransactionID: utils.StringToNullString(&notification.TransactionID),
+	})
 	if err == sql.ErrNoRows {
-		log.Error("Payment not found for webhook", "transactionID", notification.TransactionID)
+		log.Error("Payment not found for webhook", "gateway", gatewayType, "transactionID", notification.TransactionID)
 		return errs.NewNotFound("PAYMENT_NOT_FOUND")
 	}
 	if err != nil {
 		return errs.NewInternalServerError(err)
 	}
 
-	// 3. Map status and update using transaction
-	newStatus := mapMidtransStatusToPaymentStatus(notification.TransactionStatus)
+	// 3. Update status using transaction
+	newStatus := notification.Status
+
+	// Refund (full / partial) dicatat terpisah karena bisa terjadi lebih dari sekali
+	if newStatus == "refunded" {
+		return s.handleRefundNotification(ctx, payment, *notification)
+	}
+
 	if newStatus != string(payment.Status) {
 		log.Info("Updating payment status from webhook", "oldStatus", payment.Status, "newStatus", newStatus)
 
-		// Use transaction for status update + token credit
-		txErr := s.store.ExecTx(ctx, func(q *entity.Queries) error {
-			_, err := q.UpdatePaymentHistoryStatus(ctx, entity.UpdatePaymentHistoryStatusParams{
-				ID:     payment.ID,
-				Status: entity.PaymentStatus(newStatus),
-			})
-			if err != nil {
-				return err
-			}
+		if _, err := s.applyStatusChange(ctx, payment, newStatus); err != nil {
+			return errs.NewInternalServerError(err)
+		}
+	}
 
-			// Update referral record status if applicable
-			if payment.ReferralRecordID.Valid {
-				_, _ = q.UpdateReferralRecordStatus(ctx, entity.UpdateReferralRecordStatusParams{
-					ID:     payment.ReferralRecordID.Int64,
-					Status: entity.ReferralRecordStatus(newStatus),
-				})
-			}
+	return nil
+}
 
-			// Credit token if status changed to success
-			if newStatus == "success" {
-				err = s.generativeToken.CreditTokenFromPayment(ctx, q, image_token_service.CreateTokenTransactionInput{
-					ProfileID:        payment.ProfileID,
-					BusinessRootID:   payment.BusinessRootID,
-					PaymentHistoryID: payment.ID,
-					Amount:           payment.ProductAmount,
-				})
-				if err != nil {
-					log.Error("Failed to credit token from webhook", "paymentID", payment.ID, "error", err)
-					// Don't fail transaction for token credit error
-				}
-			}
+// Helpers
+
+// syncPendingStatus cek status payment pending ke gateway (fallback jika webhook tidak sampai),
+// error gateway hanya di-log dan payment dikembalikan apa adanya
+func (s *PaymentCommonService) syncPendingStatus(ctx context.Context, payment entity.PaymentHistory) entity.PaymentHistory {
+	if payment.Status != entity.PaymentStatusPending || !payment.MidtransTransactionID.Valid {
+		return payment
+	}
+	log := logger.From(ctx)
+	log.Info("Payment is pending, checking gateway status", "paymentID", payment.ID, "gateway", payment.Gateway, "transactionID", payment.MidtransTransactionID.String)
 
+	gateway, err := s.gateway.Gateway(payment.Gateway)
+	if err != nil {
+		log.Error("Failed to resolve payment gateway", "gateway", payment.Gateway, "error", err)
+		return payment
+	}
+	status, err := gateway.CheckStatus(ctx, payment.MidtransTransactionID.String)
+	if err != nil {
+		log.Error("Failed to check gateway status", "error", err)
+		// Continue with current data, don't fail
+		return payment
+	}
+	updated, err := s.applyStatusChange(ctx, payment, status.Status)
+	if err != nil {
+		log.Error("Failed to update payment status in transaction", "error", err)
+		return payment
+	}
+	return updated
+}
+
+// applyStatusChange updates payment status + referral record + token credit / template license within one transaction,
+// dipakai bersama oleh webhook, cek status saat detail dibuka dan job reconcile.
+// Row di-lock lalu dibandingkan dengan snapshot; jika status sudah berubah (diproses jalur lain) tidak ada yang diubah.
+func (s *PaymentCommonService) applyStatusChange(ctx context.Context, payment entity.PaymentHistory, newStatus string) (entity.PaymentHistory, error) {
+	log := logger.From(ctx)
+
+	if newStatus == string(payment.Status) {
+		return payment, nil
+	}
+
+	updated := payment
+	changed := false
+	var creatorSale *entity.CreatorEarningTransaction
+	txErr := s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		current, err := q.GetPaymentHistoryByIdForUpdate(ctx, payment.ID)
+		if err != nil {
+			return err
+		}
+		updated = current
+		if current.Status != payment.Status {
+			log.Info("Payment status already changed, skipping", "paymentID", payment.ID, "status", current.Status, "newStatus", newStatus)
 			return nil
+		}
+
+		updated, err = q.UpdatePaymentHistoryStatus(ctx, entity.UpdatePaymentHistoryStatusParams{
+			ID:     payment.ID,
+			Status: entity.PaymentStatus(newStatus),
 		})
+		if err != nil {
+			return err
+		}
+		changed = true
 
-		if txErr != nil {
-			return errs.NewInternalServerError(txErr)
+		// Update referral record status + affiliator reward if applicable
+		if err := s.syncReferralRecord(ctx, q, payment, newStatus); err != nil {
+			return err
 		}
 
-		// Send success email if status changed to success (async)
-		if newStatus == "success" {
-			s.sendPaymentSuccessEmail(ctx, payment)
+		// Credit token if status changed to success (sesuai token type product)
+		if tokenType, ok := token_ledger_service.TokenTypeFromProductType(payment.RecordProductType); ok && newStatus == "success" {
+			err = s.generativeToken.CreditTokenFromPayment(ctx, q, token_ledger_service.CreateTokenTransactionInput{
+				TokenType:        tokenType,
+				ProfileID:        payment.ProfileID,
+				BusinessRootID:   payment.BusinessRootID,
+				PaymentHistoryID: payment.ID,
+				Amount:           payment.ProductAmount,
+			})
+			if err != nil {
+				log.Error("Failed to credit token", "paymentID", payment.ID, "error", err)
+				// Don't fail transaction for token credit error
+			}
 		}
+
+		// Grant template license + creator earning if status changed to success
+		creatorSale, err = s.syncCreatorImagePurchase(ctx, q, payment, newStatus)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+	if txErr != nil {
+		return payment, txErr
 	}
 
+	// Send success email + issue invoice if status changed to success (async)
+	if changed && newStatus == "success" {
+		s.sendPaymentSuccessEmail(ctx, updated)
+		s.creatorEarning.SendSaleNotification(ctx, creatorSale)
+		s.issueInvoiceAsync(updated)
+	}
+
+	return updated, nil
+}
+
+// syncReferralRecord updates referral record status following payment status (within transaction)
+// Reward is accrued to affiliator wallet on success and clawed back on refund.
+// Error dikembalikan: statement yang gagal membatalkan transaksi postgres, sehingga seluruh
+// perubahan di-rollback dan webhook dikirim ulang oleh gateway.
+func (s *PaymentCommonService) syncReferralRecord(ctx context.Context, q *entity.Queries, payment entity.PaymentHistory, newStatus string) error {
+	if !payment.ReferralRecordID.Valid {
+		return nil
+	}
+	log := logger.From(ctx)
+
+	_, err := q.UpdateReferralRecordStatus(ctx, entity.UpdateReferralRecordStatusParams{
+		ID:     payment.ReferralRecordID.Int64,
+		Status: mapPaymentStatusToReferralRecordStatus(newStatus),
+	})
+	if err != nil {
+		log.Error("Failed to update referral record status", "paymentID", payment.ID, "error", err)
+		return err
+	}
+
+	switch newStatus {
+	case "success":
+		if err := s.affiliatorWallet.AccrueReward(ctx, q, payment.ReferralRecordID.Int64); err != nil {
+			log.Error("Failed to accrue affiliator reward", "paymentID", payment.ID, "error", err)
+			return err
+		}
+	case "refunded":
+		if err := s.affiliatorWallet.ClawbackReward(ctx, q, payment.ReferralRecordID.Int64); err != nil {
+			log.Error("Failed to clawback affiliator reward", "paymentID", payment.ID, "error", err)
+			return err
+		}
+	}
 	return nil
 }
 
-// Helpers
+// syncCreatorImagePurchase grants / revokes business template license and records creator earning
+// following payment status (within transaction, hanya product creator_image).
+// Returns the recorded sale (nil jika tidak ada) untuk notifikasi creator setelah commit.
+// Error dikembalikan agar transaksi di-rollback & webhook dikirim ulang (pembeli tidak boleh tertagih tanpa lisensi).
+func (s *PaymentCommonService) syncCreatorImagePurchase(ctx context.Context, q *entity.Queries, payment entity.PaymentHistory, newStatus string) (*entity.CreatorEarningTransaction, error) {
+	if payment.RecordProductType != entity.PaymentProductTypeCreatorImage {
+		return nil, nil
+	}
+	log := logger.From(ctx)
+
+	switch newStatus {
+	case "success":
+		granted, err := s.businessCreatorImage.GrantLicenseFromPayment(ctx, q, payment)
+		if err != nil {
+			log.Error("Failed to grant creator image license", "paymentID", payment.ID, "error", err)
+			return nil, err
+		}
+		if !granted {
+			return nil, nil
+		}
+		sale, err := s.creatorEarning.RecordSale(ctx, q, payment)
+		if err != nil {
+			log.Error("Failed to record creator earning", "paymentID", payment.ID, "error", err)
+			return nil, err
+		}
+		return sale, nil
+	case "refunded":
+		if err := s.businessCreatorImage.RevokeLicenseFromPayment(ctx, q, payment.ID); err != nil {
+			log.Error("Failed to revoke creator image license", "paymentID", payment.ID, "error", err)
+			return nil, err
+		}
+		if err := s.creatorEarning.ClawbackSale(ctx, q, payment.ID); err != nil {
+			log.Error("Failed to clawback creator earning", "paymentID", payment.ID, "error", err)
+			return nil, err
+		}
+	}
+	return nil, nil
+}
 
-func mapMidtransStatusToPaymentStatus(midtransStatus string) string {
-	switch midtransStatus {
-	case "capture", "settlement":
-		return "success"
-	case "pending":
-		return "pending"
-	case "deny":
-		return "denied"
-	case "cancel":
-		return "canceled"
-	case "expire":
-		return "expired"
-	case "refund", "partial_refund":
-		return "refunded"
-	case "failure":
-		return "failed"
+// mapPaymentStatusToReferralRecordStatus: referral_record_status tidak punya expired/denied
+func mapPaymentStatusToReferralRecordStatus(paymentStatus string) entity.ReferralRecordStatus {
+	switch paymentStatus {
+	case "success":
+		return entity.ReferralRecordStatusSuccess
+	case "refunded":
+		return entity.ReferralRecordStatusRefunded
+	case "canceled", "expired":
+		return entity.ReferralRecordStatusCanceled
+	case "failed", "denied":
+		return entity.ReferralRecordStatusFailed
 	default:
-		return "pending"
+		return entity.ReferralRecordStatusPending
 	}
 }
 
@@ -547,6 +581,7 @@ func mapPaymentHistoryToResponse(p entity.PaymentHistory) PaymentHistoryResponse
 		AdminFeeAmount:     p.AdminFeeAmount,
 		TaxAmount:          p.TaxAmount,
 		TotalAmount:        p.TotalAmount,
+		RefundedAmount:     p.RefundedAmount,
 		CreatedAt:          p.CreatedAt,
 		UpdatedAt:          p.UpdatedAt,
 	}
@@ -569,6 +604,16 @@ func mapPaymentHistoryToResponse(p entity.PaymentHistory) PaymentHistoryResponse
 	if p.PaymentExpiredAt.Valid {
 		resp.PaymentExpiredAt = &p.PaymentExpiredAt.Time
 	}
+	if p.PaymentRefundedAt.Valid {
+		resp.PaymentRefundedAt = &p.PaymentRefundedAt.Time
+	}
+	if p.ExchangeRateID.Valid {
+		resp.ExchangeRate = &PaymentExchangeRateResponse{
+			ID:           p.ExchangeRateID.Int64,
+			BaseCurrency: p.ExchangeBaseCurrency.String,
+			Rate:         float64(p.ExchangeRateMicros.Int64) / 1_000_000,
+		}
+	}
 
 	return resp
 }
@@ -27,6 +27,79 @@ type PaymentHistoryResponse struct {
 	PaymentFailedAt    *time.Time `json:"paymentFailedAt"`
 	PaymentCanceledAt  *time.Time `json:"paymentCanceledAt"`
 	PaymentExpiredAt   *time.Time `json:"paymentExpiredAt"`
-	CreatedAt          time.Time  `json:"createdAt"`
-	UpdatedAt          time.Time  `json:"updatedAt"`
+	PaymentRefundedAt  *time.Time `json:"paymentRefundedAt"`
+	RefundedAmount     int64      `json:"refundedAmount"`
+	// kurs saat checkout, null jika tanpa konversi
+	ExchangeRate *PaymentExchangeRateResponse `json:"exchangeRate"`
+	CreatedAt    time.Time                    `json:"createdAt"`
+	UpdatedAt    time.Time                    `json:"updatedAt"`
+}
+
+// PaymentExchangeRateResponse is the exchange rate recorded on payment (1 currency = rate baseCurrency)
+type PaymentExchangeRateResponse struct {
+	ID           int64   `json:"id"`
+	BaseCurrency string  `json:"baseCurrency"`
+	Rate         float64 `json:"rate"`
+}
+
+// PaymentRefundResponse is a single refund applied to a payment
+type PaymentRefundResponse struct {
+	ID                    int64     `json:"id"`
+	Source                string    `json:"source"`
+	Status                string    `json:"status"`
+	RefundKey             *string   `json:"refundKey"`
+	Amount                int64     `json:"amount"`
+	Currency              string    `json:"currency"`
+	Reason                *string   `json:"reason"`
+	TokenAmountExpected   int64     `json:"tokenAmountExpected"`
+	TokenAmountClawedBack int64     `json:"tokenAmountClawedBack"`
+	CreatedAt             time.Time `json:"createdAt"`
+}
+
+// RefundPaymentResponse is the response for admin refund endpoint
+type RefundPaymentResponse struct {
+	Payment PaymentHistoryResponse `json:"payment"`
+	Refund  *PaymentRefundResponse `json:"refund"` // null jika refund sudah tercatat lebih dulu oleh webhook
+}
+
+// InvoiceFileResponse is the invoice pdf file for download endpoint
+type InvoiceFileResponse struct {
+	InvoiceNumber string
+	FileName      string
+	ContentType   string
+	Body          []byte
+}
+
+// PriceCalculation represents the calculated price breakdown
+type PriceCalculation struct {
+	ItemPrice         int64 `json:"itemPrice"`         // harga asli product
+	DiscountAmount    int64 `json:"discountAmount"`    // nominal diskon
+	AfterDiscount     int64 `json:"afterDiscount"`     // harga setelah diskon
+	AdminFeeAmount    int64 `json:"adminFeeAmount"`    // nominal admin fee
+	SubtotalBeforeTax int64 `json:"subtotalBeforeTax"` // setelah diskon + admin
+	TaxAmount         int64 `json:"taxAmount"`         // nominal tax
+	TotalAmount       int64 `json:"totalAmount"`       // grand total
+}
+
+// PaymentMethodInfo represents payment method info in response
+type PaymentMethodInfo struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// PaymentActionResponse represents stored action from Midtrans
+type PaymentActionResponse struct {
+	Name      string `json:"name"`      // dari midtrans (generate-qr-code, deeplink-redirect)
+	Label     string `json:"label"`     // label readable (QR Code, Virtual Account)
+	Value     string `json:"value"`     // url atau va number
+	ValueType string `json:"valueType"` // image, link, text, claim
+	Method    string `json:"method"`    // GET, POST
+}
+
+// ChargePaymentResult is the result of charging a payment via payment gateway
+type ChargePaymentResult struct {
+	GatewayTransactionID string
+	// hanya action yang public
+	Actions []PaymentActionResponse
 }
@@ -0,0 +1,153 @@
+// internal/module/payment/template/handler/handler.go
+package template_handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"postmatic-api/internal/internal_middleware"
+	template_service "postmatic-api/internal/module/payment/template/service"
+	"postmatic-api/pkg/response"
+	"postmatic-api/pkg/utils"
+
+	"github.com/go-chi/chi/v5"
+)
+
+type TemplatePaymentHandler struct {
+	service    *template_service.TemplatePaymentService
+	middleware *internal_middleware.OwnedBusiness
+}
+
+func NewHandler(service *template_service.TemplatePaymentService, middleware *internal_middleware.OwnedBusiness) *TemplatePaymentHandler {
+	return &TemplatePaymentHandler{service: service, middleware: middleware}
+}
+
+// Routes: di-mount di /payment/template
+func (h *TemplatePaymentHandler) Routes(allAllowedMiddleware func(http.Handler) http.Handler) http.Handler {
+	r := chi.NewRouter()
+	r.Use(allAllowedMiddleware)
+
+	r.Get("/", h.CheckPrice)
+	r.Post("/", h.CreatePayment)
+
+	return r
+}
+
+// CheckPrice godoc
+// @Summary Check price for paid template (creator image) purchase
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param creatorImageId query int true "Creator image ID"
+// @Param currencyCode query string true "Currency code (IDR)"
+// @Param paymentMethod query string true "Payment method code (e.g., bca, gopay)"
+// @Param businessRootId query int true "Business root ID"
+// @Success 200 {object} response.Response{data=template_service.CheckPriceResponse}
+// @Router /api/payment/template [get]
+func (h *TemplatePaymentHandler) CheckPrice(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parse query params
+	creatorImageIdStr := r.URL.Query().Get("creatorImageId")
+	if creatorImageIdStr == "" {
+		response.ValidationFailed(w, r, map[string]string{"creatorImageId": "REQUIRED"})
+		return
+	}
+	creatorImageId, err := strconv.ParseInt(creatorImageIdStr, 10, 64)
+	if err != nil || creatorImageId <= 0 {
+		response.ValidationFailed(w, r, map[string]string{"creatorImageId": "MUST_BE_POSITIVE_INTEGER"})
+		return
+	}
+
+	currencyCode := r.URL.Query().Get("currencyCode")
+	if currencyCode == "" {
+		response.ValidationFailed(w, r, map[string]string{"currencyCode": "REQUIRED"})
+		return
+	}
+
+	paymentMethod := r.URL.Query().Get("paymentMethod")
+	if paymentMethod == "" {
+		response.ValidationFailed(w, r, map[string]string{"paymentMethod": "REQUIRED"})
+		return
+	}
+
+	businessRootIdStr := r.URL.Query().Get("businessRootId")
+	if businessRootIdStr == "" {
+		response.ValidationFailed(w, r, map[string]string{"businessRootId": "REQUIRED"})
+		return
+	}
+	businessRootId, err := strconv.ParseInt(businessRootIdStr, 10, 64)
+	if err != nil {
+		response.ValidationFailed(w, r, map[string]string{"businessRootId": "MUST_BE_INTEGER"})
+		return
+	}
+
+	// Get profile from context (ID is the Profile ID)
+	claims, _ := internal_middleware.GetProfileFromContext(ctx)
+	if claims == nil {
+		response.ValidationFailed(w, r, map[string]string{"authorization": "PROFILE_ID_REQUIRED"})
+		return
+	}
+
+	// businessRootId dari query -> cek membership + permission manual
+	if _, err := h.middleware.AuthorizeBusiness(ctx, claims.ID, businessRootId, internal_middleware.PermBillingPurchase); err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	result, err := h.service.CheckPrice(ctx, template_service.CheckPriceInput{
+		CreatorImageID: creatorImageId,
+		CurrencyCode:   currencyCode,
+		PaymentMethod:  paymentMethod,
+		BusinessRootID: businessRootId,
+		ProfileID:      claims.ID, // ID is the Profile ID
+	})
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "CHECK_PRICE_SUCCESS", result)
+}
+
+// CreatePayment godoc
+// @Summary Create payment for paid template (creator image) purchase
+// @Tags Payment
+// @Accept json
+// @Produce json
+// @Param body body template_service.CreatePaymentInput true "Payment input"
+// @Success 201 {object} response.Response{data=template_service.CreatePaymentResponse}
+// @Router /api/payment/template [post]
+func (h *TemplatePaymentHandler) CreatePayment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var input template_service.CreatePaymentInput
+
+	// Validate request body with utils.ValidateStruct
+	if appErr := utils.ValidateStruct(r.Body, &input); appErr != nil {
+		response.ValidationFailed(w, r, appErr.ValidationErrors)
+		return
+	}
+
+	// Get profile from context (ID is the Profile ID)
+	claims, _ := internal_middleware.GetProfileFromContext(ctx)
+	if claims == nil {
+		response.ValidationFailed(w, r, map[string]string{"authorization": "PROFILE_ID_REQUIRED"})
+		return
+	}
+	input.ProfileID = claims.ID // ID is the Profile ID
+
+	// businessRootId dari body -> cek membership + permission manual
+	if _, err := h.middleware.AuthorizeBusiness(ctx, claims.ID, input.BusinessRootID, internal_middleware.PermBillingPurchase); err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	result, err := h.service.CreatePayment(ctx, input)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	response.OK(w, r, "PAYMENT_CREATED", result)
+}
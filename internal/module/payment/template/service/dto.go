@@ -0,0 +1,22 @@
+// internal/module/payment/template/service/dto.go
+package template_service
+
+import "github.com/google/uuid"
+
+// CheckPriceInput is the input for checking template (creator image) price before payment
+type CheckPriceInput struct {
+	CreatorImageID int64  `json:"creatorImageId" validate:"required,min=1"`
+	CurrencyCode   string `json:"currencyCode" validate:"required"`
+	PaymentMethod  string `json:"paymentMethod" validate:"required"`
+	BusinessRootID int64  `json:"businessRootId" validate:"required"`
+	ProfileID      uuid.UUID
+}
+
+// CreatePaymentInput is the input for creating a template payment
+type CreatePaymentInput struct {
+	CreatorImageID int64  `json:"creatorImageId" validate:"required,min=1"`
+	CurrencyCode   string `json:"currencyCode" validate:"required"`
+	PaymentMethod  string `json:"paymentMethod" validate:"required"`
+	BusinessRootID int64  `json:"businessRootId" validate:"required"`
+	ProfileID      uuid.UUID
+}
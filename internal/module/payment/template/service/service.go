@@ -0,0 +1,244 @@
+// internal/module/payment/template/service/service.go
+package template_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	payment_method_service "postmatic-api/internal/module/app/payment_method/service"
+	business_creator_image_service "postmatic-api/internal/module/creator/business_creator_image/service"
+	creator_image_service "postmatic-api/internal/module/creator/creator_image/service"
+	payment_common_service "postmatic-api/internal/module/payment/common/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// templateCurrency: harga creator image hanya dalam IDR
+const templateCurrency = "IDR"
+
+// TemplatePaymentService handles paid template (creator image) purchase
+type TemplatePaymentService struct {
+	store                entity.Store
+	creatorImage         *creator_image_service.CreatorImageService
+	businessCreatorImage *business_creator_image_service.BusinessCreatorImageService
+	paymentMethod        *payment_method_service.PaymentMethodService
+	paymentCommon        *payment_common_service.PaymentCommonService
+}
+
+// NewService creates a new TemplatePaymentService
+func NewService(
+	store entity.Store,
+	creatorImage *creator_image_service.CreatorImageService,
+	businessCreatorImage *business_creator_image_service.BusinessCreatorImageService,
+	paymentMethod *payment_method_service.PaymentMethodService,
+	paymentCommon *payment_common_service.PaymentCommonService,
+) *TemplatePaymentService {
+	return &TemplatePaymentService{
+		store:                store,
+		creatorImage:         creatorImage,
+		businessCreatorImage: businessCreatorImage,
+		paymentMethod:        paymentMethod,
+		paymentCommon:        paymentCommon,
+	}
+}
+
+// CheckPrice calculates the total price for template checkout preview
+func (s *TemplatePaymentService) CheckPrice(ctx context.Context, input CheckPriceInput) (CheckPriceResponse, error) {
+	var response CheckPriceResponse
+
+	// 1. Validate currency
+	if strings.ToUpper(input.CurrencyCode) != templateCurrency {
+		return response, errs.NewBadRequest("CURRENCY_NOT_SUPPORTED")
+	}
+
+	// 2. Validate creator image can be purchased by this business
+	template, err := s.getPurchasableTemplate(ctx, input.CreatorImageID, input.BusinessRootID, input.ProfileID)
+	if err != nil {
+		return response, err
+	}
+
+	// 3. Get payment method
+	pm, err := s.paymentMethod.GetPaymentMethodByCode(ctx, input.PaymentMethod, false)
+	if err != nil {
+		return response, err
+	}
+	if !pm.IsActive {
+		return response, errs.NewBadRequest("PAYMENT_METHOD_INACTIVE")
+	}
+
+	// 4. Calculate price (referral tidak berlaku untuk template)
+	calcResult := payment_common_service.CalculatePrice(payment_common_service.PriceCalculationInput{
+		BasePrice:     template.Price,
+		AdminFeeType:  string(pm.AdminType),
+		AdminFeeValue: pm.AdminFee,
+		TaxPercentage: pm.TaxFee,
+	})
+
+	// 5. Build response
+	response.Template = TemplateInfo{
+		CreatorImageID: template.ID,
+		Name:           template.Name,
+		ImageURL:       template.ImageURL,
+		Price:          template.Price,
+	}
+	response.Calculation = payment_common_service.PriceCalculation{
+		ItemPrice:         calcResult.ItemPrice,
+		DiscountAmount:    calcResult.DiscountAmount,
+		AfterDiscount:     calcResult.AfterDiscount,
+		AdminFeeAmount:    calcResult.AdminFeeAmount,
+		SubtotalBeforeTax: calcResult.SubtotalBeforeTax,
+		TaxAmount:         calcResult.TaxAmount,
+		TotalAmount:       calcResult.TotalAmount,
+	}
+	response.PaymentMethod = payment_common_service.PaymentMethodInfo{
+		Code: pm.Code,
+		Name: pm.Name,
+		Type: string(pm.Type),
+	}
+
+	return response, nil
+}
+
+// CreatePayment creates a new template payment and charges via payment gateway.
+// Lisensi & earning creator dibuat saat payment success (lihat payment common applyStatusChange).
+func (s *TemplatePaymentService) CreatePayment(ctx context.Context, input CreatePaymentInput) (CreatePaymentResponse, error) {
+	var response CreatePaymentResponse
+
+	// 1. Re-validate and calculate price (same as CheckPrice)
+	checkResult, err := s.CheckPrice(ctx, CheckPriceInput{
+		CreatorImageID: input.CreatorImageID,
+		CurrencyCode:   input.CurrencyCode,
+		PaymentMethod:  input.PaymentMethod,
+		BusinessRootID: input.BusinessRootID,
+		ProfileID:      input.ProfileID,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	// 2. Get payment method for type detection
+	pm, err := s.paymentMethod.GetPaymentMethodByCode(ctx, input.PaymentMethod, false)
+	if err != nil {
+		return response, err
+	}
+
+	// 3. Generate order ID
+	productName := fmt.Sprintf("Template %s", checkResult.Template.Name)
+	orderID := fmt.Sprintf("TPL-%d-%s", time.Now().UnixMilli(), uuid.New().String()[:8])
+
+	// 4. Create payment history
+	now := time.Now()
+	expiresAt := now.Add(24 * time.Hour) // 24 hours expiry
+
+	var adminFeePct sql.NullInt32
+	if string(pm.AdminType) == "percentage" {
+		adminFeePct = sql.NullInt32{Int32: int32(pm.AdminFee), Valid: true}
+	}
+
+	paymentHistory, err := s.store.CreatePaymentHistory(ctx, entity.CreatePaymentHistoryParams{
+		ProfileID:               input.ProfileID,
+		BusinessRootID:          input.BusinessRootID,
+		ProductAmount:           1,
+		Status:                  entity.PaymentStatusPending,
+		Currency:                templateCurrency,
+		PaymentMethod:           pm.Code,
+		PaymentMethodType:       string(pm.Type),
+		RecordProductName:       productName,
+		RecordProductType:       entity.PaymentProductTypeCreatorImage,
+		RecordProductPrice:      checkResult.Template.Price,
+		RecordProductImageUrl:   checkResult.Template.ImageURL,
+		ReferenceCreatorImageID: sql.NullInt64{Int64: checkResult.Template.CreatorImageID, Valid: true},
+		SubtotalItemAmount:      checkResult.Calculation.ItemPrice,
+		DiscountAmount:          checkResult.Calculation.DiscountAmount,
+		DiscountType:            entity.DiscountTypeFixed,
+		AdminFeeAmount:          checkResult.Calculation.AdminFeeAmount,
+		AdminFeePercentage:      adminFeePct,
+		AdminFeeType:            entity.DiscountType(pm.AdminType),
+		TaxAmount:               checkResult.Calculation.TaxAmount,
+		TaxPercentage:           int32(pm.TaxFee),
+		MidtransExpiredAt:       sql.NullTime{Time: expiresAt, Valid: true},
+		PaymentPendingAt:        sql.NullTime{Time: now, Valid: true},
+		TotalAmount:             checkResult.Calculation.TotalAmount,
+		Gateway:                 entity.PaymentGatewayType(pm.Gateway),
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return response, appErr
+		}
+		return response, errs.NewInternalServerError(err)
+	}
+
+	// 5. Charge via payment gateway (actions, gateway transaction id & email checkout)
+	charge, err := s.paymentCommon.ChargePayment(ctx, payment_common_service.ChargePaymentInput{
+		Payment:           paymentHistory,
+		OrderID:           orderID,
+		PaymentMethodCode: pm.Code,
+		PaymentMethodName: pm.Name,
+		PaymentMethodType: string(pm.Type),
+		ItemID:            strconv.FormatInt(checkResult.Template.CreatorImageID, 10),
+		ItemName:          productName,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	// 6. Build response
+	response.PaymentID = paymentHistory.ID.String()
+	response.OrderID = orderID
+	response.Status = string(paymentHistory.Status)
+	response.PaymentMethod = checkResult.PaymentMethod
+	response.Calculation = checkResult.Calculation
+	response.Template = checkResult.Template
+	response.Actions = charge.Actions
+
+	if paymentHistory.MidtransExpiredAt.Valid {
+		response.ExpiresAt = &paymentHistory.MidtransExpiredAt.Time
+	}
+
+	return response, nil
+}
+
+// getPurchasableTemplate validates creator image exists, published, berbayar,
+// bukan milik pembeli dan belum dilisensikan ke business
+func (s *TemplatePaymentService) getPurchasableTemplate(ctx context.Context, creatorImageID int64, businessRootID int64, profileID uuid.UUID) (*creator_image_service.CreatorImageDetail, error) {
+	detail, err := s.creatorImage.GetCreatorImageDetailById(ctx, creatorImageID)
+	if err != nil {
+		return nil, err
+	}
+	if detail == nil {
+		return nil, errs.NewNotFound("CREATOR_IMAGE_NOT_FOUND")
+	}
+	if detail.IsDeleted {
+		return nil, errs.NewBadRequest("CREATOR_IMAGE_DELETED")
+	}
+	if detail.IsBanned {
+		return nil, errs.NewBadRequest("CREATOR_IMAGE_BANNED")
+	}
+	if !detail.IsPublished {
+		return nil, errs.NewBadRequest("CREATOR_IMAGE_NOT_PUBLISHED")
+	}
+	if detail.Price <= 0 {
+		return nil, errs.NewBadRequest("CREATOR_IMAGE_IS_FREE")
+	}
+	if detail.ProfileID != nil && *detail.ProfileID == profileID {
+		return nil, errs.NewBadRequest("CANNOT_PURCHASE_OWN_CREATOR_IMAGE")
+	}
+
+	licensed, err := s.businessCreatorImage.HasActiveLicense(ctx, businessRootID, creatorImageID)
+	if err != nil {
+		return nil, err
+	}
+	if licensed {
+		return nil, errs.NewBadRequest("CREATOR_IMAGE_ALREADY_LICENSED")
+	}
+
+	return detail, nil
+}
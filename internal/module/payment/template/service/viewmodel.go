@@ -0,0 +1,40 @@
+// internal/module/payment/template/service/viewmodel.go
+package template_service
+
+import (
+	"time"
+
+	payment_common_service "postmatic-api/internal/module/payment/common/service"
+)
+
+// TemplateInfo represents purchased creator image in response
+type TemplateInfo struct {
+	CreatorImageID int64  `json:"creatorImageId"`
+	Name           string `json:"name"`
+	ImageURL       string `json:"imageUrl"`
+	Price          int64  `json:"price"`
+}
+
+// CheckPriceResponse is the response for check price endpoint
+type CheckPriceResponse struct {
+	Template      TemplateInfo                             `json:"template"`
+	Calculation   payment_common_service.PriceCalculation  `json:"calculation"`
+	PaymentMethod payment_common_service.PaymentMethodInfo `json:"paymentMethod"`
+}
+
+// CreatePaymentResponse is the response for create payment endpoint
+// Note: Optional pointer fields will return null (not undefined) when nil
+type CreatePaymentResponse struct {
+	PaymentID     string                                   `json:"paymentId"`
+	OrderID       string                                   `json:"orderId"`
+	Status        string                                   `json:"status"`
+	PaymentMethod payment_common_service.PaymentMethodInfo `json:"paymentMethod"`
+	ExpiresAt     *time.Time                               `json:"expiresAt"`
+
+	// Price calculation details
+	Calculation payment_common_service.PriceCalculation `json:"calculation"`
+	Template    TemplateInfo                            `json:"template"`
+
+	// Actions from Midtrans (filtered to public only)
+	Actions []payment_common_service.PaymentActionResponse `json:"actions"`
+}
@@ -1,27 +1,32 @@
-// internal/module/payment/image_token/handler/handler.go
-package image_token_handler
+// internal/module/payment/token/handler/handler.go
+package token_handler
 
 import (
 	"net/http"
 	"strconv"
 
 	"postmatic-api/internal/internal_middleware"
-	image_token_service "postmatic-api/internal/module/payment/image_token/service"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
+	token_service "postmatic-api/internal/module/payment/token/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
 	"postmatic-api/pkg/response"
 	"postmatic-api/pkg/utils"
 
 	"github.com/go-chi/chi/v5"
 )
 
-type ImageTokenPaymentHandler struct {
-	service *image_token_service.ImageTokenPaymentService
+type TokenPaymentHandler struct {
+	service    *token_service.TokenPaymentService
+	middleware *internal_middleware.OwnedBusiness
 }
 
-func NewHandler(service *image_token_service.ImageTokenPaymentService) *ImageTokenPaymentHandler {
-	return &ImageTokenPaymentHandler{service: service}
+func NewHandler(service *token_service.TokenPaymentService, middleware *internal_middleware.OwnedBusiness) *TokenPaymentHandler {
+	return &TokenPaymentHandler{service: service, middleware: middleware}
 }
 
-func (h *ImageTokenPaymentHandler) Routes(allAllowedMiddleware func(http.Handler) http.Handler) http.Handler {
+// Routes: di-mount di /payment/{tokenType}
+func (h *TokenPaymentHandler) Routes(allAllowedMiddleware func(http.Handler) http.Handler) http.Handler {
 	r := chi.NewRouter()
 	r.Use(allAllowedMiddleware)
 
@@ -32,20 +37,27 @@ func (h *ImageTokenPaymentHandler) Routes(allAllowedMiddleware func(http.Handler
 }
 
 // CheckPrice godoc
-// @Summary Check price for image token purchase
+// @Summary Check price for token purchase
 // @Tags Payment
 // @Accept json
 // @Produce json
+// @Param tokenType path string true "Token type (image-token, video-token, livestream-token)"
 // @Param tokenAmount query int true "Token amount to purchase"
 // @Param currencyCode query string true "Currency code (e.g., IDR)"
 // @Param paymentMethod query string true "Payment method code (e.g., bca, gopay)"
 // @Param referralCode query string false "Referral code (optional)"
 // @Param businessRootId query int true "Business root ID"
-// @Success 200 {object} response.Response{data=image_token_service.CheckPriceResponse}
-// @Router /api/payment/image-token [get]
-func (h *ImageTokenPaymentHandler) CheckPrice(w http.ResponseWriter, r *http.Request) {
+// @Success 200 {object} response.Response{data=token_service.CheckPriceResponse}
+// @Router /api/payment/{tokenType} [get]
+func (h *TokenPaymentHandler) CheckPrice(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	tokenType, err := parseTokenType(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
 	// Parse query params
 	tokenAmountStr := r.URL.Query().Get("tokenAmount")
 	if tokenAmountStr == "" {
@@ -93,13 +105,20 @@ func (h *ImageTokenPaymentHandler) CheckPrice(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	input := image_token_service.CheckPriceInput{
+	input := token_service.CheckPriceInput{
 		TokenAmount:    tokenAmount,
 		CurrencyCode:   currencyCode,
 		PaymentMethod:  paymentMethod,
 		ReferralCode:   referralCode,
 		BusinessRootID: businessRootId,
 		ProfileID:      claims.ID, // ID is the Profile ID
+		TokenType:      tokenType,
+	}
+
+	// businessRootId dari query -> cek membership + permission manual
+	if _, err := h.middleware.AuthorizeBusiness(ctx, claims.ID, businessRootId, internal_middleware.PermBillingPurchase); err != nil {
+		response.Error(w, r, err, nil)
+		return
 	}
 
 	result, err := h.service.CheckPrice(ctx, input)
@@ -112,17 +131,24 @@ func (h *ImageTokenPaymentHandler) CheckPrice(w http.ResponseWriter, r *http.Req
 }
 
 // CreatePayment godoc
-// @Summary Create payment for image token purchase
+// @Summary Create payment for token purchase
 // @Tags Payment
 // @Accept json
 // @Produce json
-// @Param body body image_token_service.CreatePaymentInput true "Payment input"
-// @Success 201 {object} response.Response{data=image_token_service.CreatePaymentResponse}
-// @Router /api/payment/image-token [post]
-func (h *ImageTokenPaymentHandler) CreatePayment(w http.ResponseWriter, r *http.Request) {
+// @Param tokenType path string true "Token type (image-token, video-token, livestream-token)"
+// @Param body body token_service.CreatePaymentInput true "Payment input"
+// @Success 201 {object} response.Response{data=token_service.CreatePaymentResponse}
+// @Router /api/payment/{tokenType} [post]
+func (h *TokenPaymentHandler) CreatePayment(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var input image_token_service.CreatePaymentInput
+	tokenType, err := parseTokenType(r)
+	if err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
+
+	var input token_service.CreatePaymentInput
 
 	// Validate request body with utils.ValidateStruct
 	if appErr := utils.ValidateStruct(r.Body, &input); appErr != nil {
@@ -137,6 +163,13 @@ func (h *ImageTokenPaymentHandler) CreatePayment(w http.ResponseWriter, r *http.
 		return
 	}
 	input.ProfileID = claims.ID // ID is the Profile ID
+	input.TokenType = tokenType
+
+	// businessRootId dari body -> cek membership + permission manual
+	if _, err := h.middleware.AuthorizeBusiness(ctx, claims.ID, input.BusinessRootID, internal_middleware.PermBillingPurchase); err != nil {
+		response.Error(w, r, err, nil)
+		return
+	}
 
 	result, err := h.service.CreatePayment(ctx, input)
 	if err != nil {
@@ -146,3 +179,12 @@ func (h *ImageTokenPaymentHandler) CreatePayment(w http.ResponseWriter, r *http.
 
 	response.OK(w, r, "PAYMENT_CREATED", result)
 }
+
+// parseTokenType reads {tokenType} path param (image-token, video-token, livestream-token)
+func parseTokenType(r *http.Request) (entity.TokenType, error) {
+	tokenType, ok := token_ledger_service.TokenTypeFromSlug(chi.URLParam(r, "tokenType"))
+	if !ok {
+		return "", errs.NewNotFound("TOKEN_TYPE_NOT_FOUND")
+	}
+	return tokenType, nil
+}
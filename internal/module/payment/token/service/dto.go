@@ -1,7 +1,11 @@
-// internal/module/payment/image_token/service/dto.go
-package image_token_service
+// internal/module/payment/token/service/dto.go
+package token_service
 
-import "github.com/google/uuid"
+import (
+	"postmatic-api/internal/repository/entity"
+
+	"github.com/google/uuid"
+)
 
 // CheckPriceInput is the input for checking price before payment
 type CheckPriceInput struct {
@@ -11,6 +15,7 @@ type CheckPriceInput struct {
 	ReferralCode   *string `json:"referralCode"`
 	BusinessRootID int64   `json:"businessRootId" validate:"required"`
 	ProfileID      uuid.UUID
+	TokenType      entity.TokenType
 }
 
 // CreatePaymentInput is the input for creating a payment
@@ -21,15 +26,5 @@ type CreatePaymentInput struct {
 	ReferralCode   *string `json:"referralCode"`
 	BusinessRootID int64   `json:"businessRootId" validate:"required"`
 	ProfileID      uuid.UUID
-}
-
-// PriceCalculationInput is internal input for price calculation
-type PriceCalculationInput struct {
-	BasePrice     int64  // harga asli product
-	DiscountType  string // "fixed" atau "percentage"
-	DiscountValue int64  // nilai diskon (nominal atau %)
-	MaxDiscount   int64  // max cap untuk percentage
-	AdminFeeType  string // "fixed" atau "percentage"
-	AdminFeeValue int64  // nilai admin fee
-	TaxPercentage int64  // tax percentage
+	TokenType      entity.TokenType
 }
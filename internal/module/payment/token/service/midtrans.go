@@ -1,5 +1,5 @@
-// internal/module/payment/image_token/service/midtrans.go
-package image_token_service
+// internal/module/payment/token/service/midtrans.go
+package token_service
 
 // PaymentAction for e-wallet deep link (from Midtrans response)
 type PaymentAction struct {
@@ -0,0 +1,357 @@
+// internal/module/payment/token/service/service.go
+package token_service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	referral_basic_service "postmatic-api/internal/module/affiliator/referral_basic/service"
+	payment_method_service "postmatic-api/internal/module/app/payment_method/service"
+	token_product_service "postmatic-api/internal/module/app/token_product/service"
+	payment_common_service "postmatic-api/internal/module/payment/common/service"
+	"postmatic-api/internal/repository/entity"
+	"postmatic-api/pkg/errs"
+
+	"github.com/google/uuid"
+)
+
+// TokenPaymentService handles token purchase (checkout) for every token type
+type TokenPaymentService struct {
+	store         entity.Store
+	tokenProduct  *token_product_service.TokenProductService
+	paymentMethod *payment_method_service.PaymentMethodService
+	referral      *referral_basic_service.ReferralBasicService
+	paymentCommon *payment_common_service.PaymentCommonService
+}
+
+// NewService creates a new TokenPaymentService
+func NewService(
+	store entity.Store,
+	tokenProduct *token_product_service.TokenProductService,
+	paymentMethod *payment_method_service.PaymentMethodService,
+	referral *referral_basic_service.ReferralBasicService,
+	paymentCommon *payment_common_service.PaymentCommonService,
+) *TokenPaymentService {
+	return &TokenPaymentService{
+		store:         store,
+		tokenProduct:  tokenProduct,
+		paymentMethod: paymentMethod,
+		referral:      referral,
+		paymentCommon: paymentCommon,
+	}
+}
+
+// CheckPrice calculates the total price for checkout preview
+func (s *TokenPaymentService) CheckPrice(ctx context.Context, input CheckPriceInput) (CheckPriceResponse, error) {
+	response, _, err := s.checkPrice(ctx, input)
+	return response, err
+}
+
+// checkPrice also returns token product calculation (harga & kurs) agar CreatePayment mencatat kurs yang sama dengan kalkulasi
+func (s *TokenPaymentService) checkPrice(ctx context.Context, input CheckPriceInput) (CheckPriceResponse, token_product_service.TokenCalculateProductResponse, error) {
+	var response CheckPriceResponse
+	currencyCode := strings.ToUpper(input.CurrencyCode)
+
+	// 1. Get token product price (dikonversi ke currency pembeli jika perlu)
+	tokenCalc, err := s.tokenProduct.CalculateTokenProduct(ctx, token_product_service.TokenCalculateProductFilter{
+		Type:         string(input.TokenType),
+		CurrencyCode: currencyCode,
+		From:         "token",
+		Amount:       input.TokenAmount,
+	})
+	if err != nil {
+		return response, tokenCalc, err
+	}
+
+	// 2. Get payment method
+	pm, err := s.paymentMethod.GetPaymentMethodByCode(ctx, input.PaymentMethod, false)
+	if err != nil {
+		return response, tokenCalc, err
+	}
+	if !pm.IsActive {
+		return response, tokenCalc, errs.NewBadRequest("PAYMENT_METHOD_INACTIVE")
+	}
+	if err := s.paymentCommon.ValidatePaymentCurrency(entity.PaymentGatewayType(pm.Gateway), currencyCode); err != nil {
+		return response, tokenCalc, err
+	}
+
+	// 3. Build price calculation input
+	// admin fee & diskon fixed dalam base currency, dikonversi dengan kurs yang sama dengan harga product
+	adminFee, err := convertFixedAmount(tokenCalc, string(pm.AdminType), pm.AdminFee)
+	if err != nil {
+		return response, tokenCalc, err
+	}
+	calcInput := payment_common_service.PriceCalculationInput{
+		BasePrice:     tokenCalc.PriceAmount,
+		AdminFeeType:  string(pm.AdminType),
+		AdminFeeValue: adminFee,
+		TaxPercentage: pm.TaxFee,
+	}
+
+	// 4. Validate referral if provided
+	if input.ReferralCode != nil && *input.ReferralCode != "" {
+		referralValidation, err := s.referral.ValidateReferralForPayment(ctx, referral_basic_service.ValidateReferralInput{
+			Code:           *input.ReferralCode,
+			ProfileID:      input.ProfileID,
+			BusinessRootID: input.BusinessRootID,
+			ProductType:    string(input.TokenType),
+		})
+		if err != nil {
+			return response, tokenCalc, err
+		}
+
+		response.Referral = &ReferralInfo{
+			Valid:   referralValidation.Valid,
+			Message: referralValidation.Message,
+		}
+
+		if referralValidation.Valid {
+			calcInput.DiscountType = referralValidation.DiscountType
+			calcInput.DiscountValue, err = convertFixedAmount(tokenCalc, referralValidation.DiscountType, referralValidation.TotalDiscount)
+			if err != nil {
+				return response, tokenCalc, err
+			}
+			calcInput.MaxDiscount, err = convertFixedAmount(tokenCalc, "fixed", referralValidation.MaxDiscount)
+			if err != nil {
+				return response, tokenCalc, err
+			}
+		}
+	}
+
+	// 5. Calculate price
+	calcResult := payment_common_service.CalculatePrice(calcInput)
+
+	// 6. Build response
+	response.TokenType = string(input.TokenType)
+	response.TokenAmount = input.TokenAmount
+	response.Calculation = payment_common_service.PriceCalculation{
+		ItemPrice:         calcResult.ItemPrice,
+		DiscountAmount:    calcResult.DiscountAmount,
+		AfterDiscount:     calcResult.AfterDiscount,
+		AdminFeeAmount:    calcResult.AdminFeeAmount,
+		SubtotalBeforeTax: calcResult.SubtotalBeforeTax,
+		TaxAmount:         calcResult.TaxAmount,
+		TotalAmount:       calcResult.TotalAmount,
+	}
+	response.PaymentMethod = payment_common_service.PaymentMethodInfo{
+		Code: pm.Code,
+		Name: pm.Name,
+		Type: string(pm.Type),
+	}
+	response.CurrencyCode = currencyCode
+	response.ExchangeRate = tokenCalc.ExchangeRate
+
+	return response, tokenCalc, nil
+}
+
+// CreatePayment creates a new payment and charges via payment gateway
+func (s *TokenPaymentService) CreatePayment(ctx context.Context, input CreatePaymentInput) (CreatePaymentResponse, error) {
+	var response CreatePaymentResponse
+
+	// 1. Re-validate and calculate price (same as CheckPrice)
+	checkResult, tokenCalc, err := s.checkPrice(ctx, CheckPriceInput{
+		TokenType:      input.TokenType,
+		TokenAmount:    input.TokenAmount,
+		CurrencyCode:   input.CurrencyCode,
+		PaymentMethod:  input.PaymentMethod,
+		ReferralCode:   input.ReferralCode,
+		BusinessRootID: input.BusinessRootID,
+		ProfileID:      input.ProfileID,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	// 2. Check if referral is valid (if provided)
+	var referralRecordID sql.NullInt64
+	var referralValidation *referral_basic_service.ReferralValidationResponse
+	if input.ReferralCode != nil && *input.ReferralCode != "" {
+		referralValidation, err = s.referral.ValidateReferralForPayment(ctx, referral_basic_service.ValidateReferralInput{
+			Code:           *input.ReferralCode,
+			ProfileID:      input.ProfileID,
+			BusinessRootID: input.BusinessRootID,
+			ProductType:    string(input.TokenType),
+		})
+		if err != nil {
+			return response, err
+		}
+		if !referralValidation.Valid {
+			return response, errs.NewBadRequest(referralValidation.Message)
+		}
+	}
+
+	// 3. Get payment method for type detection
+	pm, err := s.paymentMethod.GetPaymentMethodByCode(ctx, input.PaymentMethod, false)
+	if err != nil {
+		return response, err
+	}
+
+	// 4. Exchange rate for recording (NULL jika tanpa konversi)
+	var exchangeRateID, exchangeRateMicros sql.NullInt64
+	var exchangeBaseCurrency sql.NullString
+	if tokenCalc.Rate != nil {
+		exchangeRateID = sql.NullInt64{Int64: tokenCalc.Rate.ID, Valid: true}
+		exchangeRateMicros = sql.NullInt64{Int64: tokenCalc.Rate.RateMicros, Valid: true}
+		exchangeBaseCurrency = sql.NullString{String: tokenCalc.Rate.BaseCurrency, Valid: true}
+	}
+
+	// 5. Generate order ID
+	productLabel, orderPrefix := tokenProductLabel(input.TokenType)
+	productName := fmt.Sprintf("%s x%d", productLabel, input.TokenAmount)
+	orderID := fmt.Sprintf("%s-%d-%s", orderPrefix, time.Now().UnixMilli(), uuid.New().String()[:8])
+
+	// 6. Execute in transaction
+	var paymentHistory entity.PaymentHistory
+
+	err = s.store.ExecTx(ctx, func(q *entity.Queries) error {
+		// 6a. Create referral record if applicable
+		if referralValidation != nil && referralValidation.Valid {
+			// lock referral code agar global max usage tidak terlampaui oleh checkout paralel
+			if err := q.LockProfileReferralCodeById(ctx, referralValidation.ReferralCodeID); err != nil {
+				return err
+			}
+			if referralValidation.MaxUsage != nil {
+				usageCount, err := q.CountReferralCodeUsage(ctx, referralValidation.ReferralCodeID)
+				if err != nil {
+					return err
+				}
+				if usageCount >= *referralValidation.MaxUsage {
+					return errs.NewBadRequest("REFERRAL_CODE_MAX_USAGE_REACHED")
+				}
+			}
+
+			referralRecord, err := q.CreateReferralRecord(ctx, entity.CreateReferralRecordParams{
+				ConsumerProfileID:       input.ProfileID,
+				BusinessRootID:          input.BusinessRootID,
+				ProfileReferralCodeID:   referralValidation.ReferralCodeID,
+				RecordType:              entity.ReferralType(referralValidation.Type),
+				RecordTotalDiscount:     referralValidation.TotalDiscount,
+				RecordDiscountType:      entity.DiscountType(referralValidation.DiscountType),
+				RecordMaxDiscount:       referralValidation.MaxDiscount,
+				RecordRewardPerReferral: referralValidation.RewardPerReferral,
+				DiscountAmountGranted:   checkResult.Calculation.DiscountAmount,
+				DiscountCurrency:        strings.ToUpper(input.CurrencyCode),
+				RewardAmountGranted:     referralValidation.RewardPerReferral,
+				// reward affiliator tidak dikonversi (base currency)
+				RewardCurrency: tokenCalc.BaseCurrencyCode,
+				Status:         entity.ReferralRecordStatusPending,
+			})
+			if err != nil {
+				return err
+			}
+			referralRecordID = sql.NullInt64{Int64: referralRecord.ID, Valid: true}
+		}
+
+		// 6b. Create payment history
+		now := time.Now()
+		expiresAt := now.Add(24 * time.Hour) // 24 hours expiry
+
+		// Determine discount percentage
+		var discountPct sql.NullInt32
+		if checkResult.Referral != nil && checkResult.Referral.Valid && referralValidation != nil {
+			if referralValidation.DiscountType == "percentage" {
+				discountPct = sql.NullInt32{Int32: int32(referralValidation.TotalDiscount), Valid: true}
+			}
+		}
+
+		// Determine admin fee percentage
+		var adminFeePct sql.NullInt32
+		if string(pm.AdminType) == "percentage" {
+			adminFeePct = sql.NullInt32{Int32: int32(pm.AdminFee), Valid: true}
+		}
+
+		paymentHistory, err = q.CreatePaymentHistory(ctx, entity.CreatePaymentHistoryParams{
+			ProfileID:             input.ProfileID,
+			BusinessRootID:        input.BusinessRootID,
+			ProductAmount:         input.TokenAmount,
+			Status:                entity.PaymentStatusPending,
+			Currency:              tokenCalc.CurrencyCode,
+			PaymentMethod:         pm.Code,
+			PaymentMethodType:     string(pm.Type),
+			RecordProductName:     productName,
+			RecordProductType:     entity.PaymentProductType(input.TokenType),
+			RecordProductPrice:    tokenCalc.PriceAmount,
+			RecordProductImageUrl: "",
+			ReferenceProductID:    uuid.NullUUID{UUID: tokenCalc.ID, Valid: true},
+			SubtotalItemAmount:    checkResult.Calculation.ItemPrice,
+			DiscountAmount:        checkResult.Calculation.DiscountAmount,
+			DiscountPercentage:    discountPct,
+			DiscountType:          entity.DiscountTypeFixed,
+			AdminFeeAmount:        checkResult.Calculation.AdminFeeAmount,
+			AdminFeePercentage:    adminFeePct,
+			AdminFeeType:          entity.DiscountType(pm.AdminType),
+			TaxAmount:             checkResult.Calculation.TaxAmount,
+			TaxPercentage:         int32(pm.TaxFee),
+			ReferralRecordID:      referralRecordID,
+			MidtransExpiredAt:     sql.NullTime{Time: expiresAt, Valid: true},
+			PaymentPendingAt:      sql.NullTime{Time: now, Valid: true},
+			TotalAmount:           checkResult.Calculation.TotalAmount,
+			Gateway:               entity.PaymentGatewayType(pm.Gateway),
+			ExchangeRateID:        exchangeRateID,
+			ExchangeBaseCurrency:  exchangeBaseCurrency,
+			ExchangeRateMicros:    exchangeRateMicros,
+		})
+		return err
+	})
+	if err != nil {
+		var appErr *errs.AppError
+		if errors.As(err, &appErr) {
+			return response, appErr
+		}
+		return response, errs.NewInternalServerError(err)
+	}
+
+	// 7. Charge via payment gateway (actions, gateway transaction id & email checkout)
+	charge, err := s.paymentCommon.ChargePayment(ctx, payment_common_service.ChargePaymentInput{
+		Payment:           paymentHistory,
+		OrderID:           orderID,
+		PaymentMethodCode: pm.Code,
+		PaymentMethodName: pm.Name,
+		PaymentMethodType: string(pm.Type),
+		ItemID:            tokenCalc.ID.String(),
+		ItemName:          productName,
+	})
+	if err != nil {
+		return response, err
+	}
+
+	// 8. Build response
+	response.PaymentID = paymentHistory.ID.String()
+	response.OrderID = orderID
+	response.Status = string(paymentHistory.Status)
+	response.PaymentMethod = checkResult.PaymentMethod
+	response.Calculation = checkResult.Calculation
+	response.TokenType = string(input.TokenType)
+	response.TokenAmount = input.TokenAmount
+	response.Actions = charge.Actions
+
+	if paymentHistory.MidtransExpiredAt.Valid {
+		response.ExpiresAt = &paymentHistory.MidtransExpiredAt.Time
+	}
+
+	return response, nil
+}
+
+// convertFixedAmount converts fixed fee / discount (base currency) ke currency pembeli, percentage tidak dikonversi
+func convertFixedAmount(tokenCalc token_product_service.TokenCalculateProductResponse, valueType string, value int64) (int64, error) {
+	if tokenCalc.Rate == nil || valueType != "fixed" {
+		return value, nil
+	}
+	return tokenCalc.Rate.FromBase(value)
+}
+
+// tokenProductLabel returns product name & order ID prefix per token type
+func tokenProductLabel(tokenType entity.TokenType) (label string, orderPrefix string) {
+	switch tokenType {
+	case entity.TokenTypeVideoToken:
+		return "Video Token", "VID"
+	case entity.TokenTypeLivestreamToken:
+		return "Livestream Token", "LIV"
+	default:
+		return "Image Token", "IMG"
+	}
+}
@@ -0,0 +1,47 @@
+// internal/module/payment/token/service/viewmodel.go
+package token_service
+
+import (
+	"time"
+
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+	payment_common_service "postmatic-api/internal/module/payment/common/service"
+)
+
+// ReferralInfo represents referral validation info in response
+type ReferralInfo struct {
+	Valid   bool   `json:"valid"`
+	Message string `json:"message"`
+}
+
+// CheckPriceResponse is the response for check price endpoint
+// Note: Optional pointer fields will return null (not undefined) when nil
+type CheckPriceResponse struct {
+	Referral      *ReferralInfo                            `json:"referral"`
+	Calculation   payment_common_service.PriceCalculation  `json:"calculation"`
+	PaymentMethod payment_common_service.PaymentMethodInfo `json:"paymentMethod"`
+	TokenType     string                                   `json:"tokenType"`
+	TokenAmount   int64                                    `json:"tokenAmount"`
+	// seluruh nominal calculation dalam currencyCode
+	CurrencyCode string `json:"currencyCode"`
+	// null jika harga tanpa konversi
+	ExchangeRate *exchange_rate_service.ExchangeRateInfo `json:"exchangeRate"`
+}
+
+// CreatePaymentResponse is the response for create payment endpoint
+// Note: Optional pointer fields will return null (not undefined) when nil
+type CreatePaymentResponse struct {
+	PaymentID     string                                   `json:"paymentId"`
+	OrderID       string                                   `json:"orderId"`
+	Status        string                                   `json:"status"`
+	PaymentMethod payment_common_service.PaymentMethodInfo `json:"paymentMethod"`
+	ExpiresAt     *time.Time                               `json:"expiresAt"`
+
+	// Price calculation details
+	Calculation payment_common_service.PriceCalculation `json:"calculation"`
+	TokenType   string                                  `json:"tokenType"`
+	TokenAmount int64                                   `json:"tokenAmount"`
+
+	// Actions from Midtrans (filtered to public only)
+	Actions []payment_common_service.PaymentActionResponse `json:"actions"`
+}
@@ -0,0 +1,398 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: affiliator_payout_request.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countAllAffiliatorPayoutRequests = `-- name: CountAllAffiliatorPayoutRequests :one
+SELECT COUNT(*)::bigint AS total
+FROM affiliator_payout_requests apr
+JOIN profiles pr ON pr.id = apr.profile_id
+WHERE
+    apr.deleted_at IS NULL
+    AND (
+        $1::uuid IS NULL
+        OR apr.profile_id = $1::uuid
+    )
+    AND (
+        $2::affiliator_payout_status IS NULL
+        OR apr.status = $2::affiliator_payout_status
+    )
+    AND (
+        COALESCE($3, '') = ''
+        OR pr.name ILIKE ('%' || $3 || '%')
+        OR pr.email ILIKE ('%' || $3 || '%')
+        OR apr.bank_name ILIKE ('%' || $3 || '%')
+        OR apr.bank_account_number ILIKE ('%' || $3 || '%')
+        OR apr.bank_account_name ILIKE ('%' || $3 || '%')
+    )
+    AND (
+        $4::date IS NULL
+        OR apr.created_at::date >= $4::date
+    )
+    AND (
+        $5::date IS NULL
+        OR apr.created_at::date <= $5::date
+    )
+`
+
+type CountAllAffiliatorPayoutRequestsParams struct {
+	ProfileID uuid.NullUUID              `json:"profile_id"`
+	Status    NullAffiliatorPayoutStatus `json:"status"`
+	Search    interface{}                `json:"search"`
+	DateStart sql.NullTime               `json:"date_start"`
+	DateEnd   sql.NullTime               `json:"date_end"`
+}
+
+func (q *Queries) CountAllAffiliatorPayoutRequests(ctx context.Context, arg CountAllAffiliatorPayoutRequestsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllAffiliatorPayoutRequests,
+		arg.ProfileID,
+		arg.Status,
+		arg.Search,
+		arg.DateStart,
+		arg.DateEnd,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createAffiliatorPayoutRequest = `-- name: CreateAffiliatorPayoutRequest :one
+INSERT INTO affiliator_payout_requests (
+    profile_id,
+    amount,
+    currency,
+    bank_name,
+    bank_account_number,
+    bank_account_name
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING id, profile_id, amount, currency, bank_name, bank_account_number, bank_account_name, status, admin_note, reviewed_by_profile_id, reviewed_at, created_at, updated_at, deleted_at
+`
+
+type CreateAffiliatorPayoutRequestParams struct {
+	ProfileID         uuid.UUID `json:"profile_id"`
+	Amount            int64     `json:"amount"`
+	Currency          string    `json:"currency"`
+	BankName          string    `json:"bank_name"`
+	BankAccountNumber string    `json:"bank_account_number"`
+	BankAccountName   string    `json:"bank_account_name"`
+}
+
+func (q *Queries) CreateAffiliatorPayoutRequest(ctx context.Context, arg CreateAffiliatorPayoutRequestParams) (AffiliatorPayoutRequest, error) {
+	row := q.db.QueryRowContext(ctx, createAffiliatorPayoutRequest,
+		arg.ProfileID,
+		arg.Amount,
+		arg.Currency,
+		arg.BankName,
+		arg.BankAccountNumber,
+		arg.BankAccountName,
+	)
+	var i AffiliatorPayoutRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Amount,
+		&i.Currency,
+		&i.BankName,
+		&i.BankAccountNumber,
+		&i.BankAccountName,
+		&i.Status,
+		&i.AdminNote,
+		&i.ReviewedByProfileID,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAffiliatorPayoutRequestById = `-- name: GetAffiliatorPayoutRequestById :one
+SELECT
+    apr.id, apr.profile_id, apr.amount, apr.currency, apr.bank_name, apr.bank_account_number, apr.bank_account_name, apr.status, apr.admin_note, apr.reviewed_by_profile_id, apr.reviewed_at, apr.created_at, apr.updated_at, apr.deleted_at,
+    pr.name      AS profile_name,
+    pr.email     AS profile_email,
+    pr.image_url AS profile_image_url
+FROM affiliator_payout_requests apr
+JOIN profiles pr ON pr.id = apr.profile_id
+WHERE apr.id = $1 AND apr.deleted_at IS NULL
+`
+
+type GetAffiliatorPayoutRequestByIdRow struct {
+	ID                  int64                  `json:"id"`
+	ProfileID           uuid.UUID              `json:"profile_id"`
+	Amount              int64                  `json:"amount"`
+	Currency            string                 `json:"currency"`
+	BankName            string                 `json:"bank_name"`
+	BankAccountNumber   string                 `json:"bank_account_number"`
+	BankAccountName     string                 `json:"bank_account_name"`
+	Status              AffiliatorPayoutStatus `json:"status"`
+	AdminNote           sql.NullString         `json:"admin_note"`
+	ReviewedByProfileID uuid.NullUUID          `json:"reviewed_by_profile_id"`
+	ReviewedAt          sql.NullTime           `json:"reviewed_at"`
+	CreatedAt           time.Time              `json:"created_at"`
+	UpdatedAt           time.Time              `json:"updated_at"`
+	DeletedAt           sql.NullTime           `json:"deleted_at"`
+	ProfileName         string                 `json:"profile_name"`
+	ProfileEmail        string                 `json:"profile_email"`
+	ProfileImageUrl     sql.NullString         `json:"profile_image_url"`
+}
+
+func (q *Queries) GetAffiliatorPayoutRequestById(ctx context.Context, id int64) (GetAffiliatorPayoutRequestByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getAffiliatorPayoutRequestById, id)
+	var i GetAffiliatorPayoutRequestByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Amount,
+		&i.Currency,
+		&i.BankName,
+		&i.BankAccountNumber,
+		&i.BankAccountName,
+		&i.Status,
+		&i.AdminNote,
+		&i.ReviewedByProfileID,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ProfileName,
+		&i.ProfileEmail,
+		&i.ProfileImageUrl,
+	)
+	return i, err
+}
+
+const getAffiliatorPayoutRequestByIdForUpdate = `-- name: GetAffiliatorPayoutRequestByIdForUpdate :one
+SELECT id, profile_id, amount, currency, bank_name, bank_account_number, bank_account_name, status, admin_note, reviewed_by_profile_id, reviewed_at, created_at, updated_at, deleted_at FROM affiliator_payout_requests
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetAffiliatorPayoutRequestByIdForUpdate(ctx context.Context, id int64) (AffiliatorPayoutRequest, error) {
+	row := q.db.QueryRowContext(ctx, getAffiliatorPayoutRequestByIdForUpdate, id)
+	var i AffiliatorPayoutRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Amount,
+		&i.Currency,
+		&i.BankName,
+		&i.BankAccountNumber,
+		&i.BankAccountName,
+		&i.Status,
+		&i.AdminNote,
+		&i.ReviewedByProfileID,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAllAffiliatorPayoutRequests = `-- name: GetAllAffiliatorPayoutRequests :many
+WITH p AS (
+  SELECT
+    COALESCE(NULLIF($8,  ''), 'created_at') AS sort_by,
+    COALESCE(NULLIF($9, ''), 'desc')       AS sort_dir
+)
+SELECT
+    apr.id, apr.profile_id, apr.amount, apr.currency, apr.bank_name, apr.bank_account_number, apr.bank_account_name, apr.status, apr.admin_note, apr.reviewed_by_profile_id, apr.reviewed_at, apr.created_at, apr.updated_at, apr.deleted_at,
+    pr.name      AS profile_name,
+    pr.email     AS profile_email,
+    pr.image_url AS profile_image_url
+FROM affiliator_payout_requests apr
+JOIN profiles pr ON pr.id = apr.profile_id
+CROSS JOIN p
+WHERE
+    apr.deleted_at IS NULL
+    AND (
+        $1::uuid IS NULL
+        OR apr.profile_id = $1::uuid
+    )
+    AND (
+        $2::affiliator_payout_status IS NULL
+        OR apr.status = $2::affiliator_payout_status
+    )
+
+    -- search (affiliator + rekening)
+    AND (
+        COALESCE($3, '') = ''
+        OR pr.name ILIKE ('%' || $3 || '%')
+        OR pr.email ILIKE ('%' || $3 || '%')
+        OR apr.bank_name ILIKE ('%' || $3 || '%')
+        OR apr.bank_account_number ILIKE ('%' || $3 || '%')
+        OR apr.bank_account_name ILIKE ('%' || $3 || '%')
+    )
+
+    AND (
+        $4::date IS NULL
+        OR apr.created_at::date >= $4::date
+    )
+    AND (
+        $5::date IS NULL
+        OR apr.created_at::date <= $5::date
+    )
+
+ORDER BY
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'asc'  THEN apr.created_at END ASC,
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'desc' THEN apr.created_at END DESC,
+
+    CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'asc'  THEN apr.id END ASC,
+    CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'desc' THEN apr.id END DESC,
+
+    CASE WHEN p.sort_by = 'amount' AND p.sort_dir = 'asc'  THEN apr.amount END ASC,
+    CASE WHEN p.sort_by = 'amount' AND p.sort_dir = 'desc' THEN apr.amount END DESC,
+
+    apr.id DESC
+
+LIMIT $7
+OFFSET $6
+`
+
+type GetAllAffiliatorPayoutRequestsParams struct {
+	ProfileID  uuid.NullUUID              `json:"profile_id"`
+	Status     NullAffiliatorPayoutStatus `json:"status"`
+	Search     interface{}                `json:"search"`
+	DateStart  sql.NullTime               `json:"date_start"`
+	DateEnd    sql.NullTime               `json:"date_end"`
+	PageOffset int32                      `json:"page_offset"`
+	PageLimit  int32                      `json:"page_limit"`
+	SortBy     interface{}                `json:"sort_by"`
+	SortDir    interface{}                `json:"sort_dir"`
+}
+
+type GetAllAffiliatorPayoutRequestsRow struct {
+	ID                  int64                  `json:"id"`
+	ProfileID           uuid.UUID              `json:"profile_id"`
+	Amount              int64                  `json:"amount"`
+	Currency            string                 `json:"currency"`
+	BankName            string                 `json:"bank_name"`
+	BankAccountNumber   string                 `json:"bank_account_number"`
+	BankAccountName     string                 `json:"bank_account_name"`
+	Status              AffiliatorPayoutStatus `json:"status"`
+	AdminNote           sql.NullString         `json:"admin_note"`
+	ReviewedByProfileID uuid.NullUUID          `json:"reviewed_by_profile_id"`
+	ReviewedAt          sql.NullTime           `json:"reviewed_at"`
+	CreatedAt           time.Time              `json:"created_at"`
+	UpdatedAt           time.Time              `json:"updated_at"`
+	DeletedAt           sql.NullTime           `json:"deleted_at"`
+	ProfileName         string                 `json:"profile_name"`
+	ProfileEmail        string                 `json:"profile_email"`
+	ProfileImageUrl     sql.NullString         `json:"profile_image_url"`
+}
+
+// profile_id null = semua affiliator (admin queue)
+func (q *Queries) GetAllAffiliatorPayoutRequests(ctx context.Context, arg GetAllAffiliatorPayoutRequestsParams) ([]GetAllAffiliatorPayoutRequestsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllAffiliatorPayoutRequests,
+		arg.ProfileID,
+		arg.Status,
+		arg.Search,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.PageOffset,
+		arg.PageLimit,
+		arg.SortBy,
+		arg.SortDir,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllAffiliatorPayoutRequestsRow
+	for rows.Next() {
+		var i GetAllAffiliatorPayoutRequestsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Amount,
+			&i.Currency,
+			&i.BankName,
+			&i.BankAccountNumber,
+			&i.BankAccountName,
+			&i.Status,
+			&i.AdminNote,
+			&i.ReviewedByProfileID,
+			&i.ReviewedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ProfileName,
+			&i.ProfileEmail,
+			&i.ProfileImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAffiliatorPayoutRequestStatus = `-- name: UpdateAffiliatorPayoutRequestStatus :one
+UPDATE affiliator_payout_requests
+SET status = $1,
+    admin_note = COALESCE($2, admin_note),
+    reviewed_by_profile_id = COALESCE($3, reviewed_by_profile_id),
+    reviewed_at = CASE WHEN $3::uuid IS NULL THEN reviewed_at ELSE NOW() END
+WHERE id = $4
+    AND status = 'pending'
+    AND deleted_at IS NULL
+RETURNING id, profile_id, amount, currency, bank_name, bank_account_number, bank_account_name, status, admin_note, reviewed_by_profile_id, reviewed_at, created_at, updated_at, deleted_at
+`
+
+type UpdateAffiliatorPayoutRequestStatusParams struct {
+	Status              AffiliatorPayoutStatus `json:"status"`
+	AdminNote           sql.NullString         `json:"admin_note"`
+	ReviewedByProfileID uuid.NullUUID          `json:"reviewed_by_profile_id"`
+	ID                  int64                  `json:"id"`
+}
+
+// hanya request 'pending' yang bisa berubah status (no rows = sudah diproses)
+func (q *Queries) UpdateAffiliatorPayoutRequestStatus(ctx context.Context, arg UpdateAffiliatorPayoutRequestStatusParams) (AffiliatorPayoutRequest, error) {
+	row := q.db.QueryRowContext(ctx, updateAffiliatorPayoutRequestStatus,
+		arg.Status,
+		arg.AdminNote,
+		arg.ReviewedByProfileID,
+		arg.ID,
+	)
+	var i AffiliatorPayoutRequest
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Amount,
+		&i.Currency,
+		&i.BankName,
+		&i.BankAccountNumber,
+		&i.BankAccountName,
+		&i.Status,
+		&i.AdminNote,
+		&i.ReviewedByProfileID,
+		&i.ReviewedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
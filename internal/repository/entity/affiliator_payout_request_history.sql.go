@@ -0,0 +1,115 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: affiliator_payout_request_history.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createAffiliatorPayoutRequestHistory = `-- name: CreateAffiliatorPayoutRequestHistory :one
+INSERT INTO affiliator_payout_request_histories (
+    affiliator_payout_request_id,
+    status,
+    note,
+    actor_profile_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+) RETURNING id, affiliator_payout_request_id, status, note, actor_profile_id, created_at, updated_at, deleted_at
+`
+
+type CreateAffiliatorPayoutRequestHistoryParams struct {
+	AffiliatorPayoutRequestID int64                  `json:"affiliator_payout_request_id"`
+	Status                    AffiliatorPayoutStatus `json:"status"`
+	Note                      sql.NullString         `json:"note"`
+	ActorProfileID            uuid.UUID              `json:"actor_profile_id"`
+}
+
+func (q *Queries) CreateAffiliatorPayoutRequestHistory(ctx context.Context, arg CreateAffiliatorPayoutRequestHistoryParams) (AffiliatorPayoutRequestHistory, error) {
+	row := q.db.QueryRowContext(ctx, createAffiliatorPayoutRequestHistory,
+		arg.AffiliatorPayoutRequestID,
+		arg.Status,
+		arg.Note,
+		arg.ActorProfileID,
+	)
+	var i AffiliatorPayoutRequestHistory
+	err := row.Scan(
+		&i.ID,
+		&i.AffiliatorPayoutRequestID,
+		&i.Status,
+		&i.Note,
+		&i.ActorProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAffiliatorPayoutRequestHistoriesByPayoutRequestId = `-- name: GetAffiliatorPayoutRequestHistoriesByPayoutRequestId :many
+SELECT
+    h.id, h.affiliator_payout_request_id, h.status, h.note, h.actor_profile_id, h.created_at, h.updated_at, h.deleted_at,
+    pr.name  AS actor_name,
+    pr.email AS actor_email
+FROM affiliator_payout_request_histories h
+JOIN profiles pr ON pr.id = h.actor_profile_id
+WHERE h.affiliator_payout_request_id = $1
+    AND h.deleted_at IS NULL
+ORDER BY h.created_at ASC, h.id ASC
+`
+
+type GetAffiliatorPayoutRequestHistoriesByPayoutRequestIdRow struct {
+	ID                        int64                  `json:"id"`
+	AffiliatorPayoutRequestID int64                  `json:"affiliator_payout_request_id"`
+	Status                    AffiliatorPayoutStatus `json:"status"`
+	Note                      sql.NullString         `json:"note"`
+	ActorProfileID            uuid.UUID              `json:"actor_profile_id"`
+	CreatedAt                 time.Time              `json:"created_at"`
+	UpdatedAt                 time.Time              `json:"updated_at"`
+	DeletedAt                 sql.NullTime           `json:"deleted_at"`
+	ActorName                 string                 `json:"actor_name"`
+	ActorEmail                string                 `json:"actor_email"`
+}
+
+func (q *Queries) GetAffiliatorPayoutRequestHistoriesByPayoutRequestId(ctx context.Context, affiliatorPayoutRequestID int64) ([]GetAffiliatorPayoutRequestHistoriesByPayoutRequestIdRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAffiliatorPayoutRequestHistoriesByPayoutRequestId, affiliatorPayoutRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAffiliatorPayoutRequestHistoriesByPayoutRequestIdRow
+	for rows.Next() {
+		var i GetAffiliatorPayoutRequestHistoriesByPayoutRequestIdRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.AffiliatorPayoutRequestID,
+			&i.Status,
+			&i.Note,
+			&i.ActorProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ActorName,
+			&i.ActorEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,227 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: affiliator_wallet.sql
+
+package entity
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const clawbackAffiliatorWallet = `-- name: ClawbackAffiliatorWallet :one
+UPDATE affiliator_wallets
+SET total_clawed_back = total_clawed_back + $1
+WHERE id = $2 AND deleted_at IS NULL
+RETURNING id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at
+`
+
+type ClawbackAffiliatorWalletParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) ClawbackAffiliatorWallet(ctx context.Context, arg ClawbackAffiliatorWalletParams) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, clawbackAffiliatorWallet, arg.Amount, arg.ID)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const creditAffiliatorWalletEarned = `-- name: CreditAffiliatorWalletEarned :one
+UPDATE affiliator_wallets
+SET total_earned = total_earned + $1
+WHERE id = $2 AND deleted_at IS NULL
+RETURNING id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at
+`
+
+type CreditAffiliatorWalletEarnedParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) CreditAffiliatorWalletEarned(ctx context.Context, arg CreditAffiliatorWalletEarnedParams) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, creditAffiliatorWalletEarned, arg.Amount, arg.ID)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAffiliatorWalletByProfileId = `-- name: GetAffiliatorWalletByProfileId :one
+SELECT id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at FROM affiliator_wallets
+WHERE profile_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetAffiliatorWalletByProfileId(ctx context.Context, profileID uuid.UUID) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, getAffiliatorWalletByProfileId, profileID)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const holdAffiliatorWalletBalance = `-- name: HoldAffiliatorWalletBalance :one
+UPDATE affiliator_wallets
+SET on_hold = on_hold + $1
+WHERE id = $2
+    AND deleted_at IS NULL
+    AND total_earned - total_clawed_back - total_withdrawn - on_hold >= $1
+RETURNING id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at
+`
+
+type HoldAffiliatorWalletBalanceParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+// atomic: hanya berhasil jika available cukup (no rows = saldo tidak cukup)
+func (q *Queries) HoldAffiliatorWalletBalance(ctx context.Context, arg HoldAffiliatorWalletBalanceParams) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, holdAffiliatorWalletBalance, arg.Amount, arg.ID)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const releaseAffiliatorWalletHold = `-- name: ReleaseAffiliatorWalletHold :one
+UPDATE affiliator_wallets
+SET on_hold = on_hold - $1
+WHERE id = $2
+    AND deleted_at IS NULL
+    AND on_hold >= $1
+RETURNING id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at
+`
+
+type ReleaseAffiliatorWalletHoldParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+// payout request rejected / canceled, saldo kembali ke available
+func (q *Queries) ReleaseAffiliatorWalletHold(ctx context.Context, arg ReleaseAffiliatorWalletHoldParams) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, releaseAffiliatorWalletHold, arg.Amount, arg.ID)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const upsertAffiliatorWalletByProfileId = `-- name: UpsertAffiliatorWalletByProfileId :one
+INSERT INTO affiliator_wallets (profile_id, currency)
+VALUES ($1, $2)
+ON CONFLICT (profile_id) DO UPDATE SET
+    profile_id = EXCLUDED.profile_id
+RETURNING id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at
+`
+
+type UpsertAffiliatorWalletByProfileIdParams struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	Currency  string    `json:"currency"`
+}
+
+// buat wallet jika belum ada, selalu return row (lock row sampai tx selesai)
+func (q *Queries) UpsertAffiliatorWalletByProfileId(ctx context.Context, arg UpsertAffiliatorWalletByProfileIdParams) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, upsertAffiliatorWalletByProfileId, arg.ProfileID, arg.Currency)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const withdrawAffiliatorWalletHold = `-- name: WithdrawAffiliatorWalletHold :one
+UPDATE affiliator_wallets
+SET on_hold = on_hold - $1,
+    total_withdrawn = total_withdrawn + $1
+WHERE id = $2
+    AND deleted_at IS NULL
+    AND on_hold >= $1
+RETURNING id, profile_id, currency, total_earned, total_clawed_back, total_withdrawn, on_hold, created_at, updated_at, deleted_at
+`
+
+type WithdrawAffiliatorWalletHoldParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+// payout request approved, pindahkan on_hold -> total_withdrawn
+func (q *Queries) WithdrawAffiliatorWalletHold(ctx context.Context, arg WithdrawAffiliatorWalletHoldParams) (AffiliatorWallet, error) {
+	row := q.db.QueryRowContext(ctx, withdrawAffiliatorWalletHold, arg.Amount, arg.ID)
+	var i AffiliatorWallet
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Currency,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+		&i.TotalWithdrawn,
+		&i.OnHold,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,268 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: affiliator_wallet_transaction.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+)
+
+const countAllAffiliatorWalletTransactionsByWalletId = `-- name: CountAllAffiliatorWalletTransactionsByWalletId :one
+SELECT COUNT(*)::bigint AS total
+FROM affiliator_wallet_transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.affiliator_wallet_id = $1
+    AND (
+        $2::affiliator_wallet_transaction_type IS NULL
+        OR t.type = $2::affiliator_wallet_transaction_type
+    )
+    AND (
+        $3::date IS NULL
+        OR t.created_at::date >= $3::date
+    )
+    AND (
+        $4::date IS NULL
+        OR t.created_at::date <= $4::date
+    )
+`
+
+type CountAllAffiliatorWalletTransactionsByWalletIdParams struct {
+	AffiliatorWalletID int64                               `json:"affiliator_wallet_id"`
+	Type               NullAffiliatorWalletTransactionType `json:"type"`
+	DateStart          sql.NullTime                        `json:"date_start"`
+	DateEnd            sql.NullTime                        `json:"date_end"`
+}
+
+func (q *Queries) CountAllAffiliatorWalletTransactionsByWalletId(ctx context.Context, arg CountAllAffiliatorWalletTransactionsByWalletIdParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllAffiliatorWalletTransactionsByWalletId,
+		arg.AffiliatorWalletID,
+		arg.Type,
+		arg.DateStart,
+		arg.DateEnd,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createAffiliatorWalletReferralTransaction = `-- name: CreateAffiliatorWalletReferralTransaction :one
+INSERT INTO affiliator_wallet_transactions (
+    affiliator_wallet_id,
+    type,
+    amount,
+    currency,
+    referral_record_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (referral_record_id, type) WHERE referral_record_id IS NOT NULL AND deleted_at IS NULL
+DO NOTHING
+RETURNING id, affiliator_wallet_id, type, amount, currency, referral_record_id, affiliator_payout_request_id, created_at, updated_at, deleted_at
+`
+
+type CreateAffiliatorWalletReferralTransactionParams struct {
+	AffiliatorWalletID int64                           `json:"affiliator_wallet_id"`
+	Type               AffiliatorWalletTransactionType `json:"type"`
+	Amount             int64                           `json:"amount"`
+	Currency           string                          `json:"currency"`
+	ReferralRecordID   sql.NullInt64                   `json:"referral_record_id"`
+}
+
+// idempotent untuk reward & clawback (no rows = sudah pernah dicatat)
+func (q *Queries) CreateAffiliatorWalletReferralTransaction(ctx context.Context, arg CreateAffiliatorWalletReferralTransactionParams) (AffiliatorWalletTransaction, error) {
+	row := q.db.QueryRowContext(ctx, createAffiliatorWalletReferralTransaction,
+		arg.AffiliatorWalletID,
+		arg.Type,
+		arg.Amount,
+		arg.Currency,
+		arg.ReferralRecordID,
+	)
+	var i AffiliatorWalletTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AffiliatorWalletID,
+		&i.Type,
+		&i.Amount,
+		&i.Currency,
+		&i.ReferralRecordID,
+		&i.AffiliatorPayoutRequestID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createAffiliatorWalletTransaction = `-- name: CreateAffiliatorWalletTransaction :one
+INSERT INTO affiliator_wallet_transactions (
+    affiliator_wallet_id,
+    type,
+    amount,
+    currency,
+    referral_record_id,
+    affiliator_payout_request_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING id, affiliator_wallet_id, type, amount, currency, referral_record_id, affiliator_payout_request_id, created_at, updated_at, deleted_at
+`
+
+type CreateAffiliatorWalletTransactionParams struct {
+	AffiliatorWalletID        int64                           `json:"affiliator_wallet_id"`
+	Type                      AffiliatorWalletTransactionType `json:"type"`
+	Amount                    int64                           `json:"amount"`
+	Currency                  string                          `json:"currency"`
+	ReferralRecordID          sql.NullInt64                   `json:"referral_record_id"`
+	AffiliatorPayoutRequestID sql.NullInt64                   `json:"affiliator_payout_request_id"`
+}
+
+func (q *Queries) CreateAffiliatorWalletTransaction(ctx context.Context, arg CreateAffiliatorWalletTransactionParams) (AffiliatorWalletTransaction, error) {
+	row := q.db.QueryRowContext(ctx, createAffiliatorWalletTransaction,
+		arg.AffiliatorWalletID,
+		arg.Type,
+		arg.Amount,
+		arg.Currency,
+		arg.ReferralRecordID,
+		arg.AffiliatorPayoutRequestID,
+	)
+	var i AffiliatorWalletTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AffiliatorWalletID,
+		&i.Type,
+		&i.Amount,
+		&i.Currency,
+		&i.ReferralRecordID,
+		&i.AffiliatorPayoutRequestID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAffiliatorWalletTransactionByReferralRecordIdAndType = `-- name: GetAffiliatorWalletTransactionByReferralRecordIdAndType :one
+SELECT id, affiliator_wallet_id, type, amount, currency, referral_record_id, affiliator_payout_request_id, created_at, updated_at, deleted_at FROM affiliator_wallet_transactions
+WHERE referral_record_id = $1
+    AND type = $2
+    AND deleted_at IS NULL
+`
+
+type GetAffiliatorWalletTransactionByReferralRecordIdAndTypeParams struct {
+	ReferralRecordID sql.NullInt64                   `json:"referral_record_id"`
+	Type             AffiliatorWalletTransactionType `json:"type"`
+}
+
+func (q *Queries) GetAffiliatorWalletTransactionByReferralRecordIdAndType(ctx context.Context, arg GetAffiliatorWalletTransactionByReferralRecordIdAndTypeParams) (AffiliatorWalletTransaction, error) {
+	row := q.db.QueryRowContext(ctx, getAffiliatorWalletTransactionByReferralRecordIdAndType, arg.ReferralRecordID, arg.Type)
+	var i AffiliatorWalletTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.AffiliatorWalletID,
+		&i.Type,
+		&i.Amount,
+		&i.Currency,
+		&i.ReferralRecordID,
+		&i.AffiliatorPayoutRequestID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAllAffiliatorWalletTransactionsByWalletId = `-- name: GetAllAffiliatorWalletTransactionsByWalletId :many
+SELECT t.id, t.affiliator_wallet_id, t.type, t.amount, t.currency, t.referral_record_id, t.affiliator_payout_request_id, t.created_at, t.updated_at, t.deleted_at
+FROM affiliator_wallet_transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.affiliator_wallet_id = $1
+    AND (
+        $2::affiliator_wallet_transaction_type IS NULL
+        OR t.type = $2::affiliator_wallet_transaction_type
+    )
+    AND (
+        $3::date IS NULL
+        OR t.created_at::date >= $3::date
+    )
+    AND (
+        $4::date IS NULL
+        OR t.created_at::date <= $4::date
+    )
+ORDER BY
+    CASE WHEN $5 = 'id' AND $6 = 'asc' THEN t.id END ASC,
+    CASE WHEN $5 = 'id' AND $6 = 'desc' THEN t.id END DESC,
+    CASE WHEN $5 = 'created_at' AND $6 = 'asc' THEN t.created_at END ASC,
+    CASE WHEN $5 = 'created_at' AND $6 = 'desc' THEN t.created_at END DESC,
+    CASE WHEN $5 = 'amount' AND $6 = 'asc' THEN t.amount END ASC,
+    CASE WHEN $5 = 'amount' AND $6 = 'desc' THEN t.amount END DESC,
+    t.id DESC
+LIMIT $8
+OFFSET $7
+`
+
+type GetAllAffiliatorWalletTransactionsByWalletIdParams struct {
+	AffiliatorWalletID int64                               `json:"affiliator_wallet_id"`
+	Type               NullAffiliatorWalletTransactionType `json:"type"`
+	DateStart          sql.NullTime                        `json:"date_start"`
+	DateEnd            sql.NullTime                        `json:"date_end"`
+	SortBy             interface{}                         `json:"sort_by"`
+	SortDir            interface{}                         `json:"sort_dir"`
+	PageOffset         int32                               `json:"page_offset"`
+	PageLimit          int32                               `json:"page_limit"`
+}
+
+func (q *Queries) GetAllAffiliatorWalletTransactionsByWalletId(ctx context.Context, arg GetAllAffiliatorWalletTransactionsByWalletIdParams) ([]AffiliatorWalletTransaction, error) {
+	rows, err := q.db.QueryContext(ctx, getAllAffiliatorWalletTransactionsByWalletId,
+		arg.AffiliatorWalletID,
+		arg.Type,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.SortBy,
+		arg.SortDir,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AffiliatorWalletTransaction
+	for rows.Next() {
+		var i AffiliatorWalletTransaction
+		if err := rows.Scan(
+			&i.ID,
+			&i.AffiliatorWalletID,
+			&i.Type,
+			&i.Amount,
+			&i.Currency,
+			&i.ReferralRecordID,
+			&i.AffiliatorPayoutRequestID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,324 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: app_exchange_rate.sql
+
+package entity
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countAllExchangeRates = `-- name: CountAllExchangeRates :one
+SELECT COUNT(*)::bigint AS total
+FROM app_exchange_rates r
+WHERE
+  r.deleted_at IS NULL
+  AND (
+    COALESCE($1, '') = ''
+    OR r.base_currency ILIKE ('%' || $1 || '%')
+    OR r.quote_currency ILIKE ('%' || $1 || '%')
+  )
+`
+
+func (q *Queries) CountAllExchangeRates(ctx context.Context, search interface{}) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllExchangeRates, search)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createExchangeRate = `-- name: CreateExchangeRate :one
+INSERT INTO app_exchange_rates (
+  base_currency,
+  quote_currency,
+  rate_micros,
+  rounding_mode,
+  rounding_increment,
+  effective_at,
+  created_by_profile_id,
+  updated_by_profile_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $7
+) RETURNING id, base_currency, quote_currency, rate_micros, rounding_mode, rounding_increment, effective_at, created_by_profile_id, updated_by_profile_id, created_at, updated_at, deleted_at
+`
+
+type CreateExchangeRateParams struct {
+	BaseCurrency       string                   `json:"base_currency"`
+	QuoteCurrency      string                   `json:"quote_currency"`
+	RateMicros         int64                    `json:"rate_micros"`
+	RoundingMode       ExchangeRateRoundingMode `json:"rounding_mode"`
+	RoundingIncrement  int64                    `json:"rounding_increment"`
+	EffectiveAt        time.Time                `json:"effective_at"`
+	CreatedByProfileID uuid.UUID                `json:"created_by_profile_id"`
+}
+
+func (q *Queries) CreateExchangeRate(ctx context.Context, arg CreateExchangeRateParams) (AppExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, createExchangeRate,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.RateMicros,
+		arg.RoundingMode,
+		arg.RoundingIncrement,
+		arg.EffectiveAt,
+		arg.CreatedByProfileID,
+	)
+	var i AppExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.RateMicros,
+		&i.RoundingMode,
+		&i.RoundingIncrement,
+		&i.EffectiveAt,
+		&i.CreatedByProfileID,
+		&i.UpdatedByProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAllExchangeRates = `-- name: GetAllExchangeRates :many
+SELECT r.id, r.base_currency, r.quote_currency, r.rate_micros, r.rounding_mode, r.rounding_increment, r.effective_at, r.created_by_profile_id, r.updated_by_profile_id, r.created_at, r.updated_at, r.deleted_at
+FROM app_exchange_rates r
+WHERE
+  r.deleted_at IS NULL
+  AND (
+    COALESCE($1, '') = ''
+    OR r.base_currency ILIKE ('%' || $1 || '%')
+    OR r.quote_currency ILIKE ('%' || $1 || '%')
+  )
+ORDER BY
+  -- effective_at
+  CASE WHEN $2 = 'effective_at' AND $3 = 'asc'  THEN r.effective_at END ASC,
+  CASE WHEN $2 = 'effective_at' AND $3 = 'desc' THEN r.effective_at END DESC,
+
+  -- quote_currency
+  CASE WHEN $2 = 'quote_currency' AND $3 = 'asc'  THEN r.quote_currency END ASC,
+  CASE WHEN $2 = 'quote_currency' AND $3 = 'desc' THEN r.quote_currency END DESC,
+
+  -- created_at
+  CASE WHEN $2 = 'created_at' AND $3 = 'asc'  THEN r.created_at END ASC,
+  CASE WHEN $2 = 'created_at' AND $3 = 'desc' THEN r.created_at END DESC,
+
+  -- id
+  CASE WHEN $2 = 'id' AND $3 = 'asc'  THEN r.id END ASC,
+  CASE WHEN $2 = 'id' AND $3 = 'desc' THEN r.id END DESC,
+
+  -- fallback stable order
+  r.id DESC
+LIMIT $5
+OFFSET $4
+`
+
+type GetAllExchangeRatesParams struct {
+	Search     interface{} `json:"search"`
+	SortBy     interface{} `json:"sort_by"`
+	SortDir    interface{} `json:"sort_dir"`
+	PageOffset int32       `json:"page_offset"`
+	PageLimit  int32       `json:"page_limit"`
+}
+
+func (q *Queries) GetAllExchangeRates(ctx context.Context, arg GetAllExchangeRatesParams) ([]AppExchangeRate, error) {
+	rows, err := q.db.QueryContext(ctx, getAllExchangeRates,
+		arg.Search,
+		arg.SortBy,
+		arg.SortDir,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AppExchangeRate
+	for rows.Next() {
+		var i AppExchangeRate
+		if err := rows.Scan(
+			&i.ID,
+			&i.BaseCurrency,
+			&i.QuoteCurrency,
+			&i.RateMicros,
+			&i.RoundingMode,
+			&i.RoundingIncrement,
+			&i.EffectiveAt,
+			&i.CreatedByProfileID,
+			&i.UpdatedByProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEffectiveExchangeRate = `-- name: GetEffectiveExchangeRate :one
+SELECT id, base_currency, quote_currency, rate_micros, rounding_mode, rounding_increment, effective_at, created_by_profile_id, updated_by_profile_id, created_at, updated_at, deleted_at FROM app_exchange_rates
+WHERE base_currency = $1
+  AND quote_currency = $2
+  AND effective_at <= $3
+  AND deleted_at IS NULL
+ORDER BY effective_at DESC, id DESC
+LIMIT 1
+`
+
+type GetEffectiveExchangeRateParams struct {
+	BaseCurrency  string    `json:"base_currency"`
+	QuoteCurrency string    `json:"quote_currency"`
+	At            time.Time `json:"at"`
+}
+
+// rate terbaru yang sudah berlaku pada sqlc.arg(at) untuk pair base -> quote
+func (q *Queries) GetEffectiveExchangeRate(ctx context.Context, arg GetEffectiveExchangeRateParams) (AppExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, getEffectiveExchangeRate, arg.BaseCurrency, arg.QuoteCurrency, arg.At)
+	var i AppExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.RateMicros,
+		&i.RoundingMode,
+		&i.RoundingIncrement,
+		&i.EffectiveAt,
+		&i.CreatedByProfileID,
+		&i.UpdatedByProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getExchangeRateById = `-- name: GetExchangeRateById :one
+SELECT id, base_currency, quote_currency, rate_micros, rounding_mode, rounding_increment, effective_at, created_by_profile_id, updated_by_profile_id, created_at, updated_at, deleted_at FROM app_exchange_rates
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetExchangeRateById(ctx context.Context, id int64) (AppExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, getExchangeRateById, id)
+	var i AppExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.RateMicros,
+		&i.RoundingMode,
+		&i.RoundingIncrement,
+		&i.EffectiveAt,
+		&i.CreatedByProfileID,
+		&i.UpdatedByProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const softDeleteExchangeRate = `-- name: SoftDeleteExchangeRate :one
+UPDATE app_exchange_rates
+SET
+  deleted_at = now(),
+  updated_by_profile_id = $1
+WHERE id = $2
+  AND effective_at > now()
+  AND deleted_at IS NULL
+RETURNING id, base_currency, quote_currency, rate_micros, rounding_mode, rounding_increment, effective_at, created_by_profile_id, updated_by_profile_id, created_at, updated_at, deleted_at
+`
+
+type SoftDeleteExchangeRateParams struct {
+	UpdatedByProfileID uuid.UUID `json:"updated_by_profile_id"`
+	ID                 int64     `json:"id"`
+}
+
+// hanya rate yang belum berlaku (effective_at > now) yang boleh dihapus
+func (q *Queries) SoftDeleteExchangeRate(ctx context.Context, arg SoftDeleteExchangeRateParams) (AppExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, softDeleteExchangeRate, arg.UpdatedByProfileID, arg.ID)
+	var i AppExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.RateMicros,
+		&i.RoundingMode,
+		&i.RoundingIncrement,
+		&i.EffectiveAt,
+		&i.CreatedByProfileID,
+		&i.UpdatedByProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateExchangeRate = `-- name: UpdateExchangeRate :one
+UPDATE app_exchange_rates
+SET
+  base_currency = $1,
+  quote_currency = $2,
+  rate_micros = $3,
+  rounding_mode = $4,
+  rounding_increment = $5,
+  effective_at = $6,
+  updated_by_profile_id = $7
+WHERE id = $8
+  AND effective_at > now()
+  AND deleted_at IS NULL
+RETURNING id, base_currency, quote_currency, rate_micros, rounding_mode, rounding_increment, effective_at, created_by_profile_id, updated_by_profile_id, created_at, updated_at, deleted_at
+`
+
+type UpdateExchangeRateParams struct {
+	BaseCurrency       string                   `json:"base_currency"`
+	QuoteCurrency      string                   `json:"quote_currency"`
+	RateMicros         int64                    `json:"rate_micros"`
+	RoundingMode       ExchangeRateRoundingMode `json:"rounding_mode"`
+	RoundingIncrement  int64                    `json:"rounding_increment"`
+	EffectiveAt        time.Time                `json:"effective_at"`
+	UpdatedByProfileID uuid.UUID                `json:"updated_by_profile_id"`
+	ID                 int64                    `json:"id"`
+}
+
+// hanya rate yang belum berlaku (effective_at > now) yang boleh diubah
+func (q *Queries) UpdateExchangeRate(ctx context.Context, arg UpdateExchangeRateParams) (AppExchangeRate, error) {
+	row := q.db.QueryRowContext(ctx, updateExchangeRate,
+		arg.BaseCurrency,
+		arg.QuoteCurrency,
+		arg.RateMicros,
+		arg.RoundingMode,
+		arg.RoundingIncrement,
+		arg.EffectiveAt,
+		arg.UpdatedByProfileID,
+		arg.ID,
+	)
+	var i AppExchangeRate
+	err := row.Scan(
+		&i.ID,
+		&i.BaseCurrency,
+		&i.QuoteCurrency,
+		&i.RateMicros,
+		&i.RoundingMode,
+		&i.RoundingIncrement,
+		&i.EffectiveAt,
+		&i.CreatedByProfileID,
+		&i.UpdatedByProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
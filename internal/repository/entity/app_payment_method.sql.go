@@ -49,10 +49,11 @@ INSERT INTO app_payment_methods (
   tax_fee,
   admin_type,
   admin_fee,
-  is_active
+  is_active,
+  gateway
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
-) RETURNING id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
+) RETURNING id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway
 `
 
 type CreatePaymentMethodParams struct {
@@ -64,6 +65,7 @@ type CreatePaymentMethodParams struct {
 	AdminType AppPaymentAdminType  `json:"admin_type"`
 	AdminFee  int64                `json:"admin_fee"`
 	IsActive  bool                 `json:"is_active"`
+	Gateway   PaymentGatewayType   `json:"gateway"`
 }
 
 func (q *Queries) CreatePaymentMethod(ctx context.Context, arg CreatePaymentMethodParams) (AppPaymentMethod, error) {
@@ -76,6 +78,7 @@ func (q *Queries) CreatePaymentMethod(ctx context.Context, arg CreatePaymentMeth
 		arg.AdminType,
 		arg.AdminFee,
 		arg.IsActive,
+		arg.Gateway,
 	)
 	var i AppPaymentMethod
 	err := row.Scan(
@@ -91,6 +94,7 @@ func (q *Queries) CreatePaymentMethod(ctx context.Context, arg CreatePaymentMeth
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
@@ -108,6 +112,7 @@ INSERT INTO app_payment_method_changes (
   before_admin_fee,
   before_tax_fee,
   before_is_active,
+  before_gateway,
   after_code,
   after_name,
   after_type,
@@ -115,10 +120,11 @@ INSERT INTO app_payment_method_changes (
   after_admin_type,
   after_admin_fee,
   after_tax_fee,
-  after_is_active
+  after_is_active,
+  after_gateway
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
-) RETURNING id, action, profile_id, payment_method_id, before_code, before_name, before_type, before_image, before_admin_type, before_admin_fee, before_tax_fee, before_is_active, after_code, after_name, after_type, after_image, after_admin_type, after_admin_fee, after_tax_fee, after_is_active, created_at, updated_at, deleted_at
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
+) RETURNING id, action, profile_id, payment_method_id, before_code, before_name, before_type, before_image, before_admin_type, before_admin_fee, before_tax_fee, before_is_active, after_code, after_name, after_type, after_image, after_admin_type, after_admin_fee, after_tax_fee, after_is_active, created_at, updated_at, deleted_at, before_gateway, after_gateway
 `
 
 type CreatePaymentMethodChangeParams struct {
@@ -133,6 +139,7 @@ type CreatePaymentMethodChangeParams struct {
 	BeforeAdminFee  int64                `json:"before_admin_fee"`
 	BeforeTaxFee    int64                `json:"before_tax_fee"`
 	BeforeIsActive  bool                 `json:"before_is_active"`
+	BeforeGateway   PaymentGatewayType   `json:"before_gateway"`
 	AfterCode       string               `json:"after_code"`
 	AfterName       string               `json:"after_name"`
 	AfterType       AppPaymentMethodType `json:"after_type"`
@@ -141,6 +148,7 @@ type CreatePaymentMethodChangeParams struct {
 	AfterAdminFee   int64                `json:"after_admin_fee"`
 	AfterTaxFee     int64                `json:"after_tax_fee"`
 	AfterIsActive   bool                 `json:"after_is_active"`
+	AfterGateway    PaymentGatewayType   `json:"after_gateway"`
 }
 
 func (q *Queries) CreatePaymentMethodChange(ctx context.Context, arg CreatePaymentMethodChangeParams) (AppPaymentMethodChange, error) {
@@ -156,6 +164,7 @@ func (q *Queries) CreatePaymentMethodChange(ctx context.Context, arg CreatePayme
 		arg.BeforeAdminFee,
 		arg.BeforeTaxFee,
 		arg.BeforeIsActive,
+		arg.BeforeGateway,
 		arg.AfterCode,
 		arg.AfterName,
 		arg.AfterType,
@@ -164,6 +173,7 @@ func (q *Queries) CreatePaymentMethodChange(ctx context.Context, arg CreatePayme
 		arg.AfterAdminFee,
 		arg.AfterTaxFee,
 		arg.AfterIsActive,
+		arg.AfterGateway,
 	)
 	var i AppPaymentMethodChange
 	err := row.Scan(
@@ -190,13 +200,15 @@ func (q *Queries) CreatePaymentMethodChange(ctx context.Context, arg CreatePayme
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.BeforeGateway,
+		&i.AfterGateway,
 	)
 	return i, err
 }
 
 const getAllPaymentMethods = `-- name: GetAllPaymentMethods :many
 SELECT
-  p.id, p.code, p.name, p.type, p.image, p.tax_fee, p.admin_type, p.admin_fee, p.is_active, p.created_at, p.updated_at, p.deleted_at
+  p.id, p.code, p.name, p.type, p.image, p.tax_fee, p.admin_type, p.admin_fee, p.is_active, p.created_at, p.updated_at, p.deleted_at, p.gateway
 FROM app_payment_methods p
 WHERE
   p.deleted_at IS NULL
@@ -274,6 +286,7 @@ func (q *Queries) GetAllPaymentMethods(ctx context.Context, arg GetAllPaymentMet
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Gateway,
 		); err != nil {
 			return nil, err
 		}
@@ -289,7 +302,7 @@ func (q *Queries) GetAllPaymentMethods(ctx context.Context, arg GetAllPaymentMet
 }
 
 const getPaymentMethodByCode = `-- name: GetPaymentMethodByCode :one
-SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at FROM app_payment_methods
+SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway FROM app_payment_methods
 WHERE code = $1 AND deleted_at IS NULL
 `
 
@@ -309,12 +322,13 @@ func (q *Queries) GetPaymentMethodByCode(ctx context.Context, code string) (AppP
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
 
 const getPaymentMethodByCodeAdmin = `-- name: GetPaymentMethodByCodeAdmin :one
-SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at FROM app_payment_methods
+SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway FROM app_payment_methods
 WHERE code = $1 AND deleted_at IS NULL
 `
 
@@ -334,12 +348,13 @@ func (q *Queries) GetPaymentMethodByCodeAdmin(ctx context.Context, code string)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
 
 const getPaymentMethodByCodeUser = `-- name: GetPaymentMethodByCodeUser :one
-SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at FROM app_payment_methods
+SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway FROM app_payment_methods
 WHERE code = $1 AND deleted_at IS NULL AND is_active = TRUE
 `
 
@@ -359,12 +374,13 @@ func (q *Queries) GetPaymentMethodByCodeUser(ctx context.Context, code string) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
 
 const getPaymentMethodById = `-- name: GetPaymentMethodById :one
-SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at FROM app_payment_methods
+SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway FROM app_payment_methods
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -384,12 +400,13 @@ func (q *Queries) GetPaymentMethodById(ctx context.Context, id int64) (AppPaymen
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
 
 const getPaymentMethodByIdAdmin = `-- name: GetPaymentMethodByIdAdmin :one
-SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at FROM app_payment_methods
+SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway FROM app_payment_methods
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -409,12 +426,13 @@ func (q *Queries) GetPaymentMethodByIdAdmin(ctx context.Context, id int64) (AppP
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
 
 const getPaymentMethodByIdUser = `-- name: GetPaymentMethodByIdUser :one
-SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at FROM app_payment_methods
+SELECT id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway FROM app_payment_methods
 WHERE id = $1 AND deleted_at IS NULL AND is_active = TRUE
 `
 
@@ -434,6 +452,7 @@ func (q *Queries) GetPaymentMethodByIdUser(ctx context.Context, id int64) (AppPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
@@ -442,7 +461,7 @@ const softDeletePaymentMethod = `-- name: SoftDeletePaymentMethod :one
 UPDATE app_payment_methods
 SET deleted_at = NOW()
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at
+RETURNING id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway
 `
 
 func (q *Queries) SoftDeletePaymentMethod(ctx context.Context, id int64) (AppPaymentMethod, error) {
@@ -461,6 +480,7 @@ func (q *Queries) SoftDeletePaymentMethod(ctx context.Context, id int64) (AppPay
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
@@ -475,9 +495,10 @@ SET
   tax_fee = $6,
   admin_type = $7,
   admin_fee = $8,
-  is_active = $9
+  is_active = $9,
+  gateway = $10
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at
+RETURNING id, code, name, type, image, tax_fee, admin_type, admin_fee, is_active, created_at, updated_at, deleted_at, gateway
 `
 
 type UpdatePaymentMethodParams struct {
@@ -490,6 +511,7 @@ type UpdatePaymentMethodParams struct {
 	AdminType AppPaymentAdminType  `json:"admin_type"`
 	AdminFee  int64                `json:"admin_fee"`
 	IsActive  bool                 `json:"is_active"`
+	Gateway   PaymentGatewayType   `json:"gateway"`
 }
 
 func (q *Queries) UpdatePaymentMethod(ctx context.Context, arg UpdatePaymentMethodParams) (AppPaymentMethod, error) {
@@ -503,6 +525,7 @@ func (q *Queries) UpdatePaymentMethod(ctx context.Context, arg UpdatePaymentMeth
 		arg.AdminType,
 		arg.AdminFee,
 		arg.IsActive,
+		arg.Gateway,
 	)
 	var i AppPaymentMethod
 	err := row.Scan(
@@ -518,6 +541,7 @@ func (q *Queries) UpdatePaymentMethod(ctx context.Context, arg UpdatePaymentMeth
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Gateway,
 	)
 	return i, err
 }
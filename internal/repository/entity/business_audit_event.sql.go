@@ -0,0 +1,261 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: business_audit_event.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countBusinessAuditEventsByBusinessRootID = `-- name: CountBusinessAuditEventsByBusinessRootID :one
+SELECT COUNT(*)::bigint AS total
+FROM business_audit_events bae
+JOIN profiles pr ON pr.id = bae.profile_id
+WHERE
+  bae.business_root_id = $1
+  AND bae.deleted_at IS NULL
+
+  -- search (entity id + actor)
+  AND (
+    COALESCE($2, '') = ''
+    OR bae.entity_id ILIKE ('%' || $2 || '%')
+    OR pr.name ILIKE ('%' || $2 || '%')
+    OR pr.email ILIKE ('%' || $2 || '%')
+  )
+
+  -- category = entity_type
+  AND (
+    $3::text IS NULL
+    OR bae.entity_type = $3
+  )
+
+  -- date range (berdasarkan created_at)
+  AND (
+    $4::date IS NULL
+    OR bae.created_at::date >= $4::date
+  )
+  AND (
+    $5::date IS NULL
+    OR bae.created_at::date <= $5::date
+  )
+`
+
+type CountBusinessAuditEventsByBusinessRootIDParams struct {
+	BusinessRootID int64          `json:"business_root_id"`
+	Search         interface{}    `json:"search"`
+	EntityType     sql.NullString `json:"entity_type"`
+	DateStart      sql.NullTime   `json:"date_start"`
+	DateEnd        sql.NullTime   `json:"date_end"`
+}
+
+func (q *Queries) CountBusinessAuditEventsByBusinessRootID(ctx context.Context, arg CountBusinessAuditEventsByBusinessRootIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countBusinessAuditEventsByBusinessRootID,
+		arg.BusinessRootID,
+		arg.Search,
+		arg.EntityType,
+		arg.DateStart,
+		arg.DateEnd,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createBusinessAuditEvent = `-- name: CreateBusinessAuditEvent :one
+INSERT INTO business_audit_events (
+  business_root_id,
+  profile_id,
+  entity_type,
+  entity_id,
+  action,
+  before,
+  after
+)
+VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+RETURNING id, business_root_id, profile_id, entity_type, entity_id, action, before, after, created_at, updated_at, deleted_at
+`
+
+type CreateBusinessAuditEventParams struct {
+	BusinessRootID int64            `json:"business_root_id"`
+	ProfileID      uuid.UUID        `json:"profile_id"`
+	EntityType     string           `json:"entity_type"`
+	EntityID       string           `json:"entity_id"`
+	Action         ActionChangeType `json:"action"`
+	Before         sql.NullString   `json:"before"`
+	After          sql.NullString   `json:"after"`
+}
+
+func (q *Queries) CreateBusinessAuditEvent(ctx context.Context, arg CreateBusinessAuditEventParams) (BusinessAuditEvent, error) {
+	row := q.db.QueryRowContext(ctx, createBusinessAuditEvent,
+		arg.BusinessRootID,
+		arg.ProfileID,
+		arg.EntityType,
+		arg.EntityID,
+		arg.Action,
+		arg.Before,
+		arg.After,
+	)
+	var i BusinessAuditEvent
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.ProfileID,
+		&i.EntityType,
+		&i.EntityID,
+		&i.Action,
+		&i.Before,
+		&i.After,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getBusinessAuditEventsByBusinessRootID = `-- name: GetBusinessAuditEventsByBusinessRootID :many
+WITH p AS (
+  SELECT
+    COALESCE(NULLIF($8,  ''), 'created_at') AS sort_by,
+    COALESCE(NULLIF($9, ''), 'desc')       AS sort_dir
+)
+SELECT
+  bae.id, bae.business_root_id, bae.profile_id, bae.entity_type, bae.entity_id, bae.action, bae.before, bae.after, bae.created_at, bae.updated_at, bae.deleted_at,
+  pr.name      AS profile_name,
+  pr.email     AS profile_email,
+  pr.image_url AS profile_image_url
+FROM business_audit_events bae
+JOIN profiles pr ON pr.id = bae.profile_id
+CROSS JOIN p
+WHERE
+  bae.business_root_id = $1
+  AND bae.deleted_at IS NULL
+
+  -- search (entity id + actor)
+  AND (
+    COALESCE($2, '') = ''
+    OR bae.entity_id ILIKE ('%' || $2 || '%')
+    OR pr.name ILIKE ('%' || $2 || '%')
+    OR pr.email ILIKE ('%' || $2 || '%')
+  )
+
+  -- category = entity_type
+  AND (
+    $3::text IS NULL
+    OR bae.entity_type = $3
+  )
+
+  -- date range (berdasarkan created_at)
+  AND (
+    $4::date IS NULL
+    OR bae.created_at::date >= $4::date
+  )
+  AND (
+    $5::date IS NULL
+    OR bae.created_at::date <= $5::date
+  )
+
+ORDER BY
+  -- created_at (default)
+  CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'asc'  THEN bae.created_at END ASC,
+  CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'desc' THEN bae.created_at END DESC,
+
+  CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'asc'  THEN bae.id END ASC,
+  CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'desc' THEN bae.id END DESC,
+
+  -- fallback stable order
+  bae.id DESC
+
+LIMIT $7
+OFFSET $6
+`
+
+type GetBusinessAuditEventsByBusinessRootIDParams struct {
+	BusinessRootID int64          `json:"business_root_id"`
+	Search         interface{}    `json:"search"`
+	EntityType     sql.NullString `json:"entity_type"`
+	DateStart      sql.NullTime   `json:"date_start"`
+	DateEnd        sql.NullTime   `json:"date_end"`
+	PageOffset     int32          `json:"page_offset"`
+	PageLimit      int32          `json:"page_limit"`
+	SortBy         interface{}    `json:"sort_by"`
+	SortDir        interface{}    `json:"sort_dir"`
+}
+
+type GetBusinessAuditEventsByBusinessRootIDRow struct {
+	ID              int64            `json:"id"`
+	BusinessRootID  int64            `json:"business_root_id"`
+	ProfileID       uuid.UUID        `json:"profile_id"`
+	EntityType      string           `json:"entity_type"`
+	EntityID        string           `json:"entity_id"`
+	Action          ActionChangeType `json:"action"`
+	Before          sql.NullString   `json:"before"`
+	After           sql.NullString   `json:"after"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+	DeletedAt       sql.NullTime     `json:"deleted_at"`
+	ProfileName     string           `json:"profile_name"`
+	ProfileEmail    string           `json:"profile_email"`
+	ProfileImageUrl sql.NullString   `json:"profile_image_url"`
+}
+
+func (q *Queries) GetBusinessAuditEventsByBusinessRootID(ctx context.Context, arg GetBusinessAuditEventsByBusinessRootIDParams) ([]GetBusinessAuditEventsByBusinessRootIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getBusinessAuditEventsByBusinessRootID,
+		arg.BusinessRootID,
+		arg.Search,
+		arg.EntityType,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.PageOffset,
+		arg.PageLimit,
+		arg.SortBy,
+		arg.SortDir,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetBusinessAuditEventsByBusinessRootIDRow
+	for rows.Next() {
+		var i GetBusinessAuditEventsByBusinessRootIDRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessRootID,
+			&i.ProfileID,
+			&i.EntityType,
+			&i.EntityID,
+			&i.Action,
+			&i.Before,
+			&i.After,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ProfileName,
+			&i.ProfileEmail,
+			&i.ProfileImageUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
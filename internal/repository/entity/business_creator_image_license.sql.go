@@ -0,0 +1,276 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: business_creator_image_license.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const checkActiveCreatorImageLicenseExists = `-- name: CheckActiveCreatorImageLicenseExists :one
+SELECT EXISTS (
+    SELECT 1 FROM business_creator_image_licenses
+    WHERE business_root_id = $1
+      AND creator_image_id = $2
+      AND status = 'active'
+      AND deleted_at IS NULL
+) AS exists
+`
+
+type CheckActiveCreatorImageLicenseExistsParams struct {
+	BusinessRootID int64 `json:"business_root_id"`
+	CreatorImageID int64 `json:"creator_image_id"`
+}
+
+func (q *Queries) CheckActiveCreatorImageLicenseExists(ctx context.Context, arg CheckActiveCreatorImageLicenseExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, checkActiveCreatorImageLicenseExists, arg.BusinessRootID, arg.CreatorImageID)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const countAllBusinessCreatorImageLicenses = `-- name: CountAllBusinessCreatorImageLicenses :one
+SELECT COUNT(*)::bigint AS total
+FROM business_creator_image_licenses l
+JOIN creator_images ci ON ci.id = l.creator_image_id
+WHERE
+    l.deleted_at IS NULL
+    AND l.business_root_id = $1
+    AND (
+        $2::creator_image_license_status IS NULL
+        OR l.status = $2::creator_image_license_status
+    )
+    AND (
+        COALESCE($3, '') = ''
+        OR ci.name ILIKE ('%' || $3 || '%')
+    )
+    AND (
+        $4::date IS NULL
+        OR l.created_at::date >= $4::date
+    )
+    AND (
+        $5::date IS NULL
+        OR l.created_at::date <= $5::date
+    )
+`
+
+type CountAllBusinessCreatorImageLicensesParams struct {
+	BusinessRootID int64                         `json:"business_root_id"`
+	Status         NullCreatorImageLicenseStatus `json:"status"`
+	Search         interface{}                   `json:"search"`
+	DateStart      sql.NullTime                  `json:"date_start"`
+	DateEnd        sql.NullTime                  `json:"date_end"`
+}
+
+func (q *Queries) CountAllBusinessCreatorImageLicenses(ctx context.Context, arg CountAllBusinessCreatorImageLicensesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllBusinessCreatorImageLicenses,
+		arg.BusinessRootID,
+		arg.Status,
+		arg.Search,
+		arg.DateStart,
+		arg.DateEnd,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createBusinessCreatorImageLicense = `-- name: CreateBusinessCreatorImageLicense :one
+INSERT INTO business_creator_image_licenses (
+    business_root_id,
+    creator_image_id,
+    payment_history_id,
+    profile_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4
+)
+ON CONFLICT DO NOTHING
+RETURNING id, business_root_id, creator_image_id, payment_history_id, profile_id, status, revoked_at, created_at, updated_at, deleted_at
+`
+
+type CreateBusinessCreatorImageLicenseParams struct {
+	BusinessRootID   int64     `json:"business_root_id"`
+	CreatorImageID   int64     `json:"creator_image_id"`
+	PaymentHistoryID uuid.UUID `json:"payment_history_id"`
+	ProfileID        uuid.UUID `json:"profile_id"`
+}
+
+// idempotent per payment (no rows = sudah pernah dibuat / business sudah punya lisensi aktif)
+func (q *Queries) CreateBusinessCreatorImageLicense(ctx context.Context, arg CreateBusinessCreatorImageLicenseParams) (BusinessCreatorImageLicense, error) {
+	row := q.db.QueryRowContext(ctx, createBusinessCreatorImageLicense,
+		arg.BusinessRootID,
+		arg.CreatorImageID,
+		arg.PaymentHistoryID,
+		arg.ProfileID,
+	)
+	var i BusinessCreatorImageLicense
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.CreatorImageID,
+		&i.PaymentHistoryID,
+		&i.ProfileID,
+		&i.Status,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAllBusinessCreatorImageLicenses = `-- name: GetAllBusinessCreatorImageLicenses :many
+SELECT
+    l.id,
+    l.business_root_id,
+    l.creator_image_id,
+    l.payment_history_id,
+    l.profile_id,
+    l.status,
+    l.revoked_at,
+    l.created_at,
+    ci.name AS creator_image_name,
+    ci.image_url AS creator_image_url,
+    ph.record_product_price AS price,
+    ph.currency
+FROM business_creator_image_licenses l
+JOIN creator_images ci ON ci.id = l.creator_image_id
+JOIN payment_histories ph ON ph.id = l.payment_history_id
+WHERE
+    l.deleted_at IS NULL
+    AND l.business_root_id = $1
+    AND (
+        $2::creator_image_license_status IS NULL
+        OR l.status = $2::creator_image_license_status
+    )
+    AND (
+        COALESCE($3, '') = ''
+        OR ci.name ILIKE ('%' || $3 || '%')
+    )
+    AND (
+        $4::date IS NULL
+        OR l.created_at::date >= $4::date
+    )
+    AND (
+        $5::date IS NULL
+        OR l.created_at::date <= $5::date
+    )
+ORDER BY
+    CASE WHEN $6 = 'id' AND $7 = 'asc' THEN l.id END ASC,
+    CASE WHEN $6 = 'id' AND $7 = 'desc' THEN l.id END DESC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'asc' THEN l.created_at END ASC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'desc' THEN l.created_at END DESC,
+    l.id DESC
+LIMIT $9
+OFFSET $8
+`
+
+type GetAllBusinessCreatorImageLicensesParams struct {
+	BusinessRootID int64                         `json:"business_root_id"`
+	Status         NullCreatorImageLicenseStatus `json:"status"`
+	Search         interface{}                   `json:"search"`
+	DateStart      sql.NullTime                  `json:"date_start"`
+	DateEnd        sql.NullTime                  `json:"date_end"`
+	SortBy         interface{}                   `json:"sort_by"`
+	SortDir        interface{}                   `json:"sort_dir"`
+	PageOffset     int32                         `json:"page_offset"`
+	PageLimit      int32                         `json:"page_limit"`
+}
+
+type GetAllBusinessCreatorImageLicensesRow struct {
+	ID               int64                     `json:"id"`
+	BusinessRootID   int64                     `json:"business_root_id"`
+	CreatorImageID   int64                     `json:"creator_image_id"`
+	PaymentHistoryID uuid.UUID                 `json:"payment_history_id"`
+	ProfileID        uuid.UUID                 `json:"profile_id"`
+	Status           CreatorImageLicenseStatus `json:"status"`
+	RevokedAt        sql.NullTime              `json:"revoked_at"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	CreatorImageName string                    `json:"creator_image_name"`
+	CreatorImageUrl  string                    `json:"creator_image_url"`
+	Price            int64                     `json:"price"`
+	Currency         string                    `json:"currency"`
+}
+
+func (q *Queries) GetAllBusinessCreatorImageLicenses(ctx context.Context, arg GetAllBusinessCreatorImageLicensesParams) ([]GetAllBusinessCreatorImageLicensesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllBusinessCreatorImageLicenses,
+		arg.BusinessRootID,
+		arg.Status,
+		arg.Search,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.SortBy,
+		arg.SortDir,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllBusinessCreatorImageLicensesRow
+	for rows.Next() {
+		var i GetAllBusinessCreatorImageLicensesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessRootID,
+			&i.CreatorImageID,
+			&i.PaymentHistoryID,
+			&i.ProfileID,
+			&i.Status,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.CreatorImageName,
+			&i.CreatorImageUrl,
+			&i.Price,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeBusinessCreatorImageLicenseByPaymentId = `-- name: RevokeBusinessCreatorImageLicenseByPaymentId :one
+UPDATE business_creator_image_licenses
+SET status = 'revoked',
+    revoked_at = NOW()
+WHERE payment_history_id = $1
+  AND status = 'active'
+  AND deleted_at IS NULL
+RETURNING id, business_root_id, creator_image_id, payment_history_id, profile_id, status, revoked_at, created_at, updated_at, deleted_at
+`
+
+func (q *Queries) RevokeBusinessCreatorImageLicenseByPaymentId(ctx context.Context, paymentHistoryID uuid.UUID) (BusinessCreatorImageLicense, error) {
+	row := q.db.QueryRowContext(ctx, revokeBusinessCreatorImageLicenseByPaymentId, paymentHistoryID)
+	var i BusinessCreatorImageLicense
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.CreatorImageID,
+		&i.PaymentHistoryID,
+		&i.ProfileID,
+		&i.Status,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
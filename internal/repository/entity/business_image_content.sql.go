@@ -123,6 +123,37 @@ func (q *Queries) CreateBusinessImageContent(ctx context.Context, arg CreateBusi
 	return i, err
 }
 
+const getBusinessImageContentByIdAndBusinessRootId = `-- name: GetBusinessImageContentByIdAndBusinessRootId :one
+SELECT id, image_urls, caption, type, ready_to_post, category, business_product_id, business_root_id, created_at, updated_at, deleted_at FROM business_image_contents
+WHERE id = $1
+  AND business_root_id = $2
+  AND deleted_at IS NULL
+`
+
+type GetBusinessImageContentByIdAndBusinessRootIdParams struct {
+	ID             int64 `json:"id"`
+	BusinessRootID int64 `json:"business_root_id"`
+}
+
+func (q *Queries) GetBusinessImageContentByIdAndBusinessRootId(ctx context.Context, arg GetBusinessImageContentByIdAndBusinessRootIdParams) (BusinessImageContent, error) {
+	row := q.db.QueryRowContext(ctx, getBusinessImageContentByIdAndBusinessRootId, arg.ID, arg.BusinessRootID)
+	var i BusinessImageContent
+	err := row.Scan(
+		&i.ID,
+		pq.Array(&i.ImageUrls),
+		&i.Caption,
+		&i.Type,
+		&i.ReadyToPost,
+		&i.Category,
+		&i.BusinessProductID,
+		&i.BusinessRootID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
 const getBusinessImageContentsByBusinessRootId = `-- name: GetBusinessImageContentsByBusinessRootId :many
 WITH p AS (
   SELECT
@@ -312,3 +343,37 @@ func (q *Queries) UpdateBusinessImageContent(ctx context.Context, arg UpdateBusi
 	)
 	return i, err
 }
+
+const updateBusinessImageContentCaption = `-- name: UpdateBusinessImageContentCaption :one
+UPDATE business_image_contents
+SET caption = $1
+WHERE id = $2
+  AND business_root_id = $3
+  AND deleted_at IS NULL
+RETURNING id, image_urls, caption, type, ready_to_post, category, business_product_id, business_root_id, created_at, updated_at, deleted_at
+`
+
+type UpdateBusinessImageContentCaptionParams struct {
+	Caption        sql.NullString `json:"caption"`
+	ID             int64          `json:"id"`
+	BusinessRootID int64          `json:"business_root_id"`
+}
+
+func (q *Queries) UpdateBusinessImageContentCaption(ctx context.Context, arg UpdateBusinessImageContentCaptionParams) (BusinessImageContent, error) {
+	row := q.db.QueryRowContext(ctx, updateBusinessImageContentCaption, arg.Caption, arg.ID, arg.BusinessRootID)
+	var i BusinessImageContent
+	err := row.Scan(
+		&i.ID,
+		pq.Array(&i.ImageUrls),
+		&i.Caption,
+		&i.Type,
+		&i.ReadyToPost,
+		&i.Category,
+		&i.BusinessProductID,
+		&i.BusinessRootID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
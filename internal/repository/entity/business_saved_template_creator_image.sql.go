@@ -40,6 +40,8 @@ FROM business_saved_template_creator_images bstci
 JOIN creator_images ci ON ci.id = bstci.creator_image_id
 WHERE bstci.business_root_id = $1
   AND bstci.deleted_at IS NULL
+  -- creator image yang dibanned admin tidak ditampilkan
+  AND ci.is_banned = FALSE
   AND ($2::TEXT IS NULL OR ci.name ILIKE '%' || $2::TEXT || '%')
   AND ($3::TIMESTAMPTZ IS NULL OR bstci.created_at >= $3::TIMESTAMPTZ)
   AND ($4::TIMESTAMPTZ IS NULL OR bstci.created_at <= $4::TIMESTAMPTZ)
@@ -105,6 +107,29 @@ func (q *Queries) CreateSavedCreatorImage(ctx context.Context, arg CreateSavedCr
 	return i, err
 }
 
+const createSavedCreatorImageIfNotExists = `-- name: CreateSavedCreatorImageIfNotExists :exec
+INSERT INTO business_saved_template_creator_images (
+    business_root_id,
+    creator_image_id
+) VALUES (
+    $1,
+    $2
+)
+ON CONFLICT (business_root_id, creator_image_id) WHERE deleted_at IS NULL
+DO NOTHING
+`
+
+type CreateSavedCreatorImageIfNotExistsParams struct {
+	BusinessRootID int64 `json:"business_root_id"`
+	CreatorImageID int64 `json:"creator_image_id"`
+}
+
+// dipakai saat lisensi template dibuat (template yang dibeli otomatis tersimpan)
+func (q *Queries) CreateSavedCreatorImageIfNotExists(ctx context.Context, arg CreateSavedCreatorImageIfNotExistsParams) error {
+	_, err := q.db.ExecContext(ctx, createSavedCreatorImageIfNotExists, arg.BusinessRootID, arg.CreatorImageID)
+	return err
+}
+
 const getAllSavedCreatorImageByBusinessId = `-- name: GetAllSavedCreatorImageByBusinessId :many
 
 SELECT
@@ -145,6 +170,8 @@ JOIN creator_images ci ON ci.id = bstci.creator_image_id
 LEFT JOIN profiles p ON p.id = ci.profile_id
 WHERE bstci.business_root_id = $1
   AND bstci.deleted_at IS NULL
+  -- creator image yang dibanned admin tidak ditampilkan
+  AND ci.is_banned = FALSE
   -- search by name
   AND ($2::TEXT IS NULL OR ci.name ILIKE '%' || $2::TEXT || '%')
   -- filter by date range (on saved_at)
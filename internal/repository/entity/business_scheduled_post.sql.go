@@ -0,0 +1,454 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: business_scheduled_post.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const cancelBusinessScheduledPost = `-- name: CancelBusinessScheduledPost :one
+UPDATE business_scheduled_posts
+SET status = 'canceled', deleted_at = NOW()
+WHERE id = $1
+  AND business_root_id = $2
+  AND status = 'scheduled'
+  AND deleted_at IS NULL
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type CancelBusinessScheduledPostParams struct {
+	ID             int64 `json:"id"`
+	BusinessRootID int64 `json:"business_root_id"`
+}
+
+func (q *Queries) CancelBusinessScheduledPost(ctx context.Context, arg CancelBusinessScheduledPostParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, cancelBusinessScheduledPost, arg.ID, arg.BusinessRootID)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const createBusinessScheduledPost = `-- name: CreateBusinessScheduledPost :one
+INSERT INTO business_scheduled_posts (
+    business_root_id,
+    business_image_content_id,
+    platform,
+    scheduled_at,
+    timezone,
+    profile_id
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+)
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type CreateBusinessScheduledPostParams struct {
+	BusinessRootID         int64              `json:"business_root_id"`
+	BusinessImageContentID int64              `json:"business_image_content_id"`
+	Platform               SocialPlatformType `json:"platform"`
+	ScheduledAt            time.Time          `json:"scheduled_at"`
+	Timezone               string             `json:"timezone"`
+	ProfileID              uuid.UUID          `json:"profile_id"`
+}
+
+func (q *Queries) CreateBusinessScheduledPost(ctx context.Context, arg CreateBusinessScheduledPostParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, createBusinessScheduledPost,
+		arg.BusinessRootID,
+		arg.BusinessImageContentID,
+		arg.Platform,
+		arg.ScheduledAt,
+		arg.Timezone,
+		arg.ProfileID,
+	)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const failStuckBusinessScheduledPosts = `-- name: FailStuckBusinessScheduledPosts :many
+UPDATE business_scheduled_posts
+SET status = 'failed', failure_reason = 'PUBLISH_STUCK'
+WHERE id IN (
+  SELECT sp.id FROM business_scheduled_posts sp
+  WHERE sp.status = 'publishing'
+    AND sp.updated_at < $1
+    AND sp.deleted_at IS NULL
+  ORDER BY sp.updated_at ASC
+  LIMIT $2
+  FOR UPDATE SKIP LOCKED
+)
+  AND status = 'publishing'
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type FailStuckBusinessScheduledPostsParams struct {
+	StuckBefore time.Time `json:"stuck_before"`
+	RowLimit    int32     `json:"row_limit"`
+}
+
+// post yang tertahan di 'publishing' (worker crash di antara claim & enqueue deliver, atau task deliver hilang).
+// updated_at = waktu claim, attempt deliver yang gagal tidak mengubah row ini.
+func (q *Queries) FailStuckBusinessScheduledPosts(ctx context.Context, arg FailStuckBusinessScheduledPostsParams) ([]BusinessScheduledPost, error) {
+	rows, err := q.db.QueryContext(ctx, failStuckBusinessScheduledPosts, arg.StuckBefore, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BusinessScheduledPost
+	for rows.Next() {
+		var i BusinessScheduledPost
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessRootID,
+			&i.BusinessImageContentID,
+			&i.Platform,
+			&i.ScheduledAt,
+			&i.Timezone,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ExternalPostID,
+			&i.FailureReason,
+			&i.ProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBusinessScheduledPostById = `-- name: GetBusinessScheduledPostById :one
+SELECT id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at FROM business_scheduled_posts
+WHERE id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetBusinessScheduledPostById(ctx context.Context, id int64) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, getBusinessScheduledPostById, id)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getBusinessScheduledPostByIdAndBusinessRootId = `-- name: GetBusinessScheduledPostByIdAndBusinessRootId :one
+SELECT id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at FROM business_scheduled_posts
+WHERE id = $1
+  AND business_root_id = $2
+  AND deleted_at IS NULL
+`
+
+type GetBusinessScheduledPostByIdAndBusinessRootIdParams struct {
+	ID             int64 `json:"id"`
+	BusinessRootID int64 `json:"business_root_id"`
+}
+
+func (q *Queries) GetBusinessScheduledPostByIdAndBusinessRootId(ctx context.Context, arg GetBusinessScheduledPostByIdAndBusinessRootIdParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, getBusinessScheduledPostByIdAndBusinessRootId, arg.ID, arg.BusinessRootID)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getBusinessScheduledPostsByRange = `-- name: GetBusinessScheduledPostsByRange :many
+SELECT id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at FROM business_scheduled_posts
+WHERE business_root_id = $1
+  AND deleted_at IS NULL
+  AND scheduled_at >= $2
+  AND scheduled_at < $3
+  AND (
+    $4::social_platform_type IS NULL
+    OR platform = $4::social_platform_type
+  )
+ORDER BY scheduled_at ASC, id ASC
+`
+
+type GetBusinessScheduledPostsByRangeParams struct {
+	BusinessRootID int64                  `json:"business_root_id"`
+	RangeStart     time.Time              `json:"range_start"`
+	RangeEnd       time.Time              `json:"range_end"`
+	Platform       NullSocialPlatformType `json:"platform"`
+}
+
+// calendar: semua jadwal business dalam range [range_start, range_end)
+func (q *Queries) GetBusinessScheduledPostsByRange(ctx context.Context, arg GetBusinessScheduledPostsByRangeParams) ([]BusinessScheduledPost, error) {
+	rows, err := q.db.QueryContext(ctx, getBusinessScheduledPostsByRange,
+		arg.BusinessRootID,
+		arg.RangeStart,
+		arg.RangeEnd,
+		arg.Platform,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BusinessScheduledPost
+	for rows.Next() {
+		var i BusinessScheduledPost
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessRootID,
+			&i.BusinessImageContentID,
+			&i.Platform,
+			&i.ScheduledAt,
+			&i.Timezone,
+			&i.Status,
+			&i.PublishedAt,
+			&i.ExternalPostID,
+			&i.FailureReason,
+			&i.ProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markBusinessScheduledPostFailed = `-- name: MarkBusinessScheduledPostFailed :one
+UPDATE business_scheduled_posts
+SET status = 'failed', failure_reason = $1
+WHERE id = $2
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type MarkBusinessScheduledPostFailedParams struct {
+	FailureReason sql.NullString `json:"failure_reason"`
+	ID            int64          `json:"id"`
+}
+
+func (q *Queries) MarkBusinessScheduledPostFailed(ctx context.Context, arg MarkBusinessScheduledPostFailedParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, markBusinessScheduledPostFailed, arg.FailureReason, arg.ID)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const markBusinessScheduledPostPublished = `-- name: MarkBusinessScheduledPostPublished :one
+UPDATE business_scheduled_posts
+SET status = 'published', published_at = NOW(), external_post_id = $1, failure_reason = NULL
+WHERE id = $2
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type MarkBusinessScheduledPostPublishedParams struct {
+	ExternalPostID sql.NullString `json:"external_post_id"`
+	ID             int64          `json:"id"`
+}
+
+func (q *Queries) MarkBusinessScheduledPostPublished(ctx context.Context, arg MarkBusinessScheduledPostPublishedParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, markBusinessScheduledPostPublished, arg.ExternalPostID, arg.ID)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const markBusinessScheduledPostPublishing = `-- name: MarkBusinessScheduledPostPublishing :one
+UPDATE business_scheduled_posts
+SET status = 'publishing'
+WHERE id = $1
+  AND status = 'scheduled'
+  AND scheduled_at = $2
+  AND deleted_at IS NULL
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type MarkBusinessScheduledPostPublishingParams struct {
+	ID          int64     `json:"id"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+}
+
+// claim jadwal untuk dipublish (idempotent: hanya jika status masih 'scheduled' dan waktunya sama)
+func (q *Queries) MarkBusinessScheduledPostPublishing(ctx context.Context, arg MarkBusinessScheduledPostPublishingParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, markBusinessScheduledPostPublishing, arg.ID, arg.ScheduledAt)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateBusinessScheduledPost = `-- name: UpdateBusinessScheduledPost :one
+UPDATE business_scheduled_posts
+SET
+    business_image_content_id = $1,
+    platform = $2,
+    scheduled_at = $3,
+    timezone = $4
+WHERE id = $5
+  AND business_root_id = $6
+  AND status = 'scheduled'
+  AND deleted_at IS NULL
+RETURNING id, business_root_id, business_image_content_id, platform, scheduled_at, timezone, status, published_at, external_post_id, failure_reason, profile_id, created_at, updated_at, deleted_at
+`
+
+type UpdateBusinessScheduledPostParams struct {
+	BusinessImageContentID int64              `json:"business_image_content_id"`
+	Platform               SocialPlatformType `json:"platform"`
+	ScheduledAt            time.Time          `json:"scheduled_at"`
+	Timezone               string             `json:"timezone"`
+	ID                     int64              `json:"id"`
+	BusinessRootID         int64              `json:"business_root_id"`
+}
+
+// hanya jadwal yang masih 'scheduled' yang boleh diubah
+func (q *Queries) UpdateBusinessScheduledPost(ctx context.Context, arg UpdateBusinessScheduledPostParams) (BusinessScheduledPost, error) {
+	row := q.db.QueryRowContext(ctx, updateBusinessScheduledPost,
+		arg.BusinessImageContentID,
+		arg.Platform,
+		arg.ScheduledAt,
+		arg.Timezone,
+		arg.ID,
+		arg.BusinessRootID,
+	)
+	var i BusinessScheduledPost
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.BusinessImageContentID,
+		&i.Platform,
+		&i.ScheduledAt,
+		&i.Timezone,
+		&i.Status,
+		&i.PublishedAt,
+		&i.ExternalPostID,
+		&i.FailureReason,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,346 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: business_social_account.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const disconnectBusinessSocialAccount = `-- name: DisconnectBusinessSocialAccount :one
+UPDATE business_social_accounts
+SET
+    status = 'disconnected',
+    access_token_encrypted = '',
+    refresh_token_encrypted = NULL,
+    deleted_at = NOW()
+WHERE id = $1
+  AND business_root_id = $2
+  AND deleted_at IS NULL
+RETURNING id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at
+`
+
+type DisconnectBusinessSocialAccountParams struct {
+	ID             int64 `json:"id"`
+	BusinessRootID int64 `json:"business_root_id"`
+}
+
+// credential dihapus saat disconnect
+func (q *Queries) DisconnectBusinessSocialAccount(ctx context.Context, arg DisconnectBusinessSocialAccountParams) (BusinessSocialAccount, error) {
+	row := q.db.QueryRowContext(ctx, disconnectBusinessSocialAccount, arg.ID, arg.BusinessRootID)
+	var i BusinessSocialAccount
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.ExternalAccountID,
+		&i.AccountName,
+		&i.AccountImageUrl,
+		&i.AccessTokenEncrypted,
+		&i.RefreshTokenEncrypted,
+		&i.TokenExpiresAt,
+		pq.Array(&i.Scopes),
+		&i.LastRefreshedAt,
+		&i.Status,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getBusinessSocialAccountByIdAndBusinessRootId = `-- name: GetBusinessSocialAccountByIdAndBusinessRootId :one
+SELECT id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at FROM business_social_accounts
+WHERE id = $1
+  AND business_root_id = $2
+  AND deleted_at IS NULL
+`
+
+type GetBusinessSocialAccountByIdAndBusinessRootIdParams struct {
+	ID             int64 `json:"id"`
+	BusinessRootID int64 `json:"business_root_id"`
+}
+
+func (q *Queries) GetBusinessSocialAccountByIdAndBusinessRootId(ctx context.Context, arg GetBusinessSocialAccountByIdAndBusinessRootIdParams) (BusinessSocialAccount, error) {
+	row := q.db.QueryRowContext(ctx, getBusinessSocialAccountByIdAndBusinessRootId, arg.ID, arg.BusinessRootID)
+	var i BusinessSocialAccount
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.ExternalAccountID,
+		&i.AccountName,
+		&i.AccountImageUrl,
+		&i.AccessTokenEncrypted,
+		&i.RefreshTokenEncrypted,
+		&i.TokenExpiresAt,
+		pq.Array(&i.Scopes),
+		&i.LastRefreshedAt,
+		&i.Status,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getBusinessSocialAccountByPlatform = `-- name: GetBusinessSocialAccountByPlatform :one
+SELECT id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at FROM business_social_accounts
+WHERE business_root_id = $1
+  AND platform = $2
+  AND deleted_at IS NULL
+`
+
+type GetBusinessSocialAccountByPlatformParams struct {
+	BusinessRootID int64              `json:"business_root_id"`
+	Platform       SocialPlatformType `json:"platform"`
+}
+
+func (q *Queries) GetBusinessSocialAccountByPlatform(ctx context.Context, arg GetBusinessSocialAccountByPlatformParams) (BusinessSocialAccount, error) {
+	row := q.db.QueryRowContext(ctx, getBusinessSocialAccountByPlatform, arg.BusinessRootID, arg.Platform)
+	var i BusinessSocialAccount
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.ExternalAccountID,
+		&i.AccountName,
+		&i.AccountImageUrl,
+		&i.AccessTokenEncrypted,
+		&i.RefreshTokenEncrypted,
+		&i.TokenExpiresAt,
+		pq.Array(&i.Scopes),
+		&i.LastRefreshedAt,
+		&i.Status,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getBusinessSocialAccountsByBusinessRootId = `-- name: GetBusinessSocialAccountsByBusinessRootId :many
+SELECT id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at FROM business_social_accounts
+WHERE business_root_id = $1
+  AND deleted_at IS NULL
+ORDER BY platform ASC
+`
+
+func (q *Queries) GetBusinessSocialAccountsByBusinessRootId(ctx context.Context, businessRootID int64) ([]BusinessSocialAccount, error) {
+	rows, err := q.db.QueryContext(ctx, getBusinessSocialAccountsByBusinessRootId, businessRootID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []BusinessSocialAccount
+	for rows.Next() {
+		var i BusinessSocialAccount
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessRootID,
+			&i.Platform,
+			&i.ExternalAccountID,
+			&i.AccountName,
+			&i.AccountImageUrl,
+			&i.AccessTokenEncrypted,
+			&i.RefreshTokenEncrypted,
+			&i.TokenExpiresAt,
+			pq.Array(&i.Scopes),
+			&i.LastRefreshedAt,
+			&i.Status,
+			&i.ProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markBusinessSocialAccountExpired = `-- name: MarkBusinessSocialAccountExpired :one
+UPDATE business_social_accounts
+SET status = 'expired'
+WHERE id = $1 AND deleted_at IS NULL
+RETURNING id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at
+`
+
+func (q *Queries) MarkBusinessSocialAccountExpired(ctx context.Context, id int64) (BusinessSocialAccount, error) {
+	row := q.db.QueryRowContext(ctx, markBusinessSocialAccountExpired, id)
+	var i BusinessSocialAccount
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.ExternalAccountID,
+		&i.AccountName,
+		&i.AccountImageUrl,
+		&i.AccessTokenEncrypted,
+		&i.RefreshTokenEncrypted,
+		&i.TokenExpiresAt,
+		pq.Array(&i.Scopes),
+		&i.LastRefreshedAt,
+		&i.Status,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const updateBusinessSocialAccountToken = `-- name: UpdateBusinessSocialAccountToken :one
+UPDATE business_social_accounts
+SET
+    access_token_encrypted = $1,
+    refresh_token_encrypted = $2,
+    token_expires_at = $3,
+    status = 'connected',
+    last_refreshed_at = NOW()
+WHERE id = $4 AND deleted_at IS NULL
+RETURNING id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at
+`
+
+type UpdateBusinessSocialAccountTokenParams struct {
+	AccessTokenEncrypted  string         `json:"access_token_encrypted"`
+	RefreshTokenEncrypted sql.NullString `json:"refresh_token_encrypted"`
+	TokenExpiresAt        sql.NullTime   `json:"token_expires_at"`
+	ID                    int64          `json:"id"`
+}
+
+func (q *Queries) UpdateBusinessSocialAccountToken(ctx context.Context, arg UpdateBusinessSocialAccountTokenParams) (BusinessSocialAccount, error) {
+	row := q.db.QueryRowContext(ctx, updateBusinessSocialAccountToken,
+		arg.AccessTokenEncrypted,
+		arg.RefreshTokenEncrypted,
+		arg.TokenExpiresAt,
+		arg.ID,
+	)
+	var i BusinessSocialAccount
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.ExternalAccountID,
+		&i.AccountName,
+		&i.AccountImageUrl,
+		&i.AccessTokenEncrypted,
+		&i.RefreshTokenEncrypted,
+		&i.TokenExpiresAt,
+		pq.Array(&i.Scopes),
+		&i.LastRefreshedAt,
+		&i.Status,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const upsertBusinessSocialAccount = `-- name: UpsertBusinessSocialAccount :one
+INSERT INTO business_social_accounts (
+    business_root_id,
+    platform,
+    external_account_id,
+    account_name,
+    account_image_url,
+    access_token_encrypted,
+    refresh_token_encrypted,
+    token_expires_at,
+    scopes,
+    status,
+    profile_id
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    'connected',
+    $10
+)
+ON CONFLICT (business_root_id, platform) WHERE deleted_at IS NULL
+DO UPDATE SET
+    external_account_id = EXCLUDED.external_account_id,
+    account_name = EXCLUDED.account_name,
+    account_image_url = EXCLUDED.account_image_url,
+    access_token_encrypted = EXCLUDED.access_token_encrypted,
+    refresh_token_encrypted = EXCLUDED.refresh_token_encrypted,
+    token_expires_at = EXCLUDED.token_expires_at,
+    scopes = EXCLUDED.scopes,
+    status = 'connected',
+    profile_id = EXCLUDED.profile_id,
+    last_refreshed_at = NULL
+RETURNING id, business_root_id, platform, external_account_id, account_name, account_image_url, access_token_encrypted, refresh_token_encrypted, token_expires_at, scopes, last_refreshed_at, status, profile_id, created_at, updated_at, deleted_at
+`
+
+type UpsertBusinessSocialAccountParams struct {
+	BusinessRootID        int64              `json:"business_root_id"`
+	Platform              SocialPlatformType `json:"platform"`
+	ExternalAccountID     string             `json:"external_account_id"`
+	AccountName           string             `json:"account_name"`
+	AccountImageUrl       sql.NullString     `json:"account_image_url"`
+	AccessTokenEncrypted  string             `json:"access_token_encrypted"`
+	RefreshTokenEncrypted sql.NullString     `json:"refresh_token_encrypted"`
+	TokenExpiresAt        sql.NullTime       `json:"token_expires_at"`
+	Scopes                []string           `json:"scopes"`
+	ProfileID             uuid.UUID          `json:"profile_id"`
+}
+
+// connect ulang platform yang sama akan menimpa credential lama
+func (q *Queries) UpsertBusinessSocialAccount(ctx context.Context, arg UpsertBusinessSocialAccountParams) (BusinessSocialAccount, error) {
+	row := q.db.QueryRowContext(ctx, upsertBusinessSocialAccount,
+		arg.BusinessRootID,
+		arg.Platform,
+		arg.ExternalAccountID,
+		arg.AccountName,
+		arg.AccountImageUrl,
+		arg.AccessTokenEncrypted,
+		arg.RefreshTokenEncrypted,
+		arg.TokenExpiresAt,
+		pq.Array(arg.Scopes),
+		arg.ProfileID,
+	)
+	var i BusinessSocialAccount
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.ExternalAccountID,
+		&i.AccountName,
+		&i.AccountImageUrl,
+		&i.AccessTokenEncrypted,
+		&i.RefreshTokenEncrypted,
+		&i.TokenExpiresAt,
+		pq.Array(&i.Scopes),
+		&i.LastRefreshedAt,
+		&i.Status,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
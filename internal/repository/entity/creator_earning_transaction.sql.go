@@ -0,0 +1,298 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: creator_earning_transaction.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countAllCreatorEarningTransactionsByProfileId = `-- name: CountAllCreatorEarningTransactionsByProfileId :one
+SELECT COUNT(*)::bigint AS total
+FROM creator_earning_transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.profile_id = $1
+    AND (
+        $2::creator_earning_transaction_type IS NULL
+        OR t.type = $2::creator_earning_transaction_type
+    )
+    AND (
+        $3::date IS NULL
+        OR t.created_at::date >= $3::date
+    )
+    AND (
+        $4::date IS NULL
+        OR t.created_at::date <= $4::date
+    )
+`
+
+type CountAllCreatorEarningTransactionsByProfileIdParams struct {
+	ProfileID uuid.UUID                         `json:"profile_id"`
+	Type      NullCreatorEarningTransactionType `json:"type"`
+	DateStart sql.NullTime                      `json:"date_start"`
+	DateEnd   sql.NullTime                      `json:"date_end"`
+}
+
+func (q *Queries) CountAllCreatorEarningTransactionsByProfileId(ctx context.Context, arg CountAllCreatorEarningTransactionsByProfileIdParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllCreatorEarningTransactionsByProfileId,
+		arg.ProfileID,
+		arg.Type,
+		arg.DateStart,
+		arg.DateEnd,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createCreatorEarningTransaction = `-- name: CreateCreatorEarningTransaction :one
+INSERT INTO creator_earning_transactions (
+    profile_id,
+    type,
+    creator_image_id,
+    payment_history_id,
+    gross_amount,
+    commission_percentage,
+    commission_amount,
+    net_amount,
+    currency
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9
+)
+ON CONFLICT (payment_history_id, type) WHERE deleted_at IS NULL
+DO NOTHING
+RETURNING id, profile_id, type, creator_image_id, payment_history_id, gross_amount, commission_percentage, commission_amount, net_amount, currency, created_at, updated_at, deleted_at
+`
+
+type CreateCreatorEarningTransactionParams struct {
+	ProfileID            uuid.UUID                     `json:"profile_id"`
+	Type                 CreatorEarningTransactionType `json:"type"`
+	CreatorImageID       int64                         `json:"creator_image_id"`
+	PaymentHistoryID     uuid.UUID                     `json:"payment_history_id"`
+	GrossAmount          int64                         `json:"gross_amount"`
+	CommissionPercentage int32                         `json:"commission_percentage"`
+	CommissionAmount     int64                         `json:"commission_amount"`
+	NetAmount            int64                         `json:"net_amount"`
+	Currency             string                        `json:"currency"`
+}
+
+// idempotent untuk sale & clawback per payment (no rows = sudah pernah dicatat)
+func (q *Queries) CreateCreatorEarningTransaction(ctx context.Context, arg CreateCreatorEarningTransactionParams) (CreatorEarningTransaction, error) {
+	row := q.db.QueryRowContext(ctx, createCreatorEarningTransaction,
+		arg.ProfileID,
+		arg.Type,
+		arg.CreatorImageID,
+		arg.PaymentHistoryID,
+		arg.GrossAmount,
+		arg.CommissionPercentage,
+		arg.CommissionAmount,
+		arg.NetAmount,
+		arg.Currency,
+	)
+	var i CreatorEarningTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Type,
+		&i.CreatorImageID,
+		&i.PaymentHistoryID,
+		&i.GrossAmount,
+		&i.CommissionPercentage,
+		&i.CommissionAmount,
+		&i.NetAmount,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAllCreatorEarningTransactionsByProfileId = `-- name: GetAllCreatorEarningTransactionsByProfileId :many
+SELECT
+    t.id, t.profile_id, t.type, t.creator_image_id, t.payment_history_id, t.gross_amount, t.commission_percentage, t.commission_amount, t.net_amount, t.currency, t.created_at, t.updated_at, t.deleted_at,
+    ci.name AS creator_image_name
+FROM creator_earning_transactions t
+JOIN creator_images ci ON ci.id = t.creator_image_id
+WHERE
+    t.deleted_at IS NULL
+    AND t.profile_id = $1
+    AND (
+        $2::creator_earning_transaction_type IS NULL
+        OR t.type = $2::creator_earning_transaction_type
+    )
+    AND (
+        $3::date IS NULL
+        OR t.created_at::date >= $3::date
+    )
+    AND (
+        $4::date IS NULL
+        OR t.created_at::date <= $4::date
+    )
+ORDER BY
+    CASE WHEN $5 = 'id' AND $6 = 'asc' THEN t.id END ASC,
+    CASE WHEN $5 = 'id' AND $6 = 'desc' THEN t.id END DESC,
+    CASE WHEN $5 = 'created_at' AND $6 = 'asc' THEN t.created_at END ASC,
+    CASE WHEN $5 = 'created_at' AND $6 = 'desc' THEN t.created_at END DESC,
+    CASE WHEN $5 = 'amount' AND $6 = 'asc' THEN t.net_amount END ASC,
+    CASE WHEN $5 = 'amount' AND $6 = 'desc' THEN t.net_amount END DESC,
+    t.id DESC
+LIMIT $8
+OFFSET $7
+`
+
+type GetAllCreatorEarningTransactionsByProfileIdParams struct {
+	ProfileID  uuid.UUID                         `json:"profile_id"`
+	Type       NullCreatorEarningTransactionType `json:"type"`
+	DateStart  sql.NullTime                      `json:"date_start"`
+	DateEnd    sql.NullTime                      `json:"date_end"`
+	SortBy     interface{}                       `json:"sort_by"`
+	SortDir    interface{}                       `json:"sort_dir"`
+	PageOffset int32                             `json:"page_offset"`
+	PageLimit  int32                             `json:"page_limit"`
+}
+
+type GetAllCreatorEarningTransactionsByProfileIdRow struct {
+	ID                   int64                         `json:"id"`
+	ProfileID            uuid.UUID                     `json:"profile_id"`
+	Type                 CreatorEarningTransactionType `json:"type"`
+	CreatorImageID       int64                         `json:"creator_image_id"`
+	PaymentHistoryID     uuid.UUID                     `json:"payment_history_id"`
+	GrossAmount          int64                         `json:"gross_amount"`
+	CommissionPercentage int32                         `json:"commission_percentage"`
+	CommissionAmount     int64                         `json:"commission_amount"`
+	NetAmount            int64                         `json:"net_amount"`
+	Currency             string                        `json:"currency"`
+	CreatedAt            time.Time                     `json:"created_at"`
+	UpdatedAt            time.Time                     `json:"updated_at"`
+	DeletedAt            sql.NullTime                  `json:"deleted_at"`
+	CreatorImageName     string                        `json:"creator_image_name"`
+}
+
+func (q *Queries) GetAllCreatorEarningTransactionsByProfileId(ctx context.Context, arg GetAllCreatorEarningTransactionsByProfileIdParams) ([]GetAllCreatorEarningTransactionsByProfileIdRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllCreatorEarningTransactionsByProfileId,
+		arg.ProfileID,
+		arg.Type,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.SortBy,
+		arg.SortDir,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllCreatorEarningTransactionsByProfileIdRow
+	for rows.Next() {
+		var i GetAllCreatorEarningTransactionsByProfileIdRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Type,
+			&i.CreatorImageID,
+			&i.PaymentHistoryID,
+			&i.GrossAmount,
+			&i.CommissionPercentage,
+			&i.CommissionAmount,
+			&i.NetAmount,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.CreatorImageName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCreatorEarningSummaryByProfileId = `-- name: GetCreatorEarningSummaryByProfileId :one
+SELECT
+    COUNT(*) FILTER (WHERE type = 'sale')::bigint AS total_sales,
+    COALESCE(SUM(gross_amount) FILTER (WHERE type = 'sale'), 0)::bigint AS total_gross,
+    COALESCE(SUM(commission_amount) FILTER (WHERE type = 'sale'), 0)::bigint AS total_commission,
+    COALESCE(SUM(net_amount) FILTER (WHERE type = 'sale'), 0)::bigint AS total_earned,
+    COALESCE(SUM(net_amount) FILTER (WHERE type = 'clawback'), 0)::bigint AS total_clawed_back
+FROM creator_earning_transactions
+WHERE profile_id = $1
+    AND deleted_at IS NULL
+`
+
+type GetCreatorEarningSummaryByProfileIdRow struct {
+	TotalSales      int64 `json:"total_sales"`
+	TotalGross      int64 `json:"total_gross"`
+	TotalCommission int64 `json:"total_commission"`
+	TotalEarned     int64 `json:"total_earned"`
+	TotalClawedBack int64 `json:"total_clawed_back"`
+}
+
+func (q *Queries) GetCreatorEarningSummaryByProfileId(ctx context.Context, profileID uuid.UUID) (GetCreatorEarningSummaryByProfileIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getCreatorEarningSummaryByProfileId, profileID)
+	var i GetCreatorEarningSummaryByProfileIdRow
+	err := row.Scan(
+		&i.TotalSales,
+		&i.TotalGross,
+		&i.TotalCommission,
+		&i.TotalEarned,
+		&i.TotalClawedBack,
+	)
+	return i, err
+}
+
+const getCreatorEarningTransactionByPaymentIdAndType = `-- name: GetCreatorEarningTransactionByPaymentIdAndType :one
+SELECT id, profile_id, type, creator_image_id, payment_history_id, gross_amount, commission_percentage, commission_amount, net_amount, currency, created_at, updated_at, deleted_at FROM creator_earning_transactions
+WHERE payment_history_id = $1
+    AND type = $2
+    AND deleted_at IS NULL
+`
+
+type GetCreatorEarningTransactionByPaymentIdAndTypeParams struct {
+	PaymentHistoryID uuid.UUID                     `json:"payment_history_id"`
+	Type             CreatorEarningTransactionType `json:"type"`
+}
+
+func (q *Queries) GetCreatorEarningTransactionByPaymentIdAndType(ctx context.Context, arg GetCreatorEarningTransactionByPaymentIdAndTypeParams) (CreatorEarningTransaction, error) {
+	row := q.db.QueryRowContext(ctx, getCreatorEarningTransactionByPaymentIdAndType, arg.PaymentHistoryID, arg.Type)
+	var i CreatorEarningTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Type,
+		&i.CreatorImageID,
+		&i.PaymentHistoryID,
+		&i.GrossAmount,
+		&i.CommissionPercentage,
+		&i.CommissionAmount,
+		&i.NetAmount,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
@@ -107,7 +107,7 @@ WITH ins AS (
     $4,
     $5
   )
-  RETURNING id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at
+  RETURNING id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at
 ),
 ins_prod AS (
   INSERT INTO creator_image_product_categories (creator_image_id, product_category_id)
@@ -131,7 +131,7 @@ ins_type AS (
   ) AS x
   ON CONFLICT DO NOTHING
 )
-SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at FROM ins
+SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at FROM ins
 `
 
 type CreateCreatorImageParams struct {
@@ -145,17 +145,19 @@ type CreateCreatorImageParams struct {
 }
 
 type CreateCreatorImageRow struct {
-	ID           int64          `json:"id"`
-	Name         string         `json:"name"`
-	ImageUrl     string         `json:"image_url"`
-	IsPublished  bool           `json:"is_published"`
-	IsBanned     bool           `json:"is_banned"`
-	BannedReason sql.NullString `json:"banned_reason"`
-	Price        int64          `json:"price"`
-	ProfileID    uuid.NullUUID  `json:"profile_id"`
-	CreatedAt    sql.NullTime   `json:"created_at"`
-	UpdatedAt    sql.NullTime   `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
+	ID               int64                        `json:"id"`
+	Name             string                       `json:"name"`
+	ImageUrl         string                       `json:"image_url"`
+	IsPublished      bool                         `json:"is_published"`
+	IsBanned         bool                         `json:"is_banned"`
+	BannedReason     sql.NullString               `json:"banned_reason"`
+	Price            int64                        `json:"price"`
+	ProfileID        uuid.NullUUID                `json:"profile_id"`
+	CreatedAt        sql.NullTime                 `json:"created_at"`
+	UpdatedAt        sql.NullTime                 `json:"updated_at"`
+	DeletedAt        sql.NullTime                 `json:"deleted_at"`
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	ModeratedAt      sql.NullTime                 `json:"moderated_at"`
 }
 
 func (q *Queries) CreateCreatorImage(ctx context.Context, arg CreateCreatorImageParams) (CreateCreatorImageRow, error) {
@@ -181,6 +183,8 @@ func (q *Queries) CreateCreatorImage(ctx context.Context, arg CreateCreatorImage
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ModerationStatus,
+		&i.ModeratedAt,
 	)
 	return i, err
 }
@@ -408,7 +412,7 @@ func (q *Queries) GetAllCreatorImage(ctx context.Context, arg GetAllCreatorImage
 }
 
 const getCreatorImageById = `-- name: GetCreatorImageById :one
-SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at FROM creator_images WHERE id = $1
+SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at FROM creator_images WHERE id = $1
 `
 
 func (q *Queries) GetCreatorImageById(ctx context.Context, id int64) (CreatorImage, error) {
@@ -426,6 +430,8 @@ func (q *Queries) GetCreatorImageById(ctx context.Context, id int64) (CreatorIma
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ModerationStatus,
+		&i.ModeratedAt,
 	)
 	return i, err
 }
@@ -447,9 +453,15 @@ WITH upd AS (
     image_url = $2,
     is_published = $3,
     price = $4,
-    profile_id = $5
+    profile_id = $5,
+    -- perubahan pada template published masuk antrian review lagi (banned tetap banned)
+    moderation_status = CASE
+      WHEN moderation_status = 'banned' THEN moderation_status
+      WHEN $3::boolean THEN 'pending'::creator_image_moderation_status
+      ELSE moderation_status
+    END
   WHERE id = $6
-  RETURNING id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at
+  RETURNING id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at
 ),
 del_prod AS (
   DELETE FROM creator_image_product_categories
@@ -481,7 +493,7 @@ ins_type AS (
   ) AS x
   ON CONFLICT DO NOTHING
 )
-SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at FROM upd
+SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at FROM upd
 `
 
 type UpdateCreatorImageParams struct {
@@ -496,17 +508,19 @@ type UpdateCreatorImageParams struct {
 }
 
 type UpdateCreatorImageRow struct {
-	ID           int64          `json:"id"`
-	Name         string         `json:"name"`
-	ImageUrl     string         `json:"image_url"`
-	IsPublished  bool           `json:"is_published"`
-	IsBanned     bool           `json:"is_banned"`
-	BannedReason sql.NullString `json:"banned_reason"`
-	Price        int64          `json:"price"`
-	ProfileID    uuid.NullUUID  `json:"profile_id"`
-	CreatedAt    sql.NullTime   `json:"created_at"`
-	UpdatedAt    sql.NullTime   `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
+	ID               int64                        `json:"id"`
+	Name             string                       `json:"name"`
+	ImageUrl         string                       `json:"image_url"`
+	IsPublished      bool                         `json:"is_published"`
+	IsBanned         bool                         `json:"is_banned"`
+	BannedReason     sql.NullString               `json:"banned_reason"`
+	Price            int64                        `json:"price"`
+	ProfileID        uuid.NullUUID                `json:"profile_id"`
+	CreatedAt        sql.NullTime                 `json:"created_at"`
+	UpdatedAt        sql.NullTime                 `json:"updated_at"`
+	DeletedAt        sql.NullTime                 `json:"deleted_at"`
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	ModeratedAt      sql.NullTime                 `json:"moderated_at"`
 }
 
 func (q *Queries) UpdateCreatorImage(ctx context.Context, arg UpdateCreatorImageParams) (UpdateCreatorImageRow, error) {
@@ -533,6 +547,8 @@ func (q *Queries) UpdateCreatorImage(ctx context.Context, arg UpdateCreatorImage
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ModerationStatus,
+		&i.ModeratedAt,
 	)
 	return i, err
 }
@@ -0,0 +1,355 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: creator_image_moderation.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countAllCreatorImageModerationQueue = `-- name: CountAllCreatorImageModerationQueue :one
+SELECT COUNT(*)::bigint AS total
+FROM creator_images ci
+WHERE
+    ci.deleted_at IS NULL
+    AND ci.is_published = TRUE
+    AND ci.moderation_status = $1
+    AND (
+        COALESCE($2, '') = ''
+        OR ci.name ILIKE ('%' || $2 || '%')
+    )
+    AND (
+        $3::date IS NULL
+        OR ci.updated_at::date >= $3::date
+    )
+    AND (
+        $4::date IS NULL
+        OR ci.updated_at::date <= $4::date
+    )
+`
+
+type CountAllCreatorImageModerationQueueParams struct {
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	Search           interface{}                  `json:"search"`
+	DateStart        sql.NullTime                 `json:"date_start"`
+	DateEnd          sql.NullTime                 `json:"date_end"`
+}
+
+func (q *Queries) CountAllCreatorImageModerationQueue(ctx context.Context, arg CountAllCreatorImageModerationQueueParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllCreatorImageModerationQueue,
+		arg.ModerationStatus,
+		arg.Search,
+		arg.DateStart,
+		arg.DateEnd,
+	)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
+const createCreatorImageModeration = `-- name: CreateCreatorImageModeration :one
+INSERT INTO creator_image_moderations (
+    creator_image_id,
+    action,
+    previous_status,
+    reason,
+    moderator_profile_id
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+) RETURNING id, creator_image_id, action, previous_status, reason, moderator_profile_id, created_at, updated_at, deleted_at
+`
+
+type CreateCreatorImageModerationParams struct {
+	CreatorImageID     int64                        `json:"creator_image_id"`
+	Action             CreatorImageModerationAction `json:"action"`
+	PreviousStatus     CreatorImageModerationStatus `json:"previous_status"`
+	Reason             sql.NullString               `json:"reason"`
+	ModeratorProfileID uuid.UUID                    `json:"moderator_profile_id"`
+}
+
+func (q *Queries) CreateCreatorImageModeration(ctx context.Context, arg CreateCreatorImageModerationParams) (CreatorImageModeration, error) {
+	row := q.db.QueryRowContext(ctx, createCreatorImageModeration,
+		arg.CreatorImageID,
+		arg.Action,
+		arg.PreviousStatus,
+		arg.Reason,
+		arg.ModeratorProfileID,
+	)
+	var i CreatorImageModeration
+	err := row.Scan(
+		&i.ID,
+		&i.CreatorImageID,
+		&i.Action,
+		&i.PreviousStatus,
+		&i.Reason,
+		&i.ModeratorProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getAllCreatorImageModerationQueue = `-- name: GetAllCreatorImageModerationQueue :many
+SELECT
+    ci.id,
+    ci.name,
+    ci.image_url,
+    ci.is_published,
+    ci.is_banned,
+    ci.banned_reason,
+    ci.price,
+    ci.profile_id,
+    ci.moderation_status,
+    ci.moderated_at,
+    ci.created_at,
+    ci.updated_at,
+    pub.name  AS publisher_name,
+    pub.email AS publisher_email
+FROM creator_images ci
+LEFT JOIN profiles pub ON pub.id = ci.profile_id
+WHERE
+    ci.deleted_at IS NULL
+    AND ci.is_published = TRUE
+    AND ci.moderation_status = $1
+    AND (
+        COALESCE($2, '') = ''
+        OR ci.name ILIKE ('%' || $2 || '%')
+    )
+    AND (
+        $3::date IS NULL
+        OR ci.updated_at::date >= $3::date
+    )
+    AND (
+        $4::date IS NULL
+        OR ci.updated_at::date <= $4::date
+    )
+ORDER BY
+    CASE WHEN $5 = 'id' AND $6 = 'asc' THEN ci.id END ASC,
+    CASE WHEN $5 = 'id' AND $6 = 'desc' THEN ci.id END DESC,
+    CASE WHEN $5 = 'name' AND $6 = 'asc' THEN ci.name END ASC,
+    CASE WHEN $5 = 'name' AND $6 = 'desc' THEN ci.name END DESC,
+    CASE WHEN $5 = 'created_at' AND $6 = 'asc' THEN ci.created_at END ASC,
+    CASE WHEN $5 = 'created_at' AND $6 = 'desc' THEN ci.created_at END DESC,
+    CASE WHEN $5 = 'updated_at' AND $6 = 'asc' THEN ci.updated_at END ASC,
+    CASE WHEN $5 = 'updated_at' AND $6 = 'desc' THEN ci.updated_at END DESC,
+    ci.id DESC
+LIMIT $8
+OFFSET $7
+`
+
+type GetAllCreatorImageModerationQueueParams struct {
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	Search           interface{}                  `json:"search"`
+	DateStart        sql.NullTime                 `json:"date_start"`
+	DateEnd          sql.NullTime                 `json:"date_end"`
+	SortBy           interface{}                  `json:"sort_by"`
+	SortDir          interface{}                  `json:"sort_dir"`
+	PageOffset       int32                        `json:"page_offset"`
+	PageLimit        int32                        `json:"page_limit"`
+}
+
+type GetAllCreatorImageModerationQueueRow struct {
+	ID               int64                        `json:"id"`
+	Name             string                       `json:"name"`
+	ImageUrl         string                       `json:"image_url"`
+	IsPublished      bool                         `json:"is_published"`
+	IsBanned         bool                         `json:"is_banned"`
+	BannedReason     sql.NullString               `json:"banned_reason"`
+	Price            int64                        `json:"price"`
+	ProfileID        uuid.NullUUID                `json:"profile_id"`
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	ModeratedAt      sql.NullTime                 `json:"moderated_at"`
+	CreatedAt        sql.NullTime                 `json:"created_at"`
+	UpdatedAt        sql.NullTime                 `json:"updated_at"`
+	PublisherName    sql.NullString               `json:"publisher_name"`
+	PublisherEmail   sql.NullString               `json:"publisher_email"`
+}
+
+// antrian review admin: creator image published & tidak dihapus
+func (q *Queries) GetAllCreatorImageModerationQueue(ctx context.Context, arg GetAllCreatorImageModerationQueueParams) ([]GetAllCreatorImageModerationQueueRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllCreatorImageModerationQueue,
+		arg.ModerationStatus,
+		arg.Search,
+		arg.DateStart,
+		arg.DateEnd,
+		arg.SortBy,
+		arg.SortDir,
+		arg.PageOffset,
+		arg.PageLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllCreatorImageModerationQueueRow
+	for rows.Next() {
+		var i GetAllCreatorImageModerationQueueRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ImageUrl,
+			&i.IsPublished,
+			&i.IsBanned,
+			&i.BannedReason,
+			&i.Price,
+			&i.ProfileID,
+			&i.ModerationStatus,
+			&i.ModeratedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.PublisherName,
+			&i.PublisherEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCreatorImageByIdForUpdate = `-- name: GetCreatorImageByIdForUpdate :one
+SELECT id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at FROM creator_images
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetCreatorImageByIdForUpdate(ctx context.Context, id int64) (CreatorImage, error) {
+	row := q.db.QueryRowContext(ctx, getCreatorImageByIdForUpdate, id)
+	var i CreatorImage
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ImageUrl,
+		&i.IsPublished,
+		&i.IsBanned,
+		&i.BannedReason,
+		&i.Price,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ModerationStatus,
+		&i.ModeratedAt,
+	)
+	return i, err
+}
+
+const getCreatorImageModerationsByCreatorImageId = `-- name: GetCreatorImageModerationsByCreatorImageId :many
+SELECT
+    m.id, m.creator_image_id, m.action, m.previous_status, m.reason, m.moderator_profile_id, m.created_at, m.updated_at, m.deleted_at,
+    pr.name  AS moderator_name,
+    pr.email AS moderator_email
+FROM creator_image_moderations m
+JOIN profiles pr ON pr.id = m.moderator_profile_id
+WHERE m.creator_image_id = $1
+    AND m.deleted_at IS NULL
+ORDER BY m.created_at DESC, m.id DESC
+`
+
+type GetCreatorImageModerationsByCreatorImageIdRow struct {
+	ID                 int64                        `json:"id"`
+	CreatorImageID     int64                        `json:"creator_image_id"`
+	Action             CreatorImageModerationAction `json:"action"`
+	PreviousStatus     CreatorImageModerationStatus `json:"previous_status"`
+	Reason             sql.NullString               `json:"reason"`
+	ModeratorProfileID uuid.UUID                    `json:"moderator_profile_id"`
+	CreatedAt          time.Time                    `json:"created_at"`
+	UpdatedAt          time.Time                    `json:"updated_at"`
+	DeletedAt          sql.NullTime                 `json:"deleted_at"`
+	ModeratorName      string                       `json:"moderator_name"`
+	ModeratorEmail     string                       `json:"moderator_email"`
+}
+
+func (q *Queries) GetCreatorImageModerationsByCreatorImageId(ctx context.Context, creatorImageID int64) ([]GetCreatorImageModerationsByCreatorImageIdRow, error) {
+	rows, err := q.db.QueryContext(ctx, getCreatorImageModerationsByCreatorImageId, creatorImageID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetCreatorImageModerationsByCreatorImageIdRow
+	for rows.Next() {
+		var i GetCreatorImageModerationsByCreatorImageIdRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.CreatorImageID,
+			&i.Action,
+			&i.PreviousStatus,
+			&i.Reason,
+			&i.ModeratorProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ModeratorName,
+			&i.ModeratorEmail,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateCreatorImageModeration = `-- name: UpdateCreatorImageModeration :one
+UPDATE creator_images
+SET
+    moderation_status = $1,
+    is_banned = ($1::creator_image_moderation_status = 'banned'),
+    banned_reason = CASE
+        WHEN $1::creator_image_moderation_status = 'banned' THEN $2
+        ELSE NULL
+    END,
+    moderated_at = NOW()
+WHERE id = $3
+RETURNING id, name, image_url, is_published, is_banned, banned_reason, price, profile_id, created_at, updated_at, deleted_at, moderation_status, moderated_at
+`
+
+type UpdateCreatorImageModerationParams struct {
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	BannedReason     sql.NullString               `json:"banned_reason"`
+	ID               int64                        `json:"id"`
+}
+
+// banned_reason hanya diisi saat status banned
+func (q *Queries) UpdateCreatorImageModeration(ctx context.Context, arg UpdateCreatorImageModerationParams) (CreatorImage, error) {
+	row := q.db.QueryRowContext(ctx, updateCreatorImageModeration, arg.ModerationStatus, arg.BannedReason, arg.ID)
+	var i CreatorImage
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.ImageUrl,
+		&i.IsPublished,
+		&i.IsBanned,
+		&i.BannedReason,
+		&i.Price,
+		&i.ProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ModerationStatus,
+		&i.ModeratedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,434 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: generative_token_balance.sql
+
+package entity
+
+import (
+	"context"
+)
+
+const commitGenerativeTokenBalance = `-- name: CommitGenerativeTokenBalance :one
+UPDATE generative_token_balances
+SET reserved = reserved - $1,
+    total_out = total_out + $1
+WHERE business_root_id = $2
+    AND token_type = $3
+    AND deleted_at IS NULL
+    AND reserved >= $1
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type CommitGenerativeTokenBalanceParams struct {
+	Amount         int64     `json:"amount"`
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+// pindahkan reserved -> total_out
+func (q *Queries) CommitGenerativeTokenBalance(ctx context.Context, arg CommitGenerativeTokenBalanceParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, commitGenerativeTokenBalance, arg.Amount, arg.BusinessRootID, arg.TokenType)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const creditGenerativeTokenBalance = `-- name: CreditGenerativeTokenBalance :one
+INSERT INTO generative_token_balances (business_root_id, token_type, total_in)
+VALUES ($1, $2, $3)
+ON CONFLICT (business_root_id, token_type) DO UPDATE SET
+    total_in = generative_token_balances.total_in + EXCLUDED.total_in
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type CreditGenerativeTokenBalanceParams struct {
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+	Amount         int64     `json:"amount"`
+}
+
+// tambah total_in (buat row snapshot jika belum ada)
+func (q *Queries) CreditGenerativeTokenBalance(ctx context.Context, arg CreditGenerativeTokenBalanceParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, creditGenerativeTokenBalance, arg.BusinessRootID, arg.TokenType, arg.Amount)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const debitGenerativeTokenBalance = `-- name: DebitGenerativeTokenBalance :one
+UPDATE generative_token_balances
+SET total_out = total_out + $1
+WHERE business_root_id = $2
+    AND token_type = $3
+    AND deleted_at IS NULL
+    AND total_in - total_out - reserved >= $1
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type DebitGenerativeTokenBalanceParams struct {
+	Amount         int64     `json:"amount"`
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+// debit langsung tanpa reservation (no rows = saldo tidak cukup)
+func (q *Queries) DebitGenerativeTokenBalance(ctx context.Context, arg DebitGenerativeTokenBalanceParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, debitGenerativeTokenBalance, arg.Amount, arg.BusinessRootID, arg.TokenType)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const getGenerativeTokenBalanceByBusinessRootId = `-- name: GetGenerativeTokenBalanceByBusinessRootId :one
+SELECT id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type FROM generative_token_balances
+WHERE business_root_id = $1
+    AND token_type = $2
+    AND deleted_at IS NULL
+`
+
+type GetGenerativeTokenBalanceByBusinessRootIdParams struct {
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+func (q *Queries) GetGenerativeTokenBalanceByBusinessRootId(ctx context.Context, arg GetGenerativeTokenBalanceByBusinessRootIdParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, getGenerativeTokenBalanceByBusinessRootId, arg.BusinessRootID, arg.TokenType)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const getGenerativeTokenBalanceDrifts = `-- name: GetGenerativeTokenBalanceDrifts :many
+WITH trx AS (
+    SELECT
+        business_root_id,
+        token_type,
+        COALESCE(SUM(amount) FILTER (WHERE type = 'in'), 0)::bigint  AS total_in,
+        COALESCE(SUM(amount) FILTER (WHERE type = 'out'), 0)::bigint AS total_out
+    FROM generative_token_transactions
+    WHERE deleted_at IS NULL
+    GROUP BY business_root_id, token_type
+),
+res AS (
+    SELECT business_root_id, token_type, COALESCE(SUM(amount), 0)::bigint AS reserved
+    FROM generative_token_reservations
+    WHERE status = 'reserved' AND deleted_at IS NULL
+    GROUP BY business_root_id, token_type
+),
+expected AS (
+    SELECT
+        COALESCE(trx.business_root_id, res.business_root_id) AS business_root_id,
+        COALESCE(trx.token_type, res.token_type) AS token_type,
+        COALESCE(trx.total_in, 0)::bigint  AS total_in,
+        COALESCE(trx.total_out, 0)::bigint AS total_out,
+        COALESCE(res.reserved, 0)::bigint  AS reserved
+    FROM trx
+    FULL OUTER JOIN res
+        ON res.business_root_id = trx.business_root_id AND res.token_type = trx.token_type
+)
+SELECT
+    COALESCE(e.business_root_id, b.business_root_id)::bigint AS business_root_id,
+    COALESCE(e.token_type, b.token_type)::token_type AS token_type,
+    COALESCE(e.total_in, 0)::bigint  AS expected_total_in,
+    COALESCE(e.total_out, 0)::bigint AS expected_total_out,
+    COALESCE(e.reserved, 0)::bigint  AS expected_reserved,
+    COALESCE(b.total_in, 0)::bigint  AS actual_total_in,
+    COALESCE(b.total_out, 0)::bigint AS actual_total_out,
+    COALESCE(b.reserved, 0)::bigint  AS actual_reserved
+FROM expected e
+FULL OUTER JOIN generative_token_balances b
+    ON b.business_root_id = e.business_root_id
+    AND b.token_type = e.token_type
+    AND b.deleted_at IS NULL
+WHERE
+    COALESCE(e.total_in, 0)  <> COALESCE(b.total_in, 0)
+    OR COALESCE(e.total_out, 0) <> COALESCE(b.total_out, 0)
+    OR COALESCE(e.reserved, 0)  <> COALESCE(b.reserved, 0)
+ORDER BY 1, 2
+`
+
+type GetGenerativeTokenBalanceDriftsRow struct {
+	BusinessRootID   int64     `json:"business_root_id"`
+	TokenType        TokenType `json:"token_type"`
+	ExpectedTotalIn  int64     `json:"expected_total_in"`
+	ExpectedTotalOut int64     `json:"expected_total_out"`
+	ExpectedReserved int64     `json:"expected_reserved"`
+	ActualTotalIn    int64     `json:"actual_total_in"`
+	ActualTotalOut   int64     `json:"actual_total_out"`
+	ActualReserved   int64     `json:"actual_reserved"`
+}
+
+// hitung ulang saldo dari transaksi & reservation aktif lalu bandingkan dengan snapshot (per business & token_type)
+func (q *Queries) GetGenerativeTokenBalanceDrifts(ctx context.Context) ([]GetGenerativeTokenBalanceDriftsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGenerativeTokenBalanceDrifts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGenerativeTokenBalanceDriftsRow
+	for rows.Next() {
+		var i GetGenerativeTokenBalanceDriftsRow
+		if err := rows.Scan(
+			&i.BusinessRootID,
+			&i.TokenType,
+			&i.ExpectedTotalIn,
+			&i.ExpectedTotalOut,
+			&i.ExpectedReserved,
+			&i.ActualTotalIn,
+			&i.ActualTotalOut,
+			&i.ActualReserved,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGenerativeTokenExpectedBalanceByBusinessRootId = `-- name: GetGenerativeTokenExpectedBalanceByBusinessRootId :one
+WITH trx AS (
+    SELECT
+        COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'in'), 0)::bigint  AS total_in,
+        COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'out'), 0)::bigint AS total_out
+    FROM generative_token_transactions t
+    WHERE t.business_root_id = $1
+        AND t.token_type = $2
+        AND t.deleted_at IS NULL
+),
+res AS (
+    SELECT COALESCE(SUM(r.amount), 0)::bigint AS reserved
+    FROM generative_token_reservations r
+    WHERE r.business_root_id = $1
+        AND r.token_type = $2
+        AND r.status = 'reserved'
+        AND r.deleted_at IS NULL
+)
+SELECT trx.total_in, trx.total_out, res.reserved
+FROM trx CROSS JOIN res
+`
+
+type GetGenerativeTokenExpectedBalanceByBusinessRootIdParams struct {
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+type GetGenerativeTokenExpectedBalanceByBusinessRootIdRow struct {
+	TotalIn  int64 `json:"total_in"`
+	TotalOut int64 `json:"total_out"`
+	Reserved int64 `json:"reserved"`
+}
+
+// hitung ulang saldo satu business & token_type dari transaksi & reservation aktif
+func (q *Queries) GetGenerativeTokenExpectedBalanceByBusinessRootId(ctx context.Context, arg GetGenerativeTokenExpectedBalanceByBusinessRootIdParams) (GetGenerativeTokenExpectedBalanceByBusinessRootIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getGenerativeTokenExpectedBalanceByBusinessRootId, arg.BusinessRootID, arg.TokenType)
+	var i GetGenerativeTokenExpectedBalanceByBusinessRootIdRow
+	err := row.Scan(&i.TotalIn, &i.TotalOut, &i.Reserved)
+	return i, err
+}
+
+const lockGenerativeTokenBalanceByBusinessRootId = `-- name: LockGenerativeTokenBalanceByBusinessRootId :exec
+SELECT id FROM generative_token_balances
+WHERE business_root_id = $1
+    AND token_type = $2
+FOR UPDATE
+`
+
+type LockGenerativeTokenBalanceByBusinessRootIdParams struct {
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+func (q *Queries) LockGenerativeTokenBalanceByBusinessRootId(ctx context.Context, arg LockGenerativeTokenBalanceByBusinessRootIdParams) error {
+	_, err := q.db.ExecContext(ctx, lockGenerativeTokenBalanceByBusinessRootId, arg.BusinessRootID, arg.TokenType)
+	return err
+}
+
+const releaseGenerativeTokenBalance = `-- name: ReleaseGenerativeTokenBalance :one
+UPDATE generative_token_balances
+SET reserved = reserved - $1
+WHERE business_root_id = $2
+    AND token_type = $3
+    AND deleted_at IS NULL
+    AND reserved >= $1
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type ReleaseGenerativeTokenBalanceParams struct {
+	Amount         int64     `json:"amount"`
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+func (q *Queries) ReleaseGenerativeTokenBalance(ctx context.Context, arg ReleaseGenerativeTokenBalanceParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, releaseGenerativeTokenBalance, arg.Amount, arg.BusinessRootID, arg.TokenType)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const reserveGenerativeTokenBalance = `-- name: ReserveGenerativeTokenBalance :one
+UPDATE generative_token_balances
+SET reserved = reserved + $1
+WHERE business_root_id = $2
+    AND token_type = $3
+    AND deleted_at IS NULL
+    AND total_in - total_out - reserved >= $1
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type ReserveGenerativeTokenBalanceParams struct {
+	Amount         int64     `json:"amount"`
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+// atomic: hanya berhasil jika available cukup (no rows = saldo tidak cukup)
+func (q *Queries) ReserveGenerativeTokenBalance(ctx context.Context, arg ReserveGenerativeTokenBalanceParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, reserveGenerativeTokenBalance, arg.Amount, arg.BusinessRootID, arg.TokenType)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const revertGenerativeTokenBalanceOut = `-- name: RevertGenerativeTokenBalanceOut :one
+UPDATE generative_token_balances
+SET total_out = total_out - $1
+WHERE business_root_id = $2
+    AND token_type = $3
+    AND deleted_at IS NULL
+    AND total_out >= $1
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type RevertGenerativeTokenBalanceOutParams struct {
+	Amount         int64     `json:"amount"`
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+}
+
+// kembalikan token 'out' yang di-refund
+func (q *Queries) RevertGenerativeTokenBalanceOut(ctx context.Context, arg RevertGenerativeTokenBalanceOutParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, revertGenerativeTokenBalanceOut, arg.Amount, arg.BusinessRootID, arg.TokenType)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const setGenerativeTokenBalance = `-- name: SetGenerativeTokenBalance :one
+INSERT INTO generative_token_balances (business_root_id, token_type, total_in, total_out, reserved)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (business_root_id, token_type) DO UPDATE SET
+    total_in = EXCLUDED.total_in,
+    total_out = EXCLUDED.total_out,
+    reserved = EXCLUDED.reserved
+RETURNING id, business_root_id, total_in, total_out, reserved, created_at, updated_at, deleted_at, token_type
+`
+
+type SetGenerativeTokenBalanceParams struct {
+	BusinessRootID int64     `json:"business_root_id"`
+	TokenType      TokenType `json:"token_type"`
+	TotalIn        int64     `json:"total_in"`
+	TotalOut       int64     `json:"total_out"`
+	Reserved       int64     `json:"reserved"`
+}
+
+// dipakai reconcile untuk menimpa snapshot dengan hasil hitung ulang
+func (q *Queries) SetGenerativeTokenBalance(ctx context.Context, arg SetGenerativeTokenBalanceParams) (GenerativeTokenBalance, error) {
+	row := q.db.QueryRowContext(ctx, setGenerativeTokenBalance,
+		arg.BusinessRootID,
+		arg.TokenType,
+		arg.TotalIn,
+		arg.TotalOut,
+		arg.Reserved,
+	)
+	var i GenerativeTokenBalance
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessRootID,
+		&i.TotalIn,
+		&i.TotalOut,
+		&i.Reserved,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
@@ -0,0 +1,159 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: generative_token_reservation.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createGenerativeTokenReservation = `-- name: CreateGenerativeTokenReservation :one
+INSERT INTO generative_token_reservations (
+    token_type,
+    amount,
+    profile_id,
+    business_root_id,
+    generative_image_model_id,
+    expires_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6
+) RETURNING id, status, amount, profile_id, business_root_id, generative_image_model_id, generative_token_transaction_id, expires_at, created_at, updated_at, deleted_at, token_type
+`
+
+type CreateGenerativeTokenReservationParams struct {
+	TokenType              TokenType     `json:"token_type"`
+	Amount                 int64         `json:"amount"`
+	ProfileID              uuid.UUID     `json:"profile_id"`
+	BusinessRootID         int64         `json:"business_root_id"`
+	GenerativeImageModelID sql.NullInt64 `json:"generative_image_model_id"`
+	ExpiresAt              time.Time     `json:"expires_at"`
+}
+
+func (q *Queries) CreateGenerativeTokenReservation(ctx context.Context, arg CreateGenerativeTokenReservationParams) (GenerativeTokenReservation, error) {
+	row := q.db.QueryRowContext(ctx, createGenerativeTokenReservation,
+		arg.TokenType,
+		arg.Amount,
+		arg.ProfileID,
+		arg.BusinessRootID,
+		arg.GenerativeImageModelID,
+		arg.ExpiresAt,
+	)
+	var i GenerativeTokenReservation
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Amount,
+		&i.ProfileID,
+		&i.BusinessRootID,
+		&i.GenerativeImageModelID,
+		&i.GenerativeTokenTransactionID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const getExpiredGenerativeTokenReservationIds = `-- name: GetExpiredGenerativeTokenReservationIds :many
+SELECT id FROM generative_token_reservations
+WHERE status = 'reserved'
+    AND expires_at < NOW()
+    AND deleted_at IS NULL
+ORDER BY id
+`
+
+func (q *Queries) GetExpiredGenerativeTokenReservationIds(ctx context.Context) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, getExpiredGenerativeTokenReservationIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGenerativeTokenReservationByIdForUpdate = `-- name: GetGenerativeTokenReservationByIdForUpdate :one
+SELECT id, status, amount, profile_id, business_root_id, generative_image_model_id, generative_token_transaction_id, expires_at, created_at, updated_at, deleted_at, token_type FROM generative_token_reservations
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetGenerativeTokenReservationByIdForUpdate(ctx context.Context, id int64) (GenerativeTokenReservation, error) {
+	row := q.db.QueryRowContext(ctx, getGenerativeTokenReservationByIdForUpdate, id)
+	var i GenerativeTokenReservation
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Amount,
+		&i.ProfileID,
+		&i.BusinessRootID,
+		&i.GenerativeImageModelID,
+		&i.GenerativeTokenTransactionID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
+
+const updateGenerativeTokenReservationStatus = `-- name: UpdateGenerativeTokenReservationStatus :one
+UPDATE generative_token_reservations
+SET status = $1,
+    generative_token_transaction_id = $2
+WHERE id = $3 AND status = 'reserved'
+RETURNING id, status, amount, profile_id, business_root_id, generative_image_model_id, generative_token_transaction_id, expires_at, created_at, updated_at, deleted_at, token_type
+`
+
+type UpdateGenerativeTokenReservationStatusParams struct {
+	Status                       TokenReservationStatus `json:"status"`
+	GenerativeTokenTransactionID sql.NullInt64          `json:"generative_token_transaction_id"`
+	ID                           int64                  `json:"id"`
+}
+
+func (q *Queries) UpdateGenerativeTokenReservationStatus(ctx context.Context, arg UpdateGenerativeTokenReservationStatusParams) (GenerativeTokenReservation, error) {
+	row := q.db.QueryRowContext(ctx, updateGenerativeTokenReservationStatus, arg.Status, arg.GenerativeTokenTransactionID, arg.ID)
+	var i GenerativeTokenReservation
+	err := row.Scan(
+		&i.ID,
+		&i.Status,
+		&i.Amount,
+		&i.ProfileID,
+		&i.BusinessRootID,
+		&i.GenerativeImageModelID,
+		&i.GenerativeTokenTransactionID,
+		&i.ExpiresAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.TokenType,
+	)
+	return i, err
+}
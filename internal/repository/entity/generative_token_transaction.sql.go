@@ -1,7 +1,7 @@
 // Code generated by sqlc. DO NOT EDIT.
 // versions:
 //   sqlc v1.30.0
-// source: generative_token_image_transaction.sql
+// source: generative_token_transaction.sql
 
 package entity
 
@@ -15,26 +15,28 @@ import (
 
 const countAllTokenTransactionsByBusiness = `-- name: CountAllTokenTransactionsByBusiness :one
 SELECT COUNT(*)::bigint AS total
-FROM generative_token_image_transactions t
+FROM generative_token_transactions t
 WHERE
     t.deleted_at IS NULL
     AND t.business_root_id = $1
+    AND t.token_type = $2
     AND (
-        $2::token_transaction_type IS NULL
-        OR t.type = $2::token_transaction_type
+        $3::token_transaction_type IS NULL
+        OR t.type = $3::token_transaction_type
     )
     AND (
-        $3::date IS NULL
-        OR t.created_at::date >= $3::date
+        $4::date IS NULL
+        OR t.created_at::date >= $4::date
     )
     AND (
-        $4::date IS NULL
-        OR t.created_at::date <= $4::date
+        $5::date IS NULL
+        OR t.created_at::date <= $5::date
     )
 `
 
 type CountAllTokenTransactionsByBusinessParams struct {
 	BusinessRootID int64                    `json:"business_root_id"`
+	TokenType      TokenType                `json:"token_type"`
 	Type           NullTokenTransactionType `json:"type"`
 	DateStart      sql.NullTime             `json:"date_start"`
 	DateEnd        sql.NullTime             `json:"date_end"`
@@ -43,6 +45,7 @@ type CountAllTokenTransactionsByBusinessParams struct {
 func (q *Queries) CountAllTokenTransactionsByBusiness(ctx context.Context, arg CountAllTokenTransactionsByBusinessParams) (int64, error) {
 	row := q.db.QueryRowContext(ctx, countAllTokenTransactionsByBusiness,
 		arg.BusinessRootID,
+		arg.TokenType,
 		arg.Type,
 		arg.DateStart,
 		arg.DateEnd,
@@ -52,35 +55,41 @@ func (q *Queries) CountAllTokenTransactionsByBusiness(ctx context.Context, arg C
 	return total, err
 }
 
-const createGenerativeTokenImageTransaction = `-- name: CreateGenerativeTokenImageTransaction :one
-INSERT INTO generative_token_image_transactions (
+const createGenerativeTokenTransaction = `-- name: CreateGenerativeTokenTransaction :one
+INSERT INTO generative_token_transactions (
+    token_type,
     type,
     amount,
     profile_id,
     business_root_id,
-    payment_history_id
+    payment_history_id,
+    generative_image_model_id
 ) VALUES (
-    $1, $2, $3, $4, $5
-) RETURNING id, type, amount, profile_id, business_root_id, payment_history_id, created_at, updated_at, deleted_at
+    $1, $2, $3, $4, $5, $6, $7
+) RETURNING id, type, amount, profile_id, business_root_id, payment_history_id, created_at, updated_at, deleted_at, generative_image_model_id, token_type
 `
 
-type CreateGenerativeTokenImageTransactionParams struct {
-	Type             TokenTransactionType `json:"type"`
-	Amount           int64                `json:"amount"`
-	ProfileID        uuid.UUID            `json:"profile_id"`
-	BusinessRootID   int64                `json:"business_root_id"`
-	PaymentHistoryID uuid.NullUUID        `json:"payment_history_id"`
+type CreateGenerativeTokenTransactionParams struct {
+	TokenType              TokenType            `json:"token_type"`
+	Type                   TokenTransactionType `json:"type"`
+	Amount                 int64                `json:"amount"`
+	ProfileID              uuid.UUID            `json:"profile_id"`
+	BusinessRootID         int64                `json:"business_root_id"`
+	PaymentHistoryID       uuid.NullUUID        `json:"payment_history_id"`
+	GenerativeImageModelID sql.NullInt64        `json:"generative_image_model_id"`
 }
 
-func (q *Queries) CreateGenerativeTokenImageTransaction(ctx context.Context, arg CreateGenerativeTokenImageTransactionParams) (GenerativeTokenImageTransaction, error) {
-	row := q.db.QueryRowContext(ctx, createGenerativeTokenImageTransaction,
+func (q *Queries) CreateGenerativeTokenTransaction(ctx context.Context, arg CreateGenerativeTokenTransactionParams) (GenerativeTokenTransaction, error) {
+	row := q.db.QueryRowContext(ctx, createGenerativeTokenTransaction,
+		arg.TokenType,
 		arg.Type,
 		arg.Amount,
 		arg.ProfileID,
 		arg.BusinessRootID,
 		arg.PaymentHistoryID,
+		arg.GenerativeImageModelID,
 	)
-	var i GenerativeTokenImageTransaction
+	var i GenerativeTokenTransaction
 	err := row.Scan(
 		&i.ID,
 		&i.Type,
@@ -91,42 +100,46 @@ func (q *Queries) CreateGenerativeTokenImageTransaction(ctx context.Context, arg
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.GenerativeImageModelID,
+		&i.TokenType,
 	)
 	return i, err
 }
 
 const getAllTokenTransactionsByBusiness = `-- name: GetAllTokenTransactionsByBusiness :many
-SELECT t.id, t.type, t.amount, t.profile_id, t.business_root_id, t.payment_history_id, t.created_at, t.updated_at, t.deleted_at
-FROM generative_token_image_transactions t
+SELECT t.id, t.type, t.amount, t.profile_id, t.business_root_id, t.payment_history_id, t.created_at, t.updated_at, t.deleted_at, t.generative_image_model_id, t.token_type
+FROM generative_token_transactions t
 WHERE
     t.deleted_at IS NULL
     AND t.business_root_id = $1
+    AND t.token_type = $2
     AND (
-        $2::token_transaction_type IS NULL
-        OR t.type = $2::token_transaction_type
+        $3::token_transaction_type IS NULL
+        OR t.type = $3::token_transaction_type
     )
     AND (
-        $3::date IS NULL
-        OR t.created_at::date >= $3::date
+        $4::date IS NULL
+        OR t.created_at::date >= $4::date
     )
     AND (
-        $4::date IS NULL
-        OR t.created_at::date <= $4::date
+        $5::date IS NULL
+        OR t.created_at::date <= $5::date
     )
 ORDER BY
-    CASE WHEN $5 = 'id' AND $6 = 'asc' THEN t.id END ASC,
-    CASE WHEN $5 = 'id' AND $6 = 'desc' THEN t.id END DESC,
-    CASE WHEN $5 = 'created_at' AND $6 = 'asc' THEN t.created_at END ASC,
-    CASE WHEN $5 = 'created_at' AND $6 = 'desc' THEN t.created_at END DESC,
-    CASE WHEN $5 = 'amount' AND $6 = 'asc' THEN t.amount END ASC,
-    CASE WHEN $5 = 'amount' AND $6 = 'desc' THEN t.amount END DESC,
+    CASE WHEN $6 = 'id' AND $7 = 'asc' THEN t.id END ASC,
+    CASE WHEN $6 = 'id' AND $7 = 'desc' THEN t.id END DESC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'asc' THEN t.created_at END ASC,
+    CASE WHEN $6 = 'created_at' AND $7 = 'desc' THEN t.created_at END DESC,
+    CASE WHEN $6 = 'amount' AND $7 = 'asc' THEN t.amount END ASC,
+    CASE WHEN $6 = 'amount' AND $7 = 'desc' THEN t.amount END DESC,
     t.id DESC
-LIMIT $8
-OFFSET $7
+LIMIT $9
+OFFSET $8
 `
 
 type GetAllTokenTransactionsByBusinessParams struct {
 	BusinessRootID int64                    `json:"business_root_id"`
+	TokenType      TokenType                `json:"token_type"`
 	Type           NullTokenTransactionType `json:"type"`
 	DateStart      sql.NullTime             `json:"date_start"`
 	DateEnd        sql.NullTime             `json:"date_end"`
@@ -136,9 +149,10 @@ type GetAllTokenTransactionsByBusinessParams struct {
 	PageLimit      int32                    `json:"page_limit"`
 }
 
-func (q *Queries) GetAllTokenTransactionsByBusiness(ctx context.Context, arg GetAllTokenTransactionsByBusinessParams) ([]GenerativeTokenImageTransaction, error) {
+func (q *Queries) GetAllTokenTransactionsByBusiness(ctx context.Context, arg GetAllTokenTransactionsByBusinessParams) ([]GenerativeTokenTransaction, error) {
 	rows, err := q.db.QueryContext(ctx, getAllTokenTransactionsByBusiness,
 		arg.BusinessRootID,
+		arg.TokenType,
 		arg.Type,
 		arg.DateStart,
 		arg.DateEnd,
@@ -151,9 +165,9 @@ func (q *Queries) GetAllTokenTransactionsByBusiness(ctx context.Context, arg Get
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GenerativeTokenImageTransaction
+	var items []GenerativeTokenTransaction
 	for rows.Next() {
-		var i GenerativeTokenImageTransaction
+		var i GenerativeTokenTransaction
 		if err := rows.Scan(
 			&i.ID,
 			&i.Type,
@@ -164,6 +178,8 @@ func (q *Queries) GetAllTokenTransactionsByBusiness(ctx context.Context, arg Get
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.GenerativeImageModelID,
+			&i.TokenType,
 		); err != nil {
 			return nil, err
 		}
@@ -178,14 +194,15 @@ func (q *Queries) GetAllTokenTransactionsByBusiness(ctx context.Context, arg Get
 	return items, nil
 }
 
-const getGenerativeTokenImageTransactionByPaymentHistoryId = `-- name: GetGenerativeTokenImageTransactionByPaymentHistoryId :one
-SELECT id, type, amount, profile_id, business_root_id, payment_history_id, created_at, updated_at, deleted_at FROM generative_token_image_transactions
-WHERE payment_history_id = $1 AND deleted_at IS NULL
+const getGenerativeTokenTransactionByPaymentHistoryId = `-- name: GetGenerativeTokenTransactionByPaymentHistoryId :one
+SELECT id, type, amount, profile_id, business_root_id, payment_history_id, created_at, updated_at, deleted_at, generative_image_model_id, token_type FROM generative_token_transactions
+WHERE payment_history_id = $1 AND type = 'in' AND deleted_at IS NULL
 `
 
-func (q *Queries) GetGenerativeTokenImageTransactionByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.NullUUID) (GenerativeTokenImageTransaction, error) {
-	row := q.db.QueryRowContext(ctx, getGenerativeTokenImageTransactionByPaymentHistoryId, paymentHistoryID)
-	var i GenerativeTokenImageTransaction
+// token 'in' hasil payment (payment yang di-refund juga punya token 'out')
+func (q *Queries) GetGenerativeTokenTransactionByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.NullUUID) (GenerativeTokenTransaction, error) {
+	row := q.db.QueryRowContext(ctx, getGenerativeTokenTransactionByPaymentHistoryId, paymentHistoryID)
+	var i GenerativeTokenTransaction
 	err := row.Scan(
 		&i.ID,
 		&i.Type,
@@ -196,29 +213,34 @@ func (q *Queries) GetGenerativeTokenImageTransactionByPaymentHistoryId(ctx conte
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.GenerativeImageModelID,
+		&i.TokenType,
 	)
 	return i, err
 }
 
 const getSuccessPaymentIdsWithoutTokenTransaction = `-- name: GetSuccessPaymentIdsWithoutTokenTransaction :many
-SELECT ph.id, ph.profile_id, ph.business_root_id, ph.product_amount
+SELECT ph.id, ph.profile_id, ph.business_root_id, ph.product_amount, ph.record_product_type
 FROM payment_histories ph
-LEFT JOIN generative_token_image_transactions gt 
-    ON gt.payment_history_id = ph.id AND gt.deleted_at IS NULL
-WHERE 
+LEFT JOIN generative_token_transactions gt
+    ON gt.payment_history_id = ph.id AND gt.type = 'in' AND gt.deleted_at IS NULL
+WHERE
     ph.id = ANY($1::uuid[])
     AND ph.status = 'success'
+    AND ph.record_product_type IN ('image_token', 'video_token', 'livestream_token')
     AND ph.deleted_at IS NULL
     AND gt.id IS NULL
 `
 
 type GetSuccessPaymentIdsWithoutTokenTransactionRow struct {
-	ID             uuid.UUID `json:"id"`
-	ProfileID      uuid.UUID `json:"profile_id"`
-	BusinessRootID int64     `json:"business_root_id"`
-	ProductAmount  int64     `json:"product_amount"`
+	ID                uuid.UUID          `json:"id"`
+	ProfileID         uuid.UUID          `json:"profile_id"`
+	BusinessRootID    int64              `json:"business_root_id"`
+	ProductAmount     int64              `json:"product_amount"`
+	RecordProductType PaymentProductType `json:"record_product_type"`
 }
 
+// hanya payment product token (image / video / livestream)
 func (q *Queries) GetSuccessPaymentIdsWithoutTokenTransaction(ctx context.Context, paymentIds []uuid.UUID) ([]GetSuccessPaymentIdsWithoutTokenTransactionRow, error) {
 	rows, err := q.db.QueryContext(ctx, getSuccessPaymentIdsWithoutTokenTransaction, pq.Array(paymentIds))
 	if err != nil {
@@ -233,6 +255,7 @@ func (q *Queries) GetSuccessPaymentIdsWithoutTokenTransaction(ctx context.Contex
 			&i.ProfileID,
 			&i.BusinessRootID,
 			&i.ProductAmount,
+			&i.RecordProductType,
 		); err != nil {
 			return nil, err
 		}
@@ -247,23 +270,52 @@ func (q *Queries) GetSuccessPaymentIdsWithoutTokenTransaction(ctx context.Contex
 	return items, nil
 }
 
+const refundGenerativeTokenTransaction = `-- name: RefundGenerativeTokenTransaction :one
+UPDATE generative_token_transactions
+SET deleted_at = NOW()
+WHERE id = $1 AND type = 'out' AND deleted_at IS NULL
+RETURNING id, type, amount, profile_id, business_root_id, payment_history_id, created_at, updated_at, deleted_at, generative_image_model_id, token_type
+`
+
+// refund token 'out' (ex: provider gagal generate), soft delete agar tidak terhitung di SUM
+func (q *Queries) RefundGenerativeTokenTransaction(ctx context.Context, id int64) (GenerativeTokenTransaction, error) {
+	row := q.db.QueryRowContext(ctx, refundGenerativeTokenTransaction, id)
+	var i GenerativeTokenTransaction
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Amount,
+		&i.ProfileID,
+		&i.BusinessRootID,
+		&i.PaymentHistoryID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.GenerativeImageModelID,
+		&i.TokenType,
+	)
+	return i, err
+}
+
 const sumTokenByBusinessAndType = `-- name: SumTokenByBusinessAndType :one
-SELECT 
+SELECT
     COALESCE(SUM(amount), 0)::bigint AS total
-FROM generative_token_image_transactions
-WHERE 
-    business_root_id = $1 
-    AND type = $2
+FROM generative_token_transactions
+WHERE
+    business_root_id = $1
+    AND token_type = $2
+    AND type = $3
     AND deleted_at IS NULL
 `
 
 type SumTokenByBusinessAndTypeParams struct {
 	BusinessRootID int64                `json:"business_root_id"`
+	TokenType      TokenType            `json:"token_type"`
 	Type           TokenTransactionType `json:"type"`
 }
 
 func (q *Queries) SumTokenByBusinessAndType(ctx context.Context, arg SumTokenByBusinessAndTypeParams) (int64, error) {
-	row := q.db.QueryRowContext(ctx, sumTokenByBusinessAndType, arg.BusinessRootID, arg.Type)
+	row := q.db.QueryRowContext(ctx, sumTokenByBusinessAndType, arg.BusinessRootID, arg.TokenType, arg.Type)
 	var total int64
 	err := row.Scan(&total)
 	return total, err
@@ -56,6 +56,93 @@ func (ns NullActionChangeType) Value() (driver.Value, error) {
 	return string(ns.ActionChangeType), nil
 }
 
+type AffiliatorPayoutStatus string
+
+const (
+	AffiliatorPayoutStatusPending  AffiliatorPayoutStatus = "pending"
+	AffiliatorPayoutStatusApproved AffiliatorPayoutStatus = "approved"
+	AffiliatorPayoutStatusRejected AffiliatorPayoutStatus = "rejected"
+	AffiliatorPayoutStatusCanceled AffiliatorPayoutStatus = "canceled"
+)
+
+func (e *AffiliatorPayoutStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AffiliatorPayoutStatus(s)
+	case string:
+		*e = AffiliatorPayoutStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AffiliatorPayoutStatus: %T", src)
+	}
+	return nil
+}
+
+type NullAffiliatorPayoutStatus struct {
+	AffiliatorPayoutStatus AffiliatorPayoutStatus `json:"affiliator_payout_status"`
+	Valid                  bool                   `json:"valid"` // Valid is true if AffiliatorPayoutStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAffiliatorPayoutStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.AffiliatorPayoutStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AffiliatorPayoutStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAffiliatorPayoutStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AffiliatorPayoutStatus), nil
+}
+
+type AffiliatorWalletTransactionType string
+
+const (
+	AffiliatorWalletTransactionTypeReward   AffiliatorWalletTransactionType = "reward"
+	AffiliatorWalletTransactionTypeClawback AffiliatorWalletTransactionType = "clawback"
+	AffiliatorWalletTransactionTypePayout   AffiliatorWalletTransactionType = "payout"
+)
+
+func (e *AffiliatorWalletTransactionType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = AffiliatorWalletTransactionType(s)
+	case string:
+		*e = AffiliatorWalletTransactionType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for AffiliatorWalletTransactionType: %T", src)
+	}
+	return nil
+}
+
+type NullAffiliatorWalletTransactionType struct {
+	AffiliatorWalletTransactionType AffiliatorWalletTransactionType `json:"affiliator_wallet_transaction_type"`
+	Valid                           bool                            `json:"valid"` // Valid is true if AffiliatorWalletTransactionType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullAffiliatorWalletTransactionType) Scan(value interface{}) error {
+	if value == nil {
+		ns.AffiliatorWalletTransactionType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.AffiliatorWalletTransactionType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullAffiliatorWalletTransactionType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.AffiliatorWalletTransactionType), nil
+}
+
 type AppGenerativeImageModelProviderType string
 
 const (
@@ -439,6 +526,264 @@ func (ns NullBusinessMemberStatus) Value() (driver.Value, error) {
 	return string(ns.BusinessMemberStatus), nil
 }
 
+type BusinessScheduledPostStatus string
+
+const (
+	BusinessScheduledPostStatusScheduled  BusinessScheduledPostStatus = "scheduled"
+	BusinessScheduledPostStatusPublishing BusinessScheduledPostStatus = "publishing"
+	BusinessScheduledPostStatusPublished  BusinessScheduledPostStatus = "published"
+	BusinessScheduledPostStatusFailed     BusinessScheduledPostStatus = "failed"
+	BusinessScheduledPostStatusCanceled   BusinessScheduledPostStatus = "canceled"
+)
+
+func (e *BusinessScheduledPostStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BusinessScheduledPostStatus(s)
+	case string:
+		*e = BusinessScheduledPostStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BusinessScheduledPostStatus: %T", src)
+	}
+	return nil
+}
+
+type NullBusinessScheduledPostStatus struct {
+	BusinessScheduledPostStatus BusinessScheduledPostStatus `json:"business_scheduled_post_status"`
+	Valid                       bool                        `json:"valid"` // Valid is true if BusinessScheduledPostStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBusinessScheduledPostStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.BusinessScheduledPostStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BusinessScheduledPostStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBusinessScheduledPostStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BusinessScheduledPostStatus), nil
+}
+
+type BusinessSocialAccountStatus string
+
+const (
+	BusinessSocialAccountStatusConnected    BusinessSocialAccountStatus = "connected"
+	BusinessSocialAccountStatusExpired      BusinessSocialAccountStatus = "expired"
+	BusinessSocialAccountStatusDisconnected BusinessSocialAccountStatus = "disconnected"
+)
+
+func (e *BusinessSocialAccountStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = BusinessSocialAccountStatus(s)
+	case string:
+		*e = BusinessSocialAccountStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for BusinessSocialAccountStatus: %T", src)
+	}
+	return nil
+}
+
+type NullBusinessSocialAccountStatus struct {
+	BusinessSocialAccountStatus BusinessSocialAccountStatus `json:"business_social_account_status"`
+	Valid                       bool                        `json:"valid"` // Valid is true if BusinessSocialAccountStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullBusinessSocialAccountStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.BusinessSocialAccountStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.BusinessSocialAccountStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullBusinessSocialAccountStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.BusinessSocialAccountStatus), nil
+}
+
+type CreatorEarningTransactionType string
+
+const (
+	CreatorEarningTransactionTypeSale     CreatorEarningTransactionType = "sale"
+	CreatorEarningTransactionTypeClawback CreatorEarningTransactionType = "clawback"
+)
+
+func (e *CreatorEarningTransactionType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CreatorEarningTransactionType(s)
+	case string:
+		*e = CreatorEarningTransactionType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CreatorEarningTransactionType: %T", src)
+	}
+	return nil
+}
+
+type NullCreatorEarningTransactionType struct {
+	CreatorEarningTransactionType CreatorEarningTransactionType `json:"creator_earning_transaction_type"`
+	Valid                         bool                          `json:"valid"` // Valid is true if CreatorEarningTransactionType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreatorEarningTransactionType) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreatorEarningTransactionType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreatorEarningTransactionType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreatorEarningTransactionType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreatorEarningTransactionType), nil
+}
+
+type CreatorImageLicenseStatus string
+
+const (
+	CreatorImageLicenseStatusActive  CreatorImageLicenseStatus = "active"
+	CreatorImageLicenseStatusRevoked CreatorImageLicenseStatus = "revoked"
+)
+
+func (e *CreatorImageLicenseStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CreatorImageLicenseStatus(s)
+	case string:
+		*e = CreatorImageLicenseStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CreatorImageLicenseStatus: %T", src)
+	}
+	return nil
+}
+
+type NullCreatorImageLicenseStatus struct {
+	CreatorImageLicenseStatus CreatorImageLicenseStatus `json:"creator_image_license_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if CreatorImageLicenseStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreatorImageLicenseStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreatorImageLicenseStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreatorImageLicenseStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreatorImageLicenseStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreatorImageLicenseStatus), nil
+}
+
+type CreatorImageModerationAction string
+
+const (
+	CreatorImageModerationActionApprove CreatorImageModerationAction = "approve"
+	CreatorImageModerationActionBan     CreatorImageModerationAction = "ban"
+	CreatorImageModerationActionUnban   CreatorImageModerationAction = "unban"
+)
+
+func (e *CreatorImageModerationAction) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CreatorImageModerationAction(s)
+	case string:
+		*e = CreatorImageModerationAction(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CreatorImageModerationAction: %T", src)
+	}
+	return nil
+}
+
+type NullCreatorImageModerationAction struct {
+	CreatorImageModerationAction CreatorImageModerationAction `json:"creator_image_moderation_action"`
+	Valid                        bool                         `json:"valid"` // Valid is true if CreatorImageModerationAction is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreatorImageModerationAction) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreatorImageModerationAction, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreatorImageModerationAction.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreatorImageModerationAction) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreatorImageModerationAction), nil
+}
+
+type CreatorImageModerationStatus string
+
+const (
+	CreatorImageModerationStatusPending  CreatorImageModerationStatus = "pending"
+	CreatorImageModerationStatusApproved CreatorImageModerationStatus = "approved"
+	CreatorImageModerationStatusBanned   CreatorImageModerationStatus = "banned"
+)
+
+func (e *CreatorImageModerationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = CreatorImageModerationStatus(s)
+	case string:
+		*e = CreatorImageModerationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for CreatorImageModerationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullCreatorImageModerationStatus struct {
+	CreatorImageModerationStatus CreatorImageModerationStatus `json:"creator_image_moderation_status"`
+	Valid                        bool                         `json:"valid"` // Valid is true if CreatorImageModerationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullCreatorImageModerationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.CreatorImageModerationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.CreatorImageModerationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullCreatorImageModerationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.CreatorImageModerationStatus), nil
+}
+
 type DiscountType string
 
 const (
@@ -481,6 +826,49 @@ func (ns NullDiscountType) Value() (driver.Value, error) {
 	return string(ns.DiscountType), nil
 }
 
+type ExchangeRateRoundingMode string
+
+const (
+	ExchangeRateRoundingModeUp      ExchangeRateRoundingMode = "up"
+	ExchangeRateRoundingModeDown    ExchangeRateRoundingMode = "down"
+	ExchangeRateRoundingModeNearest ExchangeRateRoundingMode = "nearest"
+)
+
+func (e *ExchangeRateRoundingMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ExchangeRateRoundingMode(s)
+	case string:
+		*e = ExchangeRateRoundingMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ExchangeRateRoundingMode: %T", src)
+	}
+	return nil
+}
+
+type NullExchangeRateRoundingMode struct {
+	ExchangeRateRoundingMode ExchangeRateRoundingMode `json:"exchange_rate_rounding_mode"`
+	Valid                    bool                     `json:"valid"` // Valid is true if ExchangeRateRoundingMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullExchangeRateRoundingMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.ExchangeRateRoundingMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ExchangeRateRoundingMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullExchangeRateRoundingMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ExchangeRateRoundingMode), nil
+}
+
 type ImageProvider string
 
 const (
@@ -567,12 +955,55 @@ func (ns NullPaymentActionValueType) Value() (driver.Value, error) {
 	return string(ns.PaymentActionValueType), nil
 }
 
+type PaymentGatewayType string
+
+const (
+	PaymentGatewayTypeMidtrans PaymentGatewayType = "midtrans"
+	PaymentGatewayTypeFake     PaymentGatewayType = "fake"
+)
+
+func (e *PaymentGatewayType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentGatewayType(s)
+	case string:
+		*e = PaymentGatewayType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentGatewayType: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentGatewayType struct {
+	PaymentGatewayType PaymentGatewayType `json:"payment_gateway_type"`
+	Valid              bool               `json:"valid"` // Valid is true if PaymentGatewayType is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentGatewayType) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentGatewayType, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentGatewayType.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentGatewayType) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentGatewayType), nil
+}
+
 type PaymentProductType string
 
 const (
 	PaymentProductTypeImageToken      PaymentProductType = "image_token"
 	PaymentProductTypeVideoToken      PaymentProductType = "video_token"
 	PaymentProductTypeLivestreamToken PaymentProductType = "livestream_token"
+	PaymentProductTypeCreatorImage    PaymentProductType = "creator_image"
 )
 
 func (e *PaymentProductType) Scan(src interface{}) error {
@@ -610,6 +1041,91 @@ func (ns NullPaymentProductType) Value() (driver.Value, error) {
 	return string(ns.PaymentProductType), nil
 }
 
+type PaymentRefundSource string
+
+const (
+	PaymentRefundSourceAdmin   PaymentRefundSource = "admin"
+	PaymentRefundSourceWebhook PaymentRefundSource = "webhook"
+)
+
+func (e *PaymentRefundSource) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentRefundSource(s)
+	case string:
+		*e = PaymentRefundSource(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentRefundSource: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentRefundSource struct {
+	PaymentRefundSource PaymentRefundSource `json:"payment_refund_source"`
+	Valid               bool                `json:"valid"` // Valid is true if PaymentRefundSource is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentRefundSource) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentRefundSource, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentRefundSource.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentRefundSource) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentRefundSource), nil
+}
+
+type PaymentRefundStatus string
+
+const (
+	PaymentRefundStatusPending   PaymentRefundStatus = "pending"
+	PaymentRefundStatusSucceeded PaymentRefundStatus = "succeeded"
+	PaymentRefundStatusFailed    PaymentRefundStatus = "failed"
+)
+
+func (e *PaymentRefundStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentRefundStatus(s)
+	case string:
+		*e = PaymentRefundStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentRefundStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentRefundStatus struct {
+	PaymentRefundStatus PaymentRefundStatus `json:"payment_refund_status"`
+	Valid               bool                `json:"valid"` // Valid is true if PaymentRefundStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentRefundStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentRefundStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentRefundStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentRefundStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentRefundStatus), nil
+}
+
 type PaymentStatus string
 
 const (
@@ -657,6 +1173,48 @@ func (ns NullPaymentStatus) Value() (driver.Value, error) {
 	return string(ns.PaymentStatus), nil
 }
 
+type PostDeliveryAttemptStatus string
+
+const (
+	PostDeliveryAttemptStatusSucceeded PostDeliveryAttemptStatus = "succeeded"
+	PostDeliveryAttemptStatusFailed    PostDeliveryAttemptStatus = "failed"
+)
+
+func (e *PostDeliveryAttemptStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PostDeliveryAttemptStatus(s)
+	case string:
+		*e = PostDeliveryAttemptStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PostDeliveryAttemptStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPostDeliveryAttemptStatus struct {
+	PostDeliveryAttemptStatus PostDeliveryAttemptStatus `json:"post_delivery_attempt_status"`
+	Valid                     bool                      `json:"valid"` // Valid is true if PostDeliveryAttemptStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPostDeliveryAttemptStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PostDeliveryAttemptStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PostDeliveryAttemptStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPostDeliveryAttemptStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PostDeliveryAttemptStatus), nil
+}
+
 type ReferralRecordStatus string
 
 const (
@@ -664,6 +1222,7 @@ const (
 	ReferralRecordStatusSuccess  ReferralRecordStatus = "success"
 	ReferralRecordStatusFailed   ReferralRecordStatus = "failed"
 	ReferralRecordStatusCanceled ReferralRecordStatus = "canceled"
+	ReferralRecordStatusRefunded ReferralRecordStatus = "refunded"
 )
 
 func (e *ReferralRecordStatus) Scan(src interface{}) error {
@@ -791,6 +1350,49 @@ func (ns NullSocialPlatformType) Value() (driver.Value, error) {
 	return string(ns.SocialPlatformType), nil
 }
 
+type TokenReservationStatus string
+
+const (
+	TokenReservationStatusReserved  TokenReservationStatus = "reserved"
+	TokenReservationStatusCommitted TokenReservationStatus = "committed"
+	TokenReservationStatusReleased  TokenReservationStatus = "released"
+)
+
+func (e *TokenReservationStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = TokenReservationStatus(s)
+	case string:
+		*e = TokenReservationStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for TokenReservationStatus: %T", src)
+	}
+	return nil
+}
+
+type NullTokenReservationStatus struct {
+	TokenReservationStatus TokenReservationStatus `json:"token_reservation_status"`
+	Valid                  bool                   `json:"valid"` // Valid is true if TokenReservationStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullTokenReservationStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.TokenReservationStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.TokenReservationStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullTokenReservationStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.TokenReservationStatus), nil
+}
+
 type TokenTransactionType string
 
 const (
@@ -876,6 +1478,60 @@ func (ns NullTokenType) Value() (driver.Value, error) {
 	return string(ns.TokenType), nil
 }
 
+type AffiliatorPayoutRequest struct {
+	ID                  int64                  `json:"id"`
+	ProfileID           uuid.UUID              `json:"profile_id"`
+	Amount              int64                  `json:"amount"`
+	Currency            string                 `json:"currency"`
+	BankName            string                 `json:"bank_name"`
+	BankAccountNumber   string                 `json:"bank_account_number"`
+	BankAccountName     string                 `json:"bank_account_name"`
+	Status              AffiliatorPayoutStatus `json:"status"`
+	AdminNote           sql.NullString         `json:"admin_note"`
+	ReviewedByProfileID uuid.NullUUID          `json:"reviewed_by_profile_id"`
+	ReviewedAt          sql.NullTime           `json:"reviewed_at"`
+	CreatedAt           time.Time              `json:"created_at"`
+	UpdatedAt           time.Time              `json:"updated_at"`
+	DeletedAt           sql.NullTime           `json:"deleted_at"`
+}
+
+type AffiliatorPayoutRequestHistory struct {
+	ID                        int64                  `json:"id"`
+	AffiliatorPayoutRequestID int64                  `json:"affiliator_payout_request_id"`
+	Status                    AffiliatorPayoutStatus `json:"status"`
+	Note                      sql.NullString         `json:"note"`
+	ActorProfileID            uuid.UUID              `json:"actor_profile_id"`
+	CreatedAt                 time.Time              `json:"created_at"`
+	UpdatedAt                 time.Time              `json:"updated_at"`
+	DeletedAt                 sql.NullTime           `json:"deleted_at"`
+}
+
+type AffiliatorWallet struct {
+	ID              int64        `json:"id"`
+	ProfileID       uuid.UUID    `json:"profile_id"`
+	Currency        string       `json:"currency"`
+	TotalEarned     int64        `json:"total_earned"`
+	TotalClawedBack int64        `json:"total_clawed_back"`
+	TotalWithdrawn  int64        `json:"total_withdrawn"`
+	OnHold          int64        `json:"on_hold"`
+	CreatedAt       time.Time    `json:"created_at"`
+	UpdatedAt       time.Time    `json:"updated_at"`
+	DeletedAt       sql.NullTime `json:"deleted_at"`
+}
+
+type AffiliatorWalletTransaction struct {
+	ID                        int64                           `json:"id"`
+	AffiliatorWalletID        int64                           `json:"affiliator_wallet_id"`
+	Type                      AffiliatorWalletTransactionType `json:"type"`
+	Amount                    int64                           `json:"amount"`
+	Currency                  string                          `json:"currency"`
+	ReferralRecordID          sql.NullInt64                   `json:"referral_record_id"`
+	AffiliatorPayoutRequestID sql.NullInt64                   `json:"affiliator_payout_request_id"`
+	CreatedAt                 time.Time                       `json:"created_at"`
+	UpdatedAt                 time.Time                       `json:"updated_at"`
+	DeletedAt                 sql.NullTime                    `json:"deleted_at"`
+}
+
 type AppCreatorImageProductCategory struct {
 	ID             int64        `json:"id"`
 	IndonesianName string       `json:"indonesian_name"`
@@ -891,6 +1547,21 @@ type AppCreatorImageTypeCategory struct {
 	UpdatedAt sql.NullTime `json:"updated_at"`
 }
 
+type AppExchangeRate struct {
+	ID                 int64                    `json:"id"`
+	BaseCurrency       string                   `json:"base_currency"`
+	QuoteCurrency      string                   `json:"quote_currency"`
+	RateMicros         int64                    `json:"rate_micros"`
+	RoundingMode       ExchangeRateRoundingMode `json:"rounding_mode"`
+	RoundingIncrement  int64                    `json:"rounding_increment"`
+	EffectiveAt        time.Time                `json:"effective_at"`
+	CreatedByProfileID uuid.UUID                `json:"created_by_profile_id"`
+	UpdatedByProfileID uuid.UUID                `json:"updated_by_profile_id"`
+	CreatedAt          time.Time                `json:"created_at"`
+	UpdatedAt          time.Time                `json:"updated_at"`
+	DeletedAt          sql.NullTime             `json:"deleted_at"`
+}
+
 type AppGenerativeImageModel struct {
 	ID          int64                               `json:"id"`
 	Model       string                              `json:"model"`
@@ -974,6 +1645,7 @@ type AppPaymentMethod struct {
 	CreatedAt time.Time            `json:"created_at"`
 	UpdatedAt time.Time            `json:"updated_at"`
 	DeletedAt sql.NullTime         `json:"deleted_at"`
+	Gateway   PaymentGatewayType   `json:"gateway"`
 }
 
 type AppPaymentMethodChange struct {
@@ -1000,6 +1672,8 @@ type AppPaymentMethodChange struct {
 	CreatedAt       time.Time            `json:"created_at"`
 	UpdatedAt       time.Time            `json:"updated_at"`
 	DeletedAt       sql.NullTime         `json:"deleted_at"`
+	BeforeGateway   PaymentGatewayType   `json:"before_gateway"`
+	AfterGateway    PaymentGatewayType   `json:"after_gateway"`
 }
 
 type AppProfileReferralChange struct {
@@ -1104,6 +1778,33 @@ type AppTokenProductChange struct {
 	UpdatedAt    time.Time `json:"updated_at"`
 }
 
+type BusinessAuditEvent struct {
+	ID             int64            `json:"id"`
+	BusinessRootID int64            `json:"business_root_id"`
+	ProfileID      uuid.UUID        `json:"profile_id"`
+	EntityType     string           `json:"entity_type"`
+	EntityID       string           `json:"entity_id"`
+	Action         ActionChangeType `json:"action"`
+	Before         sql.NullString   `json:"before"`
+	After          sql.NullString   `json:"after"`
+	CreatedAt      time.Time        `json:"created_at"`
+	UpdatedAt      time.Time        `json:"updated_at"`
+	DeletedAt      sql.NullTime     `json:"deleted_at"`
+}
+
+type BusinessCreatorImageLicense struct {
+	ID               int64                     `json:"id"`
+	BusinessRootID   int64                     `json:"business_root_id"`
+	CreatorImageID   int64                     `json:"creator_image_id"`
+	PaymentHistoryID uuid.UUID                 `json:"payment_history_id"`
+	ProfileID        uuid.UUID                 `json:"profile_id"`
+	Status           CreatorImageLicenseStatus `json:"status"`
+	RevokedAt        sql.NullTime              `json:"revoked_at"`
+	CreatedAt        time.Time                 `json:"created_at"`
+	UpdatedAt        time.Time                 `json:"updated_at"`
+	DeletedAt        sql.NullTime              `json:"deleted_at"`
+}
+
 type BusinessImageContent struct {
 	ID                int64                    `json:"id"`
 	ImageUrls         []string                 `json:"image_urls"`
@@ -1212,6 +1913,42 @@ type BusinessSavedTemplateCreatorImage struct {
 	DeletedAt      sql.NullTime `json:"deleted_at"`
 }
 
+type BusinessScheduledPost struct {
+	ID                     int64                       `json:"id"`
+	BusinessRootID         int64                       `json:"business_root_id"`
+	BusinessImageContentID int64                       `json:"business_image_content_id"`
+	Platform               SocialPlatformType          `json:"platform"`
+	ScheduledAt            time.Time                   `json:"scheduled_at"`
+	Timezone               string                      `json:"timezone"`
+	Status                 BusinessScheduledPostStatus `json:"status"`
+	PublishedAt            sql.NullTime                `json:"published_at"`
+	ExternalPostID         sql.NullString              `json:"external_post_id"`
+	FailureReason          sql.NullString              `json:"failure_reason"`
+	ProfileID              uuid.UUID                   `json:"profile_id"`
+	CreatedAt              time.Time                   `json:"created_at"`
+	UpdatedAt              time.Time                   `json:"updated_at"`
+	DeletedAt              sql.NullTime                `json:"deleted_at"`
+}
+
+type BusinessSocialAccount struct {
+	ID                    int64                       `json:"id"`
+	BusinessRootID        int64                       `json:"business_root_id"`
+	Platform              SocialPlatformType          `json:"platform"`
+	ExternalAccountID     string                      `json:"external_account_id"`
+	AccountName           string                      `json:"account_name"`
+	AccountImageUrl       sql.NullString              `json:"account_image_url"`
+	AccessTokenEncrypted  string                      `json:"access_token_encrypted"`
+	RefreshTokenEncrypted sql.NullString              `json:"refresh_token_encrypted"`
+	TokenExpiresAt        sql.NullTime                `json:"token_expires_at"`
+	Scopes                []string                    `json:"scopes"`
+	LastRefreshedAt       sql.NullTime                `json:"last_refreshed_at"`
+	Status                BusinessSocialAccountStatus `json:"status"`
+	ProfileID             uuid.UUID                   `json:"profile_id"`
+	CreatedAt             time.Time                   `json:"created_at"`
+	UpdatedAt             time.Time                   `json:"updated_at"`
+	DeletedAt             sql.NullTime                `json:"deleted_at"`
+}
+
 type BusinessTimezonePref struct {
 	ID             int32        `json:"id"`
 	BusinessRootID int64        `json:"business_root_id"`
@@ -1220,18 +1957,48 @@ type BusinessTimezonePref struct {
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 }
 
+type CreatorEarningTransaction struct {
+	ID                   int64                         `json:"id"`
+	ProfileID            uuid.UUID                     `json:"profile_id"`
+	Type                 CreatorEarningTransactionType `json:"type"`
+	CreatorImageID       int64                         `json:"creator_image_id"`
+	PaymentHistoryID     uuid.UUID                     `json:"payment_history_id"`
+	GrossAmount          int64                         `json:"gross_amount"`
+	CommissionPercentage int32                         `json:"commission_percentage"`
+	CommissionAmount     int64                         `json:"commission_amount"`
+	NetAmount            int64                         `json:"net_amount"`
+	Currency             string                        `json:"currency"`
+	CreatedAt            time.Time                     `json:"created_at"`
+	UpdatedAt            time.Time                     `json:"updated_at"`
+	DeletedAt            sql.NullTime                  `json:"deleted_at"`
+}
+
 type CreatorImage struct {
-	ID           int64          `json:"id"`
-	Name         string         `json:"name"`
-	ImageUrl     string         `json:"image_url"`
-	IsPublished  bool           `json:"is_published"`
-	IsBanned     bool           `json:"is_banned"`
-	BannedReason sql.NullString `json:"banned_reason"`
-	Price        int64          `json:"price"`
-	ProfileID    uuid.NullUUID  `json:"profile_id"`
-	CreatedAt    sql.NullTime   `json:"created_at"`
-	UpdatedAt    sql.NullTime   `json:"updated_at"`
-	DeletedAt    sql.NullTime   `json:"deleted_at"`
+	ID               int64                        `json:"id"`
+	Name             string                       `json:"name"`
+	ImageUrl         string                       `json:"image_url"`
+	IsPublished      bool                         `json:"is_published"`
+	IsBanned         bool                         `json:"is_banned"`
+	BannedReason     sql.NullString               `json:"banned_reason"`
+	Price            int64                        `json:"price"`
+	ProfileID        uuid.NullUUID                `json:"profile_id"`
+	CreatedAt        sql.NullTime                 `json:"created_at"`
+	UpdatedAt        sql.NullTime                 `json:"updated_at"`
+	DeletedAt        sql.NullTime                 `json:"deleted_at"`
+	ModerationStatus CreatorImageModerationStatus `json:"moderation_status"`
+	ModeratedAt      sql.NullTime                 `json:"moderated_at"`
+}
+
+type CreatorImageModeration struct {
+	ID                 int64                        `json:"id"`
+	CreatorImageID     int64                        `json:"creator_image_id"`
+	Action             CreatorImageModerationAction `json:"action"`
+	PreviousStatus     CreatorImageModerationStatus `json:"previous_status"`
+	Reason             sql.NullString               `json:"reason"`
+	ModeratorProfileID uuid.UUID                    `json:"moderator_profile_id"`
+	CreatedAt          time.Time                    `json:"created_at"`
+	UpdatedAt          time.Time                    `json:"updated_at"`
+	DeletedAt          sql.NullTime                 `json:"deleted_at"`
 }
 
 type CreatorImageProductCategory struct {
@@ -1246,54 +2013,89 @@ type CreatorImageTypeCategory struct {
 	CreatedAt      sql.NullTime `json:"created_at"`
 }
 
-type GenerativeTokenImageTransaction struct {
-	ID               int64                `json:"id"`
-	Type             TokenTransactionType `json:"type"`
-	Amount           int64                `json:"amount"`
-	ProfileID        uuid.UUID            `json:"profile_id"`
-	BusinessRootID   int64                `json:"business_root_id"`
-	PaymentHistoryID uuid.NullUUID        `json:"payment_history_id"`
-	CreatedAt        time.Time            `json:"created_at"`
-	UpdatedAt        time.Time            `json:"updated_at"`
-	DeletedAt        sql.NullTime         `json:"deleted_at"`
+type GenerativeTokenBalance struct {
+	ID             int64        `json:"id"`
+	BusinessRootID int64        `json:"business_root_id"`
+	TotalIn        int64        `json:"total_in"`
+	TotalOut       int64        `json:"total_out"`
+	Reserved       int64        `json:"reserved"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+	DeletedAt      sql.NullTime `json:"deleted_at"`
+	TokenType      TokenType    `json:"token_type"`
+}
+
+type GenerativeTokenReservation struct {
+	ID                           int64                  `json:"id"`
+	Status                       TokenReservationStatus `json:"status"`
+	Amount                       int64                  `json:"amount"`
+	ProfileID                    uuid.UUID              `json:"profile_id"`
+	BusinessRootID               int64                  `json:"business_root_id"`
+	GenerativeImageModelID       sql.NullInt64          `json:"generative_image_model_id"`
+	GenerativeTokenTransactionID sql.NullInt64          `json:"generative_token_transaction_id"`
+	ExpiresAt                    time.Time              `json:"expires_at"`
+	CreatedAt                    time.Time              `json:"created_at"`
+	UpdatedAt                    time.Time              `json:"updated_at"`
+	DeletedAt                    sql.NullTime           `json:"deleted_at"`
+	TokenType                    TokenType              `json:"token_type"`
+}
+
+type GenerativeTokenTransaction struct {
+	ID                     int64                `json:"id"`
+	Type                   TokenTransactionType `json:"type"`
+	Amount                 int64                `json:"amount"`
+	ProfileID              uuid.UUID            `json:"profile_id"`
+	BusinessRootID         int64                `json:"business_root_id"`
+	PaymentHistoryID       uuid.NullUUID        `json:"payment_history_id"`
+	CreatedAt              time.Time            `json:"created_at"`
+	UpdatedAt              time.Time            `json:"updated_at"`
+	DeletedAt              sql.NullTime         `json:"deleted_at"`
+	GenerativeImageModelID sql.NullInt64        `json:"generative_image_model_id"`
+	TokenType              TokenType            `json:"token_type"`
 }
 
 type PaymentHistory struct {
-	ID                    uuid.UUID          `json:"id"`
-	ProfileID             uuid.UUID          `json:"profile_id"`
-	BusinessRootID        int64              `json:"business_root_id"`
-	ProductAmount         int64              `json:"product_amount"`
-	Status                PaymentStatus      `json:"status"`
-	Currency              string             `json:"currency"`
-	PaymentMethod         string             `json:"payment_method"`
-	PaymentMethodType     string             `json:"payment_method_type"`
-	RecordProductName     string             `json:"record_product_name"`
-	RecordProductType     PaymentProductType `json:"record_product_type"`
-	RecordProductPrice    int64              `json:"record_product_price"`
-	RecordProductImageUrl string             `json:"record_product_image_url"`
-	ReferenceProductID    uuid.UUID          `json:"reference_product_id"`
-	SubtotalItemAmount    int64              `json:"subtotal_item_amount"`
-	DiscountAmount        int64              `json:"discount_amount"`
-	DiscountPercentage    sql.NullInt32      `json:"discount_percentage"`
-	DiscountType          DiscountType       `json:"discount_type"`
-	AdminFeeAmount        int64              `json:"admin_fee_amount"`
-	AdminFeePercentage    sql.NullInt32      `json:"admin_fee_percentage"`
-	AdminFeeType          DiscountType       `json:"admin_fee_type"`
-	TaxAmount             int64              `json:"tax_amount"`
-	TaxPercentage         int32              `json:"tax_percentage"`
-	ReferralRecordID      sql.NullInt64      `json:"referral_record_id"`
-	MidtransTransactionID sql.NullString     `json:"midtrans_transaction_id"`
-	MidtransExpiredAt     sql.NullTime       `json:"midtrans_expired_at"`
-	PaymentPendingAt      sql.NullTime       `json:"payment_pending_at"`
-	PaymentSuccessAt      sql.NullTime       `json:"payment_success_at"`
-	PaymentFailedAt       sql.NullTime       `json:"payment_failed_at"`
-	PaymentCanceledAt     sql.NullTime       `json:"payment_canceled_at"`
-	PaymentExpiredAt      sql.NullTime       `json:"payment_expired_at"`
-	PaymentRefundedAt     sql.NullTime       `json:"payment_refunded_at"`
-	TotalAmount           int64              `json:"total_amount"`
-	CreatedAt             time.Time          `json:"created_at"`
-	UpdatedAt             time.Time          `json:"updated_at"`
-	DeletedAt             sql.NullTime       `json:"deleted_at"`
+	ID                      uuid.UUID          `json:"id"`
+	ProfileID               uuid.UUID          `json:"profile_id"`
+	BusinessRootID          int64              `json:"business_root_id"`
+	ProductAmount           int64              `json:"product_amount"`
+	Status                  PaymentStatus      `json:"status"`
+	Currency                string             `json:"currency"`
+	PaymentMethod           string             `json:"payment_method"`
+	PaymentMethodType       string             `json:"payment_method_type"`
+	RecordProductName       string             `json:"record_product_name"`
+	RecordProductType       PaymentProductType `json:"record_product_type"`
+	RecordProductPrice      int64              `json:"record_product_price"`
+	RecordProductImageUrl   string             `json:"record_product_image_url"`
+	ReferenceProductID      uuid.NullUUID      `json:"reference_product_id"`
+	SubtotalItemAmount      int64              `json:"subtotal_item_amount"`
+	DiscountAmount          int64              `json:"discount_amount"`
+	DiscountPercentage      sql.NullInt32      `json:"discount_percentage"`
+	DiscountType            DiscountType       `json:"discount_type"`
+	AdminFeeAmount          int64              `json:"admin_fee_amount"`
+	AdminFeePercentage      sql.NullInt32      `json:"admin_fee_percentage"`
+	AdminFeeType            DiscountType       `json:"admin_fee_type"`
+	TaxAmount               int64              `json:"tax_amount"`
+	TaxPercentage           int32              `json:"tax_percentage"`
+	ReferralRecordID        sql.NullInt64      `json:"referral_record_id"`
+	MidtransTransactionID   sql.NullString     `json:"midtrans_transaction_id"`
+	MidtransExpiredAt       sql.NullTime       `json:"midtrans_expired_at"`
+	PaymentPendingAt        sql.NullTime       `json:"payment_pending_at"`
+	PaymentSuccessAt        sql.NullTime       `json:"payment_success_at"`
+	PaymentFailedAt         sql.NullTime       `json:"payment_failed_at"`
+	PaymentCanceledAt       sql.NullTime       `json:"payment_canceled_at"`
+	PaymentExpiredAt        sql.NullTime       `json:"payment_expired_at"`
+	PaymentRefundedAt       sql.NullTime       `json:"payment_refunded_at"`
+	TotalAmount             int64              `json:"total_amount"`
+	CreatedAt               time.Time          `json:"created_at"`
+	UpdatedAt               time.Time          `json:"updated_at"`
+	DeletedAt               sql.NullTime       `json:"deleted_at"`
+	RefundedAmount          int64              `json:"refunded_amount"`
+	ReferenceCreatorImageID sql.NullInt64      `json:"reference_creator_image_id"`
+	Gateway                 PaymentGatewayType `json:"gateway"`
+	ExchangeRateID          sql.NullInt64      `json:"exchange_rate_id"`
+	ExchangeBaseCurrency    sql.NullString     `json:"exchange_base_currency"`
+	ExchangeRateMicros      sql.NullInt64      `json:"exchange_rate_micros"`
 }
 
 type PaymentHistoryAction struct {
@@ -1310,6 +2112,67 @@ type PaymentHistoryAction struct {
 	UpdatedAt        time.Time              `json:"updated_at"`
 }
 
+type PaymentHistoryRefund struct {
+	ID                           int64               `json:"id"`
+	PaymentHistoryID             uuid.UUID           `json:"payment_history_id"`
+	Source                       PaymentRefundSource `json:"source"`
+	RefundKey                    sql.NullString      `json:"refund_key"`
+	Amount                       int64               `json:"amount"`
+	Currency                     string              `json:"currency"`
+	Reason                       sql.NullString      `json:"reason"`
+	TokenAmountExpected          int64               `json:"token_amount_expected"`
+	TokenAmountClawedBack        int64               `json:"token_amount_clawed_back"`
+	GenerativeTokenTransactionID sql.NullInt64       `json:"generative_token_transaction_id"`
+	ActorProfileID               uuid.NullUUID       `json:"actor_profile_id"`
+	CreatedAt                    time.Time           `json:"created_at"`
+	UpdatedAt                    time.Time           `json:"updated_at"`
+	DeletedAt                    sql.NullTime        `json:"deleted_at"`
+	Status                       PaymentRefundStatus `json:"status"`
+}
+
+type PaymentInvoice struct {
+	ID                 int64          `json:"id"`
+	PaymentHistoryID   uuid.UUID      `json:"payment_history_id"`
+	BusinessRootID     int64          `json:"business_root_id"`
+	InvoiceNumber      string         `json:"invoice_number"`
+	InvoiceYear        int32          `json:"invoice_year"`
+	InvoiceSequence    int32          `json:"invoice_sequence"`
+	BillToName         string         `json:"bill_to_name"`
+	BillToEmail        string         `json:"bill_to_email"`
+	BillToBusinessName sql.NullString `json:"bill_to_business_name"`
+	ObjectKey          string         `json:"object_key"`
+	IssuedAt           time.Time      `json:"issued_at"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
+	DeletedAt          sql.NullTime   `json:"deleted_at"`
+}
+
+type PaymentInvoiceSequence struct {
+	Year         int32     `json:"year"`
+	LastSequence int32     `json:"last_sequence"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type PostDeliveryAttempt struct {
+	ID                      int64                     `json:"id"`
+	BusinessScheduledPostID int64                     `json:"business_scheduled_post_id"`
+	BusinessRootID          int64                     `json:"business_root_id"`
+	Platform                SocialPlatformType        `json:"platform"`
+	AttemptNumber           int32                     `json:"attempt_number"`
+	Status                  PostDeliveryAttemptStatus `json:"status"`
+	IsFinal                 bool                      `json:"is_final"`
+	ResponseCode            sql.NullInt32             `json:"response_code"`
+	ResponseBody            sql.NullString            `json:"response_body"`
+	ExternalPostID          sql.NullString            `json:"external_post_id"`
+	ErrorMessage            sql.NullString            `json:"error_message"`
+	StartedAt               time.Time                 `json:"started_at"`
+	FinishedAt              time.Time                 `json:"finished_at"`
+	CreatedAt               time.Time                 `json:"created_at"`
+	UpdatedAt               time.Time                 `json:"updated_at"`
+	DeletedAt               sql.NullTime              `json:"deleted_at"`
+}
+
 type Profile struct {
 	ID          uuid.UUID      `json:"id"`
 	Name        string         `json:"name"`
@@ -1324,20 +2187,41 @@ type Profile struct {
 }
 
 type ProfileReferralCode struct {
-	ID                int64         `json:"id"`
-	ProfileID         uuid.UUID     `json:"profile_id"`
-	Code              string        `json:"code"`
-	Type              ReferralType  `json:"type"`
-	IsActive          bool          `json:"is_active"`
-	TotalDiscount     int64         `json:"total_discount"`
-	DiscountType      DiscountType  `json:"discount_type"`
-	ExpiredDays       sql.NullInt32 `json:"expired_days"`
-	MaxDiscount       int64         `json:"max_discount"`
-	MaxUsage          sql.NullInt32 `json:"max_usage"`
-	RewardPerReferral int64         `json:"reward_per_referral"`
-	CreatedAt         time.Time     `json:"created_at"`
-	UpdatedAt         time.Time     `json:"updated_at"`
-	DeletedAt         sql.NullTime  `json:"deleted_at"`
+	ID                  int64         `json:"id"`
+	ProfileID           uuid.UUID     `json:"profile_id"`
+	Code                string        `json:"code"`
+	Type                ReferralType  `json:"type"`
+	IsActive            bool          `json:"is_active"`
+	TotalDiscount       int64         `json:"total_discount"`
+	DiscountType        DiscountType  `json:"discount_type"`
+	ExpiredDays         sql.NullInt32 `json:"expired_days"`
+	MaxDiscount         int64         `json:"max_discount"`
+	MaxUsage            sql.NullInt32 `json:"max_usage"`
+	RewardPerReferral   int64         `json:"reward_per_referral"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	DeletedAt           sql.NullTime  `json:"deleted_at"`
+	StartsAt            sql.NullTime  `json:"starts_at"`
+	EndsAt              sql.NullTime  `json:"ends_at"`
+	AllowedProductTypes []string      `json:"allowed_product_types"`
+	CreatedByProfileID  uuid.NullUUID `json:"created_by_profile_id"`
+}
+
+type ProfileTwoFactor struct {
+	ProfileID       uuid.UUID     `json:"profile_id"`
+	SecretEncrypted string        `json:"secret_encrypted"`
+	LastUsedStep    sql.NullInt64 `json:"last_used_step"`
+	EnabledAt       sql.NullTime  `json:"enabled_at"`
+	CreatedAt       time.Time     `json:"created_at"`
+	UpdatedAt       time.Time     `json:"updated_at"`
+}
+
+type ProfileTwoFactorRecoveryCode struct {
+	ID        int64        `json:"id"`
+	ProfileID uuid.UUID    `json:"profile_id"`
+	CodeHash  string       `json:"code_hash"`
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
 }
 
 type ReferralRecord struct {
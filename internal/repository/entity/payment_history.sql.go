@@ -8,6 +8,7 @@ package entity
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -99,39 +100,49 @@ INSERT INTO payment_histories (
     midtrans_transaction_id,
     midtrans_expired_at,
     payment_pending_at,
-    total_amount
+    total_amount,
+    reference_creator_image_id,
+    gateway,
+    exchange_rate_id,
+    exchange_base_currency,
+    exchange_rate_micros
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26
-) RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31
+) RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros
 `
 
 type CreatePaymentHistoryParams struct {
-	ProfileID             uuid.UUID          `json:"profile_id"`
-	BusinessRootID        int64              `json:"business_root_id"`
-	ProductAmount         int64              `json:"product_amount"`
-	Status                PaymentStatus      `json:"status"`
-	Currency              string             `json:"currency"`
-	PaymentMethod         string             `json:"payment_method"`
-	PaymentMethodType     string             `json:"payment_method_type"`
-	RecordProductName     string             `json:"record_product_name"`
-	RecordProductType     PaymentProductType `json:"record_product_type"`
-	RecordProductPrice    int64              `json:"record_product_price"`
-	RecordProductImageUrl string             `json:"record_product_image_url"`
-	ReferenceProductID    uuid.UUID          `json:"reference_product_id"`
-	SubtotalItemAmount    int64              `json:"subtotal_item_amount"`
-	DiscountAmount        int64              `json:"discount_amount"`
-	DiscountPercentage    sql.NullInt32      `json:"discount_percentage"`
-	DiscountType          DiscountType       `json:"discount_type"`
-	AdminFeeAmount        int64              `json:"admin_fee_amount"`
-	AdminFeePercentage    sql.NullInt32      `json:"admin_fee_percentage"`
-	AdminFeeType          DiscountType       `json:"admin_fee_type"`
-	TaxAmount             int64              `json:"tax_amount"`
-	TaxPercentage         int32              `json:"tax_percentage"`
-	ReferralRecordID      sql.NullInt64      `json:"referral_record_id"`
-	MidtransTransactionID sql.NullString     `json:"midtrans_transaction_id"`
-	MidtransExpiredAt     sql.NullTime       `json:"midtrans_expired_at"`
-	PaymentPendingAt      sql.NullTime       `json:"payment_pending_at"`
-	TotalAmount           int64              `json:"total_amount"`
+	ProfileID               uuid.UUID          `json:"profile_id"`
+	BusinessRootID          int64              `json:"business_root_id"`
+	ProductAmount           int64              `json:"product_amount"`
+	Status                  PaymentStatus      `json:"status"`
+	Currency                string             `json:"currency"`
+	PaymentMethod           string             `json:"payment_method"`
+	PaymentMethodType       string             `json:"payment_method_type"`
+	RecordProductName       string             `json:"record_product_name"`
+	RecordProductType       PaymentProductType `json:"record_product_type"`
+	RecordProductPrice      int64              `json:"record_product_price"`
+	RecordProductImageUrl   string             `json:"record_product_image_url"`
+	ReferenceProductID      uuid.NullUUID      `json:"reference_product_id"`
+	SubtotalItemAmount      int64              `json:"subtotal_item_amount"`
+	DiscountAmount          int64              `json:"discount_amount"`
+	DiscountPercentage      sql.NullInt32      `json:"discount_percentage"`
+	DiscountType            DiscountType       `json:"discount_type"`
+	AdminFeeAmount          int64              `json:"admin_fee_amount"`
+	AdminFeePercentage      sql.NullInt32      `json:"admin_fee_percentage"`
+	AdminFeeType            DiscountType       `json:"admin_fee_type"`
+	TaxAmount               int64              `json:"tax_amount"`
+	TaxPercentage           int32              `json:"tax_percentage"`
+	ReferralRecordID        sql.NullInt64      `json:"referral_record_id"`
+	MidtransTransactionID   sql.NullString     `json:"midtrans_transaction_id"`
+	MidtransExpiredAt       sql.NullTime       `json:"midtrans_expired_at"`
+	PaymentPendingAt        sql.NullTime       `json:"payment_pending_at"`
+	TotalAmount             int64              `json:"total_amount"`
+	ReferenceCreatorImageID sql.NullInt64      `json:"reference_creator_image_id"`
+	Gateway                 PaymentGatewayType `json:"gateway"`
+	ExchangeRateID          sql.NullInt64      `json:"exchange_rate_id"`
+	ExchangeBaseCurrency    sql.NullString     `json:"exchange_base_currency"`
+	ExchangeRateMicros      sql.NullInt64      `json:"exchange_rate_micros"`
 }
 
 func (q *Queries) CreatePaymentHistory(ctx context.Context, arg CreatePaymentHistoryParams) (PaymentHistory, error) {
@@ -162,6 +173,11 @@ func (q *Queries) CreatePaymentHistory(ctx context.Context, arg CreatePaymentHis
 		arg.MidtransExpiredAt,
 		arg.PaymentPendingAt,
 		arg.TotalAmount,
+		arg.ReferenceCreatorImageID,
+		arg.Gateway,
+		arg.ExchangeRateID,
+		arg.ExchangeBaseCurrency,
+		arg.ExchangeRateMicros,
 	)
 	var i PaymentHistory
 	err := row.Scan(
@@ -200,12 +216,18 @@ func (q *Queries) CreatePaymentHistory(ctx context.Context, arg CreatePaymentHis
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
 
 const getAllPaymentHistories = `-- name: GetAllPaymentHistories :many
-SELECT p.id, p.profile_id, p.business_root_id, p.product_amount, p.status, p.currency, p.payment_method, p.payment_method_type, p.record_product_name, p.record_product_type, p.record_product_price, p.record_product_image_url, p.reference_product_id, p.subtotal_item_amount, p.discount_amount, p.discount_percentage, p.discount_type, p.admin_fee_amount, p.admin_fee_percentage, p.admin_fee_type, p.tax_amount, p.tax_percentage, p.referral_record_id, p.midtrans_transaction_id, p.midtrans_expired_at, p.payment_pending_at, p.payment_success_at, p.payment_failed_at, p.payment_canceled_at, p.payment_expired_at, p.payment_refunded_at, p.total_amount, p.created_at, p.updated_at, p.deleted_at
+SELECT p.id, p.profile_id, p.business_root_id, p.product_amount, p.status, p.currency, p.payment_method, p.payment_method_type, p.record_product_name, p.record_product_type, p.record_product_price, p.record_product_image_url, p.reference_product_id, p.subtotal_item_amount, p.discount_amount, p.discount_percentage, p.discount_type, p.admin_fee_amount, p.admin_fee_percentage, p.admin_fee_type, p.tax_amount, p.tax_percentage, p.referral_record_id, p.midtrans_transaction_id, p.midtrans_expired_at, p.payment_pending_at, p.payment_success_at, p.payment_failed_at, p.payment_canceled_at, p.payment_expired_at, p.payment_refunded_at, p.total_amount, p.created_at, p.updated_at, p.deleted_at, p.refunded_amount, p.reference_creator_image_id, p.gateway, p.exchange_rate_id, p.exchange_base_currency, p.exchange_rate_micros
 FROM payment_histories p
 WHERE
     p.deleted_at IS NULL
@@ -292,6 +314,12 @@ func (q *Queries) GetAllPaymentHistories(ctx context.Context, arg GetAllPaymentH
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.ReferenceCreatorImageID,
+			&i.Gateway,
+			&i.ExchangeRateID,
+			&i.ExchangeBaseCurrency,
+			&i.ExchangeRateMicros,
 		); err != nil {
 			return nil, err
 		}
@@ -307,7 +335,7 @@ func (q *Queries) GetAllPaymentHistories(ctx context.Context, arg GetAllPaymentH
 }
 
 const getAllPaymentHistoriesByBusiness = `-- name: GetAllPaymentHistoriesByBusiness :many
-SELECT p.id, p.profile_id, p.business_root_id, p.product_amount, p.status, p.currency, p.payment_method, p.payment_method_type, p.record_product_name, p.record_product_type, p.record_product_price, p.record_product_image_url, p.reference_product_id, p.subtotal_item_amount, p.discount_amount, p.discount_percentage, p.discount_type, p.admin_fee_amount, p.admin_fee_percentage, p.admin_fee_type, p.tax_amount, p.tax_percentage, p.referral_record_id, p.midtrans_transaction_id, p.midtrans_expired_at, p.payment_pending_at, p.payment_success_at, p.payment_failed_at, p.payment_canceled_at, p.payment_expired_at, p.payment_refunded_at, p.total_amount, p.created_at, p.updated_at, p.deleted_at
+SELECT p.id, p.profile_id, p.business_root_id, p.product_amount, p.status, p.currency, p.payment_method, p.payment_method_type, p.record_product_name, p.record_product_type, p.record_product_price, p.record_product_image_url, p.reference_product_id, p.subtotal_item_amount, p.discount_amount, p.discount_percentage, p.discount_type, p.admin_fee_amount, p.admin_fee_percentage, p.admin_fee_type, p.tax_amount, p.tax_percentage, p.referral_record_id, p.midtrans_transaction_id, p.midtrans_expired_at, p.payment_pending_at, p.payment_success_at, p.payment_failed_at, p.payment_canceled_at, p.payment_expired_at, p.payment_refunded_at, p.total_amount, p.created_at, p.updated_at, p.deleted_at, p.refunded_amount, p.reference_creator_image_id, p.gateway, p.exchange_rate_id, p.exchange_base_currency, p.exchange_rate_micros
 FROM payment_histories p
 WHERE
     p.deleted_at IS NULL
@@ -394,6 +422,12 @@ func (q *Queries) GetAllPaymentHistoriesByBusiness(ctx context.Context, arg GetA
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.ReferenceCreatorImageID,
+			&i.Gateway,
+			&i.ExchangeRateID,
+			&i.ExchangeBaseCurrency,
+			&i.ExchangeRateMicros,
 		); err != nil {
 			return nil, err
 		}
@@ -408,8 +442,68 @@ func (q *Queries) GetAllPaymentHistoriesByBusiness(ctx context.Context, arg GetA
 	return items, nil
 }
 
+const getPaymentHistoryByGatewayTransactionId = `-- name: GetPaymentHistoryByGatewayTransactionId :one
+SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros FROM payment_histories
+WHERE gateway = $1 AND midtrans_transaction_id = $2 AND deleted_at IS NULL
+`
+
+type GetPaymentHistoryByGatewayTransactionIdParams struct {
+	Gateway       PaymentGatewayType `json:"gateway"`
+	TransactionID sql.NullString     `json:"transaction_id"`
+}
+
+// transaction id hanya unik per gateway (dipakai webhook)
+func (q *Queries) GetPaymentHistoryByGatewayTransactionId(ctx context.Context, arg GetPaymentHistoryByGatewayTransactionIdParams) (PaymentHistory, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentHistoryByGatewayTransactionId, arg.Gateway, arg.TransactionID)
+	var i PaymentHistory
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.BusinessRootID,
+		&i.ProductAmount,
+		&i.Status,
+		&i.Currency,
+		&i.PaymentMethod,
+		&i.PaymentMethodType,
+		&i.RecordProductName,
+		&i.RecordProductType,
+		&i.RecordProductPrice,
+		&i.RecordProductImageUrl,
+		&i.ReferenceProductID,
+		&i.SubtotalItemAmount,
+		&i.DiscountAmount,
+		&i.DiscountPercentage,
+		&i.DiscountType,
+		&i.AdminFeeAmount,
+		&i.AdminFeePercentage,
+		&i.AdminFeeType,
+		&i.TaxAmount,
+		&i.TaxPercentage,
+		&i.ReferralRecordID,
+		&i.MidtransTransactionID,
+		&i.MidtransExpiredAt,
+		&i.PaymentPendingAt,
+		&i.PaymentSuccessAt,
+		&i.PaymentFailedAt,
+		&i.PaymentCanceledAt,
+		&i.PaymentExpiredAt,
+		&i.PaymentRefundedAt,
+		&i.TotalAmount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
+	)
+	return i, err
+}
+
 const getPaymentHistoryById = `-- name: GetPaymentHistoryById :one
-SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at FROM payment_histories
+SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros FROM payment_histories
 WHERE id = $1 AND deleted_at IS NULL
 `
 
@@ -452,12 +546,18 @@ func (q *Queries) GetPaymentHistoryById(ctx context.Context, id uuid.UUID) (Paym
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
 
 const getPaymentHistoryByIdAndBusiness = `-- name: GetPaymentHistoryByIdAndBusiness :one
-SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at FROM payment_histories
+SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros FROM payment_histories
 WHERE id = $1 AND business_root_id = $2 AND deleted_at IS NULL
 `
 
@@ -505,12 +605,18 @@ func (q *Queries) GetPaymentHistoryByIdAndBusiness(ctx context.Context, arg GetP
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
 
 const getPaymentHistoryByIdAndProfile = `-- name: GetPaymentHistoryByIdAndProfile :one
-SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at FROM payment_histories
+SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros FROM payment_histories
 WHERE id = $1 AND profile_id = $2 AND deleted_at IS NULL
 `
 
@@ -558,17 +664,24 @@ func (q *Queries) GetPaymentHistoryByIdAndProfile(ctx context.Context, arg GetPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
 
-const getPaymentHistoryByMidtransTransactionId = `-- name: GetPaymentHistoryByMidtransTransactionId :one
-SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at FROM payment_histories
-WHERE midtrans_transaction_id = $1 AND deleted_at IS NULL
+const getPaymentHistoryByIdForUpdate = `-- name: GetPaymentHistoryByIdForUpdate :one
+SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros FROM payment_histories
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE
 `
 
-func (q *Queries) GetPaymentHistoryByMidtransTransactionId(ctx context.Context, midtransTransactionID sql.NullString) (PaymentHistory, error) {
-	row := q.db.QueryRowContext(ctx, getPaymentHistoryByMidtransTransactionId, midtransTransactionID)
+func (q *Queries) GetPaymentHistoryByIdForUpdate(ctx context.Context, id uuid.UUID) (PaymentHistory, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentHistoryByIdForUpdate, id)
 	var i PaymentHistory
 	err := row.Scan(
 		&i.ID,
@@ -606,15 +719,101 @@ func (q *Queries) GetPaymentHistoryByMidtransTransactionId(ctx context.Context,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
 
+const getStalePendingPaymentHistories = `-- name: GetStalePendingPaymentHistories :many
+SELECT id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros FROM payment_histories
+WHERE status = 'pending'::payment_status
+  AND created_at < $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC
+LIMIT $2
+`
+
+type GetStalePendingPaymentHistoriesParams struct {
+	PendingBefore time.Time `json:"pending_before"`
+	RowLimit      int32     `json:"row_limit"`
+}
+
+// payment pending yang dibuat sebelum pending_before (kandidat reconcile jika webhook hilang)
+func (q *Queries) GetStalePendingPaymentHistories(ctx context.Context, arg GetStalePendingPaymentHistoriesParams) ([]PaymentHistory, error) {
+	rows, err := q.db.QueryContext(ctx, getStalePendingPaymentHistories, arg.PendingBefore, arg.RowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentHistory
+	for rows.Next() {
+		var i PaymentHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.BusinessRootID,
+			&i.ProductAmount,
+			&i.Status,
+			&i.Currency,
+			&i.PaymentMethod,
+			&i.PaymentMethodType,
+			&i.RecordProductName,
+			&i.RecordProductType,
+			&i.RecordProductPrice,
+			&i.RecordProductImageUrl,
+			&i.ReferenceProductID,
+			&i.SubtotalItemAmount,
+			&i.DiscountAmount,
+			&i.DiscountPercentage,
+			&i.DiscountType,
+			&i.AdminFeeAmount,
+			&i.AdminFeePercentage,
+			&i.AdminFeeType,
+			&i.TaxAmount,
+			&i.TaxPercentage,
+			&i.ReferralRecordID,
+			&i.MidtransTransactionID,
+			&i.MidtransExpiredAt,
+			&i.PaymentPendingAt,
+			&i.PaymentSuccessAt,
+			&i.PaymentFailedAt,
+			&i.PaymentCanceledAt,
+			&i.PaymentExpiredAt,
+			&i.PaymentRefundedAt,
+			&i.TotalAmount,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.ReferenceCreatorImageID,
+			&i.Gateway,
+			&i.ExchangeRateID,
+			&i.ExchangeBaseCurrency,
+			&i.ExchangeRateMicros,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updatePaymentHistoryMidtransId = `-- name: UpdatePaymentHistoryMidtransId :one
 UPDATE payment_histories
 SET midtrans_transaction_id = $2
 WHERE id = $1 AND deleted_at IS NULL
-RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at
+RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros
 `
 
 type UpdatePaymentHistoryMidtransIdParams struct {
@@ -661,6 +860,77 @@ func (q *Queries) UpdatePaymentHistoryMidtransId(ctx context.Context, arg Update
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
+	)
+	return i, err
+}
+
+const updatePaymentHistoryRefund = `-- name: UpdatePaymentHistoryRefund :one
+UPDATE payment_histories
+SET
+    status = 'refunded'::payment_status,
+    refunded_amount = $1,
+    payment_refunded_at = COALESCE(payment_refunded_at, NOW())
+WHERE id = $2 AND deleted_at IS NULL
+RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros
+`
+
+type UpdatePaymentHistoryRefundParams struct {
+	RefundedAmount int64     `json:"refunded_amount"`
+	ID             uuid.UUID `json:"id"`
+}
+
+// refunded_amount kumulatif, payment_refunded_at diisi saat refund pertama
+func (q *Queries) UpdatePaymentHistoryRefund(ctx context.Context, arg UpdatePaymentHistoryRefundParams) (PaymentHistory, error) {
+	row := q.db.QueryRowContext(ctx, updatePaymentHistoryRefund, arg.RefundedAmount, arg.ID)
+	var i PaymentHistory
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.BusinessRootID,
+		&i.ProductAmount,
+		&i.Status,
+		&i.Currency,
+		&i.PaymentMethod,
+		&i.PaymentMethodType,
+		&i.RecordProductName,
+		&i.RecordProductType,
+		&i.RecordProductPrice,
+		&i.RecordProductImageUrl,
+		&i.ReferenceProductID,
+		&i.SubtotalItemAmount,
+		&i.DiscountAmount,
+		&i.DiscountPercentage,
+		&i.DiscountType,
+		&i.AdminFeeAmount,
+		&i.AdminFeePercentage,
+		&i.AdminFeeType,
+		&i.TaxAmount,
+		&i.TaxPercentage,
+		&i.ReferralRecordID,
+		&i.MidtransTransactionID,
+		&i.MidtransExpiredAt,
+		&i.PaymentPendingAt,
+		&i.PaymentSuccessAt,
+		&i.PaymentFailedAt,
+		&i.PaymentCanceledAt,
+		&i.PaymentExpiredAt,
+		&i.PaymentRefundedAt,
+		&i.TotalAmount,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
@@ -675,7 +945,7 @@ SET
     payment_expired_at = CASE WHEN $1::payment_status = 'expired'::payment_status THEN NOW() ELSE payment_expired_at END,
     payment_refunded_at = CASE WHEN $1::payment_status = 'refunded'::payment_status THEN NOW() ELSE payment_refunded_at END
 WHERE id = $2 AND deleted_at IS NULL
-RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at
+RETURNING id, profile_id, business_root_id, product_amount, status, currency, payment_method, payment_method_type, record_product_name, record_product_type, record_product_price, record_product_image_url, reference_product_id, subtotal_item_amount, discount_amount, discount_percentage, discount_type, admin_fee_amount, admin_fee_percentage, admin_fee_type, tax_amount, tax_percentage, referral_record_id, midtrans_transaction_id, midtrans_expired_at, payment_pending_at, payment_success_at, payment_failed_at, payment_canceled_at, payment_expired_at, payment_refunded_at, total_amount, created_at, updated_at, deleted_at, refunded_amount, reference_creator_image_id, gateway, exchange_rate_id, exchange_base_currency, exchange_rate_micros
 `
 
 type UpdatePaymentHistoryStatusParams struct {
@@ -722,6 +992,12 @@ func (q *Queries) UpdatePaymentHistoryStatus(ctx context.Context, arg UpdatePaym
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.ReferenceCreatorImageID,
+		&i.Gateway,
+		&i.ExchangeRateID,
+		&i.ExchangeBaseCurrency,
+		&i.ExchangeRateMicros,
 	)
 	return i, err
 }
@@ -0,0 +1,260 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: payment_history_refund.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+)
+
+const completePaymentHistoryRefund = `-- name: CompletePaymentHistoryRefund :one
+UPDATE payment_history_refunds
+SET status = 'succeeded',
+    amount = $1,
+    token_amount_expected = $2,
+    token_amount_clawed_back = $3,
+    generative_token_transaction_id = $4
+WHERE id = $5 AND status = 'pending'
+RETURNING id, payment_history_id, source, refund_key, amount, currency, reason, token_amount_expected, token_amount_clawed_back, generative_token_transaction_id, actor_profile_id, created_at, updated_at, deleted_at, status
+`
+
+type CompletePaymentHistoryRefundParams struct {
+	Amount                       int64         `json:"amount"`
+	TokenAmountExpected          int64         `json:"token_amount_expected"`
+	TokenAmountClawedBack        int64         `json:"token_amount_clawed_back"`
+	GenerativeTokenTransactionID sql.NullInt64 `json:"generative_token_transaction_id"`
+	ID                           int64         `json:"id"`
+}
+
+func (q *Queries) CompletePaymentHistoryRefund(ctx context.Context, arg CompletePaymentHistoryRefundParams) (PaymentHistoryRefund, error) {
+	row := q.db.QueryRowContext(ctx, completePaymentHistoryRefund,
+		arg.Amount,
+		arg.TokenAmountExpected,
+		arg.TokenAmountClawedBack,
+		arg.GenerativeTokenTransactionID,
+		arg.ID,
+	)
+	var i PaymentHistoryRefund
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentHistoryID,
+		&i.Source,
+		&i.RefundKey,
+		&i.Amount,
+		&i.Currency,
+		&i.Reason,
+		&i.TokenAmountExpected,
+		&i.TokenAmountClawedBack,
+		&i.GenerativeTokenTransactionID,
+		&i.ActorProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+	)
+	return i, err
+}
+
+const createPaymentHistoryRefund = `-- name: CreatePaymentHistoryRefund :one
+INSERT INTO payment_history_refunds (
+    payment_history_id,
+    source,
+    refund_key,
+    amount,
+    currency,
+    reason,
+    token_amount_expected,
+    token_amount_clawed_back,
+    generative_token_transaction_id,
+    actor_profile_id,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING id, payment_history_id, source, refund_key, amount, currency, reason, token_amount_expected, token_amount_clawed_back, generative_token_transaction_id, actor_profile_id, created_at, updated_at, deleted_at, status
+`
+
+type CreatePaymentHistoryRefundParams struct {
+	PaymentHistoryID             uuid.UUID           `json:"payment_history_id"`
+	Source                       PaymentRefundSource `json:"source"`
+	RefundKey                    sql.NullString      `json:"refund_key"`
+	Amount                       int64               `json:"amount"`
+	Currency                     string              `json:"currency"`
+	Reason                       sql.NullString      `json:"reason"`
+	TokenAmountExpected          int64               `json:"token_amount_expected"`
+	TokenAmountClawedBack        int64               `json:"token_amount_clawed_back"`
+	GenerativeTokenTransactionID sql.NullInt64       `json:"generative_token_transaction_id"`
+	ActorProfileID               uuid.NullUUID       `json:"actor_profile_id"`
+	Status                       PaymentRefundStatus `json:"status"`
+}
+
+func (q *Queries) CreatePaymentHistoryRefund(ctx context.Context, arg CreatePaymentHistoryRefundParams) (PaymentHistoryRefund, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentHistoryRefund,
+		arg.PaymentHistoryID,
+		arg.Source,
+		arg.RefundKey,
+		arg.Amount,
+		arg.Currency,
+		arg.Reason,
+		arg.TokenAmountExpected,
+		arg.TokenAmountClawedBack,
+		arg.GenerativeTokenTransactionID,
+		arg.ActorProfileID,
+		arg.Status,
+	)
+	var i PaymentHistoryRefund
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentHistoryID,
+		&i.Source,
+		&i.RefundKey,
+		&i.Amount,
+		&i.Currency,
+		&i.Reason,
+		&i.TokenAmountExpected,
+		&i.TokenAmountClawedBack,
+		&i.GenerativeTokenTransactionID,
+		&i.ActorProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+	)
+	return i, err
+}
+
+const failPaymentHistoryRefund = `-- name: FailPaymentHistoryRefund :exec
+UPDATE payment_history_refunds
+SET status = 'failed'
+WHERE id = $1 AND status = 'pending'
+`
+
+func (q *Queries) FailPaymentHistoryRefund(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, failPaymentHistoryRefund, id)
+	return err
+}
+
+const getPaymentHistoryRefundByRefundKeyForUpdate = `-- name: GetPaymentHistoryRefundByRefundKeyForUpdate :one
+SELECT id, payment_history_id, source, refund_key, amount, currency, reason, token_amount_expected, token_amount_clawed_back, generative_token_transaction_id, actor_profile_id, created_at, updated_at, deleted_at, status FROM payment_history_refunds
+WHERE refund_key = $1 AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetPaymentHistoryRefundByRefundKeyForUpdate(ctx context.Context, refundKey sql.NullString) (PaymentHistoryRefund, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentHistoryRefundByRefundKeyForUpdate, refundKey)
+	var i PaymentHistoryRefund
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentHistoryID,
+		&i.Source,
+		&i.RefundKey,
+		&i.Amount,
+		&i.Currency,
+		&i.Reason,
+		&i.TokenAmountExpected,
+		&i.TokenAmountClawedBack,
+		&i.GenerativeTokenTransactionID,
+		&i.ActorProfileID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getPaymentHistoryRefundsByPaymentHistoryId = `-- name: GetPaymentHistoryRefundsByPaymentHistoryId :many
+SELECT id, payment_history_id, source, refund_key, amount, currency, reason, token_amount_expected, token_amount_clawed_back, generative_token_transaction_id, actor_profile_id, created_at, updated_at, deleted_at, status FROM payment_history_refunds
+WHERE payment_history_id = $1 AND deleted_at IS NULL
+ORDER BY created_at ASC, id ASC
+`
+
+func (q *Queries) GetPaymentHistoryRefundsByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.UUID) ([]PaymentHistoryRefund, error) {
+	rows, err := q.db.QueryContext(ctx, getPaymentHistoryRefundsByPaymentHistoryId, paymentHistoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentHistoryRefund
+	for rows.Next() {
+		var i PaymentHistoryRefund
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentHistoryID,
+			&i.Source,
+			&i.RefundKey,
+			&i.Amount,
+			&i.Currency,
+			&i.Reason,
+			&i.TokenAmountExpected,
+			&i.TokenAmountClawedBack,
+			&i.GenerativeTokenTransactionID,
+			&i.ActorProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPendingPaymentHistoryRefundsByPaymentHistoryId = `-- name: GetPendingPaymentHistoryRefundsByPaymentHistoryId :many
+SELECT id, payment_history_id, source, refund_key, amount, currency, reason, token_amount_expected, token_amount_clawed_back, generative_token_transaction_id, actor_profile_id, created_at, updated_at, deleted_at, status FROM payment_history_refunds
+WHERE payment_history_id = $1 AND status = 'pending' AND deleted_at IS NULL
+ORDER BY id ASC
+FOR UPDATE
+`
+
+// wajib dipanggil di dalam transaction setelah lock payment
+func (q *Queries) GetPendingPaymentHistoryRefundsByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.UUID) ([]PaymentHistoryRefund, error) {
+	rows, err := q.db.QueryContext(ctx, getPendingPaymentHistoryRefundsByPaymentHistoryId, paymentHistoryID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PaymentHistoryRefund
+	for rows.Next() {
+		var i PaymentHistoryRefund
+		if err := rows.Scan(
+			&i.ID,
+			&i.PaymentHistoryID,
+			&i.Source,
+			&i.RefundKey,
+			&i.Amount,
+			&i.Currency,
+			&i.Reason,
+			&i.TokenAmountExpected,
+			&i.TokenAmountClawedBack,
+			&i.GenerativeTokenTransactionID,
+			&i.ActorProfileID,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,154 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: payment_invoice.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createPaymentInvoice = `-- name: CreatePaymentInvoice :one
+INSERT INTO payment_invoices (
+    payment_history_id,
+    business_root_id,
+    invoice_number,
+    invoice_year,
+    invoice_sequence,
+    bill_to_name,
+    bill_to_email,
+    bill_to_business_name,
+    object_key,
+    issued_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10
+) RETURNING id, payment_history_id, business_root_id, invoice_number, invoice_year, invoice_sequence, bill_to_name, bill_to_email, bill_to_business_name, object_key, issued_at, created_at, updated_at, deleted_at
+`
+
+type CreatePaymentInvoiceParams struct {
+	PaymentHistoryID   uuid.UUID      `json:"payment_history_id"`
+	BusinessRootID     int64          `json:"business_root_id"`
+	InvoiceNumber      string         `json:"invoice_number"`
+	InvoiceYear        int32          `json:"invoice_year"`
+	InvoiceSequence    int32          `json:"invoice_sequence"`
+	BillToName         string         `json:"bill_to_name"`
+	BillToEmail        string         `json:"bill_to_email"`
+	BillToBusinessName sql.NullString `json:"bill_to_business_name"`
+	ObjectKey          string         `json:"object_key"`
+	IssuedAt           time.Time      `json:"issued_at"`
+}
+
+func (q *Queries) CreatePaymentInvoice(ctx context.Context, arg CreatePaymentInvoiceParams) (PaymentInvoice, error) {
+	row := q.db.QueryRowContext(ctx, createPaymentInvoice,
+		arg.PaymentHistoryID,
+		arg.BusinessRootID,
+		arg.InvoiceNumber,
+		arg.InvoiceYear,
+		arg.InvoiceSequence,
+		arg.BillToName,
+		arg.BillToEmail,
+		arg.BillToBusinessName,
+		arg.ObjectKey,
+		arg.IssuedAt,
+	)
+	var i PaymentInvoice
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentHistoryID,
+		&i.BusinessRootID,
+		&i.InvoiceNumber,
+		&i.InvoiceYear,
+		&i.InvoiceSequence,
+		&i.BillToName,
+		&i.BillToEmail,
+		&i.BillToBusinessName,
+		&i.ObjectKey,
+		&i.IssuedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getPaymentInvoiceBillTo = `-- name: GetPaymentInvoiceBillTo :one
+SELECT
+    p.name AS profile_name,
+    p.email AS profile_email,
+    kn.name AS business_name
+FROM payment_histories ph
+JOIN profiles p ON p.id = ph.profile_id
+LEFT JOIN business_knowledges kn ON kn.business_root_id = ph.business_root_id AND kn.deleted_at IS NULL
+WHERE ph.id = $1
+`
+
+type GetPaymentInvoiceBillToRow struct {
+	ProfileName  string         `json:"profile_name"`
+	ProfileEmail string         `json:"profile_email"`
+	BusinessName sql.NullString `json:"business_name"`
+}
+
+// data bill to untuk invoice (nama business bisa null jika business knowledge belum diisi)
+func (q *Queries) GetPaymentInvoiceBillTo(ctx context.Context, paymentHistoryID uuid.UUID) (GetPaymentInvoiceBillToRow, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentInvoiceBillTo, paymentHistoryID)
+	var i GetPaymentInvoiceBillToRow
+	err := row.Scan(&i.ProfileName, &i.ProfileEmail, &i.BusinessName)
+	return i, err
+}
+
+const getPaymentInvoiceByPaymentHistoryId = `-- name: GetPaymentInvoiceByPaymentHistoryId :one
+SELECT id, payment_history_id, business_root_id, invoice_number, invoice_year, invoice_sequence, bill_to_name, bill_to_email, bill_to_business_name, object_key, issued_at, created_at, updated_at, deleted_at FROM payment_invoices
+WHERE payment_history_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetPaymentInvoiceByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.UUID) (PaymentInvoice, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentInvoiceByPaymentHistoryId, paymentHistoryID)
+	var i PaymentInvoice
+	err := row.Scan(
+		&i.ID,
+		&i.PaymentHistoryID,
+		&i.BusinessRootID,
+		&i.InvoiceNumber,
+		&i.InvoiceYear,
+		&i.InvoiceSequence,
+		&i.BillToName,
+		&i.BillToEmail,
+		&i.BillToBusinessName,
+		&i.ObjectKey,
+		&i.IssuedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const nextPaymentInvoiceSequence = `-- name: NextPaymentInvoiceSequence :one
+INSERT INTO payment_invoice_sequences (year, last_sequence)
+VALUES ($1, 1)
+ON CONFLICT (year) DO UPDATE
+SET last_sequence = payment_invoice_sequences.last_sequence + 1
+RETURNING last_sequence
+`
+
+// increment atomik per tahun (wajib dipanggil di dalam transaction agar nomor tidak loncat saat gagal)
+func (q *Queries) NextPaymentInvoiceSequence(ctx context.Context, year int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, nextPaymentInvoiceSequence, year)
+	var last_sequence int32
+	err := row.Scan(&last_sequence)
+	return last_sequence, err
+}
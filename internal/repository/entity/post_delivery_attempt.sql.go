@@ -0,0 +1,150 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: post_delivery_attempt.sql
+
+package entity
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createPostDeliveryAttempt = `-- name: CreatePostDeliveryAttempt :one
+INSERT INTO post_delivery_attempts (
+    business_scheduled_post_id,
+    business_root_id,
+    platform,
+    attempt_number,
+    status,
+    is_final,
+    response_code,
+    response_body,
+    external_post_id,
+    error_message,
+    started_at,
+    finished_at
+)
+VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11,
+    $12
+)
+RETURNING id, business_scheduled_post_id, business_root_id, platform, attempt_number, status, is_final, response_code, response_body, external_post_id, error_message, started_at, finished_at, created_at, updated_at, deleted_at
+`
+
+type CreatePostDeliveryAttemptParams struct {
+	BusinessScheduledPostID int64                     `json:"business_scheduled_post_id"`
+	BusinessRootID          int64                     `json:"business_root_id"`
+	Platform                SocialPlatformType        `json:"platform"`
+	AttemptNumber           int32                     `json:"attempt_number"`
+	Status                  PostDeliveryAttemptStatus `json:"status"`
+	IsFinal                 bool                      `json:"is_final"`
+	ResponseCode            sql.NullInt32             `json:"response_code"`
+	ResponseBody            sql.NullString            `json:"response_body"`
+	ExternalPostID          sql.NullString            `json:"external_post_id"`
+	ErrorMessage            sql.NullString            `json:"error_message"`
+	StartedAt               time.Time                 `json:"started_at"`
+	FinishedAt              time.Time                 `json:"finished_at"`
+}
+
+func (q *Queries) CreatePostDeliveryAttempt(ctx context.Context, arg CreatePostDeliveryAttemptParams) (PostDeliveryAttempt, error) {
+	row := q.db.QueryRowContext(ctx, createPostDeliveryAttempt,
+		arg.BusinessScheduledPostID,
+		arg.BusinessRootID,
+		arg.Platform,
+		arg.AttemptNumber,
+		arg.Status,
+		arg.IsFinal,
+		arg.ResponseCode,
+		arg.ResponseBody,
+		arg.ExternalPostID,
+		arg.ErrorMessage,
+		arg.StartedAt,
+		arg.FinishedAt,
+	)
+	var i PostDeliveryAttempt
+	err := row.Scan(
+		&i.ID,
+		&i.BusinessScheduledPostID,
+		&i.BusinessRootID,
+		&i.Platform,
+		&i.AttemptNumber,
+		&i.Status,
+		&i.IsFinal,
+		&i.ResponseCode,
+		&i.ResponseBody,
+		&i.ExternalPostID,
+		&i.ErrorMessage,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+	)
+	return i, err
+}
+
+const getPostDeliveryAttemptsByScheduledPostId = `-- name: GetPostDeliveryAttemptsByScheduledPostId :many
+SELECT id, business_scheduled_post_id, business_root_id, platform, attempt_number, status, is_final, response_code, response_body, external_post_id, error_message, started_at, finished_at, created_at, updated_at, deleted_at
+FROM post_delivery_attempts
+WHERE business_scheduled_post_id = $1
+  AND business_root_id = $2
+  AND deleted_at IS NULL
+ORDER BY attempt_number ASC, id ASC
+`
+
+type GetPostDeliveryAttemptsByScheduledPostIdParams struct {
+	BusinessScheduledPostID int64 `json:"business_scheduled_post_id"`
+	BusinessRootID          int64 `json:"business_root_id"`
+}
+
+func (q *Queries) GetPostDeliveryAttemptsByScheduledPostId(ctx context.Context, arg GetPostDeliveryAttemptsByScheduledPostIdParams) ([]PostDeliveryAttempt, error) {
+	rows, err := q.db.QueryContext(ctx, getPostDeliveryAttemptsByScheduledPostId, arg.BusinessScheduledPostID, arg.BusinessRootID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PostDeliveryAttempt
+	for rows.Next() {
+		var i PostDeliveryAttempt
+		if err := rows.Scan(
+			&i.ID,
+			&i.BusinessScheduledPostID,
+			&i.BusinessRootID,
+			&i.Platform,
+			&i.AttemptNumber,
+			&i.Status,
+			&i.IsFinal,
+			&i.ResponseCode,
+			&i.ResponseBody,
+			&i.ExternalPostID,
+			&i.ErrorMessage,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
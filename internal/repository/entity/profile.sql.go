@@ -98,6 +98,31 @@ func (q *Queries) GetProfileById(ctx context.Context, id uuid.UUID) (Profile, er
 	return i, err
 }
 
+const getProfileByIdForUpdate = `-- name: GetProfileByIdForUpdate :one
+SELECT id, name, email, image_url, country_code, phone, description, created_at, updated_at, role FROM profiles
+WHERE id = $1 LIMIT 1
+FOR UPDATE
+`
+
+// Lock row profile agar perubahan login provider (link / unlink) tidak balapan
+func (q *Queries) GetProfileByIdForUpdate(ctx context.Context, id uuid.UUID) (Profile, error) {
+	row := q.db.QueryRowContext(ctx, getProfileByIdForUpdate, id)
+	var i Profile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.ImageUrl,
+		&i.CountryCode,
+		&i.Phone,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+	)
+	return i, err
+}
+
 const updateProfile = `-- name: UpdateProfile :one
 UPDATE profiles
 SET name = $2, image_url = $3, country_code = $4, phone = $5, description = $6
@@ -138,3 +163,33 @@ func (q *Queries) UpdateProfile(ctx context.Context, arg UpdateProfileParams) (P
 	)
 	return i, err
 }
+
+const updateProfileEmail = `-- name: UpdateProfileEmail :one
+UPDATE profiles
+SET email = $2
+WHERE id = $1
+RETURNING id, name, email, image_url, country_code, phone, description, created_at, updated_at, role
+`
+
+type UpdateProfileEmailParams struct {
+	ID    uuid.UUID `json:"id"`
+	Email string    `json:"email"`
+}
+
+func (q *Queries) UpdateProfileEmail(ctx context.Context, arg UpdateProfileEmailParams) (Profile, error) {
+	row := q.db.QueryRowContext(ctx, updateProfileEmail, arg.ID, arg.Email)
+	var i Profile
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Email,
+		&i.ImageUrl,
+		&i.CountryCode,
+		&i.Phone,
+		&i.Description,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Role,
+	)
+	return i, err
+}
@@ -8,14 +8,47 @@ package entity
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const countAllProfileReferralCodesSpecial = `-- name: CountAllProfileReferralCodesSpecial :one
+SELECT COUNT(*)::bigint AS total
+FROM profile_referral_codes prc
+JOIN profiles pr ON pr.id = prc.profile_id
+WHERE
+    prc.type = 'special'
+    AND prc.deleted_at IS NULL
+    AND (
+        COALESCE($1, '') = ''
+        OR prc.code ILIKE ('%' || $1 || '%')
+        OR pr.name ILIKE ('%' || $1 || '%')
+        OR pr.email ILIKE ('%' || $1 || '%')
+    )
+    AND (
+        $2::boolean IS NULL
+        OR prc.is_active = $2::boolean
+    )
+`
+
+type CountAllProfileReferralCodesSpecialParams struct {
+	Search   interface{}  `json:"search"`
+	IsActive sql.NullBool `json:"is_active"`
+}
+
+func (q *Queries) CountAllProfileReferralCodesSpecial(ctx context.Context, arg CountAllProfileReferralCodesSpecialParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countAllProfileReferralCodesSpecial, arg.Search, arg.IsActive)
+	var total int64
+	err := row.Scan(&total)
+	return total, err
+}
+
 const createProfileReferralCode = `-- name: CreateProfileReferralCode :one
 INSERT INTO profile_referral_codes (profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral)
 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
-RETURNING id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at
+RETURNING id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at, starts_at, ends_at, allowed_product_types, created_by_profile_id
 `
 
 type CreateProfileReferralCodeParams struct {
@@ -60,12 +93,238 @@ func (q *Queries) CreateProfileReferralCode(ctx context.Context, arg CreateProfi
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.StartsAt,
+		&i.EndsAt,
+		pq.Array(&i.AllowedProductTypes),
+		&i.CreatedByProfileID,
+	)
+	return i, err
+}
+
+const createProfileReferralCodeSpecial = `-- name: CreateProfileReferralCodeSpecial :one
+INSERT INTO profile_referral_codes (
+    profile_id,
+    code,
+    type,
+    is_active,
+    total_discount,
+    discount_type,
+    max_discount,
+    max_usage,
+    reward_per_referral,
+    starts_at,
+    ends_at,
+    allowed_product_types,
+    created_by_profile_id
+) VALUES (
+    $1,
+    $2,
+    'special',
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10,
+    $11::text[],
+    $12
+)
+RETURNING id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at, starts_at, ends_at, allowed_product_types, created_by_profile_id
+`
+
+type CreateProfileReferralCodeSpecialParams struct {
+	ProfileID           uuid.UUID     `json:"profile_id"`
+	Code                string        `json:"code"`
+	IsActive            bool          `json:"is_active"`
+	TotalDiscount       int64         `json:"total_discount"`
+	DiscountType        DiscountType  `json:"discount_type"`
+	MaxDiscount         int64         `json:"max_discount"`
+	MaxUsage            sql.NullInt32 `json:"max_usage"`
+	RewardPerReferral   int64         `json:"reward_per_referral"`
+	StartsAt            sql.NullTime  `json:"starts_at"`
+	EndsAt              sql.NullTime  `json:"ends_at"`
+	AllowedProductTypes []string      `json:"allowed_product_types"`
+	CreatedByProfileID  uuid.NullUUID `json:"created_by_profile_id"`
+}
+
+func (q *Queries) CreateProfileReferralCodeSpecial(ctx context.Context, arg CreateProfileReferralCodeSpecialParams) (ProfileReferralCode, error) {
+	row := q.db.QueryRowContext(ctx, createProfileReferralCodeSpecial,
+		arg.ProfileID,
+		arg.Code,
+		arg.IsActive,
+		arg.TotalDiscount,
+		arg.DiscountType,
+		arg.MaxDiscount,
+		arg.MaxUsage,
+		arg.RewardPerReferral,
+		arg.StartsAt,
+		arg.EndsAt,
+		pq.Array(arg.AllowedProductTypes),
+		arg.CreatedByProfileID,
+	)
+	var i ProfileReferralCode
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Code,
+		&i.Type,
+		&i.IsActive,
+		&i.TotalDiscount,
+		&i.DiscountType,
+		&i.ExpiredDays,
+		&i.MaxDiscount,
+		&i.MaxUsage,
+		&i.RewardPerReferral,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.StartsAt,
+		&i.EndsAt,
+		pq.Array(&i.AllowedProductTypes),
+		&i.CreatedByProfileID,
 	)
 	return i, err
 }
 
+const getAllProfileReferralCodesSpecial = `-- name: GetAllProfileReferralCodesSpecial :many
+WITH p AS (
+  SELECT
+    COALESCE(NULLIF($5,  ''), 'created_at') AS sort_by,
+    COALESCE(NULLIF($6, ''), 'desc')       AS sort_dir
+)
+SELECT
+    prc.id, prc.profile_id, prc.code, prc.type, prc.is_active, prc.total_discount, prc.discount_type, prc.expired_days, prc.max_discount, prc.max_usage, prc.reward_per_referral, prc.created_at, prc.updated_at, prc.deleted_at, prc.starts_at, prc.ends_at, prc.allowed_product_types, prc.created_by_profile_id,
+    pr.name  AS owner_name,
+    pr.email AS owner_email,
+    (
+        SELECT COUNT(*)::int FROM referral_records rr
+        WHERE rr.profile_referral_code_id = prc.id
+            AND rr.status IN ('pending', 'success')
+            AND rr.deleted_at IS NULL
+    ) AS usage_count
+FROM profile_referral_codes prc
+JOIN profiles pr ON pr.id = prc.profile_id
+CROSS JOIN p
+WHERE
+    prc.type = 'special'
+    AND prc.deleted_at IS NULL
+    AND (
+        COALESCE($1, '') = ''
+        OR prc.code ILIKE ('%' || $1 || '%')
+        OR pr.name ILIKE ('%' || $1 || '%')
+        OR pr.email ILIKE ('%' || $1 || '%')
+    )
+    AND (
+        $2::boolean IS NULL
+        OR prc.is_active = $2::boolean
+    )
+ORDER BY
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'asc'  THEN prc.created_at END ASC,
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'desc' THEN prc.created_at END DESC,
+
+    CASE WHEN p.sort_by = 'updated_at' AND p.sort_dir = 'asc'  THEN prc.updated_at END ASC,
+    CASE WHEN p.sort_by = 'updated_at' AND p.sort_dir = 'desc' THEN prc.updated_at END DESC,
+
+    CASE WHEN p.sort_by = 'code' AND p.sort_dir = 'asc'  THEN prc.code END ASC,
+    CASE WHEN p.sort_by = 'code' AND p.sort_dir = 'desc' THEN prc.code END DESC,
+
+    CASE WHEN p.sort_by = 'ends_at' AND p.sort_dir = 'asc'  THEN prc.ends_at END ASC,
+    CASE WHEN p.sort_by = 'ends_at' AND p.sort_dir = 'desc' THEN prc.ends_at END DESC,
+
+    prc.id DESC
+LIMIT $4
+OFFSET $3
+`
+
+type GetAllProfileReferralCodesSpecialParams struct {
+	Search     interface{}  `json:"search"`
+	IsActive   sql.NullBool `json:"is_active"`
+	PageOffset int32        `json:"page_offset"`
+	PageLimit  int32        `json:"page_limit"`
+	SortBy     interface{}  `json:"sort_by"`
+	SortDir    interface{}  `json:"sort_dir"`
+}
+
+type GetAllProfileReferralCodesSpecialRow struct {
+	ID                  int64         `json:"id"`
+	ProfileID           uuid.UUID     `json:"profile_id"`
+	Code                string        `json:"code"`
+	Type                ReferralType  `json:"type"`
+	IsActive            bool          `json:"is_active"`
+	TotalDiscount       int64         `json:"total_discount"`
+	DiscountType        DiscountType  `json:"discount_type"`
+	ExpiredDays         sql.NullInt32 `json:"expired_days"`
+	MaxDiscount         int64         `json:"max_discount"`
+	MaxUsage            sql.NullInt32 `json:"max_usage"`
+	RewardPerReferral   int64         `json:"reward_per_referral"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	DeletedAt           sql.NullTime  `json:"deleted_at"`
+	StartsAt            sql.NullTime  `json:"starts_at"`
+	EndsAt              sql.NullTime  `json:"ends_at"`
+	AllowedProductTypes []string      `json:"allowed_product_types"`
+	CreatedByProfileID  uuid.NullUUID `json:"created_by_profile_id"`
+	OwnerName           string        `json:"owner_name"`
+	OwnerEmail          string        `json:"owner_email"`
+	UsageCount          int32         `json:"usage_count"`
+}
+
+func (q *Queries) GetAllProfileReferralCodesSpecial(ctx context.Context, arg GetAllProfileReferralCodesSpecialParams) ([]GetAllProfileReferralCodesSpecialRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAllProfileReferralCodesSpecial,
+		arg.Search,
+		arg.IsActive,
+		arg.PageOffset,
+		arg.PageLimit,
+		arg.SortBy,
+		arg.SortDir,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAllProfileReferralCodesSpecialRow
+	for rows.Next() {
+		var i GetAllProfileReferralCodesSpecialRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ProfileID,
+			&i.Code,
+			&i.Type,
+			&i.IsActive,
+			&i.TotalDiscount,
+			&i.DiscountType,
+			&i.ExpiredDays,
+			&i.MaxDiscount,
+			&i.MaxUsage,
+			&i.RewardPerReferral,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.StartsAt,
+			&i.EndsAt,
+			pq.Array(&i.AllowedProductTypes),
+			&i.CreatedByProfileID,
+			&i.OwnerName,
+			&i.OwnerEmail,
+			&i.UsageCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getProfileReferralCodeByCode = `-- name: GetProfileReferralCodeByCode :one
-SELECT id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at
+SELECT id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at, starts_at, ends_at, allowed_product_types, created_by_profile_id
 FROM profile_referral_codes
 WHERE code = $1
   AND deleted_at IS NULL
@@ -89,12 +348,16 @@ func (q *Queries) GetProfileReferralCodeByCode(ctx context.Context, code string)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.StartsAt,
+		&i.EndsAt,
+		pq.Array(&i.AllowedProductTypes),
+		&i.CreatedByProfileID,
 	)
 	return i, err
 }
 
 const getProfileReferralCodeByProfileIdBasic = `-- name: GetProfileReferralCodeByProfileIdBasic :one
-SELECT id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at
+SELECT id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at, starts_at, ends_at, allowed_product_types, created_by_profile_id
 FROM profile_referral_codes
 WHERE profile_id = $1
   AND type = 'basic'
@@ -119,6 +382,182 @@ func (q *Queries) GetProfileReferralCodeByProfileIdBasic(ctx context.Context, pr
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.StartsAt,
+		&i.EndsAt,
+		pq.Array(&i.AllowedProductTypes),
+		&i.CreatedByProfileID,
+	)
+	return i, err
+}
+
+const getProfileReferralCodeSpecialById = `-- name: GetProfileReferralCodeSpecialById :one
+SELECT
+    prc.id, prc.profile_id, prc.code, prc.type, prc.is_active, prc.total_discount, prc.discount_type, prc.expired_days, prc.max_discount, prc.max_usage, prc.reward_per_referral, prc.created_at, prc.updated_at, prc.deleted_at, prc.starts_at, prc.ends_at, prc.allowed_product_types, prc.created_by_profile_id,
+    pr.name  AS owner_name,
+    pr.email AS owner_email,
+    (
+        SELECT COUNT(*)::int FROM referral_records rr
+        WHERE rr.profile_referral_code_id = prc.id
+            AND rr.status IN ('pending', 'success')
+            AND rr.deleted_at IS NULL
+    ) AS usage_count
+FROM profile_referral_codes prc
+JOIN profiles pr ON pr.id = prc.profile_id
+WHERE prc.id = $1
+    AND prc.type = 'special'
+    AND prc.deleted_at IS NULL
+`
+
+type GetProfileReferralCodeSpecialByIdRow struct {
+	ID                  int64         `json:"id"`
+	ProfileID           uuid.UUID     `json:"profile_id"`
+	Code                string        `json:"code"`
+	Type                ReferralType  `json:"type"`
+	IsActive            bool          `json:"is_active"`
+	TotalDiscount       int64         `json:"total_discount"`
+	DiscountType        DiscountType  `json:"discount_type"`
+	ExpiredDays         sql.NullInt32 `json:"expired_days"`
+	MaxDiscount         int64         `json:"max_discount"`
+	MaxUsage            sql.NullInt32 `json:"max_usage"`
+	RewardPerReferral   int64         `json:"reward_per_referral"`
+	CreatedAt           time.Time     `json:"created_at"`
+	UpdatedAt           time.Time     `json:"updated_at"`
+	DeletedAt           sql.NullTime  `json:"deleted_at"`
+	StartsAt            sql.NullTime  `json:"starts_at"`
+	EndsAt              sql.NullTime  `json:"ends_at"`
+	AllowedProductTypes []string      `json:"allowed_product_types"`
+	CreatedByProfileID  uuid.NullUUID `json:"created_by_profile_id"`
+	OwnerName           string        `json:"owner_name"`
+	OwnerEmail          string        `json:"owner_email"`
+	UsageCount          int32         `json:"usage_count"`
+}
+
+func (q *Queries) GetProfileReferralCodeSpecialById(ctx context.Context, id int64) (GetProfileReferralCodeSpecialByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getProfileReferralCodeSpecialById, id)
+	var i GetProfileReferralCodeSpecialByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Code,
+		&i.Type,
+		&i.IsActive,
+		&i.TotalDiscount,
+		&i.DiscountType,
+		&i.ExpiredDays,
+		&i.MaxDiscount,
+		&i.MaxUsage,
+		&i.RewardPerReferral,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.StartsAt,
+		&i.EndsAt,
+		pq.Array(&i.AllowedProductTypes),
+		&i.CreatedByProfileID,
+		&i.OwnerName,
+		&i.OwnerEmail,
+		&i.UsageCount,
+	)
+	return i, err
+}
+
+const lockProfileReferralCodeById = `-- name: LockProfileReferralCodeById :exec
+SELECT id FROM profile_referral_codes
+WHERE id = $1
+FOR UPDATE
+`
+
+// serialisasi pemakaian code agar max_usage (global cap) tidak terlewati
+func (q *Queries) LockProfileReferralCodeById(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, lockProfileReferralCodeById, id)
+	return err
+}
+
+const softDeleteProfileReferralCodeSpecial = `-- name: SoftDeleteProfileReferralCodeSpecial :one
+UPDATE profile_referral_codes
+SET deleted_at = NOW(), is_active = FALSE
+WHERE id = $1
+    AND type = 'special'
+    AND deleted_at IS NULL
+RETURNING id
+`
+
+func (q *Queries) SoftDeleteProfileReferralCodeSpecial(ctx context.Context, id int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, softDeleteProfileReferralCodeSpecial, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const updateProfileReferralCodeSpecial = `-- name: UpdateProfileReferralCodeSpecial :one
+UPDATE profile_referral_codes
+SET
+    profile_id = $1,
+    code = $2,
+    is_active = $3,
+    total_discount = $4,
+    discount_type = $5,
+    max_discount = $6,
+    max_usage = $7,
+    reward_per_referral = $8,
+    starts_at = $9,
+    ends_at = $10,
+    allowed_product_types = $11::text[]
+WHERE id = $12
+    AND type = 'special'
+    AND deleted_at IS NULL
+RETURNING id, profile_id, code, type, is_active, total_discount, discount_type, expired_days, max_discount, max_usage, reward_per_referral, created_at, updated_at, deleted_at, starts_at, ends_at, allowed_product_types, created_by_profile_id
+`
+
+type UpdateProfileReferralCodeSpecialParams struct {
+	ProfileID           uuid.UUID     `json:"profile_id"`
+	Code                string        `json:"code"`
+	IsActive            bool          `json:"is_active"`
+	TotalDiscount       int64         `json:"total_discount"`
+	DiscountType        DiscountType  `json:"discount_type"`
+	MaxDiscount         int64         `json:"max_discount"`
+	MaxUsage            sql.NullInt32 `json:"max_usage"`
+	RewardPerReferral   int64         `json:"reward_per_referral"`
+	StartsAt            sql.NullTime  `json:"starts_at"`
+	EndsAt              sql.NullTime  `json:"ends_at"`
+	AllowedProductTypes []string      `json:"allowed_product_types"`
+	ID                  int64         `json:"id"`
+}
+
+func (q *Queries) UpdateProfileReferralCodeSpecial(ctx context.Context, arg UpdateProfileReferralCodeSpecialParams) (ProfileReferralCode, error) {
+	row := q.db.QueryRowContext(ctx, updateProfileReferralCodeSpecial,
+		arg.ProfileID,
+		arg.Code,
+		arg.IsActive,
+		arg.TotalDiscount,
+		arg.DiscountType,
+		arg.MaxDiscount,
+		arg.MaxUsage,
+		arg.RewardPerReferral,
+		arg.StartsAt,
+		arg.EndsAt,
+		pq.Array(arg.AllowedProductTypes),
+		arg.ID,
+	)
+	var i ProfileReferralCode
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.Code,
+		&i.Type,
+		&i.IsActive,
+		&i.TotalDiscount,
+		&i.DiscountType,
+		&i.ExpiredDays,
+		&i.MaxDiscount,
+		&i.MaxUsage,
+		&i.RewardPerReferral,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.StartsAt,
+		&i.EndsAt,
+		pq.Array(&i.AllowedProductTypes),
+		&i.CreatedByProfileID,
 	)
 	return i, err
 }
@@ -0,0 +1,187 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: profile_two_factor.sql
+
+package entity
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const countUnusedProfileTwoFactorRecoveryCodes = `-- name: CountUnusedProfileTwoFactorRecoveryCodes :one
+SELECT COUNT(*) FROM profile_two_factor_recovery_codes
+WHERE profile_id = $1
+  AND used_at IS NULL
+`
+
+func (q *Queries) CountUnusedProfileTwoFactorRecoveryCodes(ctx context.Context, profileID uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countUnusedProfileTwoFactorRecoveryCodes, profileID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createProfileTwoFactorRecoveryCode = `-- name: CreateProfileTwoFactorRecoveryCode :exec
+INSERT INTO profile_two_factor_recovery_codes (profile_id, code_hash)
+VALUES ($1, $2)
+`
+
+type CreateProfileTwoFactorRecoveryCodeParams struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	CodeHash  string    `json:"code_hash"`
+}
+
+func (q *Queries) CreateProfileTwoFactorRecoveryCode(ctx context.Context, arg CreateProfileTwoFactorRecoveryCodeParams) error {
+	_, err := q.db.ExecContext(ctx, createProfileTwoFactorRecoveryCode, arg.ProfileID, arg.CodeHash)
+	return err
+}
+
+const deleteProfileTwoFactor = `-- name: DeleteProfileTwoFactor :exec
+DELETE FROM profile_two_factors
+WHERE profile_id = $1
+`
+
+func (q *Queries) DeleteProfileTwoFactor(ctx context.Context, profileID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteProfileTwoFactor, profileID)
+	return err
+}
+
+const deleteProfileTwoFactorRecoveryCodes = `-- name: DeleteProfileTwoFactorRecoveryCodes :exec
+DELETE FROM profile_two_factor_recovery_codes
+WHERE profile_id = $1
+`
+
+func (q *Queries) DeleteProfileTwoFactorRecoveryCodes(ctx context.Context, profileID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteProfileTwoFactorRecoveryCodes, profileID)
+	return err
+}
+
+const enableProfileTwoFactor = `-- name: EnableProfileTwoFactor :one
+UPDATE profile_two_factors
+SET enabled_at = now()
+WHERE profile_id = $1
+  AND enabled_at IS NULL
+RETURNING profile_id, secret_encrypted, last_used_step, enabled_at, created_at, updated_at
+`
+
+func (q *Queries) EnableProfileTwoFactor(ctx context.Context, profileID uuid.UUID) (ProfileTwoFactor, error) {
+	row := q.db.QueryRowContext(ctx, enableProfileTwoFactor, profileID)
+	var i ProfileTwoFactor
+	err := row.Scan(
+		&i.ProfileID,
+		&i.SecretEncrypted,
+		&i.LastUsedStep,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getProfileTwoFactor = `-- name: GetProfileTwoFactor :one
+SELECT profile_id, secret_encrypted, last_used_step, enabled_at, created_at, updated_at FROM profile_two_factors
+WHERE profile_id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetProfileTwoFactor(ctx context.Context, profileID uuid.UUID) (ProfileTwoFactor, error) {
+	row := q.db.QueryRowContext(ctx, getProfileTwoFactor, profileID)
+	var i ProfileTwoFactor
+	err := row.Scan(
+		&i.ProfileID,
+		&i.SecretEncrypted,
+		&i.LastUsedStep,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertPendingProfileTwoFactor = `-- name: UpsertPendingProfileTwoFactor :one
+INSERT INTO profile_two_factors (profile_id, secret_encrypted)
+VALUES ($1, $2)
+ON CONFLICT (profile_id) DO UPDATE
+SET secret_encrypted = EXCLUDED.secret_encrypted,
+    last_used_step = NULL
+WHERE profile_two_factors.enabled_at IS NULL
+RETURNING profile_id, secret_encrypted, last_used_step, enabled_at, created_at, updated_at
+`
+
+type UpsertPendingProfileTwoFactorParams struct {
+	ProfileID       uuid.UUID `json:"profile_id"`
+	SecretEncrypted string    `json:"secret_encrypted"`
+}
+
+// enroll ulang hanya boleh selama belum aktif (enabled_at NULL)
+func (q *Queries) UpsertPendingProfileTwoFactor(ctx context.Context, arg UpsertPendingProfileTwoFactorParams) (ProfileTwoFactor, error) {
+	row := q.db.QueryRowContext(ctx, upsertPendingProfileTwoFactor, arg.ProfileID, arg.SecretEncrypted)
+	var i ProfileTwoFactor
+	err := row.Scan(
+		&i.ProfileID,
+		&i.SecretEncrypted,
+		&i.LastUsedStep,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const useProfileTwoFactorRecoveryCode = `-- name: UseProfileTwoFactorRecoveryCode :one
+UPDATE profile_two_factor_recovery_codes
+SET used_at = now()
+WHERE profile_id = $1
+  AND code_hash = $2
+  AND used_at IS NULL
+RETURNING id, profile_id, code_hash, used_at, created_at
+`
+
+type UseProfileTwoFactorRecoveryCodeParams struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	CodeHash  string    `json:"code_hash"`
+}
+
+func (q *Queries) UseProfileTwoFactorRecoveryCode(ctx context.Context, arg UseProfileTwoFactorRecoveryCodeParams) (ProfileTwoFactorRecoveryCode, error) {
+	row := q.db.QueryRowContext(ctx, useProfileTwoFactorRecoveryCode, arg.ProfileID, arg.CodeHash)
+	var i ProfileTwoFactorRecoveryCode
+	err := row.Scan(
+		&i.ID,
+		&i.ProfileID,
+		&i.CodeHash,
+		&i.UsedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const useProfileTwoFactorStep = `-- name: UseProfileTwoFactorStep :one
+UPDATE profile_two_factors
+SET last_used_step = $1::bigint
+WHERE profile_id = $2
+  AND (last_used_step IS NULL OR last_used_step < $1::bigint)
+RETURNING profile_id, secret_encrypted, last_used_step, enabled_at, created_at, updated_at
+`
+
+type UseProfileTwoFactorStepParams struct {
+	Step      int64     `json:"step"`
+	ProfileID uuid.UUID `json:"profile_id"`
+}
+
+// atomic anti replay: step hanya bisa dipakai sekali & harus lebih baru
+func (q *Queries) UseProfileTwoFactorStep(ctx context.Context, arg UseProfileTwoFactorStepParams) (ProfileTwoFactor, error) {
+	row := q.db.QueryRowContext(ctx, useProfileTwoFactorStep, arg.Step, arg.ProfileID)
+	var i ProfileTwoFactor
+	err := row.Scan(
+		&i.ProfileID,
+		&i.SecretEncrypted,
+		&i.LastUsedStep,
+		&i.EnabledAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
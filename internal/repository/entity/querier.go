@@ -12,29 +12,52 @@ import (
 )
 
 type Querier interface {
+	CancelBusinessScheduledPost(ctx context.Context, arg CancelBusinessScheduledPostParams) (BusinessScheduledPost, error)
+	CheckActiveCreatorImageLicenseExists(ctx context.Context, arg CheckActiveCreatorImageLicenseExistsParams) (bool, error)
 	CheckBusinessUsedReferralCode(ctx context.Context, arg CheckBusinessUsedReferralCodeParams) (bool, error)
 	CheckProfileUsedReferralCode(ctx context.Context, arg CheckProfileUsedReferralCodeParams) (bool, error)
 	CheckSavedCreatorImageExists(ctx context.Context, arg CheckSavedCreatorImageExistsParams) (bool, error)
+	ClawbackAffiliatorWallet(ctx context.Context, arg ClawbackAffiliatorWalletParams) (AffiliatorWallet, error)
+	// pindahkan reserved -> total_out
+	CommitGenerativeTokenBalance(ctx context.Context, arg CommitGenerativeTokenBalanceParams) (GenerativeTokenBalance, error)
+	CompletePaymentHistoryRefund(ctx context.Context, arg CompletePaymentHistoryRefundParams) (PaymentHistoryRefund, error)
+	CountAllAffiliatorPayoutRequests(ctx context.Context, arg CountAllAffiliatorPayoutRequestsParams) (int64, error)
+	CountAllAffiliatorWalletTransactionsByWalletId(ctx context.Context, arg CountAllAffiliatorWalletTransactionsByWalletIdParams) (int64, error)
 	CountAllAppCreatorImageProductCategories(ctx context.Context, search interface{}) (int64, error)
 	CountAllAppCreatorImageTypeCategories(ctx context.Context, search interface{}) (int64, error)
 	CountAllAppSocialPlatforms(ctx context.Context, arg CountAllAppSocialPlatformsParams) (int64, error)
+	CountAllBusinessCreatorImageLicenses(ctx context.Context, arg CountAllBusinessCreatorImageLicensesParams) (int64, error)
+	CountAllCreatorEarningTransactionsByProfileId(ctx context.Context, arg CountAllCreatorEarningTransactionsByProfileIdParams) (int64, error)
 	CountAllCreatorImage(ctx context.Context, arg CountAllCreatorImageParams) (int64, error)
+	CountAllCreatorImageModerationQueue(ctx context.Context, arg CountAllCreatorImageModerationQueueParams) (int64, error)
+	CountAllExchangeRates(ctx context.Context, search interface{}) (int64, error)
 	CountAllGenerativeImageModels(ctx context.Context, arg CountAllGenerativeImageModelsParams) (int64, error)
 	CountAllGenerativeTextModels(ctx context.Context, arg CountAllGenerativeTextModelsParams) (int64, error)
 	CountAllPaymentHistories(ctx context.Context, arg CountAllPaymentHistoriesParams) (int64, error)
 	CountAllPaymentHistoriesByBusiness(ctx context.Context, arg CountAllPaymentHistoriesByBusinessParams) (int64, error)
 	CountAllPaymentMethods(ctx context.Context, arg CountAllPaymentMethodsParams) (int64, error)
+	CountAllProfileReferralCodesSpecial(ctx context.Context, arg CountAllProfileReferralCodesSpecialParams) (int64, error)
 	CountAllRSSCategory(ctx context.Context, search interface{}) (int64, error)
 	CountAllRSSFeed(ctx context.Context, arg CountAllRSSFeedParams) (int64, error)
 	CountAllTokenTransactionsByBusiness(ctx context.Context, arg CountAllTokenTransactionsByBusinessParams) (int64, error)
+	CountBusinessAuditEventsByBusinessRootID(ctx context.Context, arg CountBusinessAuditEventsByBusinessRootIDParams) (int64, error)
 	CountBusinessImageContentsByBusinessRootId(ctx context.Context, arg CountBusinessImageContentsByBusinessRootIdParams) (int64, error)
 	CountBusinessProductsByBusinessRootId(ctx context.Context, arg CountBusinessProductsByBusinessRootIdParams) (int64, error)
 	CountBusinessRssSubscriptionsByBusinessRootID(ctx context.Context, arg CountBusinessRssSubscriptionsByBusinessRootIDParams) (int64, error)
 	CountJoinedBusinessesByProfileID(ctx context.Context, arg CountJoinedBusinessesByProfileIDParams) (int64, error)
 	CountReferralCodeUsage(ctx context.Context, profileReferralCodeID int64) (int32, error)
 	CountSavedCreatorImageByBusinessId(ctx context.Context, arg CountSavedCreatorImageByBusinessIdParams) (int64, error)
+	CountUnusedProfileTwoFactorRecoveryCodes(ctx context.Context, profileID uuid.UUID) (int64, error)
+	CreateAffiliatorPayoutRequest(ctx context.Context, arg CreateAffiliatorPayoutRequestParams) (AffiliatorPayoutRequest, error)
+	CreateAffiliatorPayoutRequestHistory(ctx context.Context, arg CreateAffiliatorPayoutRequestHistoryParams) (AffiliatorPayoutRequestHistory, error)
+	// idempotent untuk reward & clawback (no rows = sudah pernah dicatat)
+	CreateAffiliatorWalletReferralTransaction(ctx context.Context, arg CreateAffiliatorWalletReferralTransactionParams) (AffiliatorWalletTransaction, error)
+	CreateAffiliatorWalletTransaction(ctx context.Context, arg CreateAffiliatorWalletTransactionParams) (AffiliatorWalletTransaction, error)
 	CreateAppSocialPlatform(ctx context.Context, arg CreateAppSocialPlatformParams) (AppSocialPlatform, error)
 	CreateAppSocialPlatformChange(ctx context.Context, arg CreateAppSocialPlatformChangeParams) (AppSocialPlatformChange, error)
+	CreateBusinessAuditEvent(ctx context.Context, arg CreateBusinessAuditEventParams) (BusinessAuditEvent, error)
+	// idempotent per payment (no rows = sudah pernah dibuat / business sudah punya lisensi aktif)
+	CreateBusinessCreatorImageLicense(ctx context.Context, arg CreateBusinessCreatorImageLicenseParams) (BusinessCreatorImageLicense, error)
 	CreateBusinessImageContent(ctx context.Context, arg CreateBusinessImageContentParams) (BusinessImageContent, error)
 	CreateBusinessKnowledge(ctx context.Context, arg CreateBusinessKnowledgeParams) (BusinessKnowledge, error)
 	CreateBusinessMember(ctx context.Context, arg CreateBusinessMemberParams) (BusinessMember, error)
@@ -43,45 +66,89 @@ type Querier interface {
 	CreateBusinessRole(ctx context.Context, arg CreateBusinessRoleParams) (BusinessRole, error)
 	CreateBusinessRoot(ctx context.Context) (int64, error)
 	CreateBusinessRssSubscription(ctx context.Context, arg CreateBusinessRssSubscriptionParams) (BusinessRssSubscription, error)
+	CreateBusinessScheduledPost(ctx context.Context, arg CreateBusinessScheduledPostParams) (BusinessScheduledPost, error)
+	// idempotent untuk sale & clawback per payment (no rows = sudah pernah dicatat)
+	CreateCreatorEarningTransaction(ctx context.Context, arg CreateCreatorEarningTransactionParams) (CreatorEarningTransaction, error)
 	CreateCreatorImage(ctx context.Context, arg CreateCreatorImageParams) (CreateCreatorImageRow, error)
+	CreateCreatorImageModeration(ctx context.Context, arg CreateCreatorImageModerationParams) (CreatorImageModeration, error)
+	CreateExchangeRate(ctx context.Context, arg CreateExchangeRateParams) (AppExchangeRate, error)
 	CreateGenerativeImageModel(ctx context.Context, arg CreateGenerativeImageModelParams) (AppGenerativeImageModel, error)
 	CreateGenerativeImageModelChange(ctx context.Context, arg CreateGenerativeImageModelChangeParams) (AppGenerativeImageModelChange, error)
 	CreateGenerativeTextModel(ctx context.Context, arg CreateGenerativeTextModelParams) (AppGenerativeTextModel, error)
 	CreateGenerativeTextModelChange(ctx context.Context, arg CreateGenerativeTextModelChangeParams) (AppGenerativeTextModelChange, error)
-	CreateGenerativeTokenImageTransaction(ctx context.Context, arg CreateGenerativeTokenImageTransactionParams) (GenerativeTokenImageTransaction, error)
+	CreateGenerativeTokenReservation(ctx context.Context, arg CreateGenerativeTokenReservationParams) (GenerativeTokenReservation, error)
+	CreateGenerativeTokenTransaction(ctx context.Context, arg CreateGenerativeTokenTransactionParams) (GenerativeTokenTransaction, error)
 	CreatePaymentHistory(ctx context.Context, arg CreatePaymentHistoryParams) (PaymentHistory, error)
 	CreatePaymentHistoryAction(ctx context.Context, arg CreatePaymentHistoryActionParams) (PaymentHistoryAction, error)
+	CreatePaymentHistoryRefund(ctx context.Context, arg CreatePaymentHistoryRefundParams) (PaymentHistoryRefund, error)
+	CreatePaymentInvoice(ctx context.Context, arg CreatePaymentInvoiceParams) (PaymentInvoice, error)
 	CreatePaymentMethod(ctx context.Context, arg CreatePaymentMethodParams) (AppPaymentMethod, error)
 	CreatePaymentMethodChange(ctx context.Context, arg CreatePaymentMethodChangeParams) (AppPaymentMethodChange, error)
+	CreatePostDeliveryAttempt(ctx context.Context, arg CreatePostDeliveryAttemptParams) (PostDeliveryAttempt, error)
 	CreateProfile(ctx context.Context, arg CreateProfileParams) (Profile, error)
 	CreateProfileReferralCode(ctx context.Context, arg CreateProfileReferralCodeParams) (ProfileReferralCode, error)
+	CreateProfileReferralCodeSpecial(ctx context.Context, arg CreateProfileReferralCodeSpecialParams) (ProfileReferralCode, error)
+	CreateProfileTwoFactorRecoveryCode(ctx context.Context, arg CreateProfileTwoFactorRecoveryCodeParams) error
 	CreateReferralRecord(ctx context.Context, arg CreateReferralRecordParams) (ReferralRecord, error)
 	CreateSavedCreatorImage(ctx context.Context, arg CreateSavedCreatorImageParams) (BusinessSavedTemplateCreatorImage, error)
+	// dipakai saat lisensi template dibuat (template yang dibeli otomatis tersimpan)
+	CreateSavedCreatorImageIfNotExists(ctx context.Context, arg CreateSavedCreatorImageIfNotExistsParams) error
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
+	CreditAffiliatorWalletEarned(ctx context.Context, arg CreditAffiliatorWalletEarnedParams) (AffiliatorWallet, error)
+	// tambah total_in (buat row snapshot jika belum ada)
+	CreditGenerativeTokenBalance(ctx context.Context, arg CreditGenerativeTokenBalanceParams) (GenerativeTokenBalance, error)
+	// debit langsung tanpa reservation (no rows = saldo tidak cukup)
+	DebitGenerativeTokenBalance(ctx context.Context, arg DebitGenerativeTokenBalanceParams) (GenerativeTokenBalance, error)
 	DeleteAppSocialPlatform(ctx context.Context, id int64) (AppSocialPlatform, error)
 	DeletePaymentHistoryActionsByPaymentId(ctx context.Context, paymentHistoryID uuid.UUID) error
+	DeleteProfileTwoFactor(ctx context.Context, profileID uuid.UUID) error
+	DeleteProfileTwoFactorRecoveryCodes(ctx context.Context, profileID uuid.UUID) error
+	DeleteUserByProfileIdAndProvider(ctx context.Context, arg DeleteUserByProfileIdAndProviderParams) (int64, error)
+	// credential dihapus saat disconnect
+	DisconnectBusinessSocialAccount(ctx context.Context, arg DisconnectBusinessSocialAccountParams) (BusinessSocialAccount, error)
 	EditBusinessRssSubscription(ctx context.Context, arg EditBusinessRssSubscriptionParams) (BusinessRssSubscription, error)
+	EnableProfileTwoFactor(ctx context.Context, profileID uuid.UUID) (ProfileTwoFactor, error)
 	ExistsBusinessRssSubscriptionByBusinessRootIDAndFeedIDExceptID(ctx context.Context, arg ExistsBusinessRssSubscriptionByBusinessRootIDAndFeedIDExceptIDParams) (bool, error)
+	FailPaymentHistoryRefund(ctx context.Context, id int64) error
+	// post yang tertahan di 'publishing' (worker crash di antara claim & enqueue deliver, atau task deliver hilang).
+	// updated_at = waktu claim, attempt deliver yang gagal tidak mengubah row ini.
+	FailStuckBusinessScheduledPosts(ctx context.Context, arg FailStuckBusinessScheduledPostsParams) ([]BusinessScheduledPost, error)
+	GetAffiliatorPayoutRequestById(ctx context.Context, id int64) (GetAffiliatorPayoutRequestByIdRow, error)
+	GetAffiliatorPayoutRequestByIdForUpdate(ctx context.Context, id int64) (AffiliatorPayoutRequest, error)
+	GetAffiliatorPayoutRequestHistoriesByPayoutRequestId(ctx context.Context, affiliatorPayoutRequestID int64) ([]GetAffiliatorPayoutRequestHistoriesByPayoutRequestIdRow, error)
+	GetAffiliatorWalletByProfileId(ctx context.Context, profileID uuid.UUID) (AffiliatorWallet, error)
+	GetAffiliatorWalletTransactionByReferralRecordIdAndType(ctx context.Context, arg GetAffiliatorWalletTransactionByReferralRecordIdAndTypeParams) (AffiliatorWalletTransaction, error)
+	// profile_id null = semua affiliator (admin queue)
+	GetAllAffiliatorPayoutRequests(ctx context.Context, arg GetAllAffiliatorPayoutRequestsParams) ([]GetAllAffiliatorPayoutRequestsRow, error)
+	GetAllAffiliatorWalletTransactionsByWalletId(ctx context.Context, arg GetAllAffiliatorWalletTransactionsByWalletIdParams) ([]AffiliatorWalletTransaction, error)
 	GetAllAppCreatorImageProductCategories(ctx context.Context, arg GetAllAppCreatorImageProductCategoriesParams) ([]GetAllAppCreatorImageProductCategoriesRow, error)
 	GetAllAppCreatorImageTypeCategories(ctx context.Context, arg GetAllAppCreatorImageTypeCategoriesParams) ([]GetAllAppCreatorImageTypeCategoriesRow, error)
 	GetAllAppSocialPlatforms(ctx context.Context, arg GetAllAppSocialPlatformsParams) ([]AppSocialPlatform, error)
+	GetAllBusinessCreatorImageLicenses(ctx context.Context, arg GetAllBusinessCreatorImageLicensesParams) ([]GetAllBusinessCreatorImageLicensesRow, error)
+	GetAllCreatorEarningTransactionsByProfileId(ctx context.Context, arg GetAllCreatorEarningTransactionsByProfileIdParams) ([]GetAllCreatorEarningTransactionsByProfileIdRow, error)
 	GetAllCreatorImage(ctx context.Context, arg GetAllCreatorImageParams) ([]GetAllCreatorImageRow, error)
+	// antrian review admin: creator image published & tidak dihapus
+	GetAllCreatorImageModerationQueue(ctx context.Context, arg GetAllCreatorImageModerationQueueParams) ([]GetAllCreatorImageModerationQueueRow, error)
+	GetAllExchangeRates(ctx context.Context, arg GetAllExchangeRatesParams) ([]AppExchangeRate, error)
 	GetAllGenerativeImageModels(ctx context.Context, arg GetAllGenerativeImageModelsParams) ([]AppGenerativeImageModel, error)
 	GetAllGenerativeTextModels(ctx context.Context, arg GetAllGenerativeTextModelsParams) ([]AppGenerativeTextModel, error)
 	GetAllPaymentHistories(ctx context.Context, arg GetAllPaymentHistoriesParams) ([]PaymentHistory, error)
 	GetAllPaymentHistoriesByBusiness(ctx context.Context, arg GetAllPaymentHistoriesByBusinessParams) ([]PaymentHistory, error)
 	GetAllPaymentMethods(ctx context.Context, arg GetAllPaymentMethodsParams) ([]AppPaymentMethod, error)
+	GetAllProfileReferralCodesSpecial(ctx context.Context, arg GetAllProfileReferralCodesSpecialParams) ([]GetAllProfileReferralCodesSpecialRow, error)
 	GetAllRSSCategory(ctx context.Context, arg GetAllRSSCategoryParams) ([]AppRssCategory, error)
 	GetAllRSSFeed(ctx context.Context, arg GetAllRSSFeedParams) ([]AppRssFeed, error)
 	// internal/repository/queries/business_saved_template_creator_image.sql
 	GetAllSavedCreatorImageByBusinessId(ctx context.Context, arg GetAllSavedCreatorImageByBusinessIdParams) ([]GetAllSavedCreatorImageByBusinessIdRow, error)
-	GetAllTokenTransactionsByBusiness(ctx context.Context, arg GetAllTokenTransactionsByBusinessParams) ([]GenerativeTokenImageTransaction, error)
+	GetAllTokenTransactionsByBusiness(ctx context.Context, arg GetAllTokenTransactionsByBusinessParams) ([]GenerativeTokenTransaction, error)
 	GetAppCreatorImageProductCategoriesByIds(ctx context.Context, ids []int64) ([]int64, error)
 	GetAppCreatorImageTypeCategoriesByIds(ctx context.Context, ids []int64) ([]int64, error)
 	GetAppProfileReferralRules(ctx context.Context) (AppProfileReferralRule, error)
 	GetAppSocialPlatformById(ctx context.Context, id int64) (AppSocialPlatform, error)
 	GetAppSocialPlatformByPlatformCode(ctx context.Context, platformCode SocialPlatformType) (AppSocialPlatform, error)
 	GetAppTokenProductByTypeCurrency(ctx context.Context, arg GetAppTokenProductByTypeCurrencyParams) (AppTokenProduct, error)
+	GetBusinessAuditEventsByBusinessRootID(ctx context.Context, arg GetBusinessAuditEventsByBusinessRootIDParams) ([]GetBusinessAuditEventsByBusinessRootIDRow, error)
+	GetBusinessImageContentByIdAndBusinessRootId(ctx context.Context, arg GetBusinessImageContentByIdAndBusinessRootIdParams) (BusinessImageContent, error)
 	GetBusinessImageContentsByBusinessRootId(ctx context.Context, arg GetBusinessImageContentsByBusinessRootIdParams) ([]BusinessImageContent, error)
 	GetBusinessKnowledgeByBusinessRootID(ctx context.Context, businessRootID int64) (GetBusinessKnowledgeByBusinessRootIDRow, error)
 	GetBusinessMemberStatusHistoryByMemberID(ctx context.Context, memberID int64) (GetBusinessMemberStatusHistoryByMemberIDRow, error)
@@ -93,8 +160,23 @@ type Querier interface {
 	GetBusinessRssSubscriptionByIDAndBusinessRootID(ctx context.Context, arg GetBusinessRssSubscriptionByIDAndBusinessRootIDParams) (BusinessRssSubscription, error)
 	GetBusinessRssSubscriptionById(ctx context.Context, id int64) (BusinessRssSubscription, error)
 	GetBusinessRssSubscriptionsByBusinessRootID(ctx context.Context, arg GetBusinessRssSubscriptionsByBusinessRootIDParams) ([]GetBusinessRssSubscriptionsByBusinessRootIDRow, error)
+	GetBusinessScheduledPostById(ctx context.Context, id int64) (BusinessScheduledPost, error)
+	GetBusinessScheduledPostByIdAndBusinessRootId(ctx context.Context, arg GetBusinessScheduledPostByIdAndBusinessRootIdParams) (BusinessScheduledPost, error)
+	// calendar: semua jadwal business dalam range [range_start, range_end)
+	GetBusinessScheduledPostsByRange(ctx context.Context, arg GetBusinessScheduledPostsByRangeParams) ([]BusinessScheduledPost, error)
+	GetBusinessSocialAccountByIdAndBusinessRootId(ctx context.Context, arg GetBusinessSocialAccountByIdAndBusinessRootIdParams) (BusinessSocialAccount, error)
+	GetBusinessSocialAccountByPlatform(ctx context.Context, arg GetBusinessSocialAccountByPlatformParams) (BusinessSocialAccount, error)
+	GetBusinessSocialAccountsByBusinessRootId(ctx context.Context, businessRootID int64) ([]BusinessSocialAccount, error)
 	GetBusinessTimezonePrefByBusinessRootId(ctx context.Context, businessRootID int64) (BusinessTimezonePref, error)
+	GetCreatorEarningSummaryByProfileId(ctx context.Context, profileID uuid.UUID) (GetCreatorEarningSummaryByProfileIdRow, error)
+	GetCreatorEarningTransactionByPaymentIdAndType(ctx context.Context, arg GetCreatorEarningTransactionByPaymentIdAndTypeParams) (CreatorEarningTransaction, error)
 	GetCreatorImageById(ctx context.Context, id int64) (CreatorImage, error)
+	GetCreatorImageByIdForUpdate(ctx context.Context, id int64) (CreatorImage, error)
+	GetCreatorImageModerationsByCreatorImageId(ctx context.Context, creatorImageID int64) ([]GetCreatorImageModerationsByCreatorImageIdRow, error)
+	// rate terbaru yang sudah berlaku pada sqlc.arg(at) untuk pair base -> quote
+	GetEffectiveExchangeRate(ctx context.Context, arg GetEffectiveExchangeRateParams) (AppExchangeRate, error)
+	GetExchangeRateById(ctx context.Context, id int64) (AppExchangeRate, error)
+	GetExpiredGenerativeTokenReservationIds(ctx context.Context) ([]int64, error)
 	GetGenerativeImageModelById(ctx context.Context, id int64) (AppGenerativeImageModel, error)
 	GetGenerativeImageModelByIdAdmin(ctx context.Context, id int64) (AppGenerativeImageModel, error)
 	GetGenerativeImageModelByIdUser(ctx context.Context, id int64) (AppGenerativeImageModel, error)
@@ -107,7 +189,14 @@ type Querier interface {
 	GetGenerativeTextModelByModel(ctx context.Context, model string) (AppGenerativeTextModel, error)
 	GetGenerativeTextModelByModelAdmin(ctx context.Context, model string) (AppGenerativeTextModel, error)
 	GetGenerativeTextModelByModelUser(ctx context.Context, model string) (AppGenerativeTextModel, error)
-	GetGenerativeTokenImageTransactionByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.NullUUID) (GenerativeTokenImageTransaction, error)
+	GetGenerativeTokenBalanceByBusinessRootId(ctx context.Context, arg GetGenerativeTokenBalanceByBusinessRootIdParams) (GenerativeTokenBalance, error)
+	// hitung ulang saldo dari transaksi & reservation aktif lalu bandingkan dengan snapshot (per business & token_type)
+	GetGenerativeTokenBalanceDrifts(ctx context.Context) ([]GetGenerativeTokenBalanceDriftsRow, error)
+	// hitung ulang saldo satu business & token_type dari transaksi & reservation aktif
+	GetGenerativeTokenExpectedBalanceByBusinessRootId(ctx context.Context, arg GetGenerativeTokenExpectedBalanceByBusinessRootIdParams) (GetGenerativeTokenExpectedBalanceByBusinessRootIdRow, error)
+	GetGenerativeTokenReservationByIdForUpdate(ctx context.Context, id int64) (GenerativeTokenReservation, error)
+	// token 'in' hasil payment (payment yang di-refund juga punya token 'out')
+	GetGenerativeTokenTransactionByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.NullUUID) (GenerativeTokenTransaction, error)
 	GetJoinedBusinessesByProfileID(ctx context.Context, arg GetJoinedBusinessesByProfileIDParams) ([]GetJoinedBusinessesByProfileIDRow, error)
 	GetMemberByEmailAndBusinessRootId(ctx context.Context, arg GetMemberByEmailAndBusinessRootIdParams) (GetMemberByEmailAndBusinessRootIdRow, error)
 	GetMemberByProfileIdAndBusinessRootId(ctx context.Context, arg GetMemberByProfileIdAndBusinessRootIdParams) (BusinessMember, error)
@@ -115,33 +204,76 @@ type Querier interface {
 	GetMembersByBusinessRootIDWithStatus(ctx context.Context, arg GetMembersByBusinessRootIDWithStatusParams) ([]GetMembersByBusinessRootIDWithStatusRow, error)
 	GetMembersByBusinessRootIDs(ctx context.Context, businessRootIds []int64) ([]GetMembersByBusinessRootIDsRow, error)
 	GetPaymentHistoryActionsByPaymentId(ctx context.Context, paymentHistoryID uuid.UUID) ([]PaymentHistoryAction, error)
+	// transaction id hanya unik per gateway (dipakai webhook)
+	GetPaymentHistoryByGatewayTransactionId(ctx context.Context, arg GetPaymentHistoryByGatewayTransactionIdParams) (PaymentHistory, error)
 	GetPaymentHistoryById(ctx context.Context, id uuid.UUID) (PaymentHistory, error)
 	GetPaymentHistoryByIdAndBusiness(ctx context.Context, arg GetPaymentHistoryByIdAndBusinessParams) (PaymentHistory, error)
 	GetPaymentHistoryByIdAndProfile(ctx context.Context, arg GetPaymentHistoryByIdAndProfileParams) (PaymentHistory, error)
-	GetPaymentHistoryByMidtransTransactionId(ctx context.Context, midtransTransactionID sql.NullString) (PaymentHistory, error)
+	GetPaymentHistoryByIdForUpdate(ctx context.Context, id uuid.UUID) (PaymentHistory, error)
+	GetPaymentHistoryRefundByRefundKeyForUpdate(ctx context.Context, refundKey sql.NullString) (PaymentHistoryRefund, error)
+	GetPaymentHistoryRefundsByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.UUID) ([]PaymentHistoryRefund, error)
+	// data bill to untuk invoice (nama business bisa null jika business knowledge belum diisi)
+	GetPaymentInvoiceBillTo(ctx context.Context, paymentHistoryID uuid.UUID) (GetPaymentInvoiceBillToRow, error)
+	GetPaymentInvoiceByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.UUID) (PaymentInvoice, error)
 	GetPaymentMethodByCode(ctx context.Context, code string) (AppPaymentMethod, error)
 	GetPaymentMethodByCodeAdmin(ctx context.Context, code string) (AppPaymentMethod, error)
 	GetPaymentMethodByCodeUser(ctx context.Context, code string) (AppPaymentMethod, error)
 	GetPaymentMethodById(ctx context.Context, id int64) (AppPaymentMethod, error)
 	GetPaymentMethodByIdAdmin(ctx context.Context, id int64) (AppPaymentMethod, error)
 	GetPaymentMethodByIdUser(ctx context.Context, id int64) (AppPaymentMethod, error)
+	// wajib dipanggil di dalam transaction setelah lock payment
+	GetPendingPaymentHistoryRefundsByPaymentHistoryId(ctx context.Context, paymentHistoryID uuid.UUID) ([]PaymentHistoryRefund, error)
+	GetPostDeliveryAttemptsByScheduledPostId(ctx context.Context, arg GetPostDeliveryAttemptsByScheduledPostIdParams) ([]PostDeliveryAttempt, error)
 	GetProfileByEmail(ctx context.Context, email string) (Profile, error)
 	GetProfileById(ctx context.Context, id uuid.UUID) (Profile, error)
+	// Lock row profile agar perubahan login provider (link / unlink) tidak balapan
+	GetProfileByIdForUpdate(ctx context.Context, id uuid.UUID) (Profile, error)
 	GetProfileReferralCodeByCode(ctx context.Context, code string) (ProfileReferralCode, error)
 	GetProfileReferralCodeByProfileIdBasic(ctx context.Context, profileID uuid.UUID) (ProfileReferralCode, error)
+	GetProfileReferralCodeSpecialById(ctx context.Context, id int64) (GetProfileReferralCodeSpecialByIdRow, error)
+	GetProfileTwoFactor(ctx context.Context, profileID uuid.UUID) (ProfileTwoFactor, error)
 	GetPublicPaymentHistoryActionsByPaymentId(ctx context.Context, paymentHistoryID uuid.UUID) ([]PaymentHistoryAction, error)
 	GetReferralRecordById(ctx context.Context, id int64) (ReferralRecord, error)
+	// owner = profile pemilik referral code (penerima reward)
+	GetReferralRecordWithOwnerById(ctx context.Context, id int64) (GetReferralRecordWithOwnerByIdRow, error)
 	GetRssFeedById(ctx context.Context, id int64) (AppRssFeed, error)
 	GetSavedCreatorImageByBusinessAndCreatorImage(ctx context.Context, arg GetSavedCreatorImageByBusinessAndCreatorImageParams) (BusinessSavedTemplateCreatorImage, error)
+	// payment pending yang dibuat sebelum pending_before (kandidat reconcile jika webhook hilang)
+	GetStalePendingPaymentHistories(ctx context.Context, arg GetStalePendingPaymentHistoriesParams) ([]PaymentHistory, error)
+	// hanya payment product token (image / video / livestream)
 	GetSuccessPaymentIdsWithoutTokenTransaction(ctx context.Context, paymentIds []uuid.UUID) ([]GetSuccessPaymentIdsWithoutTokenTransactionRow, error)
 	GetUploadedImageByHashkey(ctx context.Context, hashkey string) (UploadedImage, error)
 	GetUserByEmailProfile(ctx context.Context, email string) ([]GetUserByEmailProfileRow, error)
 	GetUserById(ctx context.Context, id uuid.UUID) (User, error)
 	HardDeleteBusinessRssSubscriptionByID(ctx context.Context, id int64) error
+	// atomic: hanya berhasil jika available cukup (no rows = saldo tidak cukup)
+	HoldAffiliatorWalletBalance(ctx context.Context, arg HoldAffiliatorWalletBalanceParams) (AffiliatorWallet, error)
 	InsertAppProfileReferralChange(ctx context.Context, arg InsertAppProfileReferralChangeParams) (AppProfileReferralChange, error)
 	InsertUploadedImage(ctx context.Context, arg InsertUploadedImageParams) (InsertUploadedImageRow, error)
 	ListUsersByProfileId(ctx context.Context, profileID uuid.UUID) ([]User, error)
+	LockGenerativeTokenBalanceByBusinessRootId(ctx context.Context, arg LockGenerativeTokenBalanceByBusinessRootIdParams) error
+	// serialisasi pemakaian code agar max_usage (global cap) tidak terlewati
+	LockProfileReferralCodeById(ctx context.Context, id int64) error
+	MarkBusinessScheduledPostFailed(ctx context.Context, arg MarkBusinessScheduledPostFailedParams) (BusinessScheduledPost, error)
+	MarkBusinessScheduledPostPublished(ctx context.Context, arg MarkBusinessScheduledPostPublishedParams) (BusinessScheduledPost, error)
+	// claim jadwal untuk dipublish (idempotent: hanya jika status masih 'scheduled' dan waktunya sama)
+	MarkBusinessScheduledPostPublishing(ctx context.Context, arg MarkBusinessScheduledPostPublishingParams) (BusinessScheduledPost, error)
+	MarkBusinessSocialAccountExpired(ctx context.Context, id int64) (BusinessSocialAccount, error)
+	// increment atomik per tahun (wajib dipanggil di dalam transaction agar nomor tidak loncat saat gagal)
+	NextPaymentInvoiceSequence(ctx context.Context, year int32) (int32, error)
+	// refund token 'out' (ex: provider gagal generate), soft delete agar tidak terhitung di SUM
+	RefundGenerativeTokenTransaction(ctx context.Context, id int64) (GenerativeTokenTransaction, error)
+	// payout request rejected / canceled, saldo kembali ke available
+	ReleaseAffiliatorWalletHold(ctx context.Context, arg ReleaseAffiliatorWalletHoldParams) (AffiliatorWallet, error)
+	ReleaseGenerativeTokenBalance(ctx context.Context, arg ReleaseGenerativeTokenBalanceParams) (GenerativeTokenBalance, error)
+	// atomic: hanya berhasil jika available cukup (no rows = saldo tidak cukup)
+	ReserveGenerativeTokenBalance(ctx context.Context, arg ReserveGenerativeTokenBalanceParams) (GenerativeTokenBalance, error)
+	// kembalikan token 'out' yang di-refund
+	RevertGenerativeTokenBalanceOut(ctx context.Context, arg RevertGenerativeTokenBalanceOutParams) (GenerativeTokenBalance, error)
+	RevokeBusinessCreatorImageLicenseByPaymentId(ctx context.Context, paymentHistoryID uuid.UUID) (BusinessCreatorImageLicense, error)
 	SetBusinessMemberAnsweredAt(ctx context.Context, id int64) (BusinessMember, error)
+	// dipakai reconcile untuk menimpa snapshot dengan hasil hitung ulang
+	SetGenerativeTokenBalance(ctx context.Context, arg SetGenerativeTokenBalanceParams) (GenerativeTokenBalance, error)
 	SoftDeleteBusinessImageContentByBusinessImageContentId(ctx context.Context, id int64) (BusinessImageContent, error)
 	SoftDeleteBusinessKnowledgeByBusinessRootID(ctx context.Context, businessRootID int64) (int64, error)
 	SoftDeleteBusinessMemberByBusinessRootID(ctx context.Context, businessRootID int64) (int64, error)
@@ -150,31 +282,60 @@ type Querier interface {
 	SoftDeleteBusinessRoleByBusinessRootID(ctx context.Context, businessRootID int64) (int64, error)
 	SoftDeleteBusinessRoot(ctx context.Context, id int64) (int64, error)
 	SoftDeleteCreatorImage(ctx context.Context, id int64) error
+	// hanya rate yang belum berlaku (effective_at > now) yang boleh dihapus
+	SoftDeleteExchangeRate(ctx context.Context, arg SoftDeleteExchangeRateParams) (AppExchangeRate, error)
 	SoftDeleteGenerativeImageModel(ctx context.Context, id int64) (AppGenerativeImageModel, error)
 	SoftDeleteGenerativeTextModel(ctx context.Context, id int64) (AppGenerativeTextModel, error)
 	SoftDeletePaymentMethod(ctx context.Context, id int64) (AppPaymentMethod, error)
+	SoftDeleteProfileReferralCodeSpecial(ctx context.Context, id int64) (int64, error)
 	SoftDeleteSavedCreatorImage(ctx context.Context, arg SoftDeleteSavedCreatorImageParams) error
 	SumTokenByBusinessAndType(ctx context.Context, arg SumTokenByBusinessAndTypeParams) (int64, error)
+	// hanya request 'pending' yang bisa berubah status (no rows = sudah diproses)
+	UpdateAffiliatorPayoutRequestStatus(ctx context.Context, arg UpdateAffiliatorPayoutRequestStatusParams) (AffiliatorPayoutRequest, error)
 	UpdateAppSocialPlatform(ctx context.Context, arg UpdateAppSocialPlatformParams) (AppSocialPlatform, error)
 	UpdateBusinessImageContent(ctx context.Context, arg UpdateBusinessImageContentParams) (BusinessImageContent, error)
+	UpdateBusinessImageContentCaption(ctx context.Context, arg UpdateBusinessImageContentCaptionParams) (BusinessImageContent, error)
 	UpdateBusinessMemberRole(ctx context.Context, arg UpdateBusinessMemberRoleParams) (BusinessMember, error)
 	UpdateBusinessMemberStatus(ctx context.Context, arg UpdateBusinessMemberStatusParams) (BusinessMember, error)
 	UpdateBusinessProduct(ctx context.Context, arg UpdateBusinessProductParams) (BusinessProduct, error)
+	// hanya jadwal yang masih 'scheduled' yang boleh diubah
+	UpdateBusinessScheduledPost(ctx context.Context, arg UpdateBusinessScheduledPostParams) (BusinessScheduledPost, error)
+	UpdateBusinessSocialAccountToken(ctx context.Context, arg UpdateBusinessSocialAccountTokenParams) (BusinessSocialAccount, error)
 	UpdateCreatorImage(ctx context.Context, arg UpdateCreatorImageParams) (UpdateCreatorImageRow, error)
+	// banned_reason hanya diisi saat status banned
+	UpdateCreatorImageModeration(ctx context.Context, arg UpdateCreatorImageModerationParams) (CreatorImage, error)
+	// hanya rate yang belum berlaku (effective_at > now) yang boleh diubah
+	UpdateExchangeRate(ctx context.Context, arg UpdateExchangeRateParams) (AppExchangeRate, error)
 	UpdateGenerativeImageModel(ctx context.Context, arg UpdateGenerativeImageModelParams) (AppGenerativeImageModel, error)
 	UpdateGenerativeTextModel(ctx context.Context, arg UpdateGenerativeTextModelParams) (AppGenerativeTextModel, error)
+	UpdateGenerativeTokenReservationStatus(ctx context.Context, arg UpdateGenerativeTokenReservationStatusParams) (GenerativeTokenReservation, error)
 	UpdateManyBusinessMemberStatus(ctx context.Context, arg UpdateManyBusinessMemberStatusParams) error
 	UpdatePaymentHistoryMidtransId(ctx context.Context, arg UpdatePaymentHistoryMidtransIdParams) (PaymentHistory, error)
+	// refunded_amount kumulatif, payment_refunded_at diisi saat refund pertama
+	UpdatePaymentHistoryRefund(ctx context.Context, arg UpdatePaymentHistoryRefundParams) (PaymentHistory, error)
 	UpdatePaymentHistoryStatus(ctx context.Context, arg UpdatePaymentHistoryStatusParams) (PaymentHistory, error)
 	UpdatePaymentMethod(ctx context.Context, arg UpdatePaymentMethodParams) (AppPaymentMethod, error)
 	UpdateProfile(ctx context.Context, arg UpdateProfileParams) (Profile, error)
+	UpdateProfileEmail(ctx context.Context, arg UpdateProfileEmailParams) (Profile, error)
+	UpdateProfileReferralCodeSpecial(ctx context.Context, arg UpdateProfileReferralCodeSpecialParams) (ProfileReferralCode, error)
 	UpdateReferralRecordStatus(ctx context.Context, arg UpdateReferralRecordStatusParams) (ReferralRecord, error)
 	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error)
+	// buat wallet jika belum ada, selalu return row (lock row sampai tx selesai)
+	UpsertAffiliatorWalletByProfileId(ctx context.Context, arg UpsertAffiliatorWalletByProfileIdParams) (AffiliatorWallet, error)
 	UpsertAppProfileReferralRules(ctx context.Context, arg UpsertAppProfileReferralRulesParams) (AppProfileReferralRule, error)
 	UpsertBusinessKnowledgeByBusinessRootID(ctx context.Context, arg UpsertBusinessKnowledgeByBusinessRootIDParams) (BusinessKnowledge, error)
 	UpsertBusinessRoleByBusinessRootID(ctx context.Context, arg UpsertBusinessRoleByBusinessRootIDParams) (BusinessRole, error)
+	// connect ulang platform yang sama akan menimpa credential lama
+	UpsertBusinessSocialAccount(ctx context.Context, arg UpsertBusinessSocialAccountParams) (BusinessSocialAccount, error)
 	UpsertBusinessTimezonePref(ctx context.Context, arg UpsertBusinessTimezonePrefParams) (BusinessTimezonePref, error)
+	// enroll ulang hanya boleh selama belum aktif (enabled_at NULL)
+	UpsertPendingProfileTwoFactor(ctx context.Context, arg UpsertPendingProfileTwoFactorParams) (ProfileTwoFactor, error)
+	UseProfileTwoFactorRecoveryCode(ctx context.Context, arg UseProfileTwoFactorRecoveryCodeParams) (ProfileTwoFactorRecoveryCode, error)
+	// atomic anti replay: step hanya bisa dipakai sekali & harus lebih baru
+	UseProfileTwoFactorStep(ctx context.Context, arg UseProfileTwoFactorStepParams) (ProfileTwoFactor, error)
 	VerifyUser(ctx context.Context, id uuid.UUID) (User, error)
+	// payout request approved, pindahkan on_hold -> total_withdrawn
+	WithdrawAffiliatorWalletHold(ctx context.Context, arg WithdrawAffiliatorWalletHoldParams) (AffiliatorWallet, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -8,6 +8,7 @@ package entity
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -185,6 +186,65 @@ func (q *Queries) GetReferralRecordById(ctx context.Context, id int64) (Referral
 	return i, err
 }
 
+const getReferralRecordWithOwnerById = `-- name: GetReferralRecordWithOwnerById :one
+SELECT rr.id, rr.consumer_profile_id, rr.business_root_id, rr.profile_referral_code_id, rr.record_type, rr.record_total_discount, rr.record_discount_type, rr.record_expired_days, rr.record_max_discount, rr.record_max_usage, rr.record_reward_per_referral, rr.discount_amount_granted, rr.discount_currency, rr.reward_amount_granted, rr.reward_currency, rr.status, rr.created_at, rr.updated_at, rr.deleted_at, prc.profile_id AS owner_profile_id
+FROM referral_records rr
+JOIN profile_referral_codes prc ON prc.id = rr.profile_referral_code_id
+WHERE rr.id = $1 AND rr.deleted_at IS NULL
+`
+
+type GetReferralRecordWithOwnerByIdRow struct {
+	ID                      int64                `json:"id"`
+	ConsumerProfileID       uuid.UUID            `json:"consumer_profile_id"`
+	BusinessRootID          int64                `json:"business_root_id"`
+	ProfileReferralCodeID   int64                `json:"profile_referral_code_id"`
+	RecordType              ReferralType         `json:"record_type"`
+	RecordTotalDiscount     int64                `json:"record_total_discount"`
+	RecordDiscountType      DiscountType         `json:"record_discount_type"`
+	RecordExpiredDays       sql.NullInt32        `json:"record_expired_days"`
+	RecordMaxDiscount       int64                `json:"record_max_discount"`
+	RecordMaxUsage          sql.NullInt32        `json:"record_max_usage"`
+	RecordRewardPerReferral int64                `json:"record_reward_per_referral"`
+	DiscountAmountGranted   int64                `json:"discount_amount_granted"`
+	DiscountCurrency        string               `json:"discount_currency"`
+	RewardAmountGranted     int64                `json:"reward_amount_granted"`
+	RewardCurrency          string               `json:"reward_currency"`
+	Status                  ReferralRecordStatus `json:"status"`
+	CreatedAt               time.Time            `json:"created_at"`
+	UpdatedAt               time.Time            `json:"updated_at"`
+	DeletedAt               sql.NullTime         `json:"deleted_at"`
+	OwnerProfileID          uuid.UUID            `json:"owner_profile_id"`
+}
+
+// owner = profile pemilik referral code (penerima reward)
+func (q *Queries) GetReferralRecordWithOwnerById(ctx context.Context, id int64) (GetReferralRecordWithOwnerByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, getReferralRecordWithOwnerById, id)
+	var i GetReferralRecordWithOwnerByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.ConsumerProfileID,
+		&i.BusinessRootID,
+		&i.ProfileReferralCodeID,
+		&i.RecordType,
+		&i.RecordTotalDiscount,
+		&i.RecordDiscountType,
+		&i.RecordExpiredDays,
+		&i.RecordMaxDiscount,
+		&i.RecordMaxUsage,
+		&i.RecordRewardPerReferral,
+		&i.DiscountAmountGranted,
+		&i.DiscountCurrency,
+		&i.RewardAmountGranted,
+		&i.RewardCurrency,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.OwnerProfileID,
+	)
+	return i, err
+}
+
 const updateReferralRecordStatus = `-- name: UpdateReferralRecordStatus :one
 UPDATE referral_records
 SET status = $2
@@ -39,6 +39,24 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 	return i, err
 }
 
+const deleteUserByProfileIdAndProvider = `-- name: DeleteUserByProfileIdAndProvider :execrows
+DELETE FROM users
+WHERE profile_id = $1 AND provider = $2
+`
+
+type DeleteUserByProfileIdAndProviderParams struct {
+	ProfileID uuid.UUID    `json:"profile_id"`
+	Provider  AuthProvider `json:"provider"`
+}
+
+func (q *Queries) DeleteUserByProfileIdAndProvider(ctx context.Context, arg DeleteUserByProfileIdAndProviderParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteUserByProfileIdAndProvider, arg.ProfileID, arg.Provider)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const getUserByEmailProfile = `-- name: GetUserByEmailProfile :many
 SELECT users.id, password, provider, verified_at, profile_id, users.created_at, users.updated_at, profiles.id, name, email, image_url, country_code, phone, description, profiles.created_at, profiles.updated_at, role FROM users
 INNER JOIN profiles ON users.profile_id = profiles.id
@@ -0,0 +1,134 @@
+-- name: CreateAffiliatorPayoutRequest :one
+INSERT INTO affiliator_payout_requests (
+    profile_id,
+    amount,
+    currency,
+    bank_name,
+    bank_account_number,
+    bank_account_name
+) VALUES (
+    sqlc.arg(profile_id),
+    sqlc.arg(amount),
+    sqlc.arg(currency),
+    sqlc.arg(bank_name),
+    sqlc.arg(bank_account_number),
+    sqlc.arg(bank_account_name)
+) RETURNING *;
+
+-- name: GetAffiliatorPayoutRequestById :one
+SELECT
+    apr.*,
+    pr.name      AS profile_name,
+    pr.email     AS profile_email,
+    pr.image_url AS profile_image_url
+FROM affiliator_payout_requests apr
+JOIN profiles pr ON pr.id = apr.profile_id
+WHERE apr.id = sqlc.arg(id) AND apr.deleted_at IS NULL;
+
+-- name: GetAffiliatorPayoutRequestByIdForUpdate :one
+SELECT * FROM affiliator_payout_requests
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: UpdateAffiliatorPayoutRequestStatus :one
+-- hanya request 'pending' yang bisa berubah status (no rows = sudah diproses)
+UPDATE affiliator_payout_requests
+SET status = sqlc.arg(status),
+    admin_note = COALESCE(sqlc.narg(admin_note), admin_note),
+    reviewed_by_profile_id = COALESCE(sqlc.narg(reviewed_by_profile_id), reviewed_by_profile_id),
+    reviewed_at = CASE WHEN sqlc.narg(reviewed_by_profile_id)::uuid IS NULL THEN reviewed_at ELSE NOW() END
+WHERE id = sqlc.arg(id)
+    AND status = 'pending'
+    AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetAllAffiliatorPayoutRequests :many
+-- profile_id null = semua affiliator (admin queue)
+WITH p AS (
+  SELECT
+    COALESCE(NULLIF(sqlc.narg(sort_by),  ''), 'created_at') AS sort_by,
+    COALESCE(NULLIF(sqlc.narg(sort_dir), ''), 'desc')       AS sort_dir
+)
+SELECT
+    apr.*,
+    pr.name      AS profile_name,
+    pr.email     AS profile_email,
+    pr.image_url AS profile_image_url
+FROM affiliator_payout_requests apr
+JOIN profiles pr ON pr.id = apr.profile_id
+CROSS JOIN p
+WHERE
+    apr.deleted_at IS NULL
+    AND (
+        sqlc.narg(profile_id)::uuid IS NULL
+        OR apr.profile_id = sqlc.narg(profile_id)::uuid
+    )
+    AND (
+        sqlc.narg(status)::affiliator_payout_status IS NULL
+        OR apr.status = sqlc.narg(status)::affiliator_payout_status
+    )
+
+    -- search (affiliator + rekening)
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR pr.name ILIKE ('%' || sqlc.narg(search) || '%')
+        OR pr.email ILIKE ('%' || sqlc.narg(search) || '%')
+        OR apr.bank_name ILIKE ('%' || sqlc.narg(search) || '%')
+        OR apr.bank_account_number ILIKE ('%' || sqlc.narg(search) || '%')
+        OR apr.bank_account_name ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR apr.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR apr.created_at::date <= sqlc.narg(date_end)::date
+    )
+
+ORDER BY
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'asc'  THEN apr.created_at END ASC,
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'desc' THEN apr.created_at END DESC,
+
+    CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'asc'  THEN apr.id END ASC,
+    CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'desc' THEN apr.id END DESC,
+
+    CASE WHEN p.sort_by = 'amount' AND p.sort_dir = 'asc'  THEN apr.amount END ASC,
+    CASE WHEN p.sort_by = 'amount' AND p.sort_dir = 'desc' THEN apr.amount END DESC,
+
+    apr.id DESC
+
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllAffiliatorPayoutRequests :one
+SELECT COUNT(*)::bigint AS total
+FROM affiliator_payout_requests apr
+JOIN profiles pr ON pr.id = apr.profile_id
+WHERE
+    apr.deleted_at IS NULL
+    AND (
+        sqlc.narg(profile_id)::uuid IS NULL
+        OR apr.profile_id = sqlc.narg(profile_id)::uuid
+    )
+    AND (
+        sqlc.narg(status)::affiliator_payout_status IS NULL
+        OR apr.status = sqlc.narg(status)::affiliator_payout_status
+    )
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR pr.name ILIKE ('%' || sqlc.narg(search) || '%')
+        OR pr.email ILIKE ('%' || sqlc.narg(search) || '%')
+        OR apr.bank_name ILIKE ('%' || sqlc.narg(search) || '%')
+        OR apr.bank_account_number ILIKE ('%' || sqlc.narg(search) || '%')
+        OR apr.bank_account_name ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR apr.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR apr.created_at::date <= sqlc.narg(date_end)::date
+    );
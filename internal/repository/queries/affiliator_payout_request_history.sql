@@ -0,0 +1,23 @@
+-- name: CreateAffiliatorPayoutRequestHistory :one
+INSERT INTO affiliator_payout_request_histories (
+    affiliator_payout_request_id,
+    status,
+    note,
+    actor_profile_id
+) VALUES (
+    sqlc.arg(affiliator_payout_request_id),
+    sqlc.arg(status),
+    sqlc.narg(note),
+    sqlc.arg(actor_profile_id)
+) RETURNING *;
+
+-- name: GetAffiliatorPayoutRequestHistoriesByPayoutRequestId :many
+SELECT
+    h.*,
+    pr.name  AS actor_name,
+    pr.email AS actor_email
+FROM affiliator_payout_request_histories h
+JOIN profiles pr ON pr.id = h.actor_profile_id
+WHERE h.affiliator_payout_request_id = sqlc.arg(affiliator_payout_request_id)
+    AND h.deleted_at IS NULL
+ORDER BY h.created_at ASC, h.id ASC;
@@ -0,0 +1,51 @@
+-- name: GetAffiliatorWalletByProfileId :one
+SELECT * FROM affiliator_wallets
+WHERE profile_id = sqlc.arg(profile_id) AND deleted_at IS NULL;
+
+-- name: UpsertAffiliatorWalletByProfileId :one
+-- buat wallet jika belum ada, selalu return row (lock row sampai tx selesai)
+INSERT INTO affiliator_wallets (profile_id, currency)
+VALUES (sqlc.arg(profile_id), sqlc.arg(currency))
+ON CONFLICT (profile_id) DO UPDATE SET
+    profile_id = EXCLUDED.profile_id
+RETURNING *;
+
+-- name: CreditAffiliatorWalletEarned :one
+UPDATE affiliator_wallets
+SET total_earned = total_earned + sqlc.arg(amount)
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ClawbackAffiliatorWallet :one
+UPDATE affiliator_wallets
+SET total_clawed_back = total_clawed_back + sqlc.arg(amount)
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: HoldAffiliatorWalletBalance :one
+-- atomic: hanya berhasil jika available cukup (no rows = saldo tidak cukup)
+UPDATE affiliator_wallets
+SET on_hold = on_hold + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+    AND deleted_at IS NULL
+    AND total_earned - total_clawed_back - total_withdrawn - on_hold >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: ReleaseAffiliatorWalletHold :one
+-- payout request rejected / canceled, saldo kembali ke available
+UPDATE affiliator_wallets
+SET on_hold = on_hold - sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+    AND deleted_at IS NULL
+    AND on_hold >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: WithdrawAffiliatorWalletHold :one
+-- payout request approved, pindahkan on_hold -> total_withdrawn
+UPDATE affiliator_wallets
+SET on_hold = on_hold - sqlc.arg(amount),
+    total_withdrawn = total_withdrawn + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+    AND deleted_at IS NULL
+    AND on_hold >= sqlc.arg(amount)
+RETURNING *;
@@ -0,0 +1,89 @@
+-- name: CreateAffiliatorWalletTransaction :one
+INSERT INTO affiliator_wallet_transactions (
+    affiliator_wallet_id,
+    type,
+    amount,
+    currency,
+    referral_record_id,
+    affiliator_payout_request_id
+) VALUES (
+    sqlc.arg(affiliator_wallet_id),
+    sqlc.arg(type),
+    sqlc.arg(amount),
+    sqlc.arg(currency),
+    sqlc.narg(referral_record_id),
+    sqlc.narg(affiliator_payout_request_id)
+) RETURNING *;
+
+-- name: CreateAffiliatorWalletReferralTransaction :one
+-- idempotent untuk reward & clawback (no rows = sudah pernah dicatat)
+INSERT INTO affiliator_wallet_transactions (
+    affiliator_wallet_id,
+    type,
+    amount,
+    currency,
+    referral_record_id
+) VALUES (
+    sqlc.arg(affiliator_wallet_id),
+    sqlc.arg(type),
+    sqlc.arg(amount),
+    sqlc.arg(currency),
+    sqlc.arg(referral_record_id)
+)
+ON CONFLICT (referral_record_id, type) WHERE referral_record_id IS NOT NULL AND deleted_at IS NULL
+DO NOTHING
+RETURNING *;
+
+-- name: GetAffiliatorWalletTransactionByReferralRecordIdAndType :one
+SELECT * FROM affiliator_wallet_transactions
+WHERE referral_record_id = sqlc.arg(referral_record_id)
+    AND type = sqlc.arg(type)
+    AND deleted_at IS NULL;
+
+-- name: GetAllAffiliatorWalletTransactionsByWalletId :many
+SELECT t.*
+FROM affiliator_wallet_transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.affiliator_wallet_id = sqlc.arg(affiliator_wallet_id)
+    AND (
+        sqlc.narg(type)::affiliator_wallet_transaction_type IS NULL
+        OR t.type = sqlc.narg(type)::affiliator_wallet_transaction_type
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR t.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR t.created_at::date <= sqlc.narg(date_end)::date
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'asc' THEN t.id END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'desc' THEN t.id END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'asc' THEN t.created_at END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'desc' THEN t.created_at END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'amount' AND sqlc.arg(sort_dir) = 'asc' THEN t.amount END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'amount' AND sqlc.arg(sort_dir) = 'desc' THEN t.amount END DESC,
+    t.id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllAffiliatorWalletTransactionsByWalletId :one
+SELECT COUNT(*)::bigint AS total
+FROM affiliator_wallet_transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.affiliator_wallet_id = sqlc.arg(affiliator_wallet_id)
+    AND (
+        sqlc.narg(type)::affiliator_wallet_transaction_type IS NULL
+        OR t.type = sqlc.narg(type)::affiliator_wallet_transaction_type
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR t.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR t.created_at::date <= sqlc.narg(date_end)::date
+    );
@@ -0,0 +1,97 @@
+-- name: GetAllExchangeRates :many
+SELECT r.*
+FROM app_exchange_rates r
+WHERE
+  r.deleted_at IS NULL
+  AND (
+    COALESCE(sqlc.narg(search), '') = ''
+    OR r.base_currency ILIKE ('%' || sqlc.narg(search) || '%')
+    OR r.quote_currency ILIKE ('%' || sqlc.narg(search) || '%')
+  )
+ORDER BY
+  -- effective_at
+  CASE WHEN sqlc.arg(sort_by) = 'effective_at' AND sqlc.arg(sort_dir) = 'asc'  THEN r.effective_at END ASC,
+  CASE WHEN sqlc.arg(sort_by) = 'effective_at' AND sqlc.arg(sort_dir) = 'desc' THEN r.effective_at END DESC,
+
+  -- quote_currency
+  CASE WHEN sqlc.arg(sort_by) = 'quote_currency' AND sqlc.arg(sort_dir) = 'asc'  THEN r.quote_currency END ASC,
+  CASE WHEN sqlc.arg(sort_by) = 'quote_currency' AND sqlc.arg(sort_dir) = 'desc' THEN r.quote_currency END DESC,
+
+  -- created_at
+  CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'asc'  THEN r.created_at END ASC,
+  CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'desc' THEN r.created_at END DESC,
+
+  -- id
+  CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'asc'  THEN r.id END ASC,
+  CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'desc' THEN r.id END DESC,
+
+  -- fallback stable order
+  r.id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllExchangeRates :one
+SELECT COUNT(*)::bigint AS total
+FROM app_exchange_rates r
+WHERE
+  r.deleted_at IS NULL
+  AND (
+    COALESCE(sqlc.narg(search), '') = ''
+    OR r.base_currency ILIKE ('%' || sqlc.narg(search) || '%')
+    OR r.quote_currency ILIKE ('%' || sqlc.narg(search) || '%')
+  );
+
+-- name: GetExchangeRateById :one
+SELECT * FROM app_exchange_rates
+WHERE id = $1 AND deleted_at IS NULL;
+
+-- name: GetEffectiveExchangeRate :one
+-- rate terbaru yang sudah berlaku pada sqlc.arg(at) untuk pair base -> quote
+SELECT * FROM app_exchange_rates
+WHERE base_currency = sqlc.arg(base_currency)
+  AND quote_currency = sqlc.arg(quote_currency)
+  AND effective_at <= sqlc.arg(at)
+  AND deleted_at IS NULL
+ORDER BY effective_at DESC, id DESC
+LIMIT 1;
+
+-- name: CreateExchangeRate :one
+INSERT INTO app_exchange_rates (
+  base_currency,
+  quote_currency,
+  rate_micros,
+  rounding_mode,
+  rounding_increment,
+  effective_at,
+  created_by_profile_id,
+  updated_by_profile_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $7
+) RETURNING *;
+
+-- name: UpdateExchangeRate :one
+-- hanya rate yang belum berlaku (effective_at > now) yang boleh diubah
+UPDATE app_exchange_rates
+SET
+  base_currency = sqlc.arg(base_currency),
+  quote_currency = sqlc.arg(quote_currency),
+  rate_micros = sqlc.arg(rate_micros),
+  rounding_mode = sqlc.arg(rounding_mode),
+  rounding_increment = sqlc.arg(rounding_increment),
+  effective_at = sqlc.arg(effective_at),
+  updated_by_profile_id = sqlc.arg(updated_by_profile_id)
+WHERE id = sqlc.arg(id)
+  AND effective_at > now()
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteExchangeRate :one
+-- hanya rate yang belum berlaku (effective_at > now) yang boleh dihapus
+UPDATE app_exchange_rates
+SET
+  deleted_at = now(),
+  updated_by_profile_id = sqlc.arg(updated_by_profile_id)
+WHERE id = sqlc.arg(id)
+  AND effective_at > now()
+  AND deleted_at IS NULL
+RETURNING *;
@@ -87,9 +87,10 @@ INSERT INTO app_payment_methods (
   tax_fee,
   admin_type,
   admin_fee,
-  is_active
+  is_active,
+  gateway
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8
+  $1, $2, $3, $4, $5, $6, $7, $8, $9
 ) RETURNING *;
 
 -- name: UpdatePaymentMethod :one
@@ -102,7 +103,8 @@ SET
   tax_fee = $6,
   admin_type = $7,
   admin_fee = $8,
-  is_active = $9
+  is_active = $9,
+  gateway = $10
 WHERE id = $1 AND deleted_at IS NULL
 RETURNING *;
 
@@ -125,6 +127,7 @@ INSERT INTO app_payment_method_changes (
   before_admin_fee,
   before_tax_fee,
   before_is_active,
+  before_gateway,
   after_code,
   after_name,
   after_type,
@@ -132,7 +135,8 @@ INSERT INTO app_payment_method_changes (
   after_admin_type,
   after_admin_fee,
   after_tax_fee,
-  after_is_active
+  after_is_active,
+  after_gateway
 ) VALUES (
-  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21
 ) RETURNING *;
@@ -0,0 +1,108 @@
+-- name: CreateBusinessAuditEvent :one
+INSERT INTO business_audit_events (
+  business_root_id,
+  profile_id,
+  entity_type,
+  entity_id,
+  action,
+  before,
+  after
+)
+VALUES (
+  sqlc.arg(business_root_id),
+  sqlc.arg(profile_id),
+  sqlc.arg(entity_type),
+  sqlc.arg(entity_id),
+  sqlc.arg(action),
+  sqlc.narg(before),
+  sqlc.narg(after)
+)
+RETURNING *;
+
+-- name: GetBusinessAuditEventsByBusinessRootID :many
+WITH p AS (
+  SELECT
+    COALESCE(NULLIF(sqlc.narg(sort_by),  ''), 'created_at') AS sort_by,
+    COALESCE(NULLIF(sqlc.narg(sort_dir), ''), 'desc')       AS sort_dir
+)
+SELECT
+  bae.*,
+  pr.name      AS profile_name,
+  pr.email     AS profile_email,
+  pr.image_url AS profile_image_url
+FROM business_audit_events bae
+JOIN profiles pr ON pr.id = bae.profile_id
+CROSS JOIN p
+WHERE
+  bae.business_root_id = sqlc.arg(business_root_id)
+  AND bae.deleted_at IS NULL
+
+  -- search (entity id + actor)
+  AND (
+    COALESCE(sqlc.narg(search), '') = ''
+    OR bae.entity_id ILIKE ('%' || sqlc.narg(search) || '%')
+    OR pr.name ILIKE ('%' || sqlc.narg(search) || '%')
+    OR pr.email ILIKE ('%' || sqlc.narg(search) || '%')
+  )
+
+  -- category = entity_type
+  AND (
+    sqlc.narg(entity_type)::text IS NULL
+    OR bae.entity_type = sqlc.narg(entity_type)
+  )
+
+  -- date range (berdasarkan created_at)
+  AND (
+    sqlc.narg(date_start)::date IS NULL
+    OR bae.created_at::date >= sqlc.narg(date_start)::date
+  )
+  AND (
+    sqlc.narg(date_end)::date IS NULL
+    OR bae.created_at::date <= sqlc.narg(date_end)::date
+  )
+
+ORDER BY
+  -- created_at (default)
+  CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'asc'  THEN bae.created_at END ASC,
+  CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'desc' THEN bae.created_at END DESC,
+
+  CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'asc'  THEN bae.id END ASC,
+  CASE WHEN p.sort_by = 'id' AND p.sort_dir = 'desc' THEN bae.id END DESC,
+
+  -- fallback stable order
+  bae.id DESC
+
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountBusinessAuditEventsByBusinessRootID :one
+SELECT COUNT(*)::bigint AS total
+FROM business_audit_events bae
+JOIN profiles pr ON pr.id = bae.profile_id
+WHERE
+  bae.business_root_id = sqlc.arg(business_root_id)
+  AND bae.deleted_at IS NULL
+
+  -- search (entity id + actor)
+  AND (
+    COALESCE(sqlc.narg(search), '') = ''
+    OR bae.entity_id ILIKE ('%' || sqlc.narg(search) || '%')
+    OR pr.name ILIKE ('%' || sqlc.narg(search) || '%')
+    OR pr.email ILIKE ('%' || sqlc.narg(search) || '%')
+  )
+
+  -- category = entity_type
+  AND (
+    sqlc.narg(entity_type)::text IS NULL
+    OR bae.entity_type = sqlc.narg(entity_type)
+  )
+
+  -- date range (berdasarkan created_at)
+  AND (
+    sqlc.narg(date_start)::date IS NULL
+    OR bae.created_at::date >= sqlc.narg(date_start)::date
+  )
+  AND (
+    sqlc.narg(date_end)::date IS NULL
+    OR bae.created_at::date <= sqlc.narg(date_end)::date
+  );
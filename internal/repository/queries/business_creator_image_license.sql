@@ -0,0 +1,102 @@
+-- name: CreateBusinessCreatorImageLicense :one
+-- idempotent per payment (no rows = sudah pernah dibuat / business sudah punya lisensi aktif)
+INSERT INTO business_creator_image_licenses (
+    business_root_id,
+    creator_image_id,
+    payment_history_id,
+    profile_id
+) VALUES (
+    sqlc.arg(business_root_id),
+    sqlc.arg(creator_image_id),
+    sqlc.arg(payment_history_id),
+    sqlc.arg(profile_id)
+)
+ON CONFLICT DO NOTHING
+RETURNING *;
+
+-- name: CheckActiveCreatorImageLicenseExists :one
+SELECT EXISTS (
+    SELECT 1 FROM business_creator_image_licenses
+    WHERE business_root_id = sqlc.arg(business_root_id)
+      AND creator_image_id = sqlc.arg(creator_image_id)
+      AND status = 'active'
+      AND deleted_at IS NULL
+) AS exists;
+
+-- name: RevokeBusinessCreatorImageLicenseByPaymentId :one
+UPDATE business_creator_image_licenses
+SET status = 'revoked',
+    revoked_at = NOW()
+WHERE payment_history_id = sqlc.arg(payment_history_id)
+  AND status = 'active'
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetAllBusinessCreatorImageLicenses :many
+SELECT
+    l.id,
+    l.business_root_id,
+    l.creator_image_id,
+    l.payment_history_id,
+    l.profile_id,
+    l.status,
+    l.revoked_at,
+    l.created_at,
+    ci.name AS creator_image_name,
+    ci.image_url AS creator_image_url,
+    ph.record_product_price AS price,
+    ph.currency
+FROM business_creator_image_licenses l
+JOIN creator_images ci ON ci.id = l.creator_image_id
+JOIN payment_histories ph ON ph.id = l.payment_history_id
+WHERE
+    l.deleted_at IS NULL
+    AND l.business_root_id = sqlc.arg(business_root_id)
+    AND (
+        sqlc.narg(status)::creator_image_license_status IS NULL
+        OR l.status = sqlc.narg(status)::creator_image_license_status
+    )
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR ci.name ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR l.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR l.created_at::date <= sqlc.narg(date_end)::date
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'asc' THEN l.id END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'desc' THEN l.id END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'asc' THEN l.created_at END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'desc' THEN l.created_at END DESC,
+    l.id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllBusinessCreatorImageLicenses :one
+SELECT COUNT(*)::bigint AS total
+FROM business_creator_image_licenses l
+JOIN creator_images ci ON ci.id = l.creator_image_id
+WHERE
+    l.deleted_at IS NULL
+    AND l.business_root_id = sqlc.arg(business_root_id)
+    AND (
+        sqlc.narg(status)::creator_image_license_status IS NULL
+        OR l.status = sqlc.narg(status)::creator_image_license_status
+    )
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR ci.name ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR l.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR l.created_at::date <= sqlc.narg(date_end)::date
+    );
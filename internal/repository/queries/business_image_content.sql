@@ -30,6 +30,14 @@ SET
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
+-- name: UpdateBusinessImageContentCaption :one
+UPDATE business_image_contents
+SET caption = sqlc.arg(caption)
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL
+RETURNING *;
+
 -- name: SoftDeleteBusinessImageContentByBusinessImageContentId :one
 UPDATE business_image_contents
 SET deleted_at = NOW()
@@ -125,3 +133,9 @@ WHERE
     sqlc.narg(date_end)::date IS NULL
     OR b.created_at::date <= sqlc.narg(date_end)::date
   );
+
+-- name: GetBusinessImageContentByIdAndBusinessRootId :one
+SELECT * FROM business_image_contents
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL;
@@ -39,6 +39,8 @@ JOIN creator_images ci ON ci.id = bstci.creator_image_id
 LEFT JOIN profiles p ON p.id = ci.profile_id
 WHERE bstci.business_root_id = @business_root_id
   AND bstci.deleted_at IS NULL
+  -- creator image yang dibanned admin tidak ditampilkan
+  AND ci.is_banned = FALSE
   -- search by name
   AND (sqlc.narg('search')::TEXT IS NULL OR ci.name ILIKE '%' || sqlc.narg('search')::TEXT || '%')
   -- filter by date range (on saved_at)
@@ -72,6 +74,8 @@ FROM business_saved_template_creator_images bstci
 JOIN creator_images ci ON ci.id = bstci.creator_image_id
 WHERE bstci.business_root_id = @business_root_id
   AND bstci.deleted_at IS NULL
+  -- creator image yang dibanned admin tidak ditampilkan
+  AND ci.is_banned = FALSE
   AND (sqlc.narg('search')::TEXT IS NULL OR ci.name ILIKE '%' || sqlc.narg('search')::TEXT || '%')
   AND (sqlc.narg('date_start')::TIMESTAMPTZ IS NULL OR bstci.created_at >= sqlc.narg('date_start')::TIMESTAMPTZ)
   AND (sqlc.narg('date_end')::TIMESTAMPTZ IS NULL OR bstci.created_at <= sqlc.narg('date_end')::TIMESTAMPTZ)
@@ -113,3 +117,15 @@ SET deleted_at = NOW()
 WHERE business_root_id = @business_root_id
   AND creator_image_id = @creator_image_id
   AND deleted_at IS NULL;
+
+-- name: CreateSavedCreatorImageIfNotExists :exec
+-- dipakai saat lisensi template dibuat (template yang dibeli otomatis tersimpan)
+INSERT INTO business_saved_template_creator_images (
+    business_root_id,
+    creator_image_id
+) VALUES (
+    @business_root_id,
+    @creator_image_id
+)
+ON CONFLICT (business_root_id, creator_image_id) WHERE deleted_at IS NULL
+DO NOTHING;
@@ -0,0 +1,103 @@
+-- name: CreateBusinessScheduledPost :one
+INSERT INTO business_scheduled_posts (
+    business_root_id,
+    business_image_content_id,
+    platform,
+    scheduled_at,
+    timezone,
+    profile_id
+)
+VALUES (
+    sqlc.arg(business_root_id),
+    sqlc.arg(business_image_content_id),
+    sqlc.arg(platform),
+    sqlc.arg(scheduled_at),
+    sqlc.arg(timezone),
+    sqlc.arg(profile_id)
+)
+RETURNING *;
+
+-- name: GetBusinessScheduledPostById :one
+SELECT * FROM business_scheduled_posts
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL;
+
+-- name: GetBusinessScheduledPostByIdAndBusinessRootId :one
+SELECT * FROM business_scheduled_posts
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL;
+
+-- name: GetBusinessScheduledPostsByRange :many
+-- calendar: semua jadwal business dalam range [range_start, range_end)
+SELECT * FROM business_scheduled_posts
+WHERE business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL
+  AND scheduled_at >= sqlc.arg(range_start)
+  AND scheduled_at < sqlc.arg(range_end)
+  AND (
+    sqlc.narg(platform)::social_platform_type IS NULL
+    OR platform = sqlc.narg(platform)::social_platform_type
+  )
+ORDER BY scheduled_at ASC, id ASC;
+
+-- name: UpdateBusinessScheduledPost :one
+-- hanya jadwal yang masih 'scheduled' yang boleh diubah
+UPDATE business_scheduled_posts
+SET
+    business_image_content_id = sqlc.arg(business_image_content_id),
+    platform = sqlc.arg(platform),
+    scheduled_at = sqlc.arg(scheduled_at),
+    timezone = sqlc.arg(timezone)
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND status = 'scheduled'
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: CancelBusinessScheduledPost :one
+UPDATE business_scheduled_posts
+SET status = 'canceled', deleted_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND status = 'scheduled'
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: MarkBusinessScheduledPostPublishing :one
+-- claim jadwal untuk dipublish (idempotent: hanya jika status masih 'scheduled' dan waktunya sama)
+UPDATE business_scheduled_posts
+SET status = 'publishing'
+WHERE id = sqlc.arg(id)
+  AND status = 'scheduled'
+  AND scheduled_at = sqlc.arg(scheduled_at)
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: FailStuckBusinessScheduledPosts :many
+-- post yang tertahan di 'publishing' (worker crash di antara claim & enqueue deliver, atau task deliver hilang).
+-- updated_at = waktu claim, attempt deliver yang gagal tidak mengubah row ini.
+UPDATE business_scheduled_posts
+SET status = 'failed', failure_reason = 'PUBLISH_STUCK'
+WHERE id IN (
+  SELECT sp.id FROM business_scheduled_posts sp
+  WHERE sp.status = 'publishing'
+    AND sp.updated_at < sqlc.arg(stuck_before)
+    AND sp.deleted_at IS NULL
+  ORDER BY sp.updated_at ASC
+  LIMIT sqlc.arg(row_limit)
+  FOR UPDATE SKIP LOCKED
+)
+  AND status = 'publishing'
+RETURNING *;
+
+-- name: MarkBusinessScheduledPostPublished :one
+UPDATE business_scheduled_posts
+SET status = 'published', published_at = NOW(), external_post_id = sqlc.arg(external_post_id), failure_reason = NULL
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: MarkBusinessScheduledPostFailed :one
+UPDATE business_scheduled_posts
+SET status = 'failed', failure_reason = sqlc.arg(failure_reason)
+WHERE id = sqlc.arg(id)
+RETURNING *;
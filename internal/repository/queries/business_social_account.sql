@@ -0,0 +1,89 @@
+-- name: UpsertBusinessSocialAccount :one
+-- connect ulang platform yang sama akan menimpa credential lama
+INSERT INTO business_social_accounts (
+    business_root_id,
+    platform,
+    external_account_id,
+    account_name,
+    account_image_url,
+    access_token_encrypted,
+    refresh_token_encrypted,
+    token_expires_at,
+    scopes,
+    status,
+    profile_id
+)
+VALUES (
+    sqlc.arg(business_root_id),
+    sqlc.arg(platform),
+    sqlc.arg(external_account_id),
+    sqlc.arg(account_name),
+    sqlc.arg(account_image_url),
+    sqlc.arg(access_token_encrypted),
+    sqlc.arg(refresh_token_encrypted),
+    sqlc.arg(token_expires_at),
+    sqlc.arg(scopes),
+    'connected',
+    sqlc.arg(profile_id)
+)
+ON CONFLICT (business_root_id, platform) WHERE deleted_at IS NULL
+DO UPDATE SET
+    external_account_id = EXCLUDED.external_account_id,
+    account_name = EXCLUDED.account_name,
+    account_image_url = EXCLUDED.account_image_url,
+    access_token_encrypted = EXCLUDED.access_token_encrypted,
+    refresh_token_encrypted = EXCLUDED.refresh_token_encrypted,
+    token_expires_at = EXCLUDED.token_expires_at,
+    scopes = EXCLUDED.scopes,
+    status = 'connected',
+    profile_id = EXCLUDED.profile_id,
+    last_refreshed_at = NULL
+RETURNING *;
+
+-- name: GetBusinessSocialAccountsByBusinessRootId :many
+SELECT * FROM business_social_accounts
+WHERE business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL
+ORDER BY platform ASC;
+
+-- name: GetBusinessSocialAccountByIdAndBusinessRootId :one
+SELECT * FROM business_social_accounts
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL;
+
+-- name: GetBusinessSocialAccountByPlatform :one
+SELECT * FROM business_social_accounts
+WHERE business_root_id = sqlc.arg(business_root_id)
+  AND platform = sqlc.arg(platform)
+  AND deleted_at IS NULL;
+
+-- name: UpdateBusinessSocialAccountToken :one
+UPDATE business_social_accounts
+SET
+    access_token_encrypted = sqlc.arg(access_token_encrypted),
+    refresh_token_encrypted = sqlc.arg(refresh_token_encrypted),
+    token_expires_at = sqlc.arg(token_expires_at),
+    status = 'connected',
+    last_refreshed_at = NOW()
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: MarkBusinessSocialAccountExpired :one
+UPDATE business_social_accounts
+SET status = 'expired'
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DisconnectBusinessSocialAccount :one
+-- credential dihapus saat disconnect
+UPDATE business_social_accounts
+SET
+    status = 'disconnected',
+    access_token_encrypted = '',
+    refresh_token_encrypted = NULL,
+    deleted_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL
+RETURNING *;
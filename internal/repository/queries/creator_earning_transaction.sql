@@ -0,0 +1,94 @@
+-- name: CreateCreatorEarningTransaction :one
+-- idempotent untuk sale & clawback per payment (no rows = sudah pernah dicatat)
+INSERT INTO creator_earning_transactions (
+    profile_id,
+    type,
+    creator_image_id,
+    payment_history_id,
+    gross_amount,
+    commission_percentage,
+    commission_amount,
+    net_amount,
+    currency
+) VALUES (
+    sqlc.arg(profile_id),
+    sqlc.arg(type),
+    sqlc.arg(creator_image_id),
+    sqlc.arg(payment_history_id),
+    sqlc.arg(gross_amount),
+    sqlc.arg(commission_percentage),
+    sqlc.arg(commission_amount),
+    sqlc.arg(net_amount),
+    sqlc.arg(currency)
+)
+ON CONFLICT (payment_history_id, type) WHERE deleted_at IS NULL
+DO NOTHING
+RETURNING *;
+
+-- name: GetCreatorEarningTransactionByPaymentIdAndType :one
+SELECT * FROM creator_earning_transactions
+WHERE payment_history_id = sqlc.arg(payment_history_id)
+    AND type = sqlc.arg(type)
+    AND deleted_at IS NULL;
+
+-- name: GetCreatorEarningSummaryByProfileId :one
+SELECT
+    COUNT(*) FILTER (WHERE type = 'sale')::bigint AS total_sales,
+    COALESCE(SUM(gross_amount) FILTER (WHERE type = 'sale'), 0)::bigint AS total_gross,
+    COALESCE(SUM(commission_amount) FILTER (WHERE type = 'sale'), 0)::bigint AS total_commission,
+    COALESCE(SUM(net_amount) FILTER (WHERE type = 'sale'), 0)::bigint AS total_earned,
+    COALESCE(SUM(net_amount) FILTER (WHERE type = 'clawback'), 0)::bigint AS total_clawed_back
+FROM creator_earning_transactions
+WHERE profile_id = sqlc.arg(profile_id)
+    AND deleted_at IS NULL;
+
+-- name: GetAllCreatorEarningTransactionsByProfileId :many
+SELECT
+    t.*,
+    ci.name AS creator_image_name
+FROM creator_earning_transactions t
+JOIN creator_images ci ON ci.id = t.creator_image_id
+WHERE
+    t.deleted_at IS NULL
+    AND t.profile_id = sqlc.arg(profile_id)
+    AND (
+        sqlc.narg(type)::creator_earning_transaction_type IS NULL
+        OR t.type = sqlc.narg(type)::creator_earning_transaction_type
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR t.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR t.created_at::date <= sqlc.narg(date_end)::date
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'asc' THEN t.id END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'desc' THEN t.id END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'asc' THEN t.created_at END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'desc' THEN t.created_at END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'amount' AND sqlc.arg(sort_dir) = 'asc' THEN t.net_amount END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'amount' AND sqlc.arg(sort_dir) = 'desc' THEN t.net_amount END DESC,
+    t.id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllCreatorEarningTransactionsByProfileId :one
+SELECT COUNT(*)::bigint AS total
+FROM creator_earning_transactions t
+WHERE
+    t.deleted_at IS NULL
+    AND t.profile_id = sqlc.arg(profile_id)
+    AND (
+        sqlc.narg(type)::creator_earning_transaction_type IS NULL
+        OR t.type = sqlc.narg(type)::creator_earning_transaction_type
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR t.created_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR t.created_at::date <= sqlc.narg(date_end)::date
+    );
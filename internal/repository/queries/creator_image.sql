@@ -243,7 +243,13 @@ WITH upd AS (
     image_url = sqlc.arg(image_url),
     is_published = sqlc.arg(is_published),
     price = sqlc.arg(price),
-    profile_id = sqlc.narg(profile_id)
+    profile_id = sqlc.narg(profile_id),
+    -- perubahan pada template published masuk antrian review lagi (banned tetap banned)
+    moderation_status = CASE
+      WHEN moderation_status = 'banned' THEN moderation_status
+      WHEN sqlc.arg(is_published)::boolean THEN 'pending'::creator_image_moderation_status
+      ELSE moderation_status
+    END
   WHERE id = sqlc.arg(id)
   RETURNING *
 ),
@@ -0,0 +1,112 @@
+-- name: GetAllCreatorImageModerationQueue :many
+-- antrian review admin: creator image published & tidak dihapus
+SELECT
+    ci.id,
+    ci.name,
+    ci.image_url,
+    ci.is_published,
+    ci.is_banned,
+    ci.banned_reason,
+    ci.price,
+    ci.profile_id,
+    ci.moderation_status,
+    ci.moderated_at,
+    ci.created_at,
+    ci.updated_at,
+    pub.name  AS publisher_name,
+    pub.email AS publisher_email
+FROM creator_images ci
+LEFT JOIN profiles pub ON pub.id = ci.profile_id
+WHERE
+    ci.deleted_at IS NULL
+    AND ci.is_published = TRUE
+    AND ci.moderation_status = sqlc.arg(moderation_status)
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR ci.name ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR ci.updated_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR ci.updated_at::date <= sqlc.narg(date_end)::date
+    )
+ORDER BY
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'asc' THEN ci.id END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'id' AND sqlc.arg(sort_dir) = 'desc' THEN ci.id END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'name' AND sqlc.arg(sort_dir) = 'asc' THEN ci.name END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'name' AND sqlc.arg(sort_dir) = 'desc' THEN ci.name END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'asc' THEN ci.created_at END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'created_at' AND sqlc.arg(sort_dir) = 'desc' THEN ci.created_at END DESC,
+    CASE WHEN sqlc.arg(sort_by) = 'updated_at' AND sqlc.arg(sort_dir) = 'asc' THEN ci.updated_at END ASC,
+    CASE WHEN sqlc.arg(sort_by) = 'updated_at' AND sqlc.arg(sort_dir) = 'desc' THEN ci.updated_at END DESC,
+    ci.id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllCreatorImageModerationQueue :one
+SELECT COUNT(*)::bigint AS total
+FROM creator_images ci
+WHERE
+    ci.deleted_at IS NULL
+    AND ci.is_published = TRUE
+    AND ci.moderation_status = sqlc.arg(moderation_status)
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR ci.name ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(date_start)::date IS NULL
+        OR ci.updated_at::date >= sqlc.narg(date_start)::date
+    )
+    AND (
+        sqlc.narg(date_end)::date IS NULL
+        OR ci.updated_at::date <= sqlc.narg(date_end)::date
+    );
+
+-- name: GetCreatorImageByIdForUpdate :one
+SELECT * FROM creator_images
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: UpdateCreatorImageModeration :one
+-- banned_reason hanya diisi saat status banned
+UPDATE creator_images
+SET
+    moderation_status = sqlc.arg(moderation_status),
+    is_banned = (sqlc.arg(moderation_status)::creator_image_moderation_status = 'banned'),
+    banned_reason = CASE
+        WHEN sqlc.arg(moderation_status)::creator_image_moderation_status = 'banned' THEN sqlc.narg(banned_reason)
+        ELSE NULL
+    END,
+    moderated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
+-- name: CreateCreatorImageModeration :one
+INSERT INTO creator_image_moderations (
+    creator_image_id,
+    action,
+    previous_status,
+    reason,
+    moderator_profile_id
+) VALUES (
+    sqlc.arg(creator_image_id),
+    sqlc.arg(action),
+    sqlc.arg(previous_status),
+    sqlc.narg(reason),
+    sqlc.arg(moderator_profile_id)
+) RETURNING *;
+
+-- name: GetCreatorImageModerationsByCreatorImageId :many
+SELECT
+    m.*,
+    pr.name  AS moderator_name,
+    pr.email AS moderator_email
+FROM creator_image_moderations m
+JOIN profiles pr ON pr.id = m.moderator_profile_id
+WHERE m.creator_image_id = sqlc.arg(creator_image_id)
+    AND m.deleted_at IS NULL
+ORDER BY m.created_at DESC, m.id DESC;
@@ -0,0 +1,150 @@
+-- name: GetGenerativeTokenBalanceByBusinessRootId :one
+SELECT * FROM generative_token_balances
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND deleted_at IS NULL;
+
+-- name: CreditGenerativeTokenBalance :one
+-- tambah total_in (buat row snapshot jika belum ada)
+INSERT INTO generative_token_balances (business_root_id, token_type, total_in)
+VALUES (sqlc.arg(business_root_id), sqlc.arg(token_type), sqlc.arg(amount))
+ON CONFLICT (business_root_id, token_type) DO UPDATE SET
+    total_in = generative_token_balances.total_in + EXCLUDED.total_in
+RETURNING *;
+
+-- name: ReserveGenerativeTokenBalance :one
+-- atomic: hanya berhasil jika available cukup (no rows = saldo tidak cukup)
+UPDATE generative_token_balances
+SET reserved = reserved + sqlc.arg(amount)
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND deleted_at IS NULL
+    AND total_in - total_out - reserved >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: CommitGenerativeTokenBalance :one
+-- pindahkan reserved -> total_out
+UPDATE generative_token_balances
+SET reserved = reserved - sqlc.arg(amount),
+    total_out = total_out + sqlc.arg(amount)
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND deleted_at IS NULL
+    AND reserved >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: ReleaseGenerativeTokenBalance :one
+UPDATE generative_token_balances
+SET reserved = reserved - sqlc.arg(amount)
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND deleted_at IS NULL
+    AND reserved >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: DebitGenerativeTokenBalance :one
+-- debit langsung tanpa reservation (no rows = saldo tidak cukup)
+UPDATE generative_token_balances
+SET total_out = total_out + sqlc.arg(amount)
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND deleted_at IS NULL
+    AND total_in - total_out - reserved >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: RevertGenerativeTokenBalanceOut :one
+-- kembalikan token 'out' yang di-refund
+UPDATE generative_token_balances
+SET total_out = total_out - sqlc.arg(amount)
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND deleted_at IS NULL
+    AND total_out >= sqlc.arg(amount)
+RETURNING *;
+
+-- name: SetGenerativeTokenBalance :one
+-- dipakai reconcile untuk menimpa snapshot dengan hasil hitung ulang
+INSERT INTO generative_token_balances (business_root_id, token_type, total_in, total_out, reserved)
+VALUES (sqlc.arg(business_root_id), sqlc.arg(token_type), sqlc.arg(total_in), sqlc.arg(total_out), sqlc.arg(reserved))
+ON CONFLICT (business_root_id, token_type) DO UPDATE SET
+    total_in = EXCLUDED.total_in,
+    total_out = EXCLUDED.total_out,
+    reserved = EXCLUDED.reserved
+RETURNING *;
+
+-- name: LockGenerativeTokenBalanceByBusinessRootId :exec
+SELECT id FROM generative_token_balances
+WHERE business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+FOR UPDATE;
+
+-- name: GetGenerativeTokenBalanceDrifts :many
+-- hitung ulang saldo dari transaksi & reservation aktif lalu bandingkan dengan snapshot (per business & token_type)
+WITH trx AS (
+    SELECT
+        business_root_id,
+        token_type,
+        COALESCE(SUM(amount) FILTER (WHERE type = 'in'), 0)::bigint  AS total_in,
+        COALESCE(SUM(amount) FILTER (WHERE type = 'out'), 0)::bigint AS total_out
+    FROM generative_token_transactions
+    WHERE deleted_at IS NULL
+    GROUP BY business_root_id, token_type
+),
+res AS (
+    SELECT business_root_id, token_type, COALESCE(SUM(amount), 0)::bigint AS reserved
+    FROM generative_token_reservations
+    WHERE status = 'reserved' AND deleted_at IS NULL
+    GROUP BY business_root_id, token_type
+),
+expected AS (
+    SELECT
+        COALESCE(trx.business_root_id, res.business_root_id) AS business_root_id,
+        COALESCE(trx.token_type, res.token_type) AS token_type,
+        COALESCE(trx.total_in, 0)::bigint  AS total_in,
+        COALESCE(trx.total_out, 0)::bigint AS total_out,
+        COALESCE(res.reserved, 0)::bigint  AS reserved
+    FROM trx
+    FULL OUTER JOIN res
+        ON res.business_root_id = trx.business_root_id AND res.token_type = trx.token_type
+)
+SELECT
+    COALESCE(e.business_root_id, b.business_root_id)::bigint AS business_root_id,
+    COALESCE(e.token_type, b.token_type)::token_type AS token_type,
+    COALESCE(e.total_in, 0)::bigint  AS expected_total_in,
+    COALESCE(e.total_out, 0)::bigint AS expected_total_out,
+    COALESCE(e.reserved, 0)::bigint  AS expected_reserved,
+    COALESCE(b.total_in, 0)::bigint  AS actual_total_in,
+    COALESCE(b.total_out, 0)::bigint AS actual_total_out,
+    COALESCE(b.reserved, 0)::bigint  AS actual_reserved
+FROM expected e
+FULL OUTER JOIN generative_token_balances b
+    ON b.business_root_id = e.business_root_id
+    AND b.token_type = e.token_type
+    AND b.deleted_at IS NULL
+WHERE
+    COALESCE(e.total_in, 0)  <> COALESCE(b.total_in, 0)
+    OR COALESCE(e.total_out, 0) <> COALESCE(b.total_out, 0)
+    OR COALESCE(e.reserved, 0)  <> COALESCE(b.reserved, 0)
+ORDER BY 1, 2;
+
+-- name: GetGenerativeTokenExpectedBalanceByBusinessRootId :one
+-- hitung ulang saldo satu business & token_type dari transaksi & reservation aktif
+WITH trx AS (
+    SELECT
+        COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'in'), 0)::bigint  AS total_in,
+        COALESCE(SUM(t.amount) FILTER (WHERE t.type = 'out'), 0)::bigint AS total_out
+    FROM generative_token_transactions t
+    WHERE t.business_root_id = sqlc.arg(business_root_id)
+        AND t.token_type = sqlc.arg(token_type)
+        AND t.deleted_at IS NULL
+),
+res AS (
+    SELECT COALESCE(SUM(r.amount), 0)::bigint AS reserved
+    FROM generative_token_reservations r
+    WHERE r.business_root_id = sqlc.arg(business_root_id)
+        AND r.token_type = sqlc.arg(token_type)
+        AND r.status = 'reserved'
+        AND r.deleted_at IS NULL
+)
+SELECT trx.total_in, trx.total_out, res.reserved
+FROM trx CROSS JOIN res;
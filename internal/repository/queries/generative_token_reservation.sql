@@ -0,0 +1,35 @@
+-- name: CreateGenerativeTokenReservation :one
+INSERT INTO generative_token_reservations (
+    token_type,
+    amount,
+    profile_id,
+    business_root_id,
+    generative_image_model_id,
+    expires_at
+) VALUES (
+    sqlc.arg(token_type),
+    sqlc.arg(amount),
+    sqlc.arg(profile_id),
+    sqlc.arg(business_root_id),
+    sqlc.narg(generative_image_model_id),
+    sqlc.arg(expires_at)
+) RETURNING *;
+
+-- name: GetGenerativeTokenReservationByIdForUpdate :one
+SELECT * FROM generative_token_reservations
+WHERE id = sqlc.arg(id) AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: UpdateGenerativeTokenReservationStatus :one
+UPDATE generative_token_reservations
+SET status = sqlc.arg(status),
+    generative_token_transaction_id = sqlc.narg(generative_token_transaction_id)
+WHERE id = sqlc.arg(id) AND status = 'reserved'
+RETURNING *;
+
+-- name: GetExpiredGenerativeTokenReservationIds :many
+SELECT id FROM generative_token_reservations
+WHERE status = 'reserved'
+    AND expires_at < NOW()
+    AND deleted_at IS NULL
+ORDER BY id;
@@ -1,44 +1,58 @@
--- name: CreateGenerativeTokenImageTransaction :one
-INSERT INTO generative_token_image_transactions (
+-- name: CreateGenerativeTokenTransaction :one
+INSERT INTO generative_token_transactions (
+    token_type,
     type,
     amount,
     profile_id,
     business_root_id,
-    payment_history_id
+    payment_history_id,
+    generative_image_model_id
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 ) RETURNING *;
 
--- name: GetGenerativeTokenImageTransactionByPaymentHistoryId :one
-SELECT * FROM generative_token_image_transactions
-WHERE payment_history_id = $1 AND deleted_at IS NULL;
+-- name: GetGenerativeTokenTransactionByPaymentHistoryId :one
+-- token 'in' hasil payment (payment yang di-refund juga punya token 'out')
+SELECT * FROM generative_token_transactions
+WHERE payment_history_id = $1 AND type = 'in' AND deleted_at IS NULL;
+
+-- name: RefundGenerativeTokenTransaction :one
+-- refund token 'out' (ex: provider gagal generate), soft delete agar tidak terhitung di SUM
+UPDATE generative_token_transactions
+SET deleted_at = NOW()
+WHERE id = $1 AND type = 'out' AND deleted_at IS NULL
+RETURNING *;
 
 -- name: GetSuccessPaymentIdsWithoutTokenTransaction :many
-SELECT ph.id, ph.profile_id, ph.business_root_id, ph.product_amount
+-- hanya payment product token (image / video / livestream)
+SELECT ph.id, ph.profile_id, ph.business_root_id, ph.product_amount, ph.record_product_type
 FROM payment_histories ph
-LEFT JOIN generative_token_image_transactions gt 
-    ON gt.payment_history_id = ph.id AND gt.deleted_at IS NULL
-WHERE 
+LEFT JOIN generative_token_transactions gt
+    ON gt.payment_history_id = ph.id AND gt.type = 'in' AND gt.deleted_at IS NULL
+WHERE
     ph.id = ANY(sqlc.arg(payment_ids)::uuid[])
     AND ph.status = 'success'
+    AND ph.record_product_type IN ('image_token', 'video_token', 'livestream_token')
     AND ph.deleted_at IS NULL
     AND gt.id IS NULL;
 
 -- name: SumTokenByBusinessAndType :one
-SELECT 
+SELECT
     COALESCE(SUM(amount), 0)::bigint AS total
-FROM generative_token_image_transactions
-WHERE 
-    business_root_id = $1 
-    AND type = $2
+FROM generative_token_transactions
+WHERE
+    business_root_id = sqlc.arg(business_root_id)
+    AND token_type = sqlc.arg(token_type)
+    AND type = sqlc.arg(type)
     AND deleted_at IS NULL;
 
 -- name: GetAllTokenTransactionsByBusiness :many
 SELECT t.*
-FROM generative_token_image_transactions t
+FROM generative_token_transactions t
 WHERE
     t.deleted_at IS NULL
     AND t.business_root_id = sqlc.arg(business_root_id)
+    AND t.token_type = sqlc.arg(token_type)
     AND (
         sqlc.narg(type)::token_transaction_type IS NULL
         OR t.type = sqlc.narg(type)::token_transaction_type
@@ -64,10 +78,11 @@ OFFSET sqlc.arg(page_offset);
 
 -- name: CountAllTokenTransactionsByBusiness :one
 SELECT COUNT(*)::bigint AS total
-FROM generative_token_image_transactions t
+FROM generative_token_transactions t
 WHERE
     t.deleted_at IS NULL
     AND t.business_root_id = sqlc.arg(business_root_id)
+    AND t.token_type = sqlc.arg(token_type)
     AND (
         sqlc.narg(type)::token_transaction_type IS NULL
         OR t.type = sqlc.narg(type)::token_transaction_type
@@ -80,4 +95,3 @@ WHERE
         sqlc.narg(date_end)::date IS NULL
         OR t.created_at::date <= sqlc.narg(date_end)::date
     );
-
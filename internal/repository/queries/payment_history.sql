@@ -25,9 +25,14 @@ INSERT INTO payment_histories (
     midtrans_transaction_id,
     midtrans_expired_at,
     payment_pending_at,
-    total_amount
+    total_amount,
+    reference_creator_image_id,
+    gateway,
+    exchange_rate_id,
+    exchange_base_currency,
+    exchange_rate_micros
 ) VALUES (
-    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31
 ) RETURNING *;
 
 -- name: GetPaymentHistoryById :one
@@ -38,9 +43,10 @@ WHERE id = $1 AND deleted_at IS NULL;
 SELECT * FROM payment_histories
 WHERE id = $1 AND profile_id = $2 AND deleted_at IS NULL;
 
--- name: GetPaymentHistoryByMidtransTransactionId :one
+-- name: GetPaymentHistoryByGatewayTransactionId :one
+-- transaction id hanya unik per gateway (dipakai webhook)
 SELECT * FROM payment_histories
-WHERE midtrans_transaction_id = $1 AND deleted_at IS NULL;
+WHERE gateway = sqlc.arg(gateway) AND midtrans_transaction_id = sqlc.arg(transaction_id) AND deleted_at IS NULL;
 
 -- name: GetAllPaymentHistories :many
 SELECT p.*
@@ -94,6 +100,30 @@ SET
 WHERE id = @id AND deleted_at IS NULL
 RETURNING *;
 
+-- name: GetPaymentHistoryByIdForUpdate :one
+SELECT * FROM payment_histories
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: UpdatePaymentHistoryRefund :one
+-- refunded_amount kumulatif, payment_refunded_at diisi saat refund pertama
+UPDATE payment_histories
+SET
+    status = 'refunded'::payment_status,
+    refunded_amount = @refunded_amount,
+    payment_refunded_at = COALESCE(payment_refunded_at, NOW())
+WHERE id = @id AND deleted_at IS NULL
+RETURNING *;
+
+-- name: GetStalePendingPaymentHistories :many
+-- payment pending yang dibuat sebelum pending_before (kandidat reconcile jika webhook hilang)
+SELECT * FROM payment_histories
+WHERE status = 'pending'::payment_status
+  AND created_at < @pending_before
+  AND deleted_at IS NULL
+ORDER BY created_at ASC
+LIMIT @row_limit;
+
 -- name: UpdatePaymentHistoryMidtransId :one
 UPDATE payment_histories
 SET midtrans_transaction_id = $2
@@ -0,0 +1,48 @@
+-- name: CreatePaymentHistoryRefund :one
+INSERT INTO payment_history_refunds (
+    payment_history_id,
+    source,
+    refund_key,
+    amount,
+    currency,
+    reason,
+    token_amount_expected,
+    token_amount_clawed_back,
+    generative_token_transaction_id,
+    actor_profile_id,
+    status
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+) RETURNING *;
+
+-- name: GetPaymentHistoryRefundsByPaymentHistoryId :many
+SELECT * FROM payment_history_refunds
+WHERE payment_history_id = $1 AND deleted_at IS NULL
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetPendingPaymentHistoryRefundsByPaymentHistoryId :many
+-- wajib dipanggil di dalam transaction setelah lock payment
+SELECT * FROM payment_history_refunds
+WHERE payment_history_id = $1 AND status = 'pending' AND deleted_at IS NULL
+ORDER BY id ASC
+FOR UPDATE;
+
+-- name: GetPaymentHistoryRefundByRefundKeyForUpdate :one
+SELECT * FROM payment_history_refunds
+WHERE refund_key = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: CompletePaymentHistoryRefund :one
+UPDATE payment_history_refunds
+SET status = 'succeeded',
+    amount = sqlc.arg(amount),
+    token_amount_expected = sqlc.arg(token_amount_expected),
+    token_amount_clawed_back = sqlc.arg(token_amount_clawed_back),
+    generative_token_transaction_id = sqlc.arg(generative_token_transaction_id)
+WHERE id = sqlc.arg(id) AND status = 'pending'
+RETURNING *;
+
+-- name: FailPaymentHistoryRefund :exec
+UPDATE payment_history_refunds
+SET status = 'failed'
+WHERE id = $1 AND status = 'pending';
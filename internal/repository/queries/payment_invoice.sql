@@ -0,0 +1,47 @@
+-- name: NextPaymentInvoiceSequence :one
+-- increment atomik per tahun (wajib dipanggil di dalam transaction agar nomor tidak loncat saat gagal)
+INSERT INTO payment_invoice_sequences (year, last_sequence)
+VALUES (sqlc.arg(year), 1)
+ON CONFLICT (year) DO UPDATE
+SET last_sequence = payment_invoice_sequences.last_sequence + 1
+RETURNING last_sequence;
+
+-- name: CreatePaymentInvoice :one
+INSERT INTO payment_invoices (
+    payment_history_id,
+    business_root_id,
+    invoice_number,
+    invoice_year,
+    invoice_sequence,
+    bill_to_name,
+    bill_to_email,
+    bill_to_business_name,
+    object_key,
+    issued_at
+) VALUES (
+    sqlc.arg(payment_history_id),
+    sqlc.arg(business_root_id),
+    sqlc.arg(invoice_number),
+    sqlc.arg(invoice_year),
+    sqlc.arg(invoice_sequence),
+    sqlc.arg(bill_to_name),
+    sqlc.arg(bill_to_email),
+    sqlc.narg(bill_to_business_name),
+    sqlc.arg(object_key),
+    sqlc.arg(issued_at)
+) RETURNING *;
+
+-- name: GetPaymentInvoiceByPaymentHistoryId :one
+SELECT * FROM payment_invoices
+WHERE payment_history_id = sqlc.arg(payment_history_id) AND deleted_at IS NULL;
+
+-- name: GetPaymentInvoiceBillTo :one
+-- data bill to untuk invoice (nama business bisa null jika business knowledge belum diisi)
+SELECT
+    p.name AS profile_name,
+    p.email AS profile_email,
+    kn.name AS business_name
+FROM payment_histories ph
+JOIN profiles p ON p.id = ph.profile_id
+LEFT JOIN business_knowledges kn ON kn.business_root_id = ph.business_root_id AND kn.deleted_at IS NULL
+WHERE ph.id = sqlc.arg(payment_history_id);
@@ -0,0 +1,38 @@
+-- name: CreatePostDeliveryAttempt :one
+INSERT INTO post_delivery_attempts (
+    business_scheduled_post_id,
+    business_root_id,
+    platform,
+    attempt_number,
+    status,
+    is_final,
+    response_code,
+    response_body,
+    external_post_id,
+    error_message,
+    started_at,
+    finished_at
+)
+VALUES (
+    sqlc.arg(business_scheduled_post_id),
+    sqlc.arg(business_root_id),
+    sqlc.arg(platform),
+    sqlc.arg(attempt_number),
+    sqlc.arg(status),
+    sqlc.arg(is_final),
+    sqlc.narg(response_code),
+    sqlc.narg(response_body),
+    sqlc.narg(external_post_id),
+    sqlc.narg(error_message),
+    sqlc.arg(started_at),
+    sqlc.arg(finished_at)
+)
+RETURNING *;
+
+-- name: GetPostDeliveryAttemptsByScheduledPostId :many
+SELECT *
+FROM post_delivery_attempts
+WHERE business_scheduled_post_id = sqlc.arg(business_scheduled_post_id)
+  AND business_root_id = sqlc.arg(business_root_id)
+  AND deleted_at IS NULL
+ORDER BY attempt_number ASC, id ASC;
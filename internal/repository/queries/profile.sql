@@ -16,3 +16,15 @@ UPDATE profiles
 SET name = $2, image_url = $3, country_code = $4, phone = $5, description = $6
 WHERE id = $1
 RETURNING *;
+
+-- name: GetProfileByIdForUpdate :one
+-- Lock row profile agar perubahan login provider (link / unlink) tidak balapan
+SELECT * FROM profiles
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: UpdateProfileEmail :one
+UPDATE profiles
+SET email = $2
+WHERE id = $1
+RETURNING *;
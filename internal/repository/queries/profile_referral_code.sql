@@ -18,3 +18,153 @@ FROM profile_referral_codes
 WHERE code = $1
   AND deleted_at IS NULL;
 
+
+
+-- name: LockProfileReferralCodeById :exec
+-- serialisasi pemakaian code agar max_usage (global cap) tidak terlewati
+SELECT id FROM profile_referral_codes
+WHERE id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: CreateProfileReferralCodeSpecial :one
+INSERT INTO profile_referral_codes (
+    profile_id,
+    code,
+    type,
+    is_active,
+    total_discount,
+    discount_type,
+    max_discount,
+    max_usage,
+    reward_per_referral,
+    starts_at,
+    ends_at,
+    allowed_product_types,
+    created_by_profile_id
+) VALUES (
+    sqlc.arg(profile_id),
+    sqlc.arg(code),
+    'special',
+    sqlc.arg(is_active),
+    sqlc.arg(total_discount),
+    sqlc.arg(discount_type),
+    sqlc.arg(max_discount),
+    sqlc.narg(max_usage),
+    sqlc.arg(reward_per_referral),
+    sqlc.narg(starts_at),
+    sqlc.narg(ends_at),
+    sqlc.arg(allowed_product_types)::text[],
+    sqlc.arg(created_by_profile_id)
+)
+RETURNING *;
+
+-- name: UpdateProfileReferralCodeSpecial :one
+UPDATE profile_referral_codes
+SET
+    profile_id = sqlc.arg(profile_id),
+    code = sqlc.arg(code),
+    is_active = sqlc.arg(is_active),
+    total_discount = sqlc.arg(total_discount),
+    discount_type = sqlc.arg(discount_type),
+    max_discount = sqlc.arg(max_discount),
+    max_usage = sqlc.narg(max_usage),
+    reward_per_referral = sqlc.arg(reward_per_referral),
+    starts_at = sqlc.narg(starts_at),
+    ends_at = sqlc.narg(ends_at),
+    allowed_product_types = sqlc.arg(allowed_product_types)::text[]
+WHERE id = sqlc.arg(id)
+    AND type = 'special'
+    AND deleted_at IS NULL
+RETURNING *;
+
+-- name: SoftDeleteProfileReferralCodeSpecial :one
+UPDATE profile_referral_codes
+SET deleted_at = NOW(), is_active = FALSE
+WHERE id = sqlc.arg(id)
+    AND type = 'special'
+    AND deleted_at IS NULL
+RETURNING id;
+
+-- name: GetProfileReferralCodeSpecialById :one
+SELECT
+    prc.*,
+    pr.name  AS owner_name,
+    pr.email AS owner_email,
+    (
+        SELECT COUNT(*)::int FROM referral_records rr
+        WHERE rr.profile_referral_code_id = prc.id
+            AND rr.status IN ('pending', 'success')
+            AND rr.deleted_at IS NULL
+    ) AS usage_count
+FROM profile_referral_codes prc
+JOIN profiles pr ON pr.id = prc.profile_id
+WHERE prc.id = sqlc.arg(id)
+    AND prc.type = 'special'
+    AND prc.deleted_at IS NULL;
+
+-- name: GetAllProfileReferralCodesSpecial :many
+WITH p AS (
+  SELECT
+    COALESCE(NULLIF(sqlc.narg(sort_by),  ''), 'created_at') AS sort_by,
+    COALESCE(NULLIF(sqlc.narg(sort_dir), ''), 'desc')       AS sort_dir
+)
+SELECT
+    prc.*,
+    pr.name  AS owner_name,
+    pr.email AS owner_email,
+    (
+        SELECT COUNT(*)::int FROM referral_records rr
+        WHERE rr.profile_referral_code_id = prc.id
+            AND rr.status IN ('pending', 'success')
+            AND rr.deleted_at IS NULL
+    ) AS usage_count
+FROM profile_referral_codes prc
+JOIN profiles pr ON pr.id = prc.profile_id
+CROSS JOIN p
+WHERE
+    prc.type = 'special'
+    AND prc.deleted_at IS NULL
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR prc.code ILIKE ('%' || sqlc.narg(search) || '%')
+        OR pr.name ILIKE ('%' || sqlc.narg(search) || '%')
+        OR pr.email ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(is_active)::boolean IS NULL
+        OR prc.is_active = sqlc.narg(is_active)::boolean
+    )
+ORDER BY
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'asc'  THEN prc.created_at END ASC,
+    CASE WHEN p.sort_by = 'created_at' AND p.sort_dir = 'desc' THEN prc.created_at END DESC,
+
+    CASE WHEN p.sort_by = 'updated_at' AND p.sort_dir = 'asc'  THEN prc.updated_at END ASC,
+    CASE WHEN p.sort_by = 'updated_at' AND p.sort_dir = 'desc' THEN prc.updated_at END DESC,
+
+    CASE WHEN p.sort_by = 'code' AND p.sort_dir = 'asc'  THEN prc.code END ASC,
+    CASE WHEN p.sort_by = 'code' AND p.sort_dir = 'desc' THEN prc.code END DESC,
+
+    CASE WHEN p.sort_by = 'ends_at' AND p.sort_dir = 'asc'  THEN prc.ends_at END ASC,
+    CASE WHEN p.sort_by = 'ends_at' AND p.sort_dir = 'desc' THEN prc.ends_at END DESC,
+
+    prc.id DESC
+LIMIT sqlc.arg(page_limit)
+OFFSET sqlc.arg(page_offset);
+
+-- name: CountAllProfileReferralCodesSpecial :one
+SELECT COUNT(*)::bigint AS total
+FROM profile_referral_codes prc
+JOIN profiles pr ON pr.id = prc.profile_id
+WHERE
+    prc.type = 'special'
+    AND prc.deleted_at IS NULL
+    AND (
+        COALESCE(sqlc.narg(search), '') = ''
+        OR prc.code ILIKE ('%' || sqlc.narg(search) || '%')
+        OR pr.name ILIKE ('%' || sqlc.narg(search) || '%')
+        OR pr.email ILIKE ('%' || sqlc.narg(search) || '%')
+    )
+    AND (
+        sqlc.narg(is_active)::boolean IS NULL
+        OR prc.is_active = sqlc.narg(is_active)::boolean
+    );
@@ -0,0 +1,54 @@
+-- name: GetProfileTwoFactor :one
+SELECT * FROM profile_two_factors
+WHERE profile_id = $1
+LIMIT 1;
+
+-- name: UpsertPendingProfileTwoFactor :one
+-- enroll ulang hanya boleh selama belum aktif (enabled_at NULL)
+INSERT INTO profile_two_factors (profile_id, secret_encrypted)
+VALUES ($1, $2)
+ON CONFLICT (profile_id) DO UPDATE
+SET secret_encrypted = EXCLUDED.secret_encrypted,
+    last_used_step = NULL
+WHERE profile_two_factors.enabled_at IS NULL
+RETURNING *;
+
+-- name: EnableProfileTwoFactor :one
+UPDATE profile_two_factors
+SET enabled_at = now()
+WHERE profile_id = $1
+  AND enabled_at IS NULL
+RETURNING *;
+
+-- name: UseProfileTwoFactorStep :one
+-- atomic anti replay: step hanya bisa dipakai sekali & harus lebih baru
+UPDATE profile_two_factors
+SET last_used_step = sqlc.arg(step)::bigint
+WHERE profile_id = sqlc.arg(profile_id)
+  AND (last_used_step IS NULL OR last_used_step < sqlc.arg(step)::bigint)
+RETURNING *;
+
+-- name: DeleteProfileTwoFactor :exec
+DELETE FROM profile_two_factors
+WHERE profile_id = $1;
+
+-- name: CreateProfileTwoFactorRecoveryCode :exec
+INSERT INTO profile_two_factor_recovery_codes (profile_id, code_hash)
+VALUES ($1, $2);
+
+-- name: UseProfileTwoFactorRecoveryCode :one
+UPDATE profile_two_factor_recovery_codes
+SET used_at = now()
+WHERE profile_id = $1
+  AND code_hash = $2
+  AND used_at IS NULL
+RETURNING *;
+
+-- name: CountUnusedProfileTwoFactorRecoveryCodes :one
+SELECT COUNT(*) FROM profile_two_factor_recovery_codes
+WHERE profile_id = $1
+  AND used_at IS NULL;
+
+-- name: DeleteProfileTwoFactorRecoveryCodes :exec
+DELETE FROM profile_two_factor_recovery_codes
+WHERE profile_id = $1;
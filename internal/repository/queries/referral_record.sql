@@ -52,3 +52,10 @@ SELECT COUNT(*)::int AS count FROM referral_records
 WHERE profile_referral_code_id = $1 
 AND status IN ('pending', 'success')
 AND deleted_at IS NULL;
+
+-- name: GetReferralRecordWithOwnerById :one
+-- owner = profile pemilik referral code (penerima reward)
+SELECT rr.*, prc.profile_id AS owner_profile_id
+FROM referral_records rr
+JOIN profile_referral_codes prc ON prc.id = rr.profile_referral_code_id
+WHERE rr.id = sqlc.arg(id) AND rr.deleted_at IS NULL;
@@ -26,3 +26,7 @@ WHERE id = $1 RETURNING *;
 UPDATE users
 SET password = $2
 WHERE id = $1 RETURNING *;
+
+-- name: DeleteUserByProfileIdAndProvider :execrows
+DELETE FROM users
+WHERE profile_id = $1 AND provider = $2;
@@ -0,0 +1,10 @@
+// internal/repository/redis/email_change_repository/dto.go
+package email_change_repository
+
+import "github.com/google/uuid"
+
+type PendingEmailChange struct {
+	ProfileID uuid.UUID
+	// jti pasangan token confirm & revert
+	RequestID string
+}
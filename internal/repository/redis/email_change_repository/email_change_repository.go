@@ -0,0 +1,87 @@
+// internal/repository/redis/email_change_repository/email_change_repository.go
+package email_change_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// EmailChangeRepo menyimpan request ganti email yang masih pending (1 per profile).
+// Request baru menimpa request lama, sehingga token confirm lama tidak berlaku lagi.
+type EmailChangeRepo struct {
+	rdb *redis.Client
+}
+
+func NewEmailChangeRepository(rdb *redis.Client) *EmailChangeRepo {
+	return &EmailChangeRepo{rdb: rdb}
+}
+
+// 1. SAVE PENDING (TTL = umur token confirm)
+func (r *EmailChangeRepo) SavePendingEmailChange(ctx context.Context, input PendingEmailChange, ttl time.Duration) error {
+	return r.rdb.Set(ctx, r.constructKey(input.ProfileID), input.RequestID, ttl).Err()
+}
+
+// 2. GET PENDING, "" jika tidak ada / expired
+func (r *EmailChangeRepo) GetPendingEmailChange(ctx context.Context, profileID uuid.UUID) (string, error) {
+	requestID, err := r.rdb.Get(ctx, r.constructKey(profileID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return requestID, nil
+}
+
+// 3. DELETE PENDING (confirm / revert)
+func (r *EmailChangeRepo) DeletePendingEmailChange(ctx context.Context, profileID uuid.UUID) error {
+	return r.rdb.Del(ctx, r.constructKey(profileID)).Err()
+}
+
+// 4. SAVE REVERT WINDOW setelah konfirmasi (TTL = sisa umur token revert).
+// Selama window terbuka, revert dikunci ke request ini & request ganti email baru ditolak.
+func (r *EmailChangeRepo) SaveRevertWindow(ctx context.Context, input PendingEmailChange, ttl time.Duration) error {
+	return r.rdb.Set(ctx, r.constructRevertKey(input.ProfileID), input.RequestID, ttl).Err()
+}
+
+// 5. GET REVERT WINDOW, nil jika tidak ada / expired
+func (r *EmailChangeRepo) GetRevertWindow(ctx context.Context, profileID uuid.UUID) (*RevertWindowResponse, error) {
+	key := r.constructRevertKey(profileID)
+
+	pipe := r.rdb.Pipeline()
+	getCmd := pipe.Get(ctx, key)
+	ttlCmd := pipe.TTL(ctx, key)
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	requestID, err := getCmd.Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &RevertWindowResponse{
+		RequestID:         requestID,
+		RetryAfterSeconds: int64(ttlCmd.Val().Seconds()),
+	}, nil
+}
+
+// 6. DELETE REVERT WINDOW (revert berhasil)
+func (r *EmailChangeRepo) DeleteRevertWindow(ctx context.Context, profileID uuid.UUID) error {
+	return r.rdb.Del(ctx, r.constructRevertKey(profileID)).Err()
+}
+
+func (r *EmailChangeRepo) constructKey(profileID uuid.UUID) string {
+	return fmt.Sprintf("email_change:%s", profileID.String())
+}
+
+func (r *EmailChangeRepo) constructRevertKey(profileID uuid.UUID) string {
+	return fmt.Sprintf("email_change_revert:%s", profileID.String())
+}
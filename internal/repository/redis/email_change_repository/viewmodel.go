@@ -0,0 +1,8 @@
+// internal/repository/redis/email_change_repository/viewmodel.go
+package email_change_repository
+
+type RevertWindowResponse struct {
+	// jti request yang sudah dikonfirmasi
+	RequestID         string
+	RetryAfterSeconds int64
+}
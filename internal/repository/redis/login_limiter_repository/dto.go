@@ -0,0 +1,16 @@
+// internal/repository/redis/login_limiter_repository/dto.go
+package login_limiter_repository
+
+// LimiterLoginScope identitas yang dihitung percobaan login gagalnya
+type LimiterLoginScope string
+
+const (
+	LimiterLoginScopeEmail LimiterLoginScope = "email"
+	LimiterLoginScopeIP    LimiterLoginScope = "ip"
+)
+
+type LimiterLoginInput struct {
+	Scope LimiterLoginScope
+	// email (lowercase) atau client IP
+	Identifier string
+}
@@ -0,0 +1,97 @@
+// internal/repository/redis/login_limiter_repository/login_limiter_repository.go
+package login_limiter_repository
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type LimiterLoginRepo struct {
+	rdb *redis.Client
+}
+
+func NewLimiterLoginRepository(rdb *redis.Client) *LimiterLoginRepo {
+	return &LimiterLoginRepo{rdb: rdb}
+}
+
+// 1. GET LIMITER (jumlah gagal dalam window + sisa delay & lockout)
+func (r *LimiterLoginRepo) GetLimiterLogin(ctx context.Context, input LimiterLoginInput, window time.Duration) (*LimiterLoginResponse, error) {
+	now := time.Now()
+
+	pipe := r.rdb.Pipeline()
+	failures := pipe.ZCount(ctx, r.constructFailureKey(input), strconv.FormatInt(now.Add(-window).UnixMilli(), 10), "+inf")
+	delay := pipe.TTL(ctx, r.constructDelayKey(input))
+	lock := pipe.TTL(ctx, r.constructLockKey(input))
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, err
+	}
+
+	return &LimiterLoginResponse{
+		Scope:         input.Scope,
+		Identifier:    input.Identifier,
+		Failures:      failures.Val(),
+		DelaySeconds:  ttlSeconds(delay.Val()),
+		LockedSeconds: ttlSeconds(lock.Val()),
+	}, nil
+}
+
+// 2. RECORD FAILURE (sliding window: sorted set timestamp percobaan gagal)
+func (r *LimiterLoginRepo) RecordFailure(ctx context.Context, input LimiterLoginInput, window time.Duration) (int64, error) {
+	key := r.constructFailureKey(input)
+	now := time.Now()
+
+	pipe := r.rdb.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-window).UnixMilli(), 10))
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixMilli()), Member: uuid.NewString()})
+	count := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return count.Val(), nil
+}
+
+// 3. SAVE DELAY (progressive delay, cukup key + TTL)
+func (r *LimiterLoginRepo) SaveDelay(ctx context.Context, input LimiterLoginInput, ttl time.Duration) error {
+	return r.rdb.Set(ctx, r.constructDelayKey(input), input.Identifier, ttl).Err()
+}
+
+// 4. SAVE LOCK (temporary lockout), false = sudah terkunci sebelumnya
+func (r *LimiterLoginRepo) SaveLock(ctx context.Context, input LimiterLoginInput, ttl time.Duration) (bool, error) {
+	return r.rdb.SetNX(ctx, r.constructLockKey(input), input.Identifier, ttl).Result()
+}
+
+// 5. RESET (login sukses / admin unlock)
+func (r *LimiterLoginRepo) DeleteLimiterLogin(ctx context.Context, input LimiterLoginInput) error {
+	return r.rdb.Del(ctx,
+		r.constructFailureKey(input),
+		r.constructDelayKey(input),
+		r.constructLockKey(input),
+	).Err()
+}
+
+func (r *LimiterLoginRepo) constructFailureKey(input LimiterLoginInput) string {
+	return fmt.Sprintf("limiter_login:%s:%s", input.Scope, input.Identifier)
+}
+
+func (r *LimiterLoginRepo) constructDelayKey(input LimiterLoginInput) string {
+	return fmt.Sprintf("limiter_login_delay:%s:%s", input.Scope, input.Identifier)
+}
+
+func (r *LimiterLoginRepo) constructLockKey(input LimiterLoginInput) string {
+	return fmt.Sprintf("limiter_login_lock:%s:%s", input.Scope, input.Identifier)
+}
+
+// ttlSeconds: TTL -2 (tidak ada) / -1 (tanpa expiry) dianggap 0
+func ttlSeconds(d time.Duration) int64 {
+	if d <= 0 {
+		return 0
+	}
+	return int64(math.Ceil(d.Seconds()))
+}
@@ -0,0 +1,13 @@
+// internal/repository/redis/login_limiter_repository/viewmodel.go
+package login_limiter_repository
+
+type LimiterLoginResponse struct {
+	Scope      LimiterLoginScope
+	Identifier string
+	// jumlah login gagal dalam sliding window
+	Failures int64
+	// sisa progressive delay (detik), 0 = boleh mencoba
+	DelaySeconds int64
+	// sisa lockout (detik), 0 = tidak terkunci
+	LockedSeconds int64
+}
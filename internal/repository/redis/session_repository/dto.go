@@ -17,3 +17,9 @@ type RedisSession struct {
 	CreatedAt    time.Time `json:"createdAt"`
 	ExpiredAt    time.Time `json:"expiredAt"`
 }
+
+// SessionRef isi index refresh token (aktif / sudah dirotasi) -> session
+type SessionRef struct {
+	ProfileID uuid.UUID
+	SessionID uuid.UUID
+}
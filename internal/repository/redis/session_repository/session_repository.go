@@ -3,8 +3,11 @@ package session_repository
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,19 +22,18 @@ func NewSessionRepository(rdb *redis.Client) *SessionRepository {
 	return &SessionRepository{rdb: rdb}
 }
 
-// 1. SAVE SESSION (Create)
+// 1. SAVE SESSION (Create) + index hash refresh token -> session
 func (r *SessionRepository) SaveSession(ctx context.Context, session RedisSession, ttl time.Duration) error {
-	key := r.constructKey(session.ProfileID, session.ID)
-
 	data, err := json.Marshal(session)
 	if err != nil {
 		return err
 	}
 
-	if err := r.rdb.Set(ctx, key, data, ttl).Err(); err != nil {
-		return err
-	}
-	return nil
+	pipe := r.rdb.TxPipeline()
+	pipe.Set(ctx, r.constructKey(session.ProfileID, session.ID), data, ttl)
+	pipe.Set(ctx, r.constructTokenKey(session.RefreshToken), r.constructIndexValue(session.ProfileID, session.ID), ttl)
+	_, err = pipe.Exec(ctx)
+	return err
 }
 
 // 2. GET ALL SESSIONS BY PROFILE ID
@@ -84,81 +86,222 @@ func (r *SessionRepository) GetSessionsByProfileID(ctx context.Context, profileI
 	return sessions, nil
 }
 
-// 3. DELETE SESSION BY ID
+// 3. DELETE SESSION BY ID (beserta index refresh token aktif)
 func (r *SessionRepository) DeleteSessionByID(ctx context.Context, profileID uuid.UUID, sessionID uuid.UUID) error {
 	key := r.constructKey(profileID, sessionID)
-	return r.rdb.Del(ctx, key).Err()
+
+	sess, err := r.getSessionByKey(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{key}
+	if sess != nil {
+		keys = append(keys, r.constructTokenKey(sess.RefreshToken))
+	}
+	return r.rdb.Del(ctx, keys...).Err()
 }
 
 // 4. DELETE ALL SESSIONS BY PROFILE ID (Logout All Devices)
 func (r *SessionRepository) DeleteAllSessions(ctx context.Context, profileID uuid.UUID) error {
-	pattern := fmt.Sprintf("session:%s:*", profileID)
-
-	// Cari semua key
-	iter := r.rdb.Scan(ctx, 0, pattern, 0).Iterator()
-	var keys []string
-	for iter.Next(ctx) {
-		keys = append(keys, iter.Val())
+	sessions, err := r.GetSessionsByProfileID(ctx, profileID)
+	if err != nil {
+		return err
 	}
 
-	if len(keys) == 0 {
+	if len(sessions) == 0 {
 		return nil
 	}
 
-	// Hapus sekaligus (Bulk Delete)
+	// Hapus sekaligus (Bulk Delete) session + index token
+	keys := make([]string, 0, len(sessions)*2)
+	for _, sess := range sessions {
+		keys = append(keys, r.constructKey(profileID, sess.ID), r.constructTokenKey(sess.RefreshToken))
+	}
 	return r.rdb.Del(ctx, keys...).Err()
 }
 
-// 5. DELETE BY REFRESH TOKEN (Logic: Search -> Delete)
-// Kita butuh profileID karena JWT Refresh Token mengandung profileID.
-// Tanpa profileID, kita harus scan SELURUH Redis (sangat berat).
+// 5. DELETE BY REFRESH TOKEN
 func (r *SessionRepository) DeleteByRefreshToken(ctx context.Context, profileID uuid.UUID, refreshToken string) error {
-	// 1. Ambil semua session user ini
-	sessions, err := r.GetSessionsByProfileID(ctx, profileID)
+	sess, err := r.GetSessionByRefreshToken(ctx, profileID, refreshToken)
 	if err != nil {
 		return err
 	}
 
-	// 2. Cari session yang refresh token-nya cocok
-	var targetSessionID uuid.UUID
-	for _, sess := range sessions {
-		if sess.RefreshToken == refreshToken {
-			targetSessionID = sess.ID
-			break
-		}
+	// Jika tidak ketemu, anggap sukses (idempotent)
+	if sess == nil {
+		return nil
+	}
+	return r.DeleteSessionByID(ctx, profileID, sess.ID)
+}
+
+// 6. GET SESSION BY REFRESH TOKEN (lookup via index hash token, tanpa scan)
+// profileID dari JWT tetap dicocokkan agar token milik profile lain tidak bisa dipakai.
+func (r *SessionRepository) GetSessionByRefreshToken(ctx context.Context, profileID uuid.UUID, refreshToken string) (*RedisSession, error) {
+	ref, err := r.getIndex(ctx, r.constructTokenKey(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	if ref == nil {
+		return r.getLegacySessionByRefreshToken(ctx, profileID, refreshToken)
+	}
+	if ref.ProfileID != profileID {
+		return nil, nil
 	}
 
-	// 3. Jika ketemu, hapus by ID
-	if targetSessionID != uuid.Nil {
-		return r.DeleteSessionByID(ctx, profileID, targetSessionID)
+	sess, err := r.getSessionByKey(ctx, r.constructKey(ref.ProfileID, ref.SessionID))
+	if err != nil || sess == nil {
+		return nil, err
 	}
 
-	// Jika tidak ketemu, anggap sukses (idempotent) atau return error
-	return nil
+	// index basi (session sudah dirotasi / ditimpa)
+	if sess.RefreshToken != refreshToken {
+		return nil, nil
+	}
+	return sess, nil
 }
 
-// 6. GET SESSION BY REFRESH TOKEN
-// Kita WAJIB meminta profileID agar pencarian efisien (hanya scan milik user tsb).
-func (r *SessionRepository) GetSessionByRefreshToken(ctx context.Context, profileID uuid.UUID, refreshToken string) (*RedisSession, error) {
-	// 1. Ambil semua session milik user ini (Reuse function yg sudah ada)
-	sessions, err := r.GetSessionsByProfileID(ctx, profileID)
+// 7. ROTATE REFRESH TOKEN
+// Rotasi diklaim dengan SETNX grace key (token lama -> token baru, selama graceTTL), lalu token lama ditandai used
+// dan session disimpan dengan token baru.
+// false = token lama sudah dirotasi request lain, rotatedTo berisi token baru hasil rotasi tersebut
+// ("" jika grace sudah lewat), session TIDAK diubah.
+func (r *SessionRepository) RotateRefreshToken(ctx context.Context, session RedisSession, oldRefreshToken string, ttl, usedTTL, graceTTL time.Duration) (rotatedTo string, ok bool, err error) {
+	graceKey := r.constructGraceTokenKey(oldRefreshToken)
+	claimed, err := r.rdb.SetNX(ctx, graceKey, session.RefreshToken, graceTTL).Result()
+	if err != nil {
+		return "", false, err
+	}
+	if !claimed {
+		rotatedTo, err := r.GetGraceRefreshToken(ctx, oldRefreshToken)
+		return rotatedTo, false, err
+	}
+
+	data, err := json.Marshal(session)
 	if err != nil {
+		return "", false, err
+	}
+
+	pipe := r.rdb.TxPipeline()
+	pipe.Set(ctx, r.constructUsedTokenKey(oldRefreshToken), r.constructIndexValue(session.ProfileID, session.ID), usedTTL)
+	pipe.Del(ctx, r.constructTokenKey(oldRefreshToken))
+	pipe.Set(ctx, r.constructKey(session.ProfileID, session.ID), data, ttl)
+	pipe.Set(ctx, r.constructTokenKey(session.RefreshToken), r.constructIndexValue(session.ProfileID, session.ID), ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", false, err
+	}
+	return session.RefreshToken, true, nil
+}
+
+// 8. GET ROTATED REFRESH TOKEN (deteksi reuse), nil jika token belum pernah dirotasi
+func (r *SessionRepository) GetRotatedRefreshToken(ctx context.Context, refreshToken string) (*SessionRef, error) {
+	return r.getIndex(ctx, r.constructUsedTokenKey(refreshToken))
+}
+
+// 9. GET GRACE REFRESH TOKEN: token baru hasil rotasi token lama, "" jika grace sudah lewat
+func (r *SessionRepository) GetGraceRefreshToken(ctx context.Context, refreshToken string) (string, error) {
+	val, err := r.rdb.Get(ctx, r.constructGraceTokenKey(refreshToken)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+	return val, err
+}
+
+// Helper Private
+
+// getLegacySessionByRefreshToken: session yang dibuat sebelum ada index session_token belum punya index,
+// dicari dengan scan session profile lalu index ditulis agar lookup berikutnya tanpa scan.
+// Bisa dihapus setelah JWT_REFRESH_TOKEN_EXPIRED sejak index diperkenalkan.
+func (r *SessionRepository) getLegacySessionByRefreshToken(ctx context.Context, profileID uuid.UUID, refreshToken string) (*RedisSession, error) {
+	// token yang sudah dirotasi tidak perlu scan (index sudah pasti pernah ada)
+	used, err := r.rdb.Exists(ctx, r.constructUsedTokenKey(refreshToken)).Result()
+	if err != nil || used > 0 {
 		return nil, err
 	}
 
-	// 2. Loop di memory Go (cepat karena session per user biasanya sedikit, misal < 10)
+	sessions, err := r.GetSessionsByProfileID(ctx, profileID)
+	if err != nil {
+		return nil, err
+	}
 	for _, sess := range sessions {
-		if sess.RefreshToken == refreshToken {
-			// Ketemu! Return pointer session
-			return &sess, nil
+		if sess.RefreshToken != refreshToken {
+			continue
 		}
-	}
 
-	// 3. Tidak ketemu
+		ttl := time.Until(sess.ExpiredAt)
+		if ttl <= 0 {
+			return nil, nil
+		}
+		if err := r.rdb.Set(ctx, r.constructTokenKey(refreshToken), r.constructIndexValue(profileID, sess.ID), ttl).Err(); err != nil {
+			return nil, err
+		}
+		return &sess, nil
+	}
 	return nil, nil
 }
 
-// Helper Private
+func (r *SessionRepository) getSessionByKey(ctx context.Context, key string) (*RedisSession, error) {
+	val, err := r.rdb.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var sess RedisSession
+	if err := json.Unmarshal([]byte(val), &sess); err != nil {
+		return nil, err
+	}
+	return &sess, nil
+}
+
+func (r *SessionRepository) getIndex(ctx context.Context, key string) (*SessionRef, error) {
+	val, err := r.rdb.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	profileIDStr, sessionIDStr, ok := strings.Cut(val, ":")
+	if !ok {
+		return nil, nil
+	}
+	profileID, err := uuid.Parse(profileIDStr)
+	if err != nil {
+		return nil, nil
+	}
+	sessionID, err := uuid.Parse(sessionIDStr)
+	if err != nil {
+		return nil, nil
+	}
+	return &SessionRef{ProfileID: profileID, SessionID: sessionID}, nil
+}
+
 func (r *SessionRepository) constructKey(profileID uuid.UUID, sessionID uuid.UUID) string {
 	return fmt.Sprintf("session:%s:%s", profileID.String(), sessionID.String())
 }
+
+// refresh token tidak disimpan mentah di key, cukup hash SHA-256
+func (r *SessionRepository) constructTokenKey(refreshToken string) string {
+	return fmt.Sprintf("session_token:%s", hashRefreshToken(refreshToken))
+}
+
+func (r *SessionRepository) constructUsedTokenKey(refreshToken string) string {
+	return fmt.Sprintf("session_token_used:%s", hashRefreshToken(refreshToken))
+}
+
+func (r *SessionRepository) constructGraceTokenKey(refreshToken string) string {
+	return fmt.Sprintf("session_token_grace:%s", hashRefreshToken(refreshToken))
+}
+
+func (r *SessionRepository) constructIndexValue(profileID uuid.UUID, sessionID uuid.UUID) string {
+	return profileID.String() + ":" + sessionID.String()
+}
+
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,29 @@
+// internal/repository/redis/social_oauth_state_repository/social_oauth_state_repository.go
+package social_oauth_state_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// SocialOAuthStateRepo menandai nonce state OAuth connect akun sosial yang sudah dipakai,
+// sehingga state yang sama tidak bisa di-replay selama masih berlaku.
+type SocialOAuthStateRepo struct {
+	rdb *redis.Client
+}
+
+func NewSocialOAuthStateRepository(rdb *redis.Client) *SocialOAuthStateRepo {
+	return &SocialOAuthStateRepo{rdb: rdb}
+}
+
+// 1. CONSUME STATE (TTL = sisa umur state), false = sudah pernah dipakai
+func (r *SocialOAuthStateRepo) ConsumeState(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	return r.rdb.SetNX(ctx, r.constructKey(nonce), "1", ttl).Result()
+}
+
+func (r *SocialOAuthStateRepo) constructKey(nonce string) string {
+	return fmt.Sprintf("social_oauth_state:%s", nonce)
+}
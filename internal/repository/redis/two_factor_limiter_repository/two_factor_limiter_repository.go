@@ -0,0 +1,74 @@
+// internal/repository/redis/two_factor_limiter_repository/two_factor_limiter_repository.go
+package two_factor_limiter_repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type LimiterTwoFactorRepo struct {
+	rdb *redis.Client
+}
+
+func NewLimiterTwoFactorRepository(rdb *redis.Client) *LimiterTwoFactorRepo {
+	return &LimiterTwoFactorRepo{rdb: rdb}
+}
+
+// 1. GET LIMITER (Cek jumlah kode salah & sisa TTL)
+func (r *LimiterTwoFactorRepo) GetLimiterTwoFactor(ctx context.Context, profileID uuid.UUID) (*LimiterTwoFactorResponse, error) {
+	key := r.constructAttemptKey(profileID)
+
+	attempts, err := r.rdb.Get(ctx, key).Int64()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := r.rdb.TTL(ctx, key).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	return &LimiterTwoFactorResponse{
+		ProfileID:         profileID,
+		Attempts:          attempts,
+		RetryAfterSeconds: int64(duration.Seconds()),
+	}, nil
+}
+
+// 2. INCREMENT (kode salah), TTL hanya di-set saat percobaan pertama (fixed window)
+func (r *LimiterTwoFactorRepo) IncrementLimiterTwoFactor(ctx context.Context, profileID uuid.UUID, window time.Duration) (int64, error) {
+	key := r.constructAttemptKey(profileID)
+
+	pipe := r.rdb.TxPipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+	return incr.Val(), nil
+}
+
+// 3. RESET (kode benar)
+func (r *LimiterTwoFactorRepo) DeleteLimiterTwoFactor(ctx context.Context, profileID uuid.UUID) error {
+	return r.rdb.Del(ctx, r.constructAttemptKey(profileID)).Err()
+}
+
+// 4. CONSUME CHALLENGE (challenge token sekali pakai), false = sudah pernah dipakai
+func (r *LimiterTwoFactorRepo) ConsumeChallenge(ctx context.Context, challengeID string, ttl time.Duration) (bool, error) {
+	return r.rdb.SetNX(ctx, r.constructChallengeKey(challengeID), "1", ttl).Result()
+}
+
+func (r *LimiterTwoFactorRepo) constructAttemptKey(profileID uuid.UUID) string {
+	return fmt.Sprintf("limiter_two_factor:%s", profileID.String())
+}
+
+func (r *LimiterTwoFactorRepo) constructChallengeKey(challengeID string) string {
+	return fmt.Sprintf("two_factor_challenge:%s", challengeID)
+}
@@ -0,0 +1,10 @@
+// internal/repository/redis/two_factor_limiter_repository/viewmodel.go
+package two_factor_limiter_repository
+
+import "github.com/google/uuid"
+
+type LimiterTwoFactorResponse struct {
+	ProfileID         uuid.UUID
+	Attempts          int64
+	RetryAfterSeconds int64
+}
@@ -2,7 +2,6 @@
 package internal
 
 import (
-	"database/sql"
 	"net/http"
 	"postmatic-api/config"
 	"postmatic-api/internal/internal_middleware"
@@ -11,15 +10,18 @@ import (
 	auth_handler "postmatic-api/internal/module/account/auth/handler"
 	google_oauth_handler "postmatic-api/internal/module/account/google_oauth/handler"
 	profile_handler "postmatic-api/internal/module/account/profile/handler"
+	provider_handler "postmatic-api/internal/module/account/provider/handler"
 	session_handler "postmatic-api/internal/module/account/session/handler"
 	payment_common_handler "postmatic-api/internal/module/payment/common/handler"
-	payment_common_service "postmatic-api/internal/module/payment/common/service"
-	image_token_handler "postmatic-api/internal/module/payment/image_token/handler"
-	image_token_service "postmatic-api/internal/module/payment/image_token/service"
+	template_payment_handler "postmatic-api/internal/module/payment/template/handler"
+	token_payment_handler "postmatic-api/internal/module/payment/token/handler"
 
+	affiliator_wallet_handler "postmatic-api/internal/module/affiliator/affiliator_wallet/handler"
 	referral_basic_handler "postmatic-api/internal/module/affiliator/referral_basic/handler"
+	referral_special_handler "postmatic-api/internal/module/affiliator/referral_special/handler"
 
 	category_creator_image_handler "postmatic-api/internal/module/app/category_creator_image/handler"
+	exchange_rate_handler "postmatic-api/internal/module/app/exchange_rate/handler"
 	image_uploader_handler "postmatic-api/internal/module/app/image_uploader/handler"
 	payment_method_handler "postmatic-api/internal/module/app/payment_method/handler"
 	referral_rule_handler "postmatic-api/internal/module/app/referral_rule/handler"
@@ -27,6 +29,9 @@ import (
 	timezone_handler "postmatic-api/internal/module/app/timezone/handler"
 	token_product_handler "postmatic-api/internal/module/app/token_product/handler"
 
+	business_audit_event_handler "postmatic-api/internal/module/business/business_audit_event/handler"
+	business_generate_caption_handler "postmatic-api/internal/module/business/business_generate_caption/handler"
+	business_generate_image_handler "postmatic-api/internal/module/business/business_generate_image/handler"
 	business_image_content_handler "postmatic-api/internal/module/business/business_image_content/handler"
 	business_information_handler "postmatic-api/internal/module/business/business_information/handler"
 	business_knowledge_handler "postmatic-api/internal/module/business/business_knowledge/handler"
@@ -34,157 +39,79 @@ import (
 	business_product_handler "postmatic-api/internal/module/business/business_product/handler"
 	business_role_handler "postmatic-api/internal/module/business/business_role/handler"
 	business_rss_subscription_handler "postmatic-api/internal/module/business/business_rss_subscription/handler"
+	business_scheduled_post_handler "postmatic-api/internal/module/business/business_scheduled_post/handler"
+	business_social_account_handler "postmatic-api/internal/module/business/business_social_account/handler"
 	business_timezone_pref_handler "postmatic-api/internal/module/business/business_timezone_pref/handler"
 
 	business_creator_image_handler "postmatic-api/internal/module/creator/business_creator_image/handler"
+	creator_earning_handler "postmatic-api/internal/module/creator/creator_earning/handler"
 	creator_image_handler "postmatic-api/internal/module/creator/creator_image/handler"
-	gen_token_image_handler "postmatic-api/internal/module/generative_token/image_token/handler"
+	token_ledger_handler "postmatic-api/internal/module/generative_token/token_ledger/handler"
 
 	// Module services
-	auth_service "postmatic-api/internal/module/account/auth/service"
-	google_oauth_service "postmatic-api/internal/module/account/google_oauth/service"
-	profile_service "postmatic-api/internal/module/account/profile/service"
-	session_service "postmatic-api/internal/module/account/session/service"
-	referral_basic_service "postmatic-api/internal/module/affiliator/referral_basic/service"
 	category_creator_image_service "postmatic-api/internal/module/app/category_creator_image/service"
 	generative_image_model_handler "postmatic-api/internal/module/app/generative_image_model/handler"
-	generative_image_model_service "postmatic-api/internal/module/app/generative_image_model/service"
 	generative_text_model_handler "postmatic-api/internal/module/app/generative_text_model/handler"
-	generative_text_model_service "postmatic-api/internal/module/app/generative_text_model/service"
-	image_uploader_service "postmatic-api/internal/module/app/image_uploader/service"
-	payment_method_service "postmatic-api/internal/module/app/payment_method/service"
-	referral_rule_service "postmatic-api/internal/module/app/referral_rule/service"
 	rss_service "postmatic-api/internal/module/app/rss/service"
 	social_platform_handler "postmatic-api/internal/module/app/social_platform/handler"
-	social_platform_service "postmatic-api/internal/module/app/social_platform/service"
-	timezone_service "postmatic-api/internal/module/app/timezone/service"
-	token_product_service "postmatic-api/internal/module/app/token_product/service"
-	business_image_content_service "postmatic-api/internal/module/business/business_image_content/service"
 	business_information_service "postmatic-api/internal/module/business/business_information/service"
-	business_knowledge_service "postmatic-api/internal/module/business/business_knowledge/service"
-	business_member_service "postmatic-api/internal/module/business/business_member/service"
-	business_product_service "postmatic-api/internal/module/business/business_product/service"
-	business_role_service "postmatic-api/internal/module/business/business_role/service"
-	business_rss_subscription_service "postmatic-api/internal/module/business/business_rss_subscription/service"
-	business_timezone_pref_service "postmatic-api/internal/module/business/business_timezone_pref/service"
-	business_creator_image_service "postmatic-api/internal/module/creator/business_creator_image/service"
 	creator_image_service "postmatic-api/internal/module/creator/creator_image/service"
-	gen_token_image_service "postmatic-api/internal/module/generative_token/image_token/service"
-	"postmatic-api/internal/module/headless/cloudinary_uploader"
-	"postmatic-api/internal/module/headless/midtrans"
-	"postmatic-api/internal/module/headless/queue"
-	"postmatic-api/internal/module/headless/s3_uploader"
-	"postmatic-api/internal/module/headless/token"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
 	"postmatic-api/internal/repository/entity"
-	repository "postmatic-api/internal/repository/entity"
-	emailLimiterRepo "postmatic-api/internal/repository/redis/email_limiter_repository"
-	"postmatic-api/internal/repository/redis/invitation_limiter_repository"
-	ownedBusinessRepo "postmatic-api/internal/repository/redis/owned_business_repository"
-	sessionRepo "postmatic-api/internal/repository/redis/session_repository"
 
 	"github.com/go-chi/chi/v5"
-	"github.com/hibiken/asynq"
-	"github.com/redis/go-redis/v9"
 )
 
-func NewRouter(db *sql.DB, cfg *config.Config, asynqClient *asynq.Client, rdb *redis.Client) chi.Router {
-	// 1. =========== INITIAL REPOSITORY ===========
-	store := repository.NewStore(db)
-
-	sessionRepo := sessionRepo.NewSessionRepository(rdb)
-	emailLimiterRepo := emailLimiterRepo.NewLimiterEmailRepository(rdb)
-	ownedRepo := ownedBusinessRepo.NewOwnedBusinessRepository(rdb)
-	invitationLimiterRepo := invitation_limiter_repository.NewLimiterInvitationRepository(rdb)
-
-	ownedMw := internal_middleware.NewOwnedBusiness(store, ownedRepo)
-	cldClient := config.ConnectCloudinary(cfg)
-	midtransClient := config.ConnectMidtrans(cfg)
-	s3Client := config.ConnectS3(cfg)
-
-	// 2. =========== INITIAL SERVICE ===========
-	// HEADLESS
-	tokenSvc := token.NewTokenMaker(cfg)
-	cldSvc := cloudinary_uploader.NewService(cfg, cldClient)
-	s3Svc := s3_uploader.NewService(cfg, s3Client)
-	midtransSvc := midtrans.NewService(midtransClient)
-
-	// ✅ asynq client untuk enqueue
-	queueProducer := queue.NewProducer(asynqClient)
-
-	// ACCOUNT
-	authSvc := auth_service.NewService(store, queueProducer, *cfg, sessionRepo, emailLimiterRepo, *tokenSvc)
-	sessSvc := session_service.NewService(sessionRepo, *tokenSvc)
-	profSvc := profile_service.NewService(store, queueProducer, *cfg, emailLimiterRepo, *tokenSvc)
-	googleSvc := google_oauth_service.NewService(store, queueProducer, *cfg, sessionRepo, emailLimiterRepo, *tokenSvc)
-	// BUSINESS
-	busInSvc := business_information_service.NewService(store, ownedRepo, queueProducer)
-	busKnowledgeSvc := business_knowledge_service.NewService(store)
-	busRoleSvc := business_role_service.NewService(store)
-	busProductSvc := business_product_service.NewService(store)
-	busImageContentSvc := business_image_content_service.NewService(store)
-	busMemberSvc := business_member_service.NewService(store, *cfg, queueProducer, tokenSvc, invitationLimiterRepo, ownedRepo)
-	// APP
-	imageUploaderSvc := image_uploader_service.NewImageUploaderService(cldSvc, s3Svc, store)
-	rssSvc := rss_service.NewRSSService(store)
-	rssSubscriptionSvc := business_rss_subscription_service.NewService(store, rssSvc)
-	timezoneSvc := timezone_service.NewTimezoneService()
-	busTimezonePrefSvc := business_timezone_pref_service.NewService(store, timezoneSvc)
-	catCreatorImageSvc := category_creator_image_service.NewCategoryCreatorImageService(store)
-	referralRuleSvc := referral_rule_service.NewReferralService(store)
-	tokenProductSvc := token_product_service.NewTokenProductService(store)
-	paymentMethodSvc := payment_method_service.NewService(store)
-	generativeImageModelSvc := generative_image_model_service.NewService(store)
-	generativeTextModelSvc := generative_text_model_service.NewService(store)
-	// AFFILIATOR
-	referralBasicSvc := referral_basic_service.NewService(store, referralRuleSvc)
-	// CREATOR
-	creatorImageSvc := creator_image_service.NewService(store, catCreatorImageSvc)
-	businessCreatorImageSvc := business_creator_image_service.NewService(store, creatorImageSvc)
-	// GENERATIVE TOKEN
-	genTokenImageSvc := gen_token_image_service.NewService(store)
-	// PAYMENT
-	imageTokenPaymentSvc := image_token_service.NewService(store, tokenProductSvc, paymentMethodSvc, referralBasicSvc, midtransSvc, queueProducer)
-	paymentCommonSvc := payment_common_service.NewService(store, midtransSvc, queueProducer, genTokenImageSvc)
-
+func NewRouter(s *Services, cfg *config.Config) chi.Router {
 	// 3. =========== INITIAL HANDLER ===========
 	// ACCOUNT
-	authHandler := auth_handler.NewHandler(authSvc, cfg)
-	sessHandler := session_handler.NewHandler(sessSvc)
-	profileHandler := profile_handler.NewHandler(profSvc)
-	googleOauthHandler := google_oauth_handler.NewHandler(googleSvc, cfg)
+	authHandler := auth_handler.NewHandler(s.authSvc, cfg)
+	sessHandler := session_handler.NewHandler(s.sessSvc)
+	profileHandler := profile_handler.NewHandler(s.profSvc, s.twoFactorSvc)
+	googleOauthHandler := google_oauth_handler.NewHandler(s.googleSvc, cfg)
+	providerHandler := provider_handler.NewHandler(s.providerSvc, s.authSvc)
 	// BUSINESS
-	busInHandler := business_information_handler.NewHandler(busInSvc, ownedMw)
-	busKnowledgeHandler := business_knowledge_handler.NewHandler(busKnowledgeSvc, ownedMw)
-	busRoleHandler := business_role_handler.NewHandler(busRoleSvc, ownedMw)
-	busProductHandler := business_product_handler.NewHandler(busProductSvc, ownedMw)
-	busRssSubscriptionHandler := business_rss_subscription_handler.NewHandler(rssSubscriptionSvc, ownedMw)
-	busTimezonePrefHandler := business_timezone_pref_handler.NewHandler(busTimezonePrefSvc, ownedMw)
-	busImageContentHandler := business_image_content_handler.NewHandler(busImageContentSvc, ownedMw)
-	busMemberHandler := business_member_handler.NewHandler(busMemberSvc, ownedMw)
+	busInHandler := business_information_handler.NewHandler(s.busInSvc, s.ownedMw)
+	busKnowledgeHandler := business_knowledge_handler.NewHandler(s.busKnowledgeSvc, s.ownedMw)
+	busRoleHandler := business_role_handler.NewHandler(s.busRoleSvc, s.ownedMw)
+	busProductHandler := business_product_handler.NewHandler(s.busProductSvc, s.ownedMw)
+	busRssSubscriptionHandler := business_rss_subscription_handler.NewHandler(s.rssSubscriptionSvc, s.ownedMw)
+	busAuditEventHandler := business_audit_event_handler.NewHandler(s.busAuditEventSvc, s.ownedMw)
+	busTimezonePrefHandler := business_timezone_pref_handler.NewHandler(s.busTimezonePrefSvc, s.ownedMw)
+	busImageContentHandler := business_image_content_handler.NewHandler(s.busImageContentSvc, s.ownedMw)
+	busMemberHandler := business_member_handler.NewHandler(s.busMemberSvc, s.ownedMw)
+	busGenerateImageHandler := business_generate_image_handler.NewHandler(s.busGenerateImageSvc, s.ownedMw)
+	busScheduledPostHandler := business_scheduled_post_handler.NewHandler(s.busScheduledPostSvc, s.ownedMw)
+	busGenerateCaptionHandler := business_generate_caption_handler.NewHandler(s.busGenerateCaptionSvc, s.ownedMw)
+	busSocialAccountHandler := business_social_account_handler.NewHandler(s.busSocialAccountSvc, s.ownedMw)
 	// APP
-	imageUploaderHandler := image_uploader_handler.NewHandler(imageUploaderSvc)
-	rssHandler := rss_handler.NewHandler(rssSvc)
-	timezoneHandler := timezone_handler.NewHandler(timezoneSvc)
-	catCreatorImageHandler := category_creator_image_handler.NewHandler(catCreatorImageSvc)
-	ruleRefferralHandler := referral_rule_handler.NewHandler(referralRuleSvc)
-	tokenProductHandler := token_product_handler.NewHandler(tokenProductSvc)
-	paymentMethodHandler := payment_method_handler.NewHandler(paymentMethodSvc)
-	generativeImageModelHandler := generative_image_model_handler.NewHandler(generativeImageModelSvc)
-	generativeTextModelHandler := generative_text_model_handler.NewHandler(generativeTextModelSvc)
-	socialPlatformSvc := social_platform_service.NewService(store)
-	socialPlatformHandler := social_platform_handler.NewHandler(socialPlatformSvc)
+	imageUploaderHandler := image_uploader_handler.NewHandler(s.imageUploaderSvc)
+	rssHandler := rss_handler.NewHandler(s.rssSvc)
+	timezoneHandler := timezone_handler.NewHandler(s.timezoneSvc)
+	catCreatorImageHandler := category_creator_image_handler.NewHandler(s.catCreatorImageSvc)
+	ruleRefferralHandler := referral_rule_handler.NewHandler(s.referralRuleSvc)
+	tokenProductHandler := token_product_handler.NewHandler(s.tokenProductSvc)
+	paymentMethodHandler := payment_method_handler.NewHandler(s.paymentMethodSvc)
+	exchangeRateHandler := exchange_rate_handler.NewHandler(s.exchangeRateSvc)
+	generativeImageModelHandler := generative_image_model_handler.NewHandler(s.generativeImageModelSvc)
+	generativeTextModelHandler := generative_text_model_handler.NewHandler(s.generativeTextModelSvc)
+	socialPlatformHandler := social_platform_handler.NewHandler(s.socialPlatformSvc)
 	// CREATOR
-	creatorImageHandler := creator_image_handler.NewHandler(creatorImageSvc)
-	businessCreatorImageHandler := business_creator_image_handler.NewHandler(businessCreatorImageSvc, ownedMw)
+	creatorImageHandler := creator_image_handler.NewHandler(s.creatorImageSvc)
+	businessCreatorImageHandler := business_creator_image_handler.NewHandler(s.businessCreatorImageSvc, s.ownedMw)
+	creatorEarningHandler := creator_earning_handler.NewHandler(s.creatorEarningSvc)
 	// AFFILIATOR
-	referralBasicHandler := referral_basic_handler.NewHandler(referralBasicSvc)
+	referralBasicHandler := referral_basic_handler.NewHandler(s.referralBasicSvc)
+	referralSpecialHandler := referral_special_handler.NewHandler(s.referralSpecialSvc)
+	affiliatorWalletHandler := affiliator_wallet_handler.NewHandler(s.affiliatorWalletSvc)
 	// PAYMENT
-	imageTokenPaymentHandler := image_token_handler.NewHandler(imageTokenPaymentSvc)
-	paymentCommonHandler := payment_common_handler.NewHandler(paymentCommonSvc, ownedMw)
+	tokenPaymentHandler := token_payment_handler.NewHandler(s.tokenPaymentSvc, s.ownedMw)
+	templatePaymentHandler := template_payment_handler.NewHandler(s.templatePaymentSvc, s.ownedMw)
+	paymentCommonHandler := payment_common_handler.NewHandler(s.paymentCommonSvc, s.ownedMw)
 
 	// 4. =========== INITIAL MIDDLEWARE ===========
-	allAllowed := internal_middleware.AuthMiddleware(*tokenSvc, []entity.AppRole{entity.AppRoleAdmin, entity.AppRoleUser})
-	adminOnly := internal_middleware.AuthMiddleware(*tokenSvc, []entity.AppRole{entity.AppRoleAdmin})
+	allAllowed := internal_middleware.AuthMiddleware(*s.tokenSvc, []entity.AppRole{entity.AppRoleAdmin, entity.AppRoleUser})
+	adminOnly := internal_middleware.AuthMiddleware(*s.tokenSvc, []entity.AppRole{entity.AppRoleAdmin})
 
 	// 4. =========== ROUTING ===========
 	r := chi.NewRouter()
@@ -199,9 +126,16 @@ func NewRouter(db *sql.DB, cfg *config.Config, asynqClient *asynq.Client, rdb *r
 		r.Mount("/role", busRoleHandler.Routes())
 		r.Mount("/product", busProductHandler.Routes())
 		r.Mount("/rss-subscription", busRssSubscriptionHandler.Routes())
+		r.Mount("/audit-event", busAuditEventHandler.Routes())
 		r.Mount("/timezone-pref", busTimezonePrefHandler.Routes())
 		r.Mount("/image-content", busImageContentHandler.Routes())
 		r.Mount("/member", busMemberHandler.Routes())
+		r.Mount("/scheduled-post", busScheduledPostHandler.Routes())
+		r.Mount("/social-account", busSocialAccountHandler.Routes())
+		r.Route("/generate", func(r chi.Router) {
+			r.Mount("/image", busGenerateImageHandler.Routes())
+			r.Mount("/caption", busGenerateCaptionHandler.Routes())
+		})
 	})
 
 	r.Route("/account", func(r chi.Router) {
@@ -215,10 +149,18 @@ func NewRouter(db *sql.DB, cfg *config.Config, asynqClient *asynq.Client, rdb *r
 			r.Use(allAllowed)
 			r.Mount("/", sessHandler.Routes())
 		})
+		r.Route("/email-change", func(r chi.Router) {
+			r.Mount("/", profileHandler.EmailChangeRoutes())
+		})
 		r.Route("/profile", func(r chi.Router) {
 			r.Use(allAllowed)
+			r.Mount("/providers", providerHandler.Routes())
 			r.Mount("/", profileHandler.Routes())
 		})
+		r.Route("/login-limiter", func(r chi.Router) {
+			r.Use(adminOnly)
+			r.Mount("/", authHandler.LoginLimiterRoutes())
+		})
 	})
 
 	r.Route("/app", func(r chi.Router) {
@@ -246,6 +188,7 @@ func NewRouter(db *sql.DB, cfg *config.Config, asynqClient *asynq.Client, rdb *r
 		})
 		r.Mount("/token-product", tokenProductHandler.Routes())
 		r.Mount("/payment-method", paymentMethodHandler.Routes(allAllowed, adminOnly))
+		r.Mount("/exchange-rate", exchangeRateHandler.Routes(allAllowed, adminOnly))
 		r.Mount("/generative-image-model", generativeImageModelHandler.Routes(allAllowed, adminOnly))
 		r.Mount("/generative-text-model", generativeTextModelHandler.Routes(allAllowed, adminOnly))
 		r.Mount("/social-platform", socialPlatformHandler.Routes(allAllowed, adminOnly))
@@ -256,29 +199,34 @@ func NewRouter(db *sql.DB, cfg *config.Config, asynqClient *asynq.Client, rdb *r
 		r.Use(func(next http.Handler) http.Handler {
 			return internal_middleware.ReqFilterMiddleware(next, creator_image_service.SORT_BY)
 		})
-		r.Mount("/image", creatorImageHandler.Routes())
+		r.Mount("/image", creatorImageHandler.Routes(adminOnly))
 		r.Mount("/business-saved-creator-image", businessCreatorImageHandler.Routes())
+		r.Mount("/earning", creatorEarningHandler.Routes())
 	})
 
 	r.Route("/affiliator", func(r chi.Router) {
 		r.Use(allAllowed)
 		r.Mount("/referral-basic", referralBasicHandler.Routes())
+		r.Mount("/referral-special", referralSpecialHandler.Routes(adminOnly))
+		r.Mount("/wallet", affiliatorWalletHandler.Routes(adminOnly))
 	})
 
 	// Generative Token routes
-	genTokenImageHandler := gen_token_image_handler.NewHandler(genTokenImageSvc, ownedMw)
+	tokenLedgerHandler := token_ledger_handler.NewHandler(s.tokenLedgerSvc, s.ownedMw)
 	r.Route("/generative-token", func(r chi.Router) {
 		r.Use(allAllowed)
-		r.Mount("/image-token", genTokenImageHandler.Routes())
+		r.Mount(token_ledger_service.TokenTypeRoutePattern(), tokenLedgerHandler.Routes())
 	})
 
 	// Payment routes
 	r.Route("/payment", func(r chi.Router) {
-		r.Mount("/image-token", imageTokenPaymentHandler.Routes(allAllowed))
-		r.Mount("/", paymentCommonHandler.Routes(allAllowed))
+		r.Mount(token_ledger_service.TokenTypeRoutePattern(), tokenPaymentHandler.Routes(allAllowed))
+		r.Mount("/template", templatePaymentHandler.Routes(allAllowed))
+		r.Mount("/", paymentCommonHandler.Routes(allAllowed, adminOnly))
 	})
 	// Webhook route (no auth, public)
 	r.Post("/payment/webhook", paymentCommonHandler.WebhookRoute())
+	r.Post("/payment/webhook/{gateway}", paymentCommonHandler.WebhookRoute())
 
 	return r
 }
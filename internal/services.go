@@ -0,0 +1,261 @@
+// internal/services.go
+package internal
+
+import (
+	"database/sql"
+
+	"postmatic-api/config"
+	"postmatic-api/internal/internal_middleware"
+	auth_service "postmatic-api/internal/module/account/auth/service"
+	google_oauth_service "postmatic-api/internal/module/account/google_oauth/service"
+	profile_service "postmatic-api/internal/module/account/profile/service"
+	provider_service "postmatic-api/internal/module/account/provider/service"
+	session_service "postmatic-api/internal/module/account/session/service"
+	two_factor_service "postmatic-api/internal/module/account/two_factor/service"
+	affiliator_wallet_service "postmatic-api/internal/module/affiliator/affiliator_wallet/service"
+	referral_basic_service "postmatic-api/internal/module/affiliator/referral_basic/service"
+	referral_special_service "postmatic-api/internal/module/affiliator/referral_special/service"
+	category_creator_image_service "postmatic-api/internal/module/app/category_creator_image/service"
+	exchange_rate_service "postmatic-api/internal/module/app/exchange_rate/service"
+	generative_image_model_service "postmatic-api/internal/module/app/generative_image_model/service"
+	generative_text_model_service "postmatic-api/internal/module/app/generative_text_model/service"
+	image_uploader_service "postmatic-api/internal/module/app/image_uploader/service"
+	payment_method_service "postmatic-api/internal/module/app/payment_method/service"
+	referral_rule_service "postmatic-api/internal/module/app/referral_rule/service"
+	rss_service "postmatic-api/internal/module/app/rss/service"
+	social_platform_service "postmatic-api/internal/module/app/social_platform/service"
+	timezone_service "postmatic-api/internal/module/app/timezone/service"
+	token_product_service "postmatic-api/internal/module/app/token_product/service"
+	business_audit_event_service "postmatic-api/internal/module/business/business_audit_event/service"
+	business_generate_caption_service "postmatic-api/internal/module/business/business_generate_caption/service"
+	business_generate_image_service "postmatic-api/internal/module/business/business_generate_image/service"
+	business_image_content_service "postmatic-api/internal/module/business/business_image_content/service"
+	business_information_service "postmatic-api/internal/module/business/business_information/service"
+	business_knowledge_service "postmatic-api/internal/module/business/business_knowledge/service"
+	business_member_service "postmatic-api/internal/module/business/business_member/service"
+	business_product_service "postmatic-api/internal/module/business/business_product/service"
+	business_role_service "postmatic-api/internal/module/business/business_role/service"
+	business_rss_subscription_service "postmatic-api/internal/module/business/business_rss_subscription/service"
+	business_scheduled_post_service "postmatic-api/internal/module/business/business_scheduled_post/service"
+	business_social_account_service "postmatic-api/internal/module/business/business_social_account/service"
+	business_timezone_pref_service "postmatic-api/internal/module/business/business_timezone_pref/service"
+	business_creator_image_service "postmatic-api/internal/module/creator/business_creator_image/service"
+	creator_earning_service "postmatic-api/internal/module/creator/creator_earning/service"
+	creator_image_service "postmatic-api/internal/module/creator/creator_image/service"
+	token_ledger_service "postmatic-api/internal/module/generative_token/token_ledger/service"
+	"postmatic-api/internal/module/headless/cloudinary_uploader"
+	"postmatic-api/internal/module/headless/google_genai"
+	"postmatic-api/internal/module/headless/mailer"
+	"postmatic-api/internal/module/headless/midtrans"
+	openai_svc "postmatic-api/internal/module/headless/openai"
+	"postmatic-api/internal/module/headless/payment_gateway"
+	"postmatic-api/internal/module/headless/queue"
+	"postmatic-api/internal/module/headless/s3_uploader"
+	"postmatic-api/internal/module/headless/social_oauth"
+	"postmatic-api/internal/module/headless/social_publisher"
+	"postmatic-api/internal/module/headless/token"
+	payment_common_service "postmatic-api/internal/module/payment/common/service"
+	template_payment_service "postmatic-api/internal/module/payment/template/service"
+	token_payment_service "postmatic-api/internal/module/payment/token/service"
+	repository "postmatic-api/internal/repository/entity"
+	emailChangeRepo "postmatic-api/internal/repository/redis/email_change_repository"
+	emailLimiterRepo "postmatic-api/internal/repository/redis/email_limiter_repository"
+	"postmatic-api/internal/repository/redis/invitation_limiter_repository"
+	loginLimiterRepo "postmatic-api/internal/repository/redis/login_limiter_repository"
+	ownedBusinessRepo "postmatic-api/internal/repository/redis/owned_business_repository"
+	sessionRepo "postmatic-api/internal/repository/redis/session_repository"
+	socialOAuthStateRepo "postmatic-api/internal/repository/redis/social_oauth_state_repository"
+	twoFactorLimiterRepo "postmatic-api/internal/repository/redis/two_factor_limiter_repository"
+
+	"github.com/hibiken/asynq"
+	"github.com/redis/go-redis/v9"
+)
+
+// Services berisi seluruh service yang dipakai bersama oleh HTTP router dan worker (asynq),
+// sehingga wiring dependency hanya ada di satu tempat.
+type Services struct {
+	ownedMw                 *internal_middleware.OwnedBusiness
+	tokenSvc                *token.TokenMaker
+	mailerSvc               mailer.Mailer
+	twoFactorSvc            *two_factor_service.TwoFactorService
+	authSvc                 *auth_service.AuthService
+	sessSvc                 *session_service.SessionService
+	profSvc                 *profile_service.ProfileService
+	googleSvc               *google_oauth_service.GoogleOAuthService
+	providerSvc             *provider_service.ProviderService
+	busInSvc                *business_information_service.BusinessInformationService
+	busKnowledgeSvc         *business_knowledge_service.BusinessKnowledgeService
+	busRoleSvc              *business_role_service.BusinessRoleService
+	busProductSvc           *business_product_service.BusinessProductService
+	busImageContentSvc      *business_image_content_service.BusinessImageContentService
+	busMemberSvc            *business_member_service.BusinessMemberService
+	imageUploaderSvc        *image_uploader_service.ImageUploaderService
+	rssSvc                  *rss_service.RSSService
+	rssSubscriptionSvc      *business_rss_subscription_service.BusinessRssSubscriptionService
+	busAuditEventSvc        *business_audit_event_service.BusinessAuditEventService
+	timezoneSvc             *timezone_service.TimezoneService
+	busTimezonePrefSvc      *business_timezone_pref_service.BusinessTimezonePrefService
+	busSocialAccountSvc     *business_social_account_service.BusinessSocialAccountService
+	busScheduledPostSvc     *business_scheduled_post_service.BusinessScheduledPostService
+	catCreatorImageSvc      *category_creator_image_service.CategoryCreatorImageService
+	referralRuleSvc         *referral_rule_service.ReferralService
+	exchangeRateSvc         *exchange_rate_service.ExchangeRateService
+	tokenProductSvc         *token_product_service.TokenProductService
+	paymentMethodSvc        *payment_method_service.PaymentMethodService
+	generativeImageModelSvc *generative_image_model_service.GenerativeImageModelService
+	generativeTextModelSvc  *generative_text_model_service.GenerativeTextModelService
+	socialPlatformSvc       *social_platform_service.SocialPlatformService
+	referralBasicSvc        *referral_basic_service.ReferralBasicService
+	referralSpecialSvc      *referral_special_service.ReferralSpecialService
+	affiliatorWalletSvc     *affiliator_wallet_service.AffiliatorWalletService
+	creatorImageSvc         *creator_image_service.CreatorImageService
+	businessCreatorImageSvc *business_creator_image_service.BusinessCreatorImageService
+	creatorEarningSvc       *creator_earning_service.CreatorEarningService
+	tokenLedgerSvc          *token_ledger_service.TokenLedgerService
+	paymentCommonSvc        *payment_common_service.PaymentCommonService
+	tokenPaymentSvc         *token_payment_service.TokenPaymentService
+	templatePaymentSvc      *template_payment_service.TemplatePaymentService
+	busGenerateImageSvc     *business_generate_image_service.BusinessGenerateImageService
+	busGenerateCaptionSvc   *business_generate_caption_service.BusinessGenerateCaptionService
+}
+
+func NewServices(db *sql.DB, cfg *config.Config, asynqClient *asynq.Client, rdb *redis.Client) *Services {
+	// 1. =========== INITIAL REPOSITORY ===========
+	store := repository.NewStore(db)
+
+	sessionRepo := sessionRepo.NewSessionRepository(rdb)
+	twoFactorLimiterRepo := twoFactorLimiterRepo.NewLimiterTwoFactorRepository(rdb)
+	emailLimiterRepo := emailLimiterRepo.NewLimiterEmailRepository(rdb)
+	emailChangeRepo := emailChangeRepo.NewEmailChangeRepository(rdb)
+	loginLimiterRepo := loginLimiterRepo.NewLimiterLoginRepository(rdb)
+	ownedRepo := ownedBusinessRepo.NewOwnedBusinessRepository(rdb)
+	invitationLimiterRepo := invitation_limiter_repository.NewLimiterInvitationRepository(rdb)
+	socialOAuthStateRepo := socialOAuthStateRepo.NewSocialOAuthStateRepository(rdb)
+
+	ownedMw := internal_middleware.NewOwnedBusiness(store, ownedRepo)
+	cldClient := config.ConnectCloudinary(cfg)
+	midtransClient := config.ConnectMidtrans(cfg)
+	s3Client := config.ConnectS3(cfg)
+	googleGenAIClient := config.ConnectGoogleGenAI(cfg)
+	openaiClient := config.ConnectOpenAI(cfg)
+
+	// 2. =========== INITIAL SERVICE ===========
+	// HEADLESS
+	tokenSvc := token.NewTokenMaker(cfg)
+	cldSvc := cloudinary_uploader.NewService(cfg, cldClient)
+	s3Svc := s3_uploader.NewService(cfg, s3Client)
+	midtransSvc := midtrans.NewService(midtransClient)
+	paymentGatewaySvc := payment_gateway.NewDefaultService(cfg, midtransSvc)
+	googleGenAISvc := google_genai.NewService(googleGenAIClient)
+	openaiSvc := openai_svc.NewService(openaiClient)
+	socialOAuthSvc := social_oauth.NewDefaultService(cfg)
+
+	socialPublisherSvc := social_publisher.NewDefaultService(cfg)
+
+	// ✅ asynq client untuk enqueue
+	queueProducer := queue.NewProducer(asynqClient)
+
+	// ACCOUNT
+	twoFactorSvc := two_factor_service.NewService(store, *cfg, *tokenSvc, twoFactorLimiterRepo)
+	authSvc := auth_service.NewService(store, queueProducer, *cfg, sessionRepo, emailLimiterRepo, loginLimiterRepo, *tokenSvc, twoFactorSvc)
+	sessSvc := session_service.NewService(sessionRepo, *tokenSvc)
+	profSvc := profile_service.NewService(store, queueProducer, *cfg, emailLimiterRepo, emailChangeRepo, sessionRepo, *tokenSvc)
+	googleSvc := google_oauth_service.NewService(store, queueProducer, *cfg, sessionRepo, emailLimiterRepo, *tokenSvc, twoFactorSvc)
+	providerSvc := provider_service.NewService(store, *tokenSvc, googleSvc)
+	// BUSINESS
+	busInSvc := business_information_service.NewService(store, ownedRepo, queueProducer)
+	busKnowledgeSvc := business_knowledge_service.NewService(store)
+	busRoleSvc := business_role_service.NewService(store)
+	busProductSvc := business_product_service.NewService(store)
+	busImageContentSvc := business_image_content_service.NewService(store)
+	busMemberSvc := business_member_service.NewService(store, *cfg, queueProducer, tokenSvc, invitationLimiterRepo, ownedRepo)
+	// APP
+	imageUploaderSvc := image_uploader_service.NewImageUploaderService(cldSvc, s3Svc, store)
+	rssSvc := rss_service.NewRSSService(store)
+	rssSubscriptionSvc := business_rss_subscription_service.NewService(store, rssSvc)
+	busAuditEventSvc := business_audit_event_service.NewService(store)
+	timezoneSvc := timezone_service.NewTimezoneService()
+	busTimezonePrefSvc := business_timezone_pref_service.NewService(store, timezoneSvc)
+	busSocialAccountSvc := business_social_account_service.NewService(store, *cfg, socialOAuthSvc, socialOAuthStateRepo)
+	busScheduledPostSvc := business_scheduled_post_service.NewService(store, queueProducer, busTimezonePrefSvc, busSocialAccountSvc, socialPublisherSvc)
+	catCreatorImageSvc := category_creator_image_service.NewCategoryCreatorImageService(store)
+	referralRuleSvc := referral_rule_service.NewReferralService(store)
+	exchangeRateSvc := exchange_rate_service.NewService(store)
+	tokenProductSvc := token_product_service.NewTokenProductService(store, exchangeRateSvc, paymentGatewaySvc)
+	paymentMethodSvc := payment_method_service.NewService(store)
+	generativeImageModelSvc := generative_image_model_service.NewService(store)
+	generativeTextModelSvc := generative_text_model_service.NewService(store)
+	// AFFILIATOR
+	referralBasicSvc := referral_basic_service.NewService(store, referralRuleSvc)
+	referralSpecialSvc := referral_special_service.NewService(store)
+	affiliatorWalletSvc := affiliator_wallet_service.NewService(store)
+	// CREATOR
+	creatorImageSvc := creator_image_service.NewService(store, catCreatorImageSvc, queueProducer)
+	businessCreatorImageSvc := business_creator_image_service.NewService(store, creatorImageSvc)
+	creatorEarningSvc := creator_earning_service.NewService(store, queueProducer, cfg.CREATOR_PLATFORM_COMMISSION_PERCENTAGE)
+	// GENERATIVE TOKEN
+	tokenLedgerSvc := token_ledger_service.NewService(store)
+	// PAYMENT
+	paymentCommonSvc := payment_common_service.NewService(store, paymentGatewaySvc, queueProducer, tokenLedgerSvc, affiliatorWalletSvc, businessCreatorImageSvc, creatorEarningSvc, s3Svc, cfg.APP_NAME)
+	tokenPaymentSvc := token_payment_service.NewService(store, tokenProductSvc, paymentMethodSvc, referralBasicSvc, paymentCommonSvc)
+	templatePaymentSvc := template_payment_service.NewService(store, creatorImageSvc, businessCreatorImageSvc, paymentMethodSvc, paymentCommonSvc)
+	// BUSINESS (GENERATIVE)
+	busGenerateImageSvc := business_generate_image_service.NewService(store, *cfg, tokenLedgerSvc, googleGenAISvc, openaiSvc)
+	busGenerateCaptionSvc := business_generate_caption_service.NewService(store, busImageContentSvc, googleGenAISvc, openaiSvc)
+	socialPlatformSvc := social_platform_service.NewService(store)
+	// WORKER
+	mailerSvc := mailer.NewService(cfg)
+
+	return &Services{
+		ownedMw:                 ownedMw,
+		tokenSvc:                tokenSvc,
+		mailerSvc:               mailerSvc,
+		twoFactorSvc:            twoFactorSvc,
+		authSvc:                 authSvc,
+		sessSvc:                 sessSvc,
+		profSvc:                 profSvc,
+		googleSvc:               googleSvc,
+		providerSvc:             providerSvc,
+		busInSvc:                busInSvc,
+		busKnowledgeSvc:         busKnowledgeSvc,
+		busRoleSvc:              busRoleSvc,
+		busProductSvc:           busProductSvc,
+		busImageContentSvc:      busImageContentSvc,
+		busMemberSvc:            busMemberSvc,
+		imageUploaderSvc:        imageUploaderSvc,
+		rssSvc:                  rssSvc,
+		rssSubscriptionSvc:      rssSubscriptionSvc,
+		busAuditEventSvc:        busAuditEventSvc,
+		timezoneSvc:             timezoneSvc,
+		busTimezonePrefSvc:      busTimezonePrefSvc,
+		busSocialAccountSvc:     busSocialAccountSvc,
+		busScheduledPostSvc:     busScheduledPostSvc,
+		catCreatorImageSvc:      catCreatorImageSvc,
+		referralRuleSvc:         referralRuleSvc,
+		exchangeRateSvc:         exchangeRateSvc,
+		tokenProductSvc:         tokenProductSvc,
+		paymentMethodSvc:        paymentMethodSvc,
+		generativeImageModelSvc: generativeImageModelSvc,
+		generativeTextModelSvc:  generativeTextModelSvc,
+		socialPlatformSvc:       socialPlatformSvc,
+		referralBasicSvc:        referralBasicSvc,
+		referralSpecialSvc:      referralSpecialSvc,
+		affiliatorWalletSvc:     affiliatorWalletSvc,
+		creatorImageSvc:         creatorImageSvc,
+		businessCreatorImageSvc: businessCreatorImageSvc,
+		creatorEarningSvc:       creatorEarningSvc,
+		tokenLedgerSvc:          tokenLedgerSvc,
+		paymentCommonSvc:        paymentCommonSvc,
+		tokenPaymentSvc:         tokenPaymentSvc,
+		templatePaymentSvc:      templatePaymentSvc,
+		busGenerateImageSvc:     busGenerateImageSvc,
+		busGenerateCaptionSvc:   busGenerateCaptionSvc,
+	}
+}
+
+// RegisterWorker mendaftarkan handler task asynq yang dieksekusi service di atas.
+func (s *Services) RegisterWorker(w *queue.Worker) {
+	w.RegisterMailer(s.mailerSvc)
+	w.RegisterScheduledPost(s.busScheduledPostSvc)
+	w.RegisterPaymentReconcile(s.paymentCommonSvc)
+}
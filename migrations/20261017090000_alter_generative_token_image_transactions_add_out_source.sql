@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- track from where token type 'out' (generate image)
+ALTER TABLE generative_token_image_transactions
+ADD COLUMN IF NOT EXISTS generative_image_model_id BIGINT;
+
+ALTER TABLE generative_token_image_transactions
+ADD CONSTRAINT fk_generative_token_image_transactions_generative_image_model_id
+FOREIGN KEY (generative_image_model_id)
+REFERENCES app_generative_image_models(id);
+
+CREATE INDEX IF NOT EXISTS idx_generative_token_image_transactions_business_root_id
+ON generative_token_image_transactions(business_root_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_generative_token_image_transactions_business_root_id;
+
+ALTER TABLE generative_token_image_transactions
+DROP CONSTRAINT IF EXISTS fk_generative_token_image_transactions_generative_image_model_id;
+
+ALTER TABLE generative_token_image_transactions
+DROP COLUMN IF EXISTS generative_image_model_id;
+-- +goose StatementEnd
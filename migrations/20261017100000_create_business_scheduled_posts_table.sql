@@ -0,0 +1,54 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE business_scheduled_post_status AS ENUM ('scheduled', 'publishing', 'published', 'failed', 'canceled');
+
+-- many to one with business_root_id
+-- many to one with business_image_content_id
+CREATE TABLE IF NOT EXISTS business_scheduled_posts (
+    id BIGSERIAL PRIMARY KEY,
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id) ON DELETE CASCADE,
+
+    business_image_content_id BIGINT NOT NULL,
+    FOREIGN KEY (business_image_content_id) REFERENCES business_image_contents (id) ON DELETE CASCADE,
+
+    -- target platform
+    platform social_platform_type NOT NULL,
+
+    -- waktu publish (disimpan UTC), timezone snapshot dari business_timezone_prefs saat dijadwalkan
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    timezone VARCHAR(255) NOT NULL,
+
+    status business_scheduled_post_status NOT NULL DEFAULT 'scheduled',
+    published_at TIMESTAMPTZ,
+    -- id post dari platform (setelah berhasil publish)
+    external_post_id VARCHAR(255),
+    failure_reason TEXT,
+
+    -- profile yang menjadwalkan
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_business_scheduled_posts_business_root_id_scheduled_at
+ON business_scheduled_posts (business_root_id, scheduled_at)
+WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trigger_business_scheduled_posts_updated_at
+BEFORE UPDATE ON business_scheduled_posts
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trigger_business_scheduled_posts_updated_at ON business_scheduled_posts;
+DROP INDEX IF EXISTS idx_business_scheduled_posts_business_root_id_scheduled_at;
+DROP TABLE IF EXISTS business_scheduled_posts;
+DROP TYPE IF EXISTS business_scheduled_post_status;
+-- +goose StatementEnd
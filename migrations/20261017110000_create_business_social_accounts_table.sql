@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE business_social_account_status AS ENUM ('connected', 'expired', 'disconnected');
+
+-- 1 akun per platform per business
+CREATE TABLE IF NOT EXISTS business_social_accounts (
+    id BIGSERIAL PRIMARY KEY,
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id) ON DELETE CASCADE,
+
+    platform social_platform_type NOT NULL,
+
+    -- informasi akun dari platform
+    external_account_id VARCHAR(255) NOT NULL,
+    account_name VARCHAR(255) NOT NULL,
+    account_image_url TEXT,
+
+    -- credential OAuth (terenkripsi AES-GCM, base64)
+    access_token_encrypted TEXT NOT NULL,
+    refresh_token_encrypted TEXT,
+    token_expires_at TIMESTAMPTZ,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    last_refreshed_at TIMESTAMPTZ,
+
+    status business_social_account_status NOT NULL DEFAULT 'connected',
+
+    -- profile yang menghubungkan akun
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX business_social_accounts_business_root_id_platform_key
+ON business_social_accounts (business_root_id, platform)
+WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trigger_business_social_accounts_updated_at
+BEFORE UPDATE ON business_social_accounts
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trigger_business_social_accounts_updated_at ON business_social_accounts;
+DROP INDEX IF EXISTS business_social_accounts_business_root_id_platform_key;
+DROP TABLE IF EXISTS business_social_accounts;
+DROP TYPE IF EXISTS business_social_account_status;
+-- +goose StatementEnd
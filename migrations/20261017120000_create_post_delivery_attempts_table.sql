@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE post_delivery_attempt_status AS ENUM ('succeeded', 'failed');
+
+-- many to one with business_scheduled_post_id
+-- satu row per percobaan publish (retry = row baru)
+CREATE TABLE IF NOT EXISTS post_delivery_attempts (
+    id BIGSERIAL PRIMARY KEY,
+
+    business_scheduled_post_id BIGINT NOT NULL,
+    FOREIGN KEY (business_scheduled_post_id) REFERENCES business_scheduled_posts (id) ON DELETE CASCADE,
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id) ON DELETE CASCADE,
+
+    platform social_platform_type NOT NULL,
+
+    -- urutan percobaan (mulai dari 1)
+    attempt_number INT NOT NULL,
+    status post_delivery_attempt_status NOT NULL,
+    -- true jika percobaan ini menentukan status akhir post (berhasil / retry habis / error permanen)
+    is_final BOOLEAN NOT NULL DEFAULT FALSE,
+
+    -- response dari platform
+    response_code INT,
+    response_body TEXT,
+    external_post_id VARCHAR(255),
+    error_message TEXT,
+
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_post_delivery_attempts_business_scheduled_post_id
+ON post_delivery_attempts (business_scheduled_post_id);
+
+CREATE TRIGGER trigger_post_delivery_attempts_updated_at
+BEFORE UPDATE ON post_delivery_attempts
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trigger_post_delivery_attempts_updated_at ON post_delivery_attempts;
+DROP INDEX IF EXISTS idx_post_delivery_attempts_business_scheduled_post_id;
+DROP TABLE IF EXISTS post_delivery_attempts;
+DROP TYPE IF EXISTS post_delivery_attempt_status;
+-- +goose StatementEnd
@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+-- many to one with business_root_id
+-- satu row per mutasi pada resource business (knowledge, product, member, rss subscription, ...)
+CREATE TABLE IF NOT EXISTS business_audit_events (
+    id BIGSERIAL PRIMARY KEY,
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id) ON DELETE CASCADE,
+
+    -- actioner
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    -- resource yang berubah (id disimpan sebagai text agar bisa bigint / uuid)
+    entity_type VARCHAR(50) NOT NULL,
+    entity_id VARCHAR(64) NOT NULL,
+    action action_change_type NOT NULL,
+
+    -- diff: hanya field yang berubah (create: before NULL, delete: after NULL)
+    before JSONB,
+    after JSONB,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_business_audit_events_business_root_id_created_at
+ON business_audit_events (business_root_id, created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_business_audit_events_entity
+ON business_audit_events (entity_type, entity_id);
+
+CREATE TRIGGER trigger_business_audit_events_updated_at
+BEFORE UPDATE ON business_audit_events
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trigger_business_audit_events_updated_at ON business_audit_events;
+DROP INDEX IF EXISTS idx_business_audit_events_entity;
+DROP INDEX IF EXISTS idx_business_audit_events_business_root_id_created_at;
+DROP TABLE IF EXISTS business_audit_events;
+-- +goose StatementEnd
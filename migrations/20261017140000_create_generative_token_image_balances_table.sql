@@ -0,0 +1,95 @@
+-- +goose Up
+-- +goose StatementBegin
+-- snapshot saldo token image per business (one to one with business_root_id)
+-- available = total_in - total_out - reserved, dijaga tidak pernah negatif oleh CHECK constraint
+CREATE TABLE IF NOT EXISTS generative_token_image_balances (
+    id BIGSERIAL PRIMARY KEY,
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id),
+
+    total_in BIGINT NOT NULL DEFAULT 0,
+    total_out BIGINT NOT NULL DEFAULT 0,
+    -- token yang sedang ditahan (generate sedang berjalan)
+    reserved BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+
+    CONSTRAINT generative_token_image_balances_non_negative CHECK (
+        total_in >= 0
+        AND total_out >= 0
+        AND reserved >= 0
+        AND total_in - total_out - reserved >= 0
+    )
+);
+
+CREATE UNIQUE INDEX generative_token_image_balances_business_root_id_key
+ON generative_token_image_balances (business_root_id);
+
+CREATE TRIGGER trigger_generative_token_image_balances_updated_at
+BEFORE UPDATE ON generative_token_image_balances
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- backfill dari transaksi yang sudah ada
+INSERT INTO generative_token_image_balances (business_root_id, total_in, total_out)
+SELECT
+    business_root_id,
+    COALESCE(SUM(amount) FILTER (WHERE type = 'in'), 0),
+    COALESCE(SUM(amount) FILTER (WHERE type = 'out'), 0)
+FROM generative_token_image_transactions
+WHERE deleted_at IS NULL
+GROUP BY business_root_id;
+
+CREATE TYPE token_reservation_status AS ENUM ('reserved', 'committed', 'released');
+
+-- many to one with business_root_id
+-- reserve sebelum memanggil provider, commit jika berhasil (jadi transaksi 'out'), release jika gagal
+CREATE TABLE IF NOT EXISTS generative_token_image_reservations (
+    id BIGSERIAL PRIMARY KEY,
+    status token_reservation_status NOT NULL DEFAULT 'reserved',
+    amount BIGINT NOT NULL CHECK (amount > 0),
+
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id),
+
+    generative_image_model_id BIGINT,
+    FOREIGN KEY (generative_image_model_id) REFERENCES app_generative_image_models (id),
+
+    -- terisi saat commit
+    generative_token_image_transaction_id BIGINT,
+    FOREIGN KEY (generative_token_image_transaction_id) REFERENCES generative_token_image_transactions (id),
+
+    -- reservation yang lewat expires_at masih 'reserved' akan di-release oleh reconcile
+    expires_at TIMESTAMPTZ NOT NULL,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_generative_token_image_reservations_status_expires_at
+ON generative_token_image_reservations (status, expires_at);
+
+CREATE TRIGGER trigger_generative_token_image_reservations_updated_at
+BEFORE UPDATE ON generative_token_image_reservations
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER IF EXISTS trigger_generative_token_image_reservations_updated_at ON generative_token_image_reservations;
+DROP INDEX IF EXISTS idx_generative_token_image_reservations_status_expires_at;
+DROP TABLE IF EXISTS generative_token_image_reservations;
+DROP TYPE IF EXISTS token_reservation_status;
+
+DROP TRIGGER IF EXISTS trigger_generative_token_image_balances_updated_at ON generative_token_image_balances;
+DROP INDEX IF EXISTS generative_token_image_balances_business_root_id_key;
+DROP TABLE IF EXISTS generative_token_image_balances;
+-- +goose StatementEnd
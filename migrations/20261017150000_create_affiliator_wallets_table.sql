@@ -0,0 +1,157 @@
+-- +goose Up
+-- +goose StatementBegin
+-- referral record bisa di-refund setelah success (reward di-clawback)
+ALTER TYPE referral_record_status ADD VALUE IF NOT EXISTS 'refunded';
+
+-- saldo reward affiliator (owner referral code), satu wallet per profile
+CREATE TABLE IF NOT EXISTS affiliator_wallets (
+    id BIGSERIAL PRIMARY KEY,
+
+    profile_id UUID NOT NULL UNIQUE,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    -- saat ini hanya IDR
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+
+    -- total reward yang pernah masuk (payment success)
+    total_earned BIGINT NOT NULL DEFAULT 0,
+    -- total reward yang ditarik kembali (payment refunded)
+    total_clawed_back BIGINT NOT NULL DEFAULT 0,
+    -- total payout yang sudah di-approve admin
+    total_withdrawn BIGINT NOT NULL DEFAULT 0,
+    -- saldo yang ditahan oleh payout request berstatus pending
+    on_hold BIGINT NOT NULL DEFAULT 0,
+    -- available = total_earned - total_clawed_back - total_withdrawn - on_hold
+    -- boleh negatif jika clawback terjadi setelah payout (dipotong dari reward berikutnya)
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+
+    CONSTRAINT chk_affiliator_wallets_non_negative CHECK (
+        total_earned >= 0 AND total_clawed_back >= 0 AND total_withdrawn >= 0 AND on_hold >= 0
+    )
+);
+CREATE TRIGGER trigger_affiliator_wallets_updated_at
+BEFORE UPDATE ON affiliator_wallets
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+CREATE TYPE affiliator_payout_status AS ENUM ('pending', 'approved', 'rejected', 'canceled');
+
+CREATE TABLE IF NOT EXISTS affiliator_payout_requests (
+    id BIGSERIAL PRIMARY KEY,
+
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+
+    -- DENORMALIZED FOR RECORD (rekening tujuan saat request dibuat)
+    bank_name VARCHAR(100) NOT NULL,
+    bank_account_number VARCHAR(50) NOT NULL,
+    bank_account_name VARCHAR(255) NOT NULL,
+
+    status affiliator_payout_status NOT NULL DEFAULT 'pending',
+    -- catatan admin saat approve/reject (ex: nomor referensi transfer / alasan reject)
+    admin_note TEXT,
+    reviewed_by_profile_id UUID,
+    FOREIGN KEY (reviewed_by_profile_id) REFERENCES profiles (id),
+    reviewed_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_affiliator_payout_requests_updated_at
+BEFORE UPDATE ON affiliator_payout_requests
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+CREATE INDEX IF NOT EXISTS idx_affiliator_payout_requests_status
+ON affiliator_payout_requests (status, created_at)
+WHERE deleted_at IS NULL;
+
+-- history setiap perubahan status payout request
+CREATE TABLE IF NOT EXISTS affiliator_payout_request_histories (
+    id BIGSERIAL PRIMARY KEY,
+
+    affiliator_payout_request_id BIGINT NOT NULL,
+    FOREIGN KEY (affiliator_payout_request_id) REFERENCES affiliator_payout_requests (id),
+
+    status affiliator_payout_status NOT NULL,
+    note TEXT,
+    -- profile yang melakukan perubahan (affiliator atau admin)
+    actor_profile_id UUID NOT NULL,
+    FOREIGN KEY (actor_profile_id) REFERENCES profiles (id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_affiliator_payout_request_histories_updated_at
+BEFORE UPDATE ON affiliator_payout_request_histories
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- ledger wallet affiliator
+-- reward   : +, payment dengan referral code success
+-- clawback : -, payment yang sudah memberi reward di-refund
+-- payout   : -, payout request di-approve admin
+CREATE TYPE affiliator_wallet_transaction_type AS ENUM ('reward', 'clawback', 'payout');
+
+CREATE TABLE IF NOT EXISTS affiliator_wallet_transactions (
+    id BIGSERIAL PRIMARY KEY,
+
+    affiliator_wallet_id BIGINT NOT NULL,
+    FOREIGN KEY (affiliator_wallet_id) REFERENCES affiliator_wallets (id),
+
+    type affiliator_wallet_transaction_type NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+
+    -- terisi untuk reward & clawback
+    referral_record_id BIGINT,
+    FOREIGN KEY (referral_record_id) REFERENCES referral_records (id),
+    -- terisi untuk payout
+    affiliator_payout_request_id BIGINT,
+    FOREIGN KEY (affiliator_payout_request_id) REFERENCES affiliator_payout_requests (id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_affiliator_wallet_transactions_updated_at
+BEFORE UPDATE ON affiliator_wallet_transactions
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- idempotent: satu referral record hanya bisa sekali reward & sekali clawback
+CREATE UNIQUE INDEX IF NOT EXISTS uq_affiliator_wallet_transactions_referral_record
+ON affiliator_wallet_transactions (referral_record_id, type)
+WHERE referral_record_id IS NOT NULL AND deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_affiliator_wallet_transactions_payout_request
+ON affiliator_wallet_transactions (affiliator_payout_request_id)
+WHERE affiliator_payout_request_id IS NOT NULL AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_affiliator_wallet_transactions_payout_request;
+DROP INDEX IF EXISTS uq_affiliator_wallet_transactions_referral_record;
+DROP TRIGGER IF EXISTS trigger_affiliator_wallet_transactions_updated_at ON affiliator_wallet_transactions;
+DROP TABLE IF EXISTS affiliator_wallet_transactions;
+DROP TYPE IF EXISTS affiliator_wallet_transaction_type;
+DROP TRIGGER IF EXISTS trigger_affiliator_payout_request_histories_updated_at ON affiliator_payout_request_histories;
+DROP TABLE IF EXISTS affiliator_payout_request_histories;
+DROP INDEX IF EXISTS idx_affiliator_payout_requests_status;
+DROP TRIGGER IF EXISTS trigger_affiliator_payout_requests_updated_at ON affiliator_payout_requests;
+DROP TABLE IF EXISTS affiliator_payout_requests;
+DROP TYPE IF EXISTS affiliator_payout_status;
+DROP TRIGGER IF EXISTS trigger_affiliator_wallets_updated_at ON affiliator_wallets;
+DROP TABLE IF EXISTS affiliator_wallets;
+-- enum value 'refunded' pada referral_record_status tidak bisa di-drop (postgres)
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+-- special referral code (campaign) yang dibuat admin
+ALTER TABLE profile_referral_codes
+    -- window berlaku code, null = tanpa batas
+    ADD COLUMN starts_at TIMESTAMPTZ,
+    ADD COLUMN ends_at TIMESTAMPTZ,
+    -- subset dari payment_product_type, kosong = semua product
+    ADD COLUMN allowed_product_types TEXT[] NOT NULL DEFAULT '{}',
+    -- admin yang membuat special code (null untuk basic)
+    ADD COLUMN created_by_profile_id UUID,
+    ADD CONSTRAINT fk_profile_referral_codes_created_by FOREIGN KEY (created_by_profile_id) REFERENCES profiles (id),
+    ADD CONSTRAINT chk_profile_referral_codes_window CHECK (
+        starts_at IS NULL OR ends_at IS NULL OR ends_at > starts_at
+    );
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE profile_referral_codes
+    DROP CONSTRAINT IF EXISTS chk_profile_referral_codes_window,
+    DROP CONSTRAINT IF EXISTS fk_profile_referral_codes_created_by,
+    DROP COLUMN IF EXISTS created_by_profile_id,
+    DROP COLUMN IF EXISTS allowed_product_types,
+    DROP COLUMN IF EXISTS ends_at,
+    DROP COLUMN IF EXISTS starts_at;
+-- +goose StatementEnd
@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- total nominal yang sudah di-refund (kumulatif, partial refund bisa lebih dari sekali)
+ALTER TABLE payment_histories
+    ADD COLUMN IF NOT EXISTS refunded_amount BIGINT NOT NULL DEFAULT 0;
+
+CREATE TYPE payment_refund_source AS ENUM ('admin', 'webhook');
+
+-- many to one with payment_histories
+-- satu row per refund yang diproses (admin request atau notifikasi midtrans)
+CREATE TABLE IF NOT EXISTS payment_history_refunds (
+    id BIGSERIAL PRIMARY KEY,
+
+    payment_history_id UUID NOT NULL,
+    FOREIGN KEY (payment_history_id) REFERENCES payment_histories (id),
+
+    source payment_refund_source NOT NULL,
+    -- refund_key yang dikirim ke midtrans (null untuk refund dari dashboard midtrans)
+    refund_key VARCHAR(100) UNIQUE,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL,
+    reason TEXT,
+
+    -- token yang ditarik kembali (bisa lebih kecil dari seharusnya jika token sudah terpakai)
+    token_amount_expected BIGINT NOT NULL DEFAULT 0,
+    token_amount_clawed_back BIGINT NOT NULL DEFAULT 0,
+    generative_token_image_transaction_id BIGINT,
+    FOREIGN KEY (generative_token_image_transaction_id) REFERENCES generative_token_image_transactions (id),
+
+    -- admin yang melakukan refund (null jika dari webhook)
+    actor_profile_id UUID,
+    FOREIGN KEY (actor_profile_id) REFERENCES profiles (id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_payment_history_refunds_updated_at
+BEFORE UPDATE ON payment_history_refunds
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+CREATE INDEX IF NOT EXISTS idx_payment_history_refunds_payment_history_id
+ON payment_history_refunds (payment_history_id)
+WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_history_refunds_payment_history_id;
+DROP TRIGGER IF EXISTS trigger_payment_history_refunds_updated_at ON payment_history_refunds;
+DROP TABLE IF EXISTS payment_history_refunds;
+DROP TYPE IF EXISTS payment_refund_source;
+ALTER TABLE payment_histories DROP COLUMN IF EXISTS refunded_amount;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- dipakai job reconcile payment pending (scan status pending berdasarkan created_at)
+CREATE INDEX IF NOT EXISTS idx_payment_histories_pending_created_at
+ON payment_histories (created_at)
+WHERE status = 'pending' AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_histories_pending_created_at;
+-- +goose StatementEnd
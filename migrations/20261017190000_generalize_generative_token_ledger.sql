@@ -0,0 +1,87 @@
+-- +goose Up
+-- +goose StatementBegin
+-- ledger token dipakai semua token_type (image, video, livestream), bukan hanya image
+ALTER TABLE generative_token_image_transactions RENAME TO generative_token_transactions;
+ALTER TABLE generative_token_image_balances RENAME TO generative_token_balances;
+ALTER TABLE generative_token_image_reservations RENAME TO generative_token_reservations;
+
+ALTER TRIGGER trigger_generative_token_image_transactions_updated_at ON generative_token_transactions
+RENAME TO trigger_generative_token_transactions_updated_at;
+ALTER TRIGGER trigger_generative_token_image_balances_updated_at ON generative_token_balances
+RENAME TO trigger_generative_token_balances_updated_at;
+ALTER TRIGGER trigger_generative_token_image_reservations_updated_at ON generative_token_reservations
+RENAME TO trigger_generative_token_reservations_updated_at;
+
+ALTER INDEX idx_generative_token_image_transactions_business_root_id
+RENAME TO idx_generative_token_transactions_business_root_id;
+ALTER INDEX idx_generative_token_image_reservations_status_expires_at
+RENAME TO idx_generative_token_reservations_status_expires_at;
+ALTER TABLE generative_token_balances
+RENAME CONSTRAINT generative_token_image_balances_non_negative TO generative_token_balances_non_negative;
+
+ALTER TABLE generative_token_reservations
+RENAME COLUMN generative_token_image_transaction_id TO generative_token_transaction_id;
+ALTER TABLE payment_history_refunds
+RENAME COLUMN generative_token_image_transaction_id TO generative_token_transaction_id;
+
+-- token_type: data lama seluruhnya image_token
+ALTER TABLE generative_token_transactions
+ADD COLUMN IF NOT EXISTS token_type token_type NOT NULL DEFAULT 'image_token';
+ALTER TABLE generative_token_transactions ALTER COLUMN token_type DROP DEFAULT;
+
+ALTER TABLE generative_token_reservations
+ADD COLUMN IF NOT EXISTS token_type token_type NOT NULL DEFAULT 'image_token';
+ALTER TABLE generative_token_reservations ALTER COLUMN token_type DROP DEFAULT;
+
+-- saldo per (business, token_type)
+ALTER TABLE generative_token_balances
+ADD COLUMN IF NOT EXISTS token_type token_type NOT NULL DEFAULT 'image_token';
+ALTER TABLE generative_token_balances ALTER COLUMN token_type DROP DEFAULT;
+
+DROP INDEX IF EXISTS generative_token_image_balances_business_root_id_key;
+CREATE UNIQUE INDEX generative_token_balances_business_root_id_token_type_key
+ON generative_token_balances (business_root_id, token_type);
+
+DROP INDEX IF EXISTS idx_generative_token_transactions_business_root_id;
+CREATE INDEX IF NOT EXISTS idx_generative_token_transactions_business_root_id_token_type
+ON generative_token_transactions (business_root_id, token_type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+-- hanya bisa di-rollback jika belum ada transaksi selain image_token
+DROP INDEX IF EXISTS idx_generative_token_transactions_business_root_id_token_type;
+CREATE INDEX IF NOT EXISTS idx_generative_token_transactions_business_root_id
+ON generative_token_transactions (business_root_id);
+
+DROP INDEX IF EXISTS generative_token_balances_business_root_id_token_type_key;
+CREATE UNIQUE INDEX generative_token_image_balances_business_root_id_key
+ON generative_token_balances (business_root_id);
+
+ALTER TABLE generative_token_balances DROP COLUMN IF EXISTS token_type;
+ALTER TABLE generative_token_reservations DROP COLUMN IF EXISTS token_type;
+ALTER TABLE generative_token_transactions DROP COLUMN IF EXISTS token_type;
+
+ALTER TABLE payment_history_refunds
+RENAME COLUMN generative_token_transaction_id TO generative_token_image_transaction_id;
+ALTER TABLE generative_token_reservations
+RENAME COLUMN generative_token_transaction_id TO generative_token_image_transaction_id;
+
+ALTER TABLE generative_token_balances
+RENAME CONSTRAINT generative_token_balances_non_negative TO generative_token_image_balances_non_negative;
+ALTER INDEX idx_generative_token_reservations_status_expires_at
+RENAME TO idx_generative_token_image_reservations_status_expires_at;
+ALTER INDEX idx_generative_token_transactions_business_root_id
+RENAME TO idx_generative_token_image_transactions_business_root_id;
+
+ALTER TRIGGER trigger_generative_token_reservations_updated_at ON generative_token_reservations
+RENAME TO trigger_generative_token_image_reservations_updated_at;
+ALTER TRIGGER trigger_generative_token_balances_updated_at ON generative_token_balances
+RENAME TO trigger_generative_token_image_balances_updated_at;
+ALTER TRIGGER trigger_generative_token_transactions_updated_at ON generative_token_transactions
+RENAME TO trigger_generative_token_image_transactions_updated_at;
+
+ALTER TABLE generative_token_reservations RENAME TO generative_token_image_reservations;
+ALTER TABLE generative_token_balances RENAME TO generative_token_image_balances;
+ALTER TABLE generative_token_transactions RENAME TO generative_token_image_transactions;
+-- +goose StatementEnd
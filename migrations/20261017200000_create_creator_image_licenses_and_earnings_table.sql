@@ -0,0 +1,115 @@
+-- +goose Up
+-- +goose StatementBegin
+-- pembelian template creator image berbayar
+ALTER TYPE payment_product_type ADD VALUE IF NOT EXISTS 'creator_image';
+
+-- reference_product_id (uuid) hanya untuk app_token_products, creator image pakai id bigint
+ALTER TABLE payment_histories
+    ALTER COLUMN reference_product_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS reference_creator_image_id BIGINT,
+    ADD CONSTRAINT fk_payment_histories_reference_creator_image
+        FOREIGN KEY (reference_creator_image_id) REFERENCES creator_images (id);
+
+-- active  : payment success, business boleh memakai template
+-- revoked : payment di-refund
+CREATE TYPE creator_image_license_status AS ENUM ('active', 'revoked');
+
+-- lisensi template per business, satu row per payment
+CREATE TABLE IF NOT EXISTS business_creator_image_licenses (
+    id BIGSERIAL PRIMARY KEY,
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id),
+
+    creator_image_id BIGINT NOT NULL,
+    FOREIGN KEY (creator_image_id) REFERENCES creator_images (id),
+
+    payment_history_id UUID NOT NULL UNIQUE,
+    FOREIGN KEY (payment_history_id) REFERENCES payment_histories (id),
+
+    -- profile yang membeli
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    status creator_image_license_status NOT NULL DEFAULT 'active',
+    revoked_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_business_creator_image_licenses_updated_at
+BEFORE UPDATE ON business_creator_image_licenses
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- 1 business hanya punya 1 lisensi aktif untuk creator image yang sama
+CREATE UNIQUE INDEX IF NOT EXISTS uq_business_creator_image_licenses_active
+ON business_creator_image_licenses (business_root_id, creator_image_id)
+WHERE status = 'active' AND deleted_at IS NULL;
+
+-- ledger pendapatan creator
+-- sale     : +, payment template success (net setelah komisi platform)
+-- clawback : -, payment template di-refund
+CREATE TYPE creator_earning_transaction_type AS ENUM ('sale', 'clawback');
+
+CREATE TABLE IF NOT EXISTS creator_earning_transactions (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- creator (owner creator image)
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id),
+
+    type creator_earning_transaction_type NOT NULL,
+
+    creator_image_id BIGINT NOT NULL,
+    FOREIGN KEY (creator_image_id) REFERENCES creator_images (id),
+    payment_history_id UUID NOT NULL,
+    FOREIGN KEY (payment_history_id) REFERENCES payment_histories (id),
+
+    -- DENORMALIZED FOR RECORD
+    -- gross = harga item setelah diskon (tanpa admin fee & tax)
+    gross_amount BIGINT NOT NULL CHECK (gross_amount >= 0),
+    commission_percentage INT NOT NULL CHECK (commission_percentage BETWEEN 0 AND 100),
+    commission_amount BIGINT NOT NULL CHECK (commission_amount >= 0),
+    -- net = gross - commission (yang masuk / ditarik dari creator)
+    net_amount BIGINT NOT NULL CHECK (net_amount >= 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_creator_earning_transactions_updated_at
+BEFORE UPDATE ON creator_earning_transactions
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- idempotent: satu payment hanya bisa sekali sale & sekali clawback
+CREATE UNIQUE INDEX IF NOT EXISTS uq_creator_earning_transactions_payment
+ON creator_earning_transactions (payment_history_id, type)
+WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_creator_earning_transactions_profile
+ON creator_earning_transactions (profile_id, created_at)
+WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_creator_earning_transactions_profile;
+DROP INDEX IF EXISTS uq_creator_earning_transactions_payment;
+DROP TRIGGER IF EXISTS trigger_creator_earning_transactions_updated_at ON creator_earning_transactions;
+DROP TABLE IF EXISTS creator_earning_transactions;
+DROP TYPE IF EXISTS creator_earning_transaction_type;
+DROP INDEX IF EXISTS uq_business_creator_image_licenses_active;
+DROP TRIGGER IF EXISTS trigger_business_creator_image_licenses_updated_at ON business_creator_image_licenses;
+DROP TABLE IF EXISTS business_creator_image_licenses;
+DROP TYPE IF EXISTS creator_image_license_status;
+ALTER TABLE payment_histories
+    DROP CONSTRAINT IF EXISTS fk_payment_histories_reference_creator_image,
+    DROP COLUMN IF EXISTS reference_creator_image_id;
+-- reference_product_id tidak dikembalikan ke NOT NULL jika sudah ada payment creator image
+-- enum value 'creator_image' pada payment_product_type tidak bisa di-drop (postgres)
+-- +goose StatementEnd
@@ -0,0 +1,62 @@
+-- +goose Up
+-- +goose StatementBegin
+-- pending  : baru dibuat / dipublish / diubah creator, menunggu review admin
+-- approved : sudah direview admin
+-- banned   : diblokir admin (is_banned = TRUE)
+CREATE TYPE creator_image_moderation_status AS ENUM ('pending', 'approved', 'banned');
+
+-- data lama sudah tampil, anggap sudah direview (default 'approved' saat add column, lalu default 'pending')
+ALTER TABLE creator_images
+    ADD COLUMN IF NOT EXISTS moderation_status creator_image_moderation_status NOT NULL DEFAULT 'approved',
+    ADD COLUMN IF NOT EXISTS moderated_at TIMESTAMPTZ;
+UPDATE creator_images SET moderation_status = 'banned' WHERE is_banned = TRUE;
+ALTER TABLE creator_images ALTER COLUMN moderation_status SET DEFAULT 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_creator_images_moderation_queue
+ON creator_images (moderation_status, updated_at)
+WHERE deleted_at IS NULL AND is_published = TRUE;
+
+CREATE TYPE creator_image_moderation_action AS ENUM ('approve', 'ban', 'unban');
+
+-- riwayat moderasi creator image (append only)
+CREATE TABLE IF NOT EXISTS creator_image_moderations (
+    id BIGSERIAL PRIMARY KEY,
+
+    creator_image_id BIGINT NOT NULL,
+    FOREIGN KEY (creator_image_id) REFERENCES creator_images (id),
+
+    action creator_image_moderation_action NOT NULL,
+    previous_status creator_image_moderation_status NOT NULL,
+    reason TEXT,
+
+    -- admin yang melakukan moderasi
+    moderator_profile_id UUID NOT NULL,
+    FOREIGN KEY (moderator_profile_id) REFERENCES profiles (id),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ
+);
+CREATE TRIGGER trigger_creator_image_moderations_updated_at
+BEFORE UPDATE ON creator_image_moderations
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+CREATE INDEX IF NOT EXISTS idx_creator_image_moderations_creator_image
+ON creator_image_moderations (creator_image_id, created_at)
+WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_creator_image_moderations_creator_image;
+DROP TRIGGER IF EXISTS trigger_creator_image_moderations_updated_at ON creator_image_moderations;
+DROP TABLE IF EXISTS creator_image_moderations;
+DROP TYPE IF EXISTS creator_image_moderation_action;
+DROP INDEX IF EXISTS idx_creator_images_moderation_queue;
+ALTER TABLE creator_images
+    DROP COLUMN IF EXISTS moderated_at,
+    DROP COLUMN IF EXISTS moderation_status;
+DROP TYPE IF EXISTS creator_image_moderation_status;
+-- +goose StatementEnd
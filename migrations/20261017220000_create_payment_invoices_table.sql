@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+-- counter nomor invoice per tahun (row di-lock saat increment agar nomor berurutan tanpa duplikat)
+CREATE TABLE IF NOT EXISTS payment_invoice_sequences (
+    year INT PRIMARY KEY,
+    last_sequence INT NOT NULL DEFAULT 0 CHECK (last_sequence >= 0),
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TRIGGER trigger_payment_invoice_sequences_updated_at
+BEFORE UPDATE ON payment_invoice_sequences
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- invoice untuk payment success, satu invoice per payment
+CREATE TABLE IF NOT EXISTS payment_invoices (
+    id BIGSERIAL PRIMARY KEY,
+
+    payment_history_id UUID NOT NULL UNIQUE,
+    FOREIGN KEY (payment_history_id) REFERENCES payment_histories (id),
+
+    business_root_id BIGINT NOT NULL,
+    FOREIGN KEY (business_root_id) REFERENCES business_roots (id),
+
+    -- ex: INV-2026-000001
+    invoice_number VARCHAR(50) NOT NULL UNIQUE,
+    invoice_year INT NOT NULL,
+    invoice_sequence INT NOT NULL,
+
+    -- DENORMALIZED FOR RECORD (bill to saat invoice diterbitkan)
+    bill_to_name VARCHAR(255) NOT NULL,
+    bill_to_email VARCHAR(255) NOT NULL,
+    bill_to_business_name VARCHAR(255),
+
+    -- object key file pdf di s3
+    object_key VARCHAR(255) NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+
+    UNIQUE (invoice_year, invoice_sequence)
+);
+CREATE TRIGGER trigger_payment_invoices_updated_at
+BEFORE UPDATE ON payment_invoices
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+CREATE INDEX IF NOT EXISTS idx_payment_invoices_business
+ON payment_invoices (business_root_id, issued_at)
+WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_invoices_business;
+DROP TRIGGER IF EXISTS trigger_payment_invoices_updated_at ON payment_invoices;
+DROP TABLE IF EXISTS payment_invoices;
+DROP TRIGGER IF EXISTS trigger_payment_invoice_sequences_updated_at ON payment_invoice_sequences;
+DROP TABLE IF EXISTS payment_invoice_sequences;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- midtrans : payment gateway production (Midtrans Core API)
+-- fake     : gateway lokal untuk development / testing (status diubah lewat webhook simulasi)
+CREATE TYPE payment_gateway_type AS ENUM ('midtrans', 'fake');
+
+-- gateway yang memproses payment method (code payment method tetap unik lintas gateway)
+ALTER TABLE app_payment_methods
+    ADD COLUMN IF NOT EXISTS gateway payment_gateway_type NOT NULL DEFAULT 'midtrans';
+
+ALTER TABLE app_payment_method_changes
+    ADD COLUMN IF NOT EXISTS before_gateway payment_gateway_type NOT NULL DEFAULT 'midtrans',
+    ADD COLUMN IF NOT EXISTS after_gateway payment_gateway_type NOT NULL DEFAULT 'midtrans';
+
+-- DENORMALIZED FOR RECORD: gateway saat payment dibuat (status, cancel, refund & webhook memakai gateway ini)
+-- midtrans_transaction_id & midtrans_expired_at menyimpan transaction id & batas bayar dari gateway manapun
+ALTER TABLE payment_histories
+    ADD COLUMN IF NOT EXISTS gateway payment_gateway_type NOT NULL DEFAULT 'midtrans';
+
+CREATE INDEX IF NOT EXISTS idx_payment_histories_gateway_transaction
+ON payment_histories (gateway, midtrans_transaction_id)
+WHERE deleted_at IS NULL;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_histories_gateway_transaction;
+ALTER TABLE payment_histories DROP COLUMN IF EXISTS gateway;
+ALTER TABLE app_payment_method_changes
+    DROP COLUMN IF EXISTS before_gateway,
+    DROP COLUMN IF EXISTS after_gateway;
+ALTER TABLE app_payment_methods DROP COLUMN IF EXISTS gateway;
+DROP TYPE IF EXISTS payment_gateway_type;
+-- +goose StatementEnd
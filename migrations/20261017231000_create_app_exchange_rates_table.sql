@@ -0,0 +1,77 @@
+-- +goose Up
+-- +goose StatementBegin
+-- rounding hasil konversi ke kelipatan rounding_increment (dalam satuan utuh quote currency)
+CREATE TYPE exchange_rate_rounding_mode AS ENUM ('up', 'down', 'nearest');
+
+-- Kurs admin-managed: 1 quote_currency = rate_micros / 1.000.000 base_currency
+-- Rate berlaku mulai effective_at sampai ada rate lain (pair yang sama) dengan effective_at lebih baru.
+-- Rate yang sudah berlaku tidak boleh diubah / dihapus (dipakai sebagai record payment), koreksi = buat rate baru.
+CREATE TABLE IF NOT EXISTS app_exchange_rates (
+    id BIGSERIAL PRIMARY KEY,
+
+    -- ex: IDR (currency harga app_token_products & fee payment method)
+    base_currency VARCHAR(3) NOT NULL,
+    -- ex: USD (currency pembeli)
+    quote_currency VARCHAR(3) NOT NULL,
+    -- ex: 1 USD = 16.250,5 IDR -> 16250500000
+    rate_micros BIGINT NOT NULL,
+
+    -- rounding rules per quote currency
+    rounding_mode exchange_rate_rounding_mode NOT NULL DEFAULT 'up',
+    rounding_increment BIGINT NOT NULL DEFAULT 1,
+
+    effective_at TIMESTAMPTZ NOT NULL,
+
+    -- actioner
+    created_by_profile_id UUID NOT NULL,
+    FOREIGN KEY (created_by_profile_id) REFERENCES profiles (id) ON DELETE RESTRICT,
+    updated_by_profile_id UUID NOT NULL,
+    FOREIGN KEY (updated_by_profile_id) REFERENCES profiles (id) ON DELETE RESTRICT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    deleted_at TIMESTAMPTZ,
+
+    -- sanity checks
+    CONSTRAINT app_exchange_rates_rate_positive CHECK (rate_micros > 0),
+    CONSTRAINT app_exchange_rates_rounding_increment_positive CHECK (rounding_increment > 0),
+    CONSTRAINT app_exchange_rates_currency_len CHECK (char_length(base_currency) = 3 AND char_length(quote_currency) = 3),
+    CONSTRAINT app_exchange_rates_currency_upper CHECK (base_currency = UPPER(base_currency) AND quote_currency = UPPER(quote_currency)),
+    CONSTRAINT app_exchange_rates_currency_pair CHECK (base_currency <> quote_currency)
+);
+
+-- satu rate per pair per effective_at
+CREATE UNIQUE INDEX app_exchange_rates_pair_effective_key
+ON app_exchange_rates (base_currency, quote_currency, effective_at)
+WHERE deleted_at IS NULL;
+
+CREATE TRIGGER trigger_app_exchange_rates_updated_at
+BEFORE UPDATE ON app_exchange_rates
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- DENORMALIZED FOR RECORD: kurs yang dipakai saat checkout (NULL = tanpa konversi)
+-- seluruh nominal payment tetap dalam payment_histories.currency
+ALTER TABLE payment_histories
+    ADD COLUMN IF NOT EXISTS exchange_rate_id BIGINT,
+    ADD COLUMN IF NOT EXISTS exchange_base_currency VARCHAR(3),
+    ADD COLUMN IF NOT EXISTS exchange_rate_micros BIGINT,
+    ADD CONSTRAINT fk_payment_histories_exchange_rate
+        FOREIGN KEY (exchange_rate_id) REFERENCES app_exchange_rates (id) ON DELETE RESTRICT;
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE payment_histories
+    DROP CONSTRAINT IF EXISTS fk_payment_histories_exchange_rate,
+    DROP COLUMN IF EXISTS exchange_rate_id,
+    DROP COLUMN IF EXISTS exchange_base_currency,
+    DROP COLUMN IF EXISTS exchange_rate_micros;
+
+DROP TRIGGER IF EXISTS trigger_app_exchange_rates_updated_at ON app_exchange_rates;
+DROP INDEX IF EXISTS app_exchange_rates_pair_effective_key;
+DROP TABLE IF EXISTS app_exchange_rates;
+
+DROP TYPE IF EXISTS exchange_rate_rounding_mode;
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+-- TOTP 2FA per profile (berlaku untuk semua provider login: credential & google)
+-- Row dibuat saat enroll (enabled_at NULL), aktif setelah kode pertama dikonfirmasi.
+CREATE TABLE IF NOT EXISTS profile_two_factors (
+    profile_id UUID PRIMARY KEY,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+
+    -- base32 TOTP secret, dienkripsi AES-GCM (TWO_FACTOR_SECRET)
+    secret_encrypted TEXT NOT NULL,
+
+    -- time step terakhir yang dipakai (anti replay kode yang sama)
+    last_used_step BIGINT,
+
+    enabled_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TRIGGER trigger_profile_two_factors_updated_at
+BEFORE UPDATE ON profile_two_factors
+FOR EACH ROW
+EXECUTE FUNCTION set_updated_at();
+
+-- Recovery code sekali pakai, hanya hash yang disimpan (SHA-256)
+CREATE TABLE IF NOT EXISTS profile_two_factor_recovery_codes (
+    id BIGSERIAL PRIMARY KEY,
+
+    profile_id UUID NOT NULL,
+    FOREIGN KEY (profile_id) REFERENCES profiles (id) ON DELETE CASCADE,
+
+    code_hash VARCHAR(64) NOT NULL,
+    used_at TIMESTAMPTZ,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX profile_two_factor_recovery_codes_profile_hash_key
+ON profile_two_factor_recovery_codes (profile_id, code_hash);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS profile_two_factor_recovery_codes_profile_hash_key;
+DROP TABLE IF EXISTS profile_two_factor_recovery_codes;
+
+DROP TRIGGER IF EXISTS trigger_profile_two_factors_updated_at ON profile_two_factors;
+DROP TABLE IF EXISTS profile_two_factors;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- dipakai job sweep scheduled post yang tertahan di status publishing (scan berdasarkan updated_at)
+CREATE INDEX IF NOT EXISTS idx_business_scheduled_posts_publishing_updated_at
+ON business_scheduled_posts (updated_at)
+WHERE status = 'publishing' AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_business_scheduled_posts_publishing_updated_at;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- refund admin dicatat 'pending' (di bawah lock payment) sebelum memanggil gateway,
+-- sehingga refund bersamaan tidak melebihi sisa nominal; 'succeeded' setelah tercatat, 'failed' jika gateway menolak
+CREATE TYPE payment_refund_status AS ENUM ('pending', 'succeeded', 'failed');
+
+ALTER TABLE payment_history_refunds
+    ADD COLUMN IF NOT EXISTS status payment_refund_status NOT NULL DEFAULT 'succeeded';
+
+CREATE INDEX IF NOT EXISTS idx_payment_history_refunds_pending
+ON payment_history_refunds (payment_history_id)
+WHERE status = 'pending' AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_payment_history_refunds_pending;
+ALTER TABLE payment_history_refunds DROP COLUMN IF EXISTS status;
+DROP TYPE IF EXISTS payment_refund_status;
+-- +goose StatementEnd
@@ -0,0 +1,280 @@
+// pkg/pdf/pdf.go
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// Renderer PDF minimal (pure Go, tanpa dependency) untuk dokumen sederhana seperti invoice:
+// teks dengan font standar Helvetica, garis dan kotak berwarna abu-abu.
+// Koordinat dalam point (1/72 inch) dengan titik (0,0) di pojok kiri atas halaman.
+
+const (
+	// A4 portrait
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+type Font int
+
+const (
+	FontRegular Font = iota
+	FontBold
+)
+
+// resource name & base font (standard 14 fonts, tidak perlu embed)
+var fontNames = map[Font][2]string{
+	FontRegular: {"F1", "Helvetica"},
+	FontBold:    {"F2", "Helvetica-Bold"},
+}
+
+type Document struct {
+	title string
+	pages []*Page
+}
+
+type Page struct {
+	content bytes.Buffer
+}
+
+func New(title string) *Document {
+	return &Document{title: title}
+}
+
+func (d *Document) AddPage() *Page {
+	p := &Page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// Text menulis teks dengan baseline di posisi (x, y)
+func (p *Page) Text(x, y float64, font Font, size float64, text string) {
+	fmt.Fprintf(&p.content, "BT /%s %s Tf %s %s Td (%s) Tj ET\n",
+		fontNames[font][0], num(size), num(x), num(PageHeight-y), escape(text))
+}
+
+// TextRight menulis teks rata kanan dengan ujung kanan di x
+func (p *Page) TextRight(x, y float64, font Font, size float64, text string) {
+	p.Text(x-TextWidth(font, size, text), y, font, size, text)
+}
+
+// Line menggambar garis hitam dengan ketebalan width
+func (p *Page) Line(x1, y1, x2, y2, width float64) {
+	fmt.Fprintf(&p.content, "%s w 0 G %s %s m %s %s l S\n",
+		num(width), num(x1), num(PageHeight-y1), num(x2), num(PageHeight-y2))
+}
+
+// FillRect mengisi kotak dengan warna abu-abu (0 = hitam, 1 = putih), (x, y) adalah pojok kiri atas
+func (p *Page) FillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f 0 g\n",
+		num(gray), num(x), num(PageHeight-y-h), num(w), num(h))
+}
+
+// TextWidth menghitung lebar teks (point) berdasarkan metric font standar
+func TextWidth(font Font, size float64, text string) float64 {
+	total := 0
+	for _, r := range text {
+		total += glyphWidth(font, winAnsiByte(r))
+	}
+	return float64(total) * size / 1000
+}
+
+// ClipText memotong teks agar muat di maxWidth, diakhiri "..." jika terpotong
+func ClipText(font Font, size, maxWidth float64, text string) string {
+	if TextWidth(font, size, text) <= maxWidth {
+		return text
+	}
+
+	const ellipsis = "..."
+	runes := []rune(text)
+	for len(runes) > 0 {
+		runes = runes[:len(runes)-1]
+		clipped := strings.TrimRight(string(runes), " ") + ellipsis
+		if TextWidth(font, size, clipped) <= maxWidth {
+			return clipped
+		}
+	}
+	return ""
+}
+
+// WrapText memecah teks per kata menjadi beberapa baris selebar maksimal maxWidth,
+// kata yang lebih panjang dari maxWidth dipotong per karakter
+func WrapText(font Font, size, maxWidth float64, text string) []string {
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if TextWidth(font, size, candidate) <= maxWidth {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+			line = ""
+		}
+		// kata tunggal yang tetap tidak muat
+		for TextWidth(font, size, word) > maxWidth {
+			runes := []rune(word)
+			n := 1
+			for n < len(runes) && TextWidth(font, size, string(runes[:n+1])) <= maxWidth {
+				n++
+			}
+			lines = append(lines, string(runes[:n]))
+			word = string(runes[n:])
+		}
+		line = word
+	}
+	if line != "" || len(lines) == 0 {
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Bytes menghasilkan file PDF lengkap (header, object, xref, trailer)
+func (d *Document) Bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+
+	writeObj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// object layout: 1 catalog, 2 pages, 3-4 font, 5 info, lalu (page, content) per halaman
+	const firstPageObj = 6
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObj+i*2)
+	}
+
+	writeObj("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	writeObj(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", fontNames[FontRegular][1]))
+	writeObj(fmt.Sprintf("<< /Type /Font /Subtype /Type1 /BaseFont /%s /Encoding /WinAnsiEncoding >>", fontNames[FontBold][1]))
+	writeObj(fmt.Sprintf("<< /Title (%s) /Producer (postmatic-api) >>", escape(d.title)))
+
+	for i, p := range d.pages {
+		writeObj(fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+			num(PageWidth), num(PageHeight), firstPageObj+i*2+1,
+		))
+		writeObj(fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", p.content.Len(), p.content.String()))
+	}
+
+	xrefOffset := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xrefOffset)
+
+	return buf.Bytes()
+}
+
+// escape string literal PDF dalam encoding WinAnsi (font memakai /WinAnsiEncoding),
+// karakter di luar WinAnsi diganti '?' dan byte non-ASCII ditulis sebagai octal
+func escape(text string) string {
+	var b strings.Builder
+	for _, r := range text {
+		c := winAnsiByte(r)
+		switch {
+		case c == '\\' || c == '(' || c == ')':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 32 && c <= 126:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "\\%03o", c)
+		}
+	}
+	return b.String()
+}
+
+// winAnsiByte memetakan rune ke byte WinAnsiEncoding (CP1252): ASCII printable & Latin-1 (0xA0-0xFF)
+// sama dengan code point-nya, 0x80-0x9F lewat tabel, selain itu '?'
+func winAnsiByte(r rune) byte {
+	switch {
+	case r >= 32 && r <= 126, r >= 0xA0 && r <= 0xFF:
+		return byte(r)
+	}
+	if c, ok := winAnsiExtra[r]; ok {
+		return c
+	}
+	return '?'
+}
+
+func glyphWidth(font Font, c byte) int {
+	if c < 128 {
+		if font == FontBold {
+			return helveticaBoldWidths[c-32]
+		}
+		return helveticaWidths[c-32]
+	}
+	if font == FontBold {
+		return helveticaBoldHighWidths[c-128]
+	}
+	return helveticaHighWidths[c-128]
+}
+
+func num(f float64) string {
+	s := fmt.Sprintf("%.2f", f)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
+
+// glyph width (1/1000 em) karakter ASCII 32-126, dari AFM Adobe Helvetica
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556,
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556,
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556,
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584,
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611,
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778,
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556,
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611,
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584,
+}
+
+// karakter WinAnsiEncoding di range 0x80-0x9F (berbeda dengan Latin-1)
+var winAnsiExtra = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88,
+	'‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B,
+	'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// glyph width (1/1000 em) byte WinAnsi 128-255, dari AFM Adobe Helvetica (0 = tidak terpakai)
+var helveticaHighWidths = [128]int{
+	556, 0, 222, 556, 333, 1000, 556, 556, 333, 1000, 667, 333, 1000, 0, 611, 0,
+	0, 222, 222, 333, 333, 350, 556, 1000, 333, 1000, 500, 333, 944, 0, 500, 667,
+	278, 333, 556, 556, 556, 556, 260, 556, 333, 737, 370, 556, 584, 333, 737, 333,
+	400, 584, 333, 333, 333, 556, 537, 278, 333, 333, 365, 556, 834, 834, 834, 611,
+	667, 667, 667, 667, 667, 667, 1000, 722, 667, 667, 667, 667, 278, 278, 278, 278,
+	722, 722, 778, 778, 778, 778, 778, 584, 778, 722, 722, 722, 722, 667, 667, 611,
+	556, 556, 556, 556, 556, 556, 889, 500, 556, 556, 556, 556, 278, 278, 278, 278,
+	556, 556, 556, 556, 556, 556, 556, 584, 611, 556, 556, 556, 556, 500, 556, 500,
+}
+
+var helveticaBoldHighWidths = [128]int{
+	556, 0, 278, 556, 500, 1000, 556, 556, 333, 1000, 667, 333, 1000, 0, 611, 0,
+	0, 278, 278, 500, 500, 350, 556, 1000, 333, 1000, 556, 333, 944, 0, 500, 667,
+	278, 333, 556, 556, 556, 556, 280, 556, 333, 737, 370, 556, 584, 333, 737, 333,
+	400, 584, 333, 333, 333, 611, 556, 278, 333, 333, 365, 556, 834, 834, 834, 611,
+	722, 722, 722, 722, 722, 722, 1000, 722, 667, 667, 667, 667, 278, 278, 278, 278,
+	722, 722, 778, 778, 778, 778, 778, 584, 778, 722, 722, 722, 722, 667, 667, 611,
+	556, 556, 556, 556, 556, 556, 889, 556, 556, 556, 556, 556, 278, 278, 278, 278,
+	611, 611, 611, 611, 611, 611, 611, 584, 611, 611, 611, 611, 611, 556, 611, 556,
+}
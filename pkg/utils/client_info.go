@@ -1,6 +1,7 @@
 package utils
 
 import (
+	"fmt"
 	"net"
 	"net/http"
 	"strings"
@@ -44,34 +45,85 @@ func ExtractClientInfo(r *http.Request) ClientInfo {
 	}
 }
 
-// Helper untuk mendapatkan Real IP (menangani X-Forwarded-For)
+// trustedProxies reverse proxy yang header forwarded-nya dipercaya, diisi sekali saat startup (SetTrustedProxies)
+var trustedProxies []*net.IPNet
+
+// SetTrustedProxies mengatur daftar IP / CIDR reverse proxy (config TRUSTED_PROXIES)
+func SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, p := range proxies {
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy %q", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy %q: %w", p, err)
+		}
+		nets = append(nets, n)
+	}
+	trustedProxies = nets
+	return nil
+}
+
+func isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return false
+	}
+	for _, n := range trustedProxies {
+		if n.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Helper untuk mendapatkan Real IP.
+// Header forwarded (Cloudflare / X-Forwarded-For / X-Real-IP) bisa diisi bebas oleh client,
+// jadi hanya dibaca jika request datang langsung dari trusted proxy.
 func getClientIP(r *http.Request) string {
+	remoteIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteIP = r.RemoteAddr
+	}
+	if !isTrustedProxy(remoteIP) {
+		return remoteIP
+	}
+
 	// 1. Cek Header Cloudflare
-	cfIP := r.Header.Get("CF-Connecting-IP")
-	if cfIP != "" {
+	if cfIP := strings.TrimSpace(r.Header.Get("CF-Connecting-IP")); net.ParseIP(cfIP) != nil {
 		return cfIP
 	}
 
 	// 2. Cek X-Forwarded-For (Standard Proxy)
-	// Format: client, proxy1, proxy2
-	xForwardedFor := r.Header.Get("X-Forwarded-For")
-	if xForwardedFor != "" {
+	// Format: client, proxy1, proxy2 -> dibaca dari kanan, IP pertama yang bukan trusted proxy adalah client
+	if xForwardedFor := r.Header.Get("X-Forwarded-For"); xForwardedFor != "" {
 		ips := strings.Split(xForwardedFor, ",")
-		if len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
+		for i := len(ips) - 1; i >= 0; i-- {
+			ip := strings.TrimSpace(ips[i])
+			if net.ParseIP(ip) == nil {
+				break
+			}
+			if i == 0 || !isTrustedProxy(ip) {
+				return ip
+			}
 		}
 	}
 
 	// 3. Cek X-Real-IP
-	xRealIP := r.Header.Get("X-Real-IP")
-	if xRealIP != "" {
+	if xRealIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); net.ParseIP(xRealIP) != nil {
 		return xRealIP
 	}
 
-	// 4. Fallback ke RemoteAddr
-	ip, _, err := net.SplitHostPort(r.RemoteAddr)
-	if err != nil {
-		return r.RemoteAddr
-	}
-	return ip
+	// 4. Fallback ke RemoteAddr (proxy itu sendiri)
+	return remoteIP
 }